@@ -89,12 +89,13 @@ func (d *Decoder) Decode() (value cadence.Value, err error) {
 }
 
 const (
-	typeKey   = "type"
-	valueKey  = "value"
-	keyKey    = "key"
-	nameKey   = "name"
-	fieldsKey = "fields"
-	idKey     = "id"
+	typeKey       = "type"
+	valueKey      = "value"
+	keyKey        = "key"
+	nameKey       = "name"
+	fieldsKey     = "fields"
+	idKey         = "id"
+	staticTypeKey = "staticType"
 )
 
 var ErrInvalidJSONCadence = errors.New("invalid JSON Cadence structure")
@@ -175,6 +176,10 @@ func decodeJSON(v interface{}) cadence.Value {
 		return decodeStruct(valueJSON)
 	case eventTypeStr:
 		return decodeEvent(valueJSON)
+	case enumTypeStr:
+		return decodeEnum(valueJSON)
+	case typeTypeStr:
+		return decodeTypeValue(valueJSON)
 	}
 
 	panic(ErrInvalidJSONCadence)
@@ -560,6 +565,35 @@ func decodeEvent(valueJSON interface{}) cadence.Event {
 	})
 }
 
+const enumRawValueFieldName = "rawValue"
+
+func decodeEnum(valueJSON interface{}) cadence.Enum {
+	comp := decodeComposite(valueJSON)
+
+	// the raw type of the enum is the type of the raw value field
+
+	var rawType cadence.Type
+	for _, field := range comp.fieldTypes {
+		if field.Identifier == enumRawValueFieldName {
+			rawType = field.Type
+			break
+		}
+	}
+
+	return cadence.NewEnum(comp.fieldValues).WithType(cadence.EnumType{
+		TypeID:     comp.typeID,
+		Identifier: comp.identifier,
+		RawType:    rawType,
+		Fields:     comp.fieldTypes,
+	})
+}
+
+func decodeTypeValue(valueJSON interface{}) cadence.TypeValue {
+	obj := toObject(valueJSON)
+
+	return cadence.NewTypeValue(obj.GetString(staticTypeKey))
+}
+
 // JSON types
 
 type jsonObject map[string]interface{}
@@ -118,6 +118,10 @@ type jsonCompositeField struct {
 	Value jsonValue `json:"value"`
 }
 
+type jsonTypeValue struct {
+	StaticType string `json:"staticType"`
+}
+
 const (
 	voidTypeStr       = "Void"
 	optionalTypeStr   = "Optional"
@@ -149,6 +153,8 @@ const (
 	structTypeStr     = "Struct"
 	resourceTypeStr   = "Resource"
 	eventTypeStr      = "Event"
+	enumTypeStr       = "Enum"
+	typeTypeStr       = "Type"
 )
 
 // prepare traverses the object graph of the provided value and constructs
@@ -215,6 +221,10 @@ func (e *Encoder) prepare(v cadence.Value) jsonValue {
 		return e.prepareResource(x)
 	case cadence.Event:
 		return e.prepareEvent(x)
+	case cadence.Enum:
+		return e.prepareEnum(x)
+	case cadence.TypeValue:
+		return e.prepareTypeValue(x)
 	default:
 		return fmt.Errorf("unsupported value: %T, %v", v, v)
 	}
@@ -439,6 +449,10 @@ func (e *Encoder) prepareEvent(v cadence.Event) jsonValue {
 	return e.prepareComposite(eventTypeStr, v.EventType.ID(), v.EventType.Fields, v.Fields)
 }
 
+func (e *Encoder) prepareEnum(v cadence.Enum) jsonValue {
+	return e.prepareComposite(enumTypeStr, v.EnumType.ID(), v.EnumType.Fields, v.Fields)
+}
+
 func (e *Encoder) prepareComposite(kind, id string, fieldTypes []cadence.Field, fields []cadence.Value) jsonValue {
 	if len(fieldTypes) != len(fields) {
 		panic(fmt.Errorf("%s value does not contain fields compatible with declared type", kind))
@@ -464,6 +478,15 @@ func (e *Encoder) prepareComposite(kind, id string, fieldTypes []cadence.Field,
 	}
 }
 
+func (e *Encoder) prepareTypeValue(v cadence.TypeValue) jsonValue {
+	return jsonValueObject{
+		Type: typeTypeStr,
+		Value: jsonTypeValue{
+			StaticType: v.StaticType,
+		},
+	}
+}
+
 func encodeBytes(v []byte) string {
 	return fmt.Sprintf("0x%x", v)
 }
@@ -763,11 +763,69 @@ func TestEncodeEvent(t *testing.T) {
 	testAllEncode(t, simpleEvent, resourceEvent)
 }
 
+func TestEncodeEnum(t *testing.T) {
+	enumType := cadence.EnumType{
+		TypeID:     "test.FooEnum",
+		Identifier: "FooEnum",
+		RawType:    cadence.UInt8Type{},
+		Fields: []cadence.Field{
+			{
+				Identifier: "rawValue",
+				Type:       cadence.UInt8Type{},
+			},
+		},
+	}
+
+	simpleEnum := encodeTest{
+		"Simple",
+		cadence.NewEnum(
+			[]cadence.Value{
+				cadence.NewUInt8(1),
+			},
+		).WithType(enumType),
+		`{"type":"Enum","value":{"id":"test.FooEnum","fields":[{"name":"rawValue","value":{"type":"UInt8","value":"1"}}]}}`,
+	}
+
+	testAllEncode(t, simpleEnum)
+}
+
+func TestEncodeType(t *testing.T) {
+
+	t.Run("primitive", func(t *testing.T) {
+
+		testEncode(
+			t,
+			cadence.NewTypeValue("Int"),
+			`{"type":"Type","value":{"staticType":"Int"}}`,
+		)
+	})
+
+	t.Run("from script", func(t *testing.T) {
+
+		script := `
+          pub resource Foo {}
+
+          pub fun main(): [Type] {
+              return [Type<@Foo>(), Type<[Int]>()]
+          }
+        `
+
+		actual := convertValueFromScript(t, script)
+
+		testEncode(
+			t,
+			actual,
+			`{"type":"Array","value":[{"type":"Type","value":{"staticType":"test.Foo"}},{"type":"Type","value":{"staticType":"[Int]"}}]}`,
+		)
+	})
+}
+
 func convertValueFromScript(t *testing.T, script string) cadence.Value {
 	rt := runtime.NewInterpreterRuntime()
 
 	value, err := rt.ExecuteScript(
 		[]byte(script),
+		nil,
 		&runtime.EmptyRuntimeInterface{},
 		runtime.StringLocation("test"),
 	)
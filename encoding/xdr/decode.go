@@ -125,6 +125,8 @@ func (d *Decoder) Decode(t cadence.Type) (cadence.Value, error) {
 		return d.DecodeStruct(x)
 	case cadence.EventType:
 		return d.DecodeEvent(x)
+	case cadence.EnumType:
+		return d.DecodeEnum(x)
 
 	default:
 		return nil, fmt.Errorf("unsupported type: %T", t)
@@ -678,6 +680,21 @@ func (d *Decoder) DecodeEvent(t cadence.EventType) (v cadence.Event, err error)
 	}, nil
 }
 
+// DecodeEnum reads the XDR-encoded representation of an enum value.
+//
+// An enum is encoded as a fixed-length array of its field values.
+func (d *Decoder) DecodeEnum(t cadence.EnumType) (v cadence.Enum, err error) {
+	fields, err := d.decodeComposite(t.Fields)
+	if err != nil {
+		return v, err
+	}
+
+	return cadence.Enum{
+		EnumType: t,
+		Fields:   fields,
+	}, nil
+}
+
 // decodeComposite reads the XDR-encoded representation of a composite value.
 //
 // A composite is encoded as a fixed-length array of its field values.
@@ -122,6 +122,8 @@ func (e *Encoder) Encode(v cadence.Value) error {
 		return e.EncodeResource(x)
 	case cadence.Event:
 		return e.EncodeEvent(x)
+	case cadence.Enum:
+		return e.EncodeEnum(x)
 	default:
 		return fmt.Errorf("unsupported value: %T, %v", v, v)
 	}
@@ -520,6 +522,10 @@ func (e *Encoder) EncodeEvent(v cadence.Event) error {
 	return e.encodeComposite(v.Fields)
 }
 
+func (e *Encoder) EncodeEnum(v cadence.Enum) error {
+	return e.encodeComposite(v.Fields)
+}
+
 // encodeComposite writes the XDR-encoded representation of a composite value.
 //
 // A composite is encoded as a fixed-length array of its field values.
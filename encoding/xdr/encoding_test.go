@@ -609,6 +609,30 @@ func TestEncodeEvent(t *testing.T) {
 	testAllEncode(t, simpleEvent, resourceEvent)
 }
 
+func TestEncodeEnum(t *testing.T) {
+	enumType := cadence.EnumType{
+		RawType: cadence.UInt8Type{},
+		Fields: []cadence.Field{
+			{
+				Identifier: "rawValue",
+				Type:       cadence.UInt8Type{},
+			},
+		},
+	}
+
+	simpleEnum := encodeTest{
+		"Simple",
+		enumType,
+		cadence.NewEnum(
+			[]cadence.Value{
+				cadence.NewUInt8(1),
+			},
+		).WithType(enumType),
+	}
+
+	testAllEncode(t, simpleEnum)
+}
+
 func testAllEncode(t *testing.T, tests ...encodeTest) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -32,6 +32,10 @@ type Config struct {
 
 	// The root account key information.
 	RootAccountKey AccountPrivateKey
+
+	// Whether deployments, transactions, and scripts are executed locally,
+	// using an in-memory ledger, instead of being submitted to the emulator.
+	Offline bool
 }
 
 type AccountPrivateKey struct {
@@ -43,6 +47,8 @@ type AccountPrivateKey struct {
 // FromInitializationOptions creates a new config instance from the
 // initialization options field passed from the client at startup.
 //
+// In offline mode, the emulator address and the root account key are not required.
+//
 // Returns an error if any fields are missing or malformed.
 func FromInitializationOptions(opts interface{}) (conf Config, err error) {
 	optsMap, ok := opts.(map[string]interface{})
@@ -50,6 +56,12 @@ func FromInitializationOptions(opts interface{}) (conf Config, err error) {
 		return Config{}, errors.New("")
 	}
 
+	offline, _ := optsMap["offline"].(bool)
+	if offline {
+		conf.Offline = true
+		return
+	}
+
 	emulatorAddr, ok := optsMap["emulatorAddress"].(string)
 	if !ok {
 		return Config{}, errors.New("missing emulatorAddress field")
@@ -76,6 +76,69 @@ func (server *Server) handleSignatureHelp(req *json.RawMessage) (interface{}, er
 	return server.Handler.SignatureHelp(server.conn, &params)
 }
 
+func (server *Server) handleCompletion(req *json.RawMessage) (interface{}, error) {
+	var params CompletionParams
+	if err := json.Unmarshal(*req, &params); err != nil {
+		return nil, err
+	}
+
+	return server.Handler.Completion(server.conn, &params)
+}
+
+func (server *Server) handleReferences(req *json.RawMessage) (interface{}, error) {
+	var params ReferenceParams
+	if err := json.Unmarshal(*req, &params); err != nil {
+		return nil, err
+	}
+
+	return server.Handler.References(server.conn, &params)
+}
+
+func (server *Server) handleDocumentHighlight(req *json.RawMessage) (interface{}, error) {
+	var params TextDocumentPositionParams
+	if err := json.Unmarshal(*req, &params); err != nil {
+		return nil, err
+	}
+
+	return server.Handler.DocumentHighlight(server.conn, &params)
+}
+
+func (server *Server) handlePrepareRename(req *json.RawMessage) (interface{}, error) {
+	var params TextDocumentPositionParams
+	if err := json.Unmarshal(*req, &params); err != nil {
+		return nil, err
+	}
+
+	return server.Handler.PrepareRename(server.conn, &params)
+}
+
+func (server *Server) handleRename(req *json.RawMessage) (interface{}, error) {
+	var params RenameParams
+	if err := json.Unmarshal(*req, &params); err != nil {
+		return nil, err
+	}
+
+	return server.Handler.Rename(server.conn, &params)
+}
+
+func (server *Server) handleDocumentSymbol(req *json.RawMessage) (interface{}, error) {
+	var params DocumentSymbolParams
+	if err := json.Unmarshal(*req, &params); err != nil {
+		return nil, err
+	}
+
+	return server.Handler.DocumentSymbol(server.conn, &params)
+}
+
+func (server *Server) handleWorkspaceSymbol(req *json.RawMessage) (interface{}, error) {
+	var params WorkspaceSymbolParams
+	if err := json.Unmarshal(*req, &params); err != nil {
+		return nil, err
+	}
+
+	return server.Handler.WorkspaceSymbol(server.conn, &params)
+}
+
 func (server *Server) handleCodeLens(req *json.RawMessage) (interface{}, error) {
 	var params CodeLensParams
 	if err := json.Unmarshal(*req, &params); err != nil {
@@ -78,6 +78,13 @@ type Handler interface {
 	Hover(conn Conn, params *TextDocumentPositionParams) (*Hover, error)
 	Definition(conn Conn, params *TextDocumentPositionParams) (*Location, error)
 	SignatureHelp(conn Conn, params *TextDocumentPositionParams) (*SignatureHelp, error)
+	Completion(conn Conn, params *CompletionParams) ([]*CompletionItem, error)
+	References(conn Conn, params *ReferenceParams) ([]*Location, error)
+	DocumentHighlight(conn Conn, params *TextDocumentPositionParams) ([]*DocumentHighlight, error)
+	PrepareRename(conn Conn, params *TextDocumentPositionParams) (*PrepareRenameResult, error)
+	Rename(conn Conn, params *RenameParams) (*WorkspaceEdit, error)
+	DocumentSymbol(conn Conn, params *DocumentSymbolParams) ([]*DocumentSymbol, error)
+	WorkspaceSymbol(conn Conn, params *WorkspaceSymbolParams) ([]*SymbolInformation, error)
 	CodeLens(conn Conn, params *CodeLensParams) ([]*CodeLens, error)
 	ExecuteCommand(conn Conn, params *ExecuteCommandParams) (interface{}, error)
 	Shutdown(conn Conn) error
@@ -115,6 +122,27 @@ func NewServer(handler Handler) *Server {
 	jsonrpc2Server.Methods["textDocument/signatureHelp"] =
 		server.handleSignatureHelp
 
+	jsonrpc2Server.Methods["textDocument/completion"] =
+		server.handleCompletion
+
+	jsonrpc2Server.Methods["textDocument/references"] =
+		server.handleReferences
+
+	jsonrpc2Server.Methods["textDocument/documentHighlight"] =
+		server.handleDocumentHighlight
+
+	jsonrpc2Server.Methods["textDocument/prepareRename"] =
+		server.handlePrepareRename
+
+	jsonrpc2Server.Methods["textDocument/rename"] =
+		server.handleRename
+
+	jsonrpc2Server.Methods["textDocument/documentSymbol"] =
+		server.handleDocumentSymbol
+
+	jsonrpc2Server.Methods["workspace/symbol"] =
+		server.handleWorkspaceSymbol
+
 	jsonrpc2Server.Methods["textDocument/codeLens"] =
 		server.handleCodeLens
 
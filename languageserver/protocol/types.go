@@ -2151,6 +2151,19 @@ type RenameParams struct {
 	NewName string `json:"newName"`
 }
 
+/*PrepareRenameResult defined:
+ * The result of a prepare rename request:
+ * The range of the string to rename and a placeholder text of the string content to be renamed.
+ */
+type PrepareRenameResult struct {
+
+	// Range is
+	Range Range `json:"range"`
+
+	// Placeholder is
+	Placeholder string `json:"placeholder"`
+}
+
 /*RenameRegistrationOptions defined:
  * Rename registration options.
  */
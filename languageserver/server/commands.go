@@ -130,6 +130,10 @@ func (s *Server) submitTransaction(conn protocol.Conn, args ...interface{}) (int
 
 	script := []byte(doc.text)
 
+	if s.ledger != nil {
+		return nil, s.executeTransactionLocally(conn, uriToFilename(protocol.DocumentUri(uri)), script)
+	}
+
 	_, err := s.sendTransactionHelper(conn, script, true)
 	return nil, err
 }
@@ -159,6 +163,11 @@ func (s *Server) executeScript(conn protocol.Conn, args ...interface{}) (interfa
 	}
 
 	script := []byte(doc.text)
+
+	if s.ledger != nil {
+		return s.executeScriptLocally(conn, uriToFilename(protocol.DocumentUri(uri)), script)
+	}
+
 	res, err := s.flowClient.ExecuteScriptAtLatestBlock(context.Background(), script)
 	if err != nil {
 
@@ -254,6 +263,7 @@ func (s *Server) createAccount(conn protocol.Conn, args ...interface{}) (interfa
 // createDefaultAccounts creates a set of default accounts and returns their addresses.
 //
 // This command will wait until the emulator server is started before submitting any transactions.
+// In offline mode, the accounts are created in the in-memory ledger instead.
 func (s *Server) createDefaultAccounts(conn protocol.Conn, args ...interface{}) (interface{}, error) {
 	conn.LogMessage(&protocol.LogMessageParams{
 		Type:    protocol.Log,
@@ -277,17 +287,20 @@ func (s *Server) createDefaultAccounts(conn protocol.Conn, args ...interface{})
 		Message: fmt.Sprintf("Creating %d default accounts", count),
 	})
 
-	// Ping the emulator server for 30 seconds until it is available
-	timer := time.NewTimer(30 * time.Second)
-RetryLoop:
-	for {
-		select {
-		case <-timer.C:
-			return nil, errors.New("emulator server timed out")
-		default:
-			err := s.flowClient.Ping(context.Background())
-			if err == nil {
-				break RetryLoop
+	// Ping the emulator server for 30 seconds until it is available.
+	// In offline mode there is no emulator server to wait for
+	if s.ledger == nil {
+		timer := time.NewTimer(30 * time.Second)
+	RetryLoop:
+		for {
+			select {
+			case <-timer.C:
+				return nil, errors.New("emulator server timed out")
+			default:
+				err := s.flowClient.Ping(context.Background())
+				if err == nil {
+					break RetryLoop
+				}
 			}
 		}
 	}
@@ -340,6 +353,10 @@ func (s *Server) updateAccountCode(conn protocol.Conn, args ...interface{}) (int
 	accountCode := []byte(doc.text)
 	script := templates.UpdateAccountCode(accountCode)
 
+	if s.ledger != nil {
+		return nil, s.executeTransactionLocally(conn, uriToFilename(protocol.DocumentUri(uri)), script)
+	}
+
 	_, err := s.sendTransactionHelper(conn, script, true)
 	return nil, err
 }
@@ -418,6 +435,10 @@ func (s *Server) sendTransactionHelper(conn protocol.Conn, script []byte, author
 
 // createAccountHelper creates a new account and returns its address.
 func (s *Server) createAccountHelper(conn protocol.Conn) (addr flow.Address, err error) {
+	if s.ledger != nil {
+		return s.createAccountLocally(conn), nil
+	}
+
 	accountKey := &flow.AccountKey{
 		PublicKey: s.config.RootAccountKey.PrivateKey.PublicKey(),
 		SigAlgo:   s.config.RootAccountKey.SigAlgo,
@@ -0,0 +1,583 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+
+	"github.com/onflow/cadence/languageserver/protocol"
+)
+
+// keywords are the keywords offered as completion items
+// in positions where a statement or expression is expected.
+var keywords = []string{
+	"access", "account", "all", "as", "auth", "break", "case", "continue",
+	"contract", "create", "default", "destroy", "else", "emit", "enum",
+	"event", "execute", "false", "for", "from", "fun", "if", "import", "in",
+	"init", "interface", "let", "nil", "post", "pre", "prepare", "priv",
+	"pub", "resource", "return", "self", "set", "struct", "switch",
+	"transaction", "true", "var", "while",
+}
+
+// builtinMemberNames are the names of the members of the built-in types.
+//
+// The members of these types are not stored in a map,
+// so the names are needed to look the members up using `GetMember`.
+var builtinMemberNames = map[sema.Type][]string{
+	&sema.StringType{}: {
+		"concat", "slice", "decodeHex", "length",
+	},
+	&sema.AuthAccountType{}: {
+		"address", "contracts", "keys", "storageUsed", "storageCapacity",
+		"setCode", "addPublicKey", "removePublicKey",
+		"save", "load", "copy", "borrow", "link", "unlink",
+		"getCapability", "getLinkTarget",
+		"storagePaths", "publicPaths", "privatePaths", "forEachStored",
+	},
+	&sema.AuthAccountContractsType{}: {
+		"add", "update", "get", "remove",
+	},
+	&sema.DeployedContractType{}: {
+		"address", "name", "code",
+	},
+	&sema.PublicAccountType{}: {
+		"address", "keys", "storageUsed", "storageCapacity",
+		"getCapability", "getLinkTarget",
+	},
+	&sema.AccountKeyType{}: {
+		"keyIndex", "publicKey", "signatureAlgorithm", "hashAlgorithm",
+		"weight", "isRevoked",
+	},
+	&sema.AuthAccountKeysType{}: {
+		"add", "get", "revoke",
+	},
+	&sema.PublicAccountKeysType{}: {
+		"get",
+	},
+	&sema.MetaType{}: {
+		sema.MetaTypeIdentifierFieldName,
+		sema.MetaTypeIsSubtypeFunctionName,
+	},
+}
+
+var arrayMemberNames = []string{
+	"append", "concat", "insert", "remove", "removeFirst", "removeLast",
+	"contains", "length",
+}
+
+var dictionaryMemberNames = []string{
+	"length", "keys", "values", "insert", "remove",
+}
+
+var capabilityMemberNames = []string{
+	"borrow", "check",
+}
+
+// typeAnnotationPositionRegexps match the text before the cursor
+// in positions where a type is expected.
+var typeAnnotationPositionRegexps = []*regexp.Regexp{
+	// variable and field declarations, e.g. `let x: `
+	regexp.MustCompile(`\b(let|var)\s+[[:word:]]+\s*:\s*$`),
+	// parameters, e.g. `fun foo(a: ` or `fun foo(label a: `
+	regexp.MustCompile(`\bfun\b.*[(,]\s*([[:word:]]+\s+)?[[:word:]]+\s*:\s*$`),
+	// return types, e.g. `fun foo(): `
+	regexp.MustCompile(`\)\s*:\s*$`),
+	// type arguments, references, resource annotations, and casts
+	regexp.MustCompile(`(<|@|&|\bas[?!]?)\s*$`),
+}
+
+// Completion returns the completion items for the given cursor position.
+//
+// After a `.`, the members of the type of the accessed expression are offered.
+// In positions where a type is expected, the types which are in scope are offered.
+// Otherwise, the variables and functions which are in scope and keywords are offered.
+func (s *Server) Completion(
+	_ protocol.Conn,
+	params *protocol.CompletionParams,
+) ([]*protocol.CompletionItem, error) {
+
+	items := []*protocol.CompletionItem{}
+
+	uri := params.TextDocument.URI
+	checker, ok := s.checkers[uri]
+	if !ok {
+		return items, nil
+	}
+
+	doc, ok := s.documents[uri]
+	if !ok {
+		return items, nil
+	}
+
+	position := protocolToSemaPosition(params.Position)
+
+	// Remove the partially typed identifier, if any,
+	// the client filters the items based on it
+
+	prefix := strings.TrimRightFunc(
+		linePrefix(doc.text, params.Position),
+		isIdentifierRune,
+	)
+
+	if strings.HasSuffix(prefix, ".") {
+		receiver := strings.TrimSuffix(prefix[:len(prefix)-1], "?")
+		receiverPosition := sema.Position{
+			Line:   position.Line,
+			Column: len([]rune(receiver)) - 1,
+		}
+
+		receiverType := findExpressionType(checker, receiver, receiverPosition)
+		if receiverType == nil {
+			return items, nil
+		}
+
+		return memberCompletionItems(receiverType), nil
+	}
+
+	for _, typeAnnotationPositionRegexp := range typeAnnotationPositionRegexps {
+		if typeAnnotationPositionRegexp.MatchString(prefix) {
+			return typeCompletionItems(checker), nil
+		}
+	}
+
+	items = append(items, valueCompletionItems(checker, position)...)
+
+	for _, keyword := range keywords {
+		items = append(items, &protocol.CompletionItem{
+			Label: keyword,
+			Kind:  protocol.KeywordCompletion,
+		})
+	}
+
+	return items, nil
+}
+
+// findExpressionType returns the type of the expression which ends at the given position,
+// e.g. the expression which is accessed in a member access, or the invoked expression of an invocation.
+//
+// The type is determined from the occurrence at the position, the result of an invocation
+// which ends at the position, or by looking up the variable or member before the position.
+// The latter is necessary when the checker is stale, i.e. the document was changed,
+// but could not be parsed and checked yet.
+func findExpressionType(checker *sema.Checker, prefix string, position sema.Position) sema.Type {
+	if position.Column < 0 {
+		return nil
+	}
+
+	rest := strings.TrimRightFunc(prefix, isIdentifierRune)
+	identifier := prefix[len(rest):]
+
+	if identifier == "" {
+		if !strings.HasSuffix(prefix, ")") {
+			return nil
+		}
+
+		for invocation, returnType := range checker.Elaboration.InvocationExpressionReturnTypes {
+			endPosition := invocation.EndPosition()
+			if endPosition.Line == position.Line && endPosition.Column == position.Column {
+				return returnType
+			}
+		}
+
+		return nil
+	}
+
+	// Only use the occurrence if it is the occurrence of the identifier,
+	// as the occurrences might be stale
+
+	occurrence := checker.Occurrences.Find(position)
+	if occurrence != nil &&
+		occurrence.Origin != nil &&
+		occurrence.Origin.Type != nil &&
+		occurrence.EndPos == position &&
+		occurrence.StartPos.Column == position.Column-len([]rune(identifier))+1 {
+
+		return occurrence.Origin.Type
+	}
+
+	// If the identifier is accessed as a member,
+	// look up the member in the type of the accessed expression
+
+	if strings.HasSuffix(rest, ".") {
+		receiver := strings.TrimSuffix(rest[:len(rest)-1], "?")
+		receiverPosition := sema.Position{
+			Line:   position.Line,
+			Column: len([]rune(receiver)) - 1,
+		}
+
+		receiverType := findExpressionType(checker, receiver, receiverPosition)
+		if receiverType == nil {
+			return nil
+		}
+
+		memberAccessibleType, ok := unwrapOptionalType(receiverType).(sema.MemberAccessibleType)
+		if !ok || !memberAccessibleType.CanHaveMembers() {
+			return nil
+		}
+
+		member := memberAccessibleType.GetMember(identifier, ast.Range{}, func(error) {})
+		if member == nil {
+			member = sema.GetBuiltinMember(memberAccessibleType, identifier)
+		}
+		if member == nil {
+			return nil
+		}
+
+		return member.TypeAnnotation.Type
+	}
+
+	for _, variableRange := range checker.Ranges.FindAll(position) {
+		if variableRange.Identifier == identifier {
+			return variableRange.Type
+		}
+	}
+
+	if variable, ok := checker.ValueActivationVariables()[identifier]; ok {
+		return variable.Type
+	}
+
+	return nil
+}
+
+// unwrapOptionalType returns the type wrapped by the given optional type, if any.
+func unwrapOptionalType(ty sema.Type) sema.Type {
+	for {
+		optionalType, ok := ty.(*sema.OptionalType)
+		if !ok {
+			return ty
+		}
+		ty = optionalType.Type
+	}
+}
+
+// memberCompletionItems returns the completion items for the members of the given type.
+func memberCompletionItems(ty sema.Type) []*protocol.CompletionItem {
+
+	// Optional chaining accesses the members of the wrapped type
+
+	ty = unwrapOptionalType(ty)
+
+	items := []*protocol.CompletionItem{}
+
+	memberAccessibleType, ok := ty.(sema.MemberAccessibleType)
+	if !ok || !memberAccessibleType.CanHaveMembers() {
+		return items
+	}
+
+	names := append(memberNames(ty), sema.BuiltinMemberNames...)
+	sort.Strings(names)
+
+	seen := map[string]bool{}
+
+	for _, name := range names {
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		member := memberAccessibleType.GetMember(name, ast.Range{}, func(error) {})
+		if member == nil {
+			member = sema.GetBuiltinMember(ty, name)
+		}
+		if member == nil {
+			continue
+		}
+
+		memberType := member.TypeAnnotation.Type
+
+		kind := protocol.FieldCompletion
+		if member.DeclarationKind == common.DeclarationKindFunction {
+			kind = protocol.MethodCompletion
+		}
+
+		items = append(items, &protocol.CompletionItem{
+			Label:  name,
+			Kind:   kind,
+			Detail: declarationDetail(name, memberType),
+			Documentation: fmt.Sprintf(
+				"%s %s of type %s",
+				member.DeclarationKind.Name(),
+				name,
+				ty.QualifiedString(),
+			),
+		})
+	}
+
+	return items
+}
+
+// memberNames returns the names of the members of the given type.
+func memberNames(ty sema.Type) []string {
+	var members map[string]*sema.Member
+
+	switch ty := ty.(type) {
+	case *sema.CompositeType:
+		members = ty.Members
+
+	case *sema.InterfaceType:
+		members = ty.Members
+
+	case *sema.TransactionType:
+		members = ty.Members
+
+	case *sema.SpecialFunctionType:
+		members = ty.Members
+
+	case *sema.ReferenceType:
+		return memberNames(ty.Type)
+
+	case *sema.RestrictedType:
+		var names []string
+		for _, restriction := range ty.Restrictions {
+			names = append(names, memberNames(restriction)...)
+		}
+		return names
+
+	case sema.ArrayType:
+		return arrayMemberNames
+
+	case *sema.DictionaryType:
+		return dictionaryMemberNames
+
+	case *sema.CapabilityType:
+		return capabilityMemberNames
+
+	default:
+		for builtinType, names := range builtinMemberNames {
+			if builtinType.Equal(ty) {
+				return names
+			}
+		}
+		return nil
+	}
+
+	names := make([]string, 0, len(members))
+	for name := range members {
+		names = append(names, name)
+	}
+	return names
+}
+
+// typeCompletionItems returns the completion items for the types which are in scope.
+func typeCompletionItems(checker *sema.Checker) []*protocol.CompletionItem {
+	variables := checker.TypeActivationVariables()
+
+	items := make([]*protocol.CompletionItem, 0, len(variables))
+
+	for name, variable := range variables {
+		// The empty type name is used for the void type
+		if name == "" {
+			continue
+		}
+
+		items = append(items, &protocol.CompletionItem{
+			Label:  name,
+			Kind:   typeCompletionItemKind(variable.Type),
+			Detail: variable.Type.QualifiedString(),
+		})
+	}
+
+	return items
+}
+
+func typeCompletionItemKind(ty sema.Type) protocol.CompletionItemKind {
+	switch ty := ty.(type) {
+	case *sema.CompositeType:
+		return compositeCompletionItemKind(ty.Kind)
+
+	case *sema.InterfaceType:
+		return protocol.InterfaceCompletion
+
+	default:
+		return protocol.ClassCompletion
+	}
+}
+
+func compositeCompletionItemKind(compositeKind common.CompositeKind) protocol.CompletionItemKind {
+	switch compositeKind {
+	case common.CompositeKindStructure:
+		return protocol.StructCompletion
+
+	case common.CompositeKindContract:
+		return protocol.ModuleCompletion
+
+	case common.CompositeKindEvent:
+		return protocol.EventCompletion
+
+	case common.CompositeKindEnum:
+		return protocol.EnumCompletion
+
+	default:
+		return protocol.ClassCompletion
+	}
+}
+
+// valueCompletionItems returns the completion items for the variables and functions
+// which are in scope at the given position, including imported contracts.
+//
+// Local variables shadow global variables with the same name.
+func valueCompletionItems(checker *sema.Checker, position sema.Position) []*protocol.CompletionItem {
+	var items []*protocol.CompletionItem
+
+	seen := map[string]bool{}
+
+	for _, variableRange := range checker.Ranges.FindAll(position) {
+		name := variableRange.Identifier
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		items = append(items, &protocol.CompletionItem{
+			Label:         name,
+			Kind:          valueCompletionItemKind(variableRange.DeclarationKind, variableRange.Type),
+			Detail:        declarationDetail(name, variableRange.Type),
+			Documentation: fmt.Sprintf("local %s %s", variableRange.DeclarationKind.Name(), name),
+		})
+	}
+
+	for name, variable := range checker.ValueActivationVariables() {
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		documentation := fmt.Sprintf("%s %s", variable.DeclarationKind.Name(), name)
+		if variable.IsBaseValue {
+			documentation = fmt.Sprintf("built-in %s", documentation)
+		}
+
+		items = append(items, &protocol.CompletionItem{
+			Label:         name,
+			Kind:          valueCompletionItemKind(variable.DeclarationKind, variable.Type),
+			Detail:        declarationDetail(name, variable.Type),
+			Documentation: documentation,
+		})
+	}
+
+	return items
+}
+
+func valueCompletionItemKind(
+	declarationKind common.DeclarationKind,
+	ty sema.Type,
+) protocol.CompletionItemKind {
+
+	switch declarationKind {
+	case common.DeclarationKindFunction:
+		return protocol.FunctionCompletion
+
+	case common.DeclarationKindStructure,
+		common.DeclarationKindResource,
+		common.DeclarationKindContract,
+		common.DeclarationKindEvent,
+		common.DeclarationKindEnum:
+
+		return typeCompletionItemKind(ty)
+
+	default:
+		if compositeType, ok := ty.(*sema.CompositeType); ok &&
+			compositeType.Kind == common.CompositeKindContract {
+
+			return protocol.ModuleCompletion
+		}
+
+		return protocol.VariableCompletion
+	}
+}
+
+// declarationDetail returns the signature of the declaration with the given name and type,
+// e.g. `fun foo(a: Int): String` for functions, or `foo: Int` for other declarations.
+func declarationDetail(name string, ty sema.Type) string {
+	if invokableType, ok := ty.(sema.InvokableType); ok {
+		return "fun " + functionSignature(name, invokableType.InvocationFunctionType())
+	}
+	return fmt.Sprintf("%s: %s", name, ty.QualifiedString())
+}
+
+// functionSignature returns the signature of the function with the given name and type,
+// e.g. `foo<T: AnyStruct>(label a: Int): String`.
+func functionSignature(name string, functionType *sema.FunctionType) string {
+	var builder strings.Builder
+
+	builder.WriteString(name)
+
+	if len(functionType.TypeParameters) > 0 {
+		typeParameters := make([]string, len(functionType.TypeParameters))
+		for i, typeParameter := range functionType.TypeParameters {
+			typeParameters[i] = typeParameter.QualifiedString()
+		}
+
+		builder.WriteRune('<')
+		builder.WriteString(strings.Join(typeParameters, ", "))
+		builder.WriteRune('>')
+	}
+
+	parameters := make([]string, len(functionType.Parameters))
+	for i, parameter := range functionType.Parameters {
+		parameters[i] = parameter.QualifiedString()
+	}
+
+	builder.WriteRune('(')
+	builder.WriteString(strings.Join(parameters, ", "))
+	builder.WriteRune(')')
+
+	returnTypeAnnotation := functionType.ReturnTypeAnnotation
+	if returnTypeAnnotation != nil &&
+		returnTypeAnnotation.Type != nil &&
+		!returnTypeAnnotation.Type.Equal(&sema.VoidType{}) {
+
+		builder.WriteString(": ")
+		builder.WriteString(returnTypeAnnotation.QualifiedString())
+	}
+
+	return builder.String()
+}
+
+// linePrefix returns the text of the line of the given position
+// which is before the position.
+func linePrefix(text string, position protocol.Position) string {
+	lines := strings.Split(text, "\n")
+
+	line := int(position.Line)
+	if line < 0 || line >= len(lines) {
+		return ""
+	}
+
+	runes := []rune(lines[line])
+
+	column := int(position.Character)
+	if column > len(runes) {
+		column = len(runes)
+	}
+
+	return string(runes[:column])
+}
+
+func isIdentifierRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,536 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"sort"
+	"sync"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/common"
+)
+
+const (
+	// ledgerComputationLimit is the computation limit of executions,
+	// so that programs which do not terminate fail instead of blocking the server
+	ledgerComputationLimit = 100_000
+	// ledgerMemoryLimit is the limit of the estimated memory in bytes
+	// which may be allocated by an execution
+	ledgerMemoryLimit = 256 << 20
+)
+
+// ledgerComputationWeights are the computation weights of executions.
+// In addition to the default weights, operations on strings and collections
+// are metered, as their cost is proportional to the size of the operands
+var ledgerComputationWeights = runtime.ComputationWeights{
+	common.ComputationKindStatement:           1,
+	common.ComputationKindLoop:                1,
+	common.ComputationKindFunctionInvocation:  1,
+	common.ComputationKindStringOperation:     1,
+	common.ComputationKindArrayOperation:      1,
+	common.ComputationKindDictionaryOperation: 1,
+}
+
+// ledger is an in-memory ledger of accounts, used in offline mode
+// to execute deployments, transactions, and scripts with an in-process runtime
+// instead of submitting them to the emulator.
+type ledger struct {
+	mutex          sync.Mutex
+	runtime        runtime.Runtime
+	accounts       map[runtime.Address]*ledgerAccount
+	storage        map[storageKey][]byte
+	addressCounter uint64
+	programCounter uint64
+	uuid           uint64
+}
+
+// ledgerAccount is an account of the in-memory ledger.
+type ledgerAccount struct {
+	keys      []*runtime.AccountKey
+	code      []byte
+	contracts map[string][]byte
+}
+
+// storageKey is the key of a value in the storage of the in-memory ledger.
+type storageKey struct {
+	owner      string
+	controller string
+	key        string
+}
+
+// executionResult is the outcome of a program executed by the in-memory ledger.
+type executionResult struct {
+	// the result of a script, or nil for transactions
+	value  cadence.Value
+	logs   []string
+	events []cadence.Event
+}
+
+func newLedger() *ledger {
+	return &ledger{
+		runtime:  runtime.NewInterpreterRuntime(),
+		accounts: make(map[runtime.Address]*ledgerAccount),
+		storage:  make(map[storageKey][]byte),
+	}
+}
+
+// createAccount creates a new account without keys and returns its address.
+func (l *ledger) createAccount() runtime.Address {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.addressCounter++
+	address := counterAddress(l.addressCounter)
+	l.accounts[address] = newLedgerAccount(nil)
+
+	return address
+}
+
+// accountCode returns the code of the account with the given address.
+func (l *ledger) accountCode(address runtime.Address) ([]byte, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	account, ok := l.accounts[address]
+	if !ok {
+		return nil, fmt.Errorf("unknown account %s", address)
+	}
+
+	return account.code, nil
+}
+
+// executeTransaction executes the given transaction, signed by the given accounts.
+// The changes of the transaction are only applied to the ledger if it succeeds.
+//
+// The filename is used to resolve imports of files.
+func (l *ledger) executeTransaction(
+	script []byte,
+	filename string,
+	signers []runtime.Address,
+) (executionResult, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	execution := l.newExecution(filename)
+	execution.signers = signers
+
+	err := l.runtime.ExecuteTransaction(
+		script,
+		nil,
+		execution,
+		runtime.TransactionLocation(l.nextProgramID()),
+	)
+	if err == nil {
+		execution.commit()
+	}
+
+	return execution.result, err
+}
+
+// executeScript executes the given script and returns its result.
+// Scripts never change the ledger.
+//
+// The filename is used to resolve imports of files.
+func (l *ledger) executeScript(script []byte, filename string) (executionResult, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	execution := l.newExecution(filename)
+
+	value, err := l.runtime.ExecuteScript(
+		script,
+		nil,
+		execution,
+		runtime.ScriptLocation(l.nextProgramID()),
+	)
+	execution.result.value = value
+
+	return execution.result, err
+}
+
+// nextProgramID returns a new unique ID for an executed program.
+func (l *ledger) nextProgramID() []byte {
+	l.programCounter++
+
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], l.programCounter)
+	return b[:]
+}
+
+// counterAddress returns the address of the account created with the given counter.
+func counterAddress(counter uint64) runtime.Address {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], counter)
+	return common.BytesToAddress(b[:])
+}
+
+func newLedgerAccount(publicKeys [][]byte) *ledgerAccount {
+	account := &ledgerAccount{
+		contracts: make(map[string][]byte),
+	}
+	for _, publicKey := range publicKeys {
+		account.addKey(&runtime.AccountKey{
+			PublicKey: publicKey,
+		})
+	}
+	return account
+}
+
+// copy returns a copy of the account which can be changed
+// without affecting the original.
+func (a *ledgerAccount) copy() *ledgerAccount {
+	keys := make([]*runtime.AccountKey, len(a.keys))
+	for i, key := range a.keys {
+		keyCopy := *key
+		keys[i] = &keyCopy
+	}
+
+	contracts := make(map[string][]byte, len(a.contracts))
+	for name, code := range a.contracts {
+		contracts[name] = code
+	}
+
+	return &ledgerAccount{
+		keys:      keys,
+		code:      a.code,
+		contracts: contracts,
+	}
+}
+
+func (a *ledgerAccount) addKey(key *runtime.AccountKey) *runtime.AccountKey {
+	key.KeyIndex = len(a.keys)
+	a.keys = append(a.keys, key)
+	return key
+}
+
+func (a *ledgerAccount) removeKey(index int) {
+	a.keys = append(a.keys[:index], a.keys[index+1:]...)
+	for i, key := range a.keys {
+		key.KeyIndex = i
+	}
+}
+
+func (a *ledgerAccount) key(index int) *runtime.AccountKey {
+	if index < 0 || index >= len(a.keys) {
+		return nil
+	}
+	return a.keys[index]
+}
+
+// ledgerExecution is the runtime interface for a single execution of a program
+// against the in-memory ledger. It collects the logs and events of the execution.
+//
+// Changes to accounts and storage are staged in the execution,
+// and are only applied to the ledger when the execution is committed.
+//
+// The ledger must be locked for the duration of the execution.
+type ledgerExecution struct {
+	runtime.EmptyRuntimeInterface
+	ledger   *ledger
+	filename string
+	signers  []runtime.Address
+	result   executionResult
+	// changed and created accounts
+	accounts map[runtime.Address]*ledgerAccount
+	// changed storage values, empty values are removed
+	storage        map[storageKey][]byte
+	addressCounter uint64
+}
+
+var _ runtime.Interface = &ledgerExecution{}
+
+func (l *ledger) newExecution(filename string) *ledgerExecution {
+	return &ledgerExecution{
+		ledger:         l,
+		filename:       filename,
+		accounts:       make(map[runtime.Address]*ledgerAccount),
+		storage:        make(map[storageKey][]byte),
+		addressCounter: l.addressCounter,
+	}
+}
+
+// commit applies the staged changes of the execution to the ledger.
+func (e *ledgerExecution) commit() {
+	for address, account := range e.accounts {
+		e.ledger.accounts[address] = account
+	}
+
+	for storageKey, value := range e.storage {
+		if len(value) == 0 {
+			delete(e.ledger.storage, storageKey)
+		} else {
+			e.ledger.storage[storageKey] = value
+		}
+	}
+
+	e.ledger.addressCounter = e.addressCounter
+}
+
+// account returns the account with the given address, for reading.
+func (e *ledgerExecution) account(address runtime.Address) (*ledgerAccount, error) {
+	if account, ok := e.accounts[address]; ok {
+		return account, nil
+	}
+
+	account, ok := e.ledger.accounts[address]
+	if !ok {
+		return nil, fmt.Errorf("unknown account %s", address)
+	}
+	return account, nil
+}
+
+// changedAccount returns the staged copy of the account with the given address, for changing.
+func (e *ledgerExecution) changedAccount(address runtime.Address) (*ledgerAccount, error) {
+	if account, ok := e.accounts[address]; ok {
+		return account, nil
+	}
+
+	account, ok := e.ledger.accounts[address]
+	if !ok {
+		return nil, fmt.Errorf("unknown account %s", address)
+	}
+
+	account = account.copy()
+	e.accounts[address] = account
+	return account, nil
+}
+
+func (e *ledgerExecution) ResolveImport(location runtime.Location) ([]byte, error) {
+	switch location := location.(type) {
+	case runtime.StringLocation:
+		filename := path.Join(path.Dir(e.filename), string(location))
+		if filename == e.filename {
+			return nil, fmt.Errorf("cannot import current file: %s", filename)
+		}
+		return ioutil.ReadFile(filename)
+
+	case runtime.AddressLocation:
+		account, err := e.account(location.ToAddress())
+		if err != nil {
+			return nil, err
+		}
+		return account.code, nil
+
+	default:
+		return nil, fmt.Errorf("unresolvable import location %s", location.ID())
+	}
+}
+
+func (e *ledgerExecution) ValueExists(owner, controller, key []byte) (bool, error) {
+	value, err := e.GetValue(owner, controller, key)
+	return len(value) > 0, err
+}
+
+func (e *ledgerExecution) GetValue(owner, controller, key []byte) ([]byte, error) {
+	storageKey := storageKey{string(owner), string(controller), string(key)}
+	if value, ok := e.storage[storageKey]; ok {
+		return value, nil
+	}
+	return e.ledger.storage[storageKey], nil
+}
+
+func (e *ledgerExecution) SetValue(owner, controller, key, value []byte) error {
+	e.storage[storageKey{string(owner), string(controller), string(key)}] = value
+	return nil
+}
+
+func (e *ledgerExecution) GetValueKeys(owner, controller []byte) ([][]byte, error) {
+	var keys []string
+
+	for storageKey := range e.ledger.storage {
+		if storageKey.owner != string(owner) || storageKey.controller != string(controller) {
+			continue
+		}
+		if _, ok := e.storage[storageKey]; ok {
+			continue
+		}
+		keys = append(keys, storageKey.key)
+	}
+
+	for storageKey, value := range e.storage {
+		if storageKey.owner != string(owner) || storageKey.controller != string(controller) {
+			continue
+		}
+		if len(value) == 0 {
+			continue
+		}
+		keys = append(keys, storageKey.key)
+	}
+
+	sort.Strings(keys)
+
+	result := make([][]byte, len(keys))
+	for i, key := range keys {
+		result[i] = []byte(key)
+	}
+	return result, nil
+}
+
+func (e *ledgerExecution) CreateAccount(publicKeys [][]byte) (runtime.Address, error) {
+	e.addressCounter++
+	address := counterAddress(e.addressCounter)
+	e.accounts[address] = newLedgerAccount(publicKeys)
+	return address, nil
+}
+
+func (e *ledgerExecution) AddAccountKey(address runtime.Address, publicKey []byte) error {
+	account, err := e.changedAccount(address)
+	if err != nil {
+		return err
+	}
+	account.addKey(&runtime.AccountKey{
+		PublicKey: publicKey,
+	})
+	return nil
+}
+
+func (e *ledgerExecution) AddStructuredAccountKey(
+	address runtime.Address,
+	publicKey []byte,
+	signatureAlgorithm runtime.SignatureAlgorithm,
+	hashAlgorithm runtime.HashAlgorithm,
+	weight uint64,
+) (*runtime.AccountKey, error) {
+	account, err := e.changedAccount(address)
+	if err != nil {
+		return nil, err
+	}
+	key := account.addKey(&runtime.AccountKey{
+		PublicKey:          publicKey,
+		SignatureAlgorithm: signatureAlgorithm,
+		HashAlgorithm:      hashAlgorithm,
+		Weight:             weight,
+	})
+	return key, nil
+}
+
+func (e *ledgerExecution) GetAccountKey(address runtime.Address, keyIndex int) (*runtime.AccountKey, error) {
+	account, err := e.account(address)
+	if err != nil {
+		return nil, err
+	}
+	return account.key(keyIndex), nil
+}
+
+func (e *ledgerExecution) RevokeAccountKey(address runtime.Address, keyIndex int) (*runtime.AccountKey, error) {
+	account, err := e.changedAccount(address)
+	if err != nil {
+		return nil, err
+	}
+	key := account.key(keyIndex)
+	if key != nil {
+		key.IsRevoked = true
+	}
+	return key, nil
+}
+
+func (e *ledgerExecution) RemoveAccountKey(address runtime.Address, index int) ([]byte, error) {
+	account, err := e.changedAccount(address)
+	if err != nil {
+		return nil, err
+	}
+	key := account.key(index)
+	if key == nil {
+		return nil, fmt.Errorf("invalid key index %d for account %s", index, address)
+	}
+	account.removeKey(index)
+	return key.PublicKey, nil
+}
+
+func (e *ledgerExecution) GetAccountCode(address runtime.Address) ([]byte, error) {
+	account, err := e.account(address)
+	if err != nil {
+		return nil, err
+	}
+	return account.code, nil
+}
+
+func (e *ledgerExecution) UpdateAccountCode(address runtime.Address, code []byte, _ bool) error {
+	account, err := e.changedAccount(address)
+	if err != nil {
+		return err
+	}
+	account.code = code
+	return nil
+}
+
+func (e *ledgerExecution) GetAccountContractCode(address runtime.Address, name string) ([]byte, error) {
+	account, err := e.account(address)
+	if err != nil {
+		return nil, err
+	}
+	return account.contracts[name], nil
+}
+
+func (e *ledgerExecution) UpdateAccountContractCode(address runtime.Address, name string, code []byte) error {
+	account, err := e.changedAccount(address)
+	if err != nil {
+		return err
+	}
+	account.contracts[name] = code
+	return nil
+}
+
+func (e *ledgerExecution) RemoveAccountContractCode(address runtime.Address, name string) error {
+	account, err := e.changedAccount(address)
+	if err != nil {
+		return err
+	}
+	delete(account.contracts, name)
+	return nil
+}
+
+func (e *ledgerExecution) GetSigningAccounts() []runtime.Address {
+	return e.signers
+}
+
+func (e *ledgerExecution) Log(message string) {
+	e.result.logs = append(e.result.logs, message)
+}
+
+func (e *ledgerExecution) EmitEvent(event cadence.Event) {
+	e.result.events = append(e.result.events, event)
+}
+
+func (e *ledgerExecution) GenerateUUID() uint64 {
+	e.ledger.uuid++
+	return e.ledger.uuid
+}
+
+func (e *ledgerExecution) GetComputationLimit() uint64 {
+	return ledgerComputationLimit
+}
+
+func (e *ledgerExecution) GetComputationWeights() runtime.ComputationWeights {
+	return ledgerComputationWeights
+}
+
+func (e *ledgerExecution) GetMemoryLimit() uint64 {
+	return ledgerMemoryLimit
+}
+
+func (e *ledgerExecution) DecodeArgument(b []byte, _ cadence.Type) (cadence.Value, error) {
+	return jsoncdc.Decode(b)
+}
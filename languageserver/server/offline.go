@@ -0,0 +1,144 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/onflow/flow-go-sdk"
+
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/cadence/runtime/common"
+
+	"github.com/onflow/cadence/languageserver/protocol"
+)
+
+// executeTransactionLocally executes the given transaction with the in-memory ledger,
+// signed by the currently active account, and shows the outcome to the client.
+//
+// The filename is used to resolve imports of files.
+func (s *Server) executeTransactionLocally(conn protocol.Conn, filename string, script []byte) error {
+	signers := []common.Address{
+		common.BytesToAddress(s.activeAccount[:]),
+	}
+
+	result, err := s.ledger.executeTransaction(script, filename, signers)
+	logExecutionOutput(conn, result)
+	if err != nil {
+		conn.ShowMessage(&protocol.ShowMessageParams{
+			Type:    protocol.Warning,
+			Message: fmt.Sprintf("Failed to execute transaction: %s", err.Error()),
+		})
+		return nil
+	}
+
+	message := "Executed transaction"
+	if len(result.events) > 0 {
+		message += fmt.Sprintf(", emitted events: %s", eventTypeIDs(result))
+	}
+
+	conn.ShowMessage(&protocol.ShowMessageParams{
+		Type:    protocol.Info,
+		Message: message,
+	})
+
+	return nil
+}
+
+// executeScriptLocally executes the given script with the in-memory ledger,
+// shows the outcome to the client, and returns the JSON-encoded result.
+//
+// The filename is used to resolve imports of files.
+func (s *Server) executeScriptLocally(conn protocol.Conn, filename string, script []byte) (interface{}, error) {
+	result, err := s.ledger.executeScript(script, filename)
+	logExecutionOutput(conn, result)
+	if err != nil {
+		conn.ShowMessage(&protocol.ShowMessageParams{
+			Type:    protocol.Warning,
+			Message: fmt.Sprintf("Failed to execute script: %s", err.Error()),
+		})
+		return nil, nil
+	}
+
+	encoded, err := jsoncdc.Encode(result.value)
+	if err != nil {
+		return nil, err
+	}
+
+	conn.ShowMessage(&protocol.ShowMessageParams{
+		Type:    protocol.Info,
+		Message: fmt.Sprintf("Executed script with result: %s", encoded),
+	})
+
+	return string(encoded), nil
+}
+
+// createAccountLocally creates a new account in the in-memory ledger
+// and returns its address.
+func (s *Server) createAccountLocally(conn protocol.Conn) flow.Address {
+	address := s.ledger.createAccount()
+	addr := flow.BytesToAddress(address[:])
+
+	s.accounts[addr] = s.config.RootAccountKey
+
+	conn.LogMessage(&protocol.LogMessageParams{
+		Type:    protocol.Info,
+		Message: fmt.Sprintf("Created account 0x%s", addr.Short()),
+	})
+
+	return addr
+}
+
+// logExecutionOutput sends the log messages and the emitted events
+// of a locally executed program to the client.
+func logExecutionOutput(conn protocol.Conn, result executionResult) {
+	for _, message := range result.logs {
+		conn.LogMessage(&protocol.LogMessageParams{
+			Type:    protocol.Log,
+			Message: message,
+		})
+	}
+
+	for _, event := range result.events {
+		encoded, err := jsoncdc.Encode(event)
+		if err != nil {
+			conn.LogMessage(&protocol.LogMessageParams{
+				Type:    protocol.Warning,
+				Message: fmt.Sprintf("Failed to encode event %s: %s", event.EventType.TypeID, err.Error()),
+			})
+			continue
+		}
+
+		conn.LogMessage(&protocol.LogMessageParams{
+			Type:    protocol.Info,
+			Message: fmt.Sprintf("Emitted event %s: %s", event.EventType.TypeID, encoded),
+		})
+	}
+}
+
+// eventTypeIDs returns the comma-separated type IDs of the events
+// emitted by a locally executed program.
+func eventTypeIDs(result executionResult) string {
+	typeIDs := make([]string, len(result.events))
+	for i, event := range result.events {
+		typeIDs[i] = event.EventType.TypeID
+	}
+	return strings.Join(typeIDs, ", ")
+}
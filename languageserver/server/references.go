@@ -0,0 +1,814 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"unicode"
+
+	"github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+
+	"github.com/onflow/cadence/languageserver/protocol"
+)
+
+var (
+	errRenameBuiltin       = errors.New("cannot rename built-in declaration")
+	errRenameAccountImport = errors.New("cannot rename declaration imported from an account")
+	errRenameKeyword       = errors.New("cannot rename keyword")
+	errRenameType          = errors.New("cannot rename type declaration, as type annotations are not tracked")
+)
+
+// symbol is a declaration which can be referenced,
+// potentially from other files of the workspace.
+type symbol struct {
+	// filename is the name of the file which contains the declaration
+	filename string
+	// name is the identifier of the declaration
+	name string
+	// pos is the position of the identifier of the declaration
+	pos             ast.Position
+	declarationKind common.DeclarationKind
+}
+
+// reference is an occurrence of the identifier of a symbol.
+type reference struct {
+	filename string
+	pos      ast.Position
+}
+
+// parameterFunction is the function of a parameter without an argument label.
+// The parameter name is the argument label in invocations of the function.
+type parameterFunction struct {
+	// pos is the position of the identifier of the function,
+	// or of the composite for initializers
+	pos ast.Position
+	// index is the index of the parameter
+	index int
+}
+
+// symbolResolver finds symbols and their references in the files of the workspace.
+//
+// A resolver is used for a single request, the checked files are cached.
+type symbolResolver struct {
+	server *Server
+	conn   protocol.Conn
+	files  map[string]checkedFile
+}
+
+func newSymbolResolver(server *Server, conn protocol.Conn) *symbolResolver {
+	return &symbolResolver{
+		server: server,
+		conn:   conn,
+		files:  map[string]checkedFile{},
+	}
+}
+
+func (r *symbolResolver) file(filename string) checkedFile {
+	file, ok := r.files[filename]
+	if !ok {
+		file = r.server.checkFile(r.conn, filename)
+		r.files[filename] = file
+	}
+	return file
+}
+
+// symbolAt returns the symbol of the identifier at the given position of the given document.
+//
+// Returns nil if there is no identifier at the position,
+// and an error if the identifier refers to a declaration which is not part of the workspace,
+// e.g. a built-in declaration or a declaration imported from an account.
+func (r *symbolResolver) symbolAt(uri protocol.DocumentUri, position protocol.Position) (*symbol, error) {
+	filename := uriToFilename(uri)
+
+	file := r.file(filename)
+	if file.err != nil {
+		return nil, nil
+	}
+
+	checker := file.checker
+	pos := protocolToSemaPosition(position)
+
+	// Members are resolved through the member,
+	// as the origins of members of imported types are not recorded
+
+	for expression, memberInfo := range checker.Elaboration.MemberExpressionMemberInfos {
+		identifier := expression.Identifier
+		if !identifierContains(identifier.Pos, identifier.Identifier, pos) {
+			continue
+		}
+
+		return r.memberSymbol(filename, memberInfo.Member)
+	}
+
+	occurrence := checker.Occurrences.Find(pos)
+	if occurrence == nil || occurrence.Origin == nil {
+		return nil, nil
+	}
+
+	origin := occurrence.Origin
+
+	name := identifierAt(file.text, occurrenceStart(*occurrence))
+	if name == "" {
+		return nil, nil
+	}
+
+	if isKeyword(name) {
+		return nil, errRenameKeyword
+	}
+
+	if origin.StartPos == nil {
+		return nil, errRenameBuiltin
+	}
+
+	// Imported variables have no position
+
+	if origin.StartPos.Line == 0 {
+		return r.importedSymbol(filename, checker, name)
+	}
+
+	return &symbol{
+		filename:        filename,
+		name:            name,
+		pos:             *origin.StartPos,
+		declarationKind: origin.DeclarationKind,
+	}, nil
+}
+
+// memberSymbol returns the symbol for the given member,
+// which is accessed in the file with the given name.
+func (r *symbolResolver) memberSymbol(filename string, member *sema.Member) (*symbol, error) {
+	if member == nil {
+		return nil, nil
+	}
+
+	if member.Predeclared || member.Identifier.Pos.Line == 0 {
+		return nil, errRenameBuiltin
+	}
+
+	declarationFilename, err := containerFilename(filename, member.ContainerType)
+	if err != nil {
+		return nil, err
+	}
+
+	return &symbol{
+		filename:        declarationFilename,
+		name:            member.Identifier.Identifier,
+		pos:             member.Identifier.Pos,
+		declarationKind: member.DeclarationKind,
+	}, nil
+}
+
+// importedSymbol returns the symbol for the global declaration with the given name
+// which is imported into the given file.
+//
+// Returns an error if the declaration is imported from an account or is predeclared.
+func (r *symbolResolver) importedSymbol(filename string, checker *sema.Checker, name string) (*symbol, error) {
+	importedFromAccount := false
+
+	for _, declaration := range checker.Program.ImportDeclarations() {
+		if !importsIdentifier(declaration, name) {
+			continue
+		}
+
+		switch location := declaration.Location.(type) {
+		case ast.StringLocation:
+			importedFilename := importFilename(filename, location)
+
+			file := r.file(importedFilename)
+			if file.err != nil {
+				continue
+			}
+
+			variable, ok := file.checker.GlobalValues[name]
+			if !ok || variable.Pos == nil {
+				continue
+			}
+
+			return &symbol{
+				filename:        importedFilename,
+				name:            name,
+				pos:             *variable.Pos,
+				declarationKind: variable.DeclarationKind,
+			}, nil
+
+		case ast.AddressLocation:
+			importedFromAccount = true
+		}
+	}
+
+	if importedFromAccount {
+		return nil, errRenameAccountImport
+	}
+
+	// Predeclared values, e.g. the functions of the standard library,
+	// have no position either
+
+	return nil, errRenameBuiltin
+}
+
+// references returns the references to the given symbol in all files of the workspace,
+// including the declaration.
+func (r *symbolResolver) references(symbol *symbol) []reference {
+	filenames := r.server.workspaceFilenames()
+
+	declared := false
+	for _, filename := range filenames {
+		if filename == symbol.filename {
+			declared = true
+			break
+		}
+	}
+	if !declared {
+		filenames = append(filenames, symbol.filename)
+	}
+
+	var references []reference
+	for _, filename := range filenames {
+		references = append(references, r.fileReferences(filename, symbol)...)
+	}
+	return references
+}
+
+// fileReferences returns the references to the given symbol in the file with the given name.
+func (r *symbolResolver) fileReferences(filename string, symbol *symbol) []reference {
+	file := r.file(filename)
+	if file.err != nil {
+		return nil
+	}
+
+	checker := file.checker
+
+	var references []reference
+	seen := map[sema.Position]bool{}
+
+	add := func(pos ast.Position) {
+		// Ignore stale occurrences, i.e. if the document changed,
+		// but could not be checked yet
+
+		if identifierAt(file.text, pos) != symbol.name {
+			return
+		}
+
+		position := sema.ToPosition(pos)
+		if seen[position] {
+			return
+		}
+		seen[position] = true
+
+		references = append(references, reference{
+			filename: filename,
+			pos:      pos,
+		})
+	}
+
+	if filename == symbol.filename {
+
+		// The occurrences in the declaring file refer to the declaration through their origin
+
+		for _, occurrence := range checker.Occurrences.All() {
+			origin := occurrence.Origin
+			if origin == nil ||
+				origin.StartPos == nil ||
+				!samePosition(*origin.StartPos, symbol.pos) {
+
+				continue
+			}
+
+			add(occurrenceStart(occurrence))
+		}
+
+	} else if r.isGlobal(symbol) {
+
+		// The occurrences of imported global declarations have no position,
+		// so find the imports of the declaring file, and the occurrences with the name
+
+		imported := false
+
+		for _, declaration := range checker.Program.ImportDeclarations() {
+			location, ok := declaration.Location.(ast.StringLocation)
+			if !ok || importFilename(filename, location) != symbol.filename {
+				continue
+			}
+
+			if !importsIdentifier(declaration, symbol.name) {
+				continue
+			}
+
+			imported = true
+
+			for _, identifier := range declaration.Identifiers {
+				if identifier.Identifier == symbol.name {
+					add(identifier.Pos)
+				}
+			}
+		}
+
+		if imported {
+			for _, occurrence := range checker.Occurrences.All() {
+				origin := occurrence.Origin
+				if origin == nil ||
+					origin.StartPos == nil ||
+					origin.StartPos.Line != 0 {
+
+					continue
+				}
+
+				add(ast.Position{
+					Line:   occurrence.StartPos.Line,
+					Column: occurrence.StartPos.Column,
+				})
+			}
+		}
+	}
+
+	// Member accesses refer to the declaration through the member,
+	// also for members of imported types
+
+	for expression, memberInfo := range checker.Elaboration.MemberExpressionMemberInfos {
+		member := memberInfo.Member
+		if member == nil ||
+			member.Identifier.Identifier != symbol.name ||
+			!samePosition(member.Identifier.Pos, symbol.pos) {
+
+			continue
+		}
+
+		memberFilename, err := containerFilename(filename, member.ContainerType)
+		if err != nil || memberFilename != symbol.filename {
+			continue
+		}
+
+		add(expression.Identifier.Pos)
+	}
+
+	// The name of a parameter without an argument label
+	// is the argument label in invocations of the function
+
+	if function := r.parameterFunction(symbol); function != nil {
+		for invocation := range checker.Elaboration.InvocationExpressionArgumentTypes {
+			if function.index >= len(invocation.Arguments) {
+				continue
+			}
+
+			argument := invocation.Arguments[function.index]
+			if argument.LabelStartPos == nil || argument.Label != symbol.name {
+				continue
+			}
+
+			invoked := r.invokedSymbol(filename, checker, invocation.InvokedExpression)
+			if invoked == nil ||
+				invoked.filename != symbol.filename ||
+				!samePosition(invoked.pos, function.pos) {
+
+				continue
+			}
+
+			add(*argument.LabelStartPos)
+		}
+	}
+
+	return references
+}
+
+// isGlobal returns true if the given symbol is a global declaration of its file,
+// i.e. if it can be imported.
+func (r *symbolResolver) isGlobal(symbol *symbol) bool {
+	file := r.file(symbol.filename)
+	if file.err != nil {
+		return false
+	}
+
+	variable, ok := file.checker.GlobalValues[symbol.name]
+	return ok &&
+		variable.Pos != nil &&
+		samePosition(*variable.Pos, symbol.pos)
+}
+
+// invokedSymbol returns the symbol of the function which is invoked in the given expression.
+func (r *symbolResolver) invokedSymbol(
+	filename string,
+	checker *sema.Checker,
+	invokedExpression ast.Expression,
+) *symbol {
+
+	switch invokedExpression := invokedExpression.(type) {
+	case *ast.MemberExpression:
+		memberInfo := checker.Elaboration.MemberExpressionMemberInfos[invokedExpression]
+		invoked, _ := r.memberSymbol(filename, memberInfo.Member)
+		return invoked
+
+	case *ast.IdentifierExpression:
+		identifier := invokedExpression.Identifier
+
+		occurrence := checker.Occurrences.Find(sema.ToPosition(identifier.Pos))
+		if occurrence == nil ||
+			occurrence.Origin == nil ||
+			occurrence.Origin.StartPos == nil {
+
+			return nil
+		}
+
+		origin := occurrence.Origin
+
+		if origin.StartPos.Line == 0 {
+			invoked, _ := r.importedSymbol(filename, checker, identifier.Identifier)
+			return invoked
+		}
+
+		return &symbol{
+			filename:        filename,
+			name:            identifier.Identifier,
+			pos:             *origin.StartPos,
+			declarationKind: origin.DeclarationKind,
+		}
+
+	default:
+		return nil
+	}
+}
+
+// parameterFunction returns the function of the given symbol,
+// if the symbol is a parameter without an argument label.
+func (r *symbolResolver) parameterFunction(symbol *symbol) *parameterFunction {
+	if symbol.declarationKind != common.DeclarationKindParameter {
+		return nil
+	}
+
+	file := r.file(symbol.filename)
+	if file.err != nil {
+		return nil
+	}
+
+	var result *parameterFunction
+
+	checkParameters := func(functionPos ast.Position, parameterList *ast.ParameterList) {
+		if result != nil || parameterList == nil {
+			return
+		}
+
+		for i, parameter := range parameterList.Parameters {
+			if parameter.Label == "" &&
+				samePosition(parameter.Identifier.Pos, symbol.pos) {
+
+				result = &parameterFunction{
+					pos:   functionPos,
+					index: i,
+				}
+				return
+			}
+		}
+	}
+
+	var checkComposites func(declarations []*ast.CompositeDeclaration)
+
+	checkComposites = func(declarations []*ast.CompositeDeclaration) {
+		for _, declaration := range declarations {
+			members := declaration.Members
+
+			for _, function := range members.Functions {
+				checkParameters(function.Identifier.Pos, function.ParameterList)
+			}
+
+			// The parameters of initializers are the parameters of the composite's constructor
+
+			for _, initializer := range members.Initializers() {
+				checkParameters(declaration.Identifier.Pos, initializer.ParameterList)
+			}
+
+			checkComposites(declaration.CompositeDeclarations)
+		}
+	}
+
+	program := file.checker.Program
+
+	for _, function := range program.FunctionDeclarations() {
+		checkParameters(function.Identifier.Pos, function.ParameterList)
+	}
+
+	checkComposites(program.CompositeDeclarations())
+
+	return result
+}
+
+// References returns the references to the symbol at the given position,
+// in all files of the workspace.
+func (s *Server) References(
+	conn protocol.Conn,
+	params *protocol.ReferenceParams,
+) ([]*protocol.Location, error) {
+
+	resolver := newSymbolResolver(s, conn)
+
+	symbol, err := resolver.symbolAt(params.TextDocument.URI, params.Position)
+	if err != nil || symbol == nil {
+		return nil, nil
+	}
+
+	var locations []*protocol.Location
+
+	for _, reference := range resolver.references(symbol) {
+		if !params.Context.IncludeDeclaration && isDeclaration(reference, symbol) {
+			continue
+		}
+
+		locations = append(locations, &protocol.Location{
+			URI:   filenameToURI(reference.filename),
+			Range: identifierRange(reference.pos, symbol.name),
+		})
+	}
+
+	return locations, nil
+}
+
+// DocumentHighlight returns the references to the symbol at the given position
+// in the same document.
+func (s *Server) DocumentHighlight(
+	conn protocol.Conn,
+	params *protocol.TextDocumentPositionParams,
+) ([]*protocol.DocumentHighlight, error) {
+
+	resolver := newSymbolResolver(s, conn)
+
+	symbol, err := resolver.symbolAt(params.TextDocument.URI, params.Position)
+	if err != nil || symbol == nil {
+		return nil, nil
+	}
+
+	filename := uriToFilename(params.TextDocument.URI)
+
+	var highlights []*protocol.DocumentHighlight
+
+	for _, reference := range resolver.fileReferences(filename, symbol) {
+		kind := protocol.Read
+		if isDeclaration(reference, symbol) {
+			kind = protocol.Write
+		}
+
+		highlights = append(highlights, &protocol.DocumentHighlight{
+			Range: identifierRange(reference.pos, symbol.name),
+			Kind:  &kind,
+		})
+	}
+
+	return highlights, nil
+}
+
+// PrepareRename checks if the symbol at the given position can be renamed,
+// and returns the range of the identifier.
+//
+// Built-in declarations, declarations imported from accounts, and types cannot be renamed.
+func (s *Server) PrepareRename(
+	conn protocol.Conn,
+	params *protocol.TextDocumentPositionParams,
+) (*protocol.PrepareRenameResult, error) {
+
+	resolver := newSymbolResolver(s, conn)
+
+	symbol, err := resolveRenamedSymbol(resolver, params.TextDocument.URI, params.Position)
+	if err != nil || symbol == nil {
+		return nil, err
+	}
+
+	filename := uriToFilename(params.TextDocument.URI)
+	position := protocolToSemaPosition(params.Position)
+
+	for _, reference := range resolver.fileReferences(filename, symbol) {
+		if !identifierContains(reference.pos, symbol.name, position) {
+			continue
+		}
+
+		return &protocol.PrepareRenameResult{
+			Range:       identifierRange(reference.pos, symbol.name),
+			Placeholder: symbol.name,
+		}, nil
+	}
+
+	return nil, nil
+}
+
+// Rename renames the symbol at the given position,
+// i.e. its declaration and all references to it in all files of the workspace.
+func (s *Server) Rename(
+	conn protocol.Conn,
+	params *protocol.RenameParams,
+) (*protocol.WorkspaceEdit, error) {
+
+	if !isIdentifier(params.NewName) || isKeyword(params.NewName) {
+		return nil, fmt.Errorf("invalid name: %s", params.NewName)
+	}
+
+	resolver := newSymbolResolver(s, conn)
+
+	position := protocol.TextDocumentPositionParams{
+		TextDocument: params.TextDocument,
+		Position:     params.Position,
+	}
+
+	symbol, err := resolveRenamedSymbol(resolver, position.TextDocument.URI, position.Position)
+	if err != nil || symbol == nil {
+		return nil, err
+	}
+
+	changes := map[string][]protocol.TextEdit{}
+
+	for _, reference := range resolver.references(symbol) {
+		uri := string(filenameToURI(reference.filename))
+		changes[uri] = append(changes[uri], protocol.TextEdit{
+			Range:   identifierRange(reference.pos, symbol.name),
+			NewText: params.NewName,
+		})
+	}
+
+	return &protocol.WorkspaceEdit{
+		Changes: &changes,
+	}, nil
+}
+
+// resolveRenamedSymbol returns the symbol at the given position, if it can be renamed.
+func resolveRenamedSymbol(
+	resolver *symbolResolver,
+	uri protocol.DocumentUri,
+	position protocol.Position,
+) (*symbol, error) {
+
+	symbol, err := resolver.symbolAt(uri, position)
+	if err != nil || symbol == nil {
+		return nil, err
+	}
+
+	// Type annotations are not recorded as occurrences,
+	// so types cannot be renamed consistently
+
+	if symbol.declarationKind.IsTypeDeclaration() {
+		return nil, errRenameType
+	}
+
+	return symbol, nil
+}
+
+// containerFilename returns the name of the file which declares the given container type,
+// relative to the file with the given name, in which the container type is used.
+func containerFilename(filename string, containerType sema.Type) (string, error) {
+	var location ast.Location
+
+	switch containerType := containerType.(type) {
+	case *sema.CompositeType:
+		location = containerType.Location
+
+	case *sema.InterfaceType:
+		location = containerType.Location
+
+	case *sema.TransactionType:
+		return filename, nil
+
+	default:
+		return "", errRenameBuiltin
+	}
+
+	switch location := location.(type) {
+	case runtime.FileLocation:
+		return uriToFilename(protocol.DocumentUri(location)), nil
+
+	case ast.StringLocation:
+		return importFilename(filename, location), nil
+
+	case ast.AddressLocation:
+		return "", errRenameAccountImport
+
+	default:
+		return "", errRenameBuiltin
+	}
+}
+
+// importFilename returns the name of the file which is imported
+// from the file with the given name, see `resolveFileImport`.
+func importFilename(filename string, location ast.StringLocation) string {
+	return path.Join(path.Dir(filename), string(location))
+}
+
+// importsIdentifier returns true if the given import declaration
+// imports the declaration with the given name.
+func importsIdentifier(declaration *ast.ImportDeclaration, name string) bool {
+	if len(declaration.Identifiers) == 0 {
+		return true
+	}
+
+	for _, identifier := range declaration.Identifiers {
+		if identifier.Identifier == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// occurrenceStart returns the start position of the identifier of the given occurrence.
+//
+// The occurrences of field declarations span the whole declaration,
+// so the start of their origin is used instead.
+func occurrenceStart(occurrence sema.Occurrence) ast.Position {
+	origin := occurrence.Origin
+	if origin != nil && origin.StartPos != nil {
+		originStart := sema.ToPosition(*origin.StartPos)
+		if originStart.Compare(occurrence.StartPos) > 0 &&
+			originStart.Compare(occurrence.EndPos) <= 0 {
+
+			return *origin.StartPos
+		}
+	}
+
+	return ast.Position{
+		Line:   occurrence.StartPos.Line,
+		Column: occurrence.StartPos.Column,
+	}
+}
+
+func isDeclaration(reference reference, symbol *symbol) bool {
+	return reference.filename == symbol.filename &&
+		samePosition(reference.pos, symbol.pos)
+}
+
+func samePosition(a, b ast.Position) bool {
+	return a.Line == b.Line && a.Column == b.Column
+}
+
+// identifierContains returns true if the identifier with the given name at the given position
+// contains the given position.
+func identifierContains(pos ast.Position, name string, position sema.Position) bool {
+	return position.Line == pos.Line &&
+		position.Column >= pos.Column &&
+		position.Column <= pos.Column+len([]rune(name))
+}
+
+// identifierRange returns the range of the identifier with the given name at the given position.
+func identifierRange(pos ast.Position, name string) protocol.Range {
+	return protocol.Range{
+		Start: astToProtocolPosition(pos),
+		End: protocol.Position{
+			Line:      float64(pos.Line - 1),
+			Character: float64(pos.Column + len([]rune(name))),
+		},
+	}
+}
+
+// identifierAt returns the identifier which starts at the given position in the given text.
+func identifierAt(text string, pos ast.Position) string {
+	lines := strings.Split(text, "\n")
+
+	line := pos.Line - 1
+	if line < 0 || line >= len(lines) {
+		return ""
+	}
+
+	runes := []rune(lines[line])
+	if pos.Column < 0 || pos.Column >= len(runes) {
+		return ""
+	}
+
+	end := pos.Column
+	for end < len(runes) && isIdentifierRune(runes[end]) {
+		end++
+	}
+
+	return string(runes[pos.Column:end])
+}
+
+func isIdentifier(name string) bool {
+	for i, r := range name {
+		if !isIdentifierRune(r) || (i == 0 && unicode.IsDigit(r)) {
+			return false
+		}
+	}
+	return name != ""
+}
+
+func isKeyword(name string) bool {
+	for _, keyword := range keywords {
+		if name == keyword {
+			return true
+		}
+	}
+	return false
+}
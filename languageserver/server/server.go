@@ -31,7 +31,6 @@ import (
 	"github.com/onflow/flow-go-sdk/crypto"
 	"google.golang.org/grpc"
 
-	"github.com/onflow/cadence/runtime"
 	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/errors"
@@ -71,6 +70,10 @@ type Server struct {
 	// set of created accounts we can submit transactions for
 	accounts      map[flow.Address]config.AccountPrivateKey
 	activeAccount flow.Address
+	// in-memory ledger used instead of the emulator in offline mode
+	ledger *ledger
+	// root folder of the workspace, if any
+	rootPath string
 }
 
 func NewServer() *Server {
@@ -98,13 +101,22 @@ func (s *Server) Initialize(
 			TextDocumentSync:   protocol.Full,
 			HoverProvider:      true,
 			DefinitionProvider: true,
-			// TODO:
-			//SignatureHelpProvider: &protocol.SignatureHelpOptions{
-			//	TriggerCharacters: []string{"("},
-			//},
+			SignatureHelpProvider: &protocol.SignatureHelpOptions{
+				TriggerCharacters: []string{"(", ","},
+			},
 			CodeLensProvider: &protocol.CodeLensOptions{
 				ResolveProvider: false,
 			},
+			CompletionProvider: &protocol.CompletionOptions{
+				TriggerCharacters: []string{"."},
+			},
+			ReferencesProvider:        true,
+			DocumentHighlightProvider: true,
+			RenameProvider: &protocol.RenameOptions{
+				PrepareProvider: true,
+			},
+			DocumentSymbolProvider:  true,
+			WorkspaceSymbolProvider: true,
 		},
 	}
 
@@ -115,24 +127,46 @@ func (s *Server) Initialize(
 	}
 	s.config = conf
 
-	// add the root account as a usable account
-	s.accounts[flow.RootAddress] = conf.RootAccountKey
-	s.activeAccount = flow.RootAddress
+	// remember the root folder of the workspace, if any,
+	// so the files of the workspace can be found
+	if params.RootURI != "" {
+		s.rootPath = uriToFilename(params.RootURI)
+	} else {
+		s.rootPath = params.RootPath
+	}
+
+	if conf.Offline {
+		// execute everything locally, starting with a single root account
+		s.ledger = newLedger()
+		rootAddress := s.ledger.createAccount()
+		rootAccount := flow.BytesToAddress(rootAddress[:])
+		s.accounts[rootAccount] = conf.RootAccountKey
+		s.activeAccount = rootAccount
+
+		conn.LogMessage(&protocol.LogMessageParams{
+			Type:    protocol.Info,
+			Message: "Successfully loaded config in offline mode",
+		})
+	} else {
+		// add the root account as a usable account
+		s.accounts[flow.RootAddress] = conf.RootAccountKey
+		s.activeAccount = flow.RootAddress
+
+		s.flowClient, err = client.New(
+			s.config.EmulatorAddr,
+			grpc.WithInsecure(),
+		)
+		if err != nil {
+			return nil, err
+		}
 
-	s.flowClient, err = client.New(
-		s.config.EmulatorAddr,
-		grpc.WithInsecure(),
-	)
-	if err != nil {
-		return nil, err
+		// TODO remove
+		conn.LogMessage(&protocol.LogMessageParams{
+			Type:    protocol.Info,
+			Message: fmt.Sprintf("Successfully loaded config emu_addr: %s", conf.EmulatorAddr),
+		})
 	}
 
-	// TODO remove
-	conn.LogMessage(&protocol.LogMessageParams{
-		Type:    protocol.Info,
-		Message: fmt.Sprintf("Successfully loaded config emu_addr: %s", conf.EmulatorAddr),
-	})
-
 	// after initialization, indicate to the client which commands we support
 	go s.registerCommands(conn)
 
@@ -254,14 +288,6 @@ func (s *Server) Definition(
 	}, nil
 }
 
-// TODO
-func (s *Server) SignatureHelp(
-	_ protocol.Conn,
-	_ *protocol.TextDocumentPositionParams,
-) (*protocol.SignatureHelp, error) {
-	return nil, nil
-}
-
 // CodeLens is called every time the document contents change and returns a
 // list of actions to be injected into the source as inline buttons.
 func (s *Server) CodeLens(conn protocol.Conn, params *protocol.CodeLensParams) ([]*protocol.CodeLens, error) {
@@ -443,18 +469,13 @@ func (s *Server) getDiagnostics(conn protocol.Conn, uri protocol.DocumentUri, te
 
 	// There were no parser errors. Proceed to resolving imports and
 	// checking the parsed program.
-	mainPath := strings.TrimPrefix(string(uri), "file://")
+	mainPath := uriToFilename(uri)
 
 	_ = program.ResolveImports(func(location ast.Location) (program *ast.Program, err error) {
 		return s.resolveImport(conn, mainPath, location)
 	})
 
-	checker, err := sema.NewChecker(
-		program,
-		runtime.FileLocation(string(uri)),
-		sema.WithPredeclaredValues(valueDeclarations),
-		sema.WithPredeclaredTypes(typeDeclarations),
-	)
+	checker, err := newChecker(program, uri)
 	if err != nil {
 		return nil, err
 	}
@@ -601,12 +622,22 @@ func (s *Server) resolveFileImport(mainPath string, location ast.StringLocation)
 func (s *Server) resolveAccountImport(location ast.AddressLocation) (*ast.Program, error) {
 	accountAddr := location.ToAddress()
 
-	acct, err := s.flowClient.GetAccount(context.Background(), flow.BytesToAddress(accountAddr[:]))
-	if err != nil {
-		return nil, fmt.Errorf("cannot get account with address 0x%s. err: %w", accountAddr, err)
+	var code []byte
+	if s.ledger != nil {
+		var err error
+		code, err = s.ledger.accountCode(accountAddr)
+		if err != nil {
+			return nil, fmt.Errorf("cannot get account with address 0x%s. err: %w", accountAddr, err)
+		}
+	} else {
+		acct, err := s.flowClient.GetAccount(context.Background(), flow.BytesToAddress(accountAddr[:]))
+		if err != nil {
+			return nil, fmt.Errorf("cannot get account with address 0x%s. err: %w", accountAddr, err)
+		}
+		code = acct.Code
 	}
 
-	program, _, err := parser.ParseProgram(string(acct.Code))
+	program, _, err := parser.ParseProgram(string(code))
 	if err != nil {
 		return nil, fmt.Errorf("cannot parse code at adddress 0x%s. err: %w", accountAddr, err)
 	}
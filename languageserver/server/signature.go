@@ -0,0 +1,328 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/onflow/cadence/runtime/sema"
+
+	"github.com/onflow/cadence/languageserver/protocol"
+)
+
+// SignatureHelp returns the signature of the function, host function, or composite initializer
+// which is invoked at the given position, and highlights the parameter of the argument
+// the cursor is in.
+func (s *Server) SignatureHelp(
+	_ protocol.Conn,
+	params *protocol.TextDocumentPositionParams,
+) (*protocol.SignatureHelp, error) {
+
+	uri := params.TextDocument.URI
+	checker, ok := s.checkers[uri]
+	if !ok {
+		return nil, nil
+	}
+
+	doc, ok := s.documents[uri]
+	if !ok {
+		return nil, nil
+	}
+
+	context, ok := findInvocationContext(doc.text, params.Position)
+	if !ok {
+		return nil, nil
+	}
+
+	invokedPosition := sema.Position{
+		Line:   context.line + 1,
+		Column: len([]rune(context.invokedPrefix)) - 1,
+	}
+
+	invokedType := findExpressionType(checker, context.invokedPrefix, invokedPosition)
+
+	invokableType, ok := invokedType.(sema.InvokableType)
+	if !ok {
+		return nil, nil
+	}
+
+	functionType := invokableType.InvocationFunctionType()
+
+	name := context.invokedPrefix[len(strings.TrimRightFunc(context.invokedPrefix, isIdentifierRune)):]
+
+	parameters := make([]protocol.ParameterInformation, len(functionType.Parameters))
+	for i, parameter := range functionType.Parameters {
+		parameters[i] = protocol.ParameterInformation{
+			Label: parameter.QualifiedString(),
+		}
+	}
+
+	signature := protocol.SignatureInformation{
+		Label:         functionSignature(name, functionType),
+		Documentation: typeArgumentsDocumentation(checker, functionType, invokedPosition),
+		Parameters:    parameters,
+	}
+
+	activeParameter := context.argumentIndex
+	if activeParameter >= len(parameters) && len(parameters) > 0 {
+		activeParameter = len(parameters) - 1
+	}
+
+	return &protocol.SignatureHelp{
+		Signatures:      []protocol.SignatureInformation{signature},
+		ActiveSignature: 0,
+		ActiveParameter: float64(activeParameter),
+	}, nil
+}
+
+// typeArgumentsDocumentation returns a description of the type arguments
+// which were inferred or given for the invocation of the given generic function,
+// if the invocation was checked.
+func typeArgumentsDocumentation(
+	checker *sema.Checker,
+	functionType *sema.FunctionType,
+	invokedPosition sema.Position,
+) string {
+
+	if len(functionType.TypeParameters) == 0 {
+		return ""
+	}
+
+	typeArguments := findInvocationTypeArguments(checker, invokedPosition)
+	if typeArguments == nil {
+		return ""
+	}
+
+	var descriptions []string
+
+	for _, typeParameter := range functionType.TypeParameters {
+		typeArgument, ok := typeArguments[typeParameter]
+		if !ok || typeArgument == nil {
+			continue
+		}
+
+		descriptions = append(
+			descriptions,
+			fmt.Sprintf("%s = %s", typeParameter.Name, typeArgument.QualifiedString()),
+		)
+	}
+
+	if len(descriptions) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("Type arguments: %s", strings.Join(descriptions, ", "))
+}
+
+// findInvocationTypeArguments returns the type arguments of the checked invocation
+// whose invoked expression ends at the given position.
+func findInvocationTypeArguments(
+	checker *sema.Checker,
+	invokedPosition sema.Position,
+) map[*sema.TypeParameter]sema.Type {
+
+	elaboration := checker.Elaboration
+
+	for invocation, typeArguments := range elaboration.InvocationExpressionTypeParameterTypes {
+		endPosition := invocation.InvokedExpression.EndPosition()
+		if endPosition.Line == invokedPosition.Line &&
+			endPosition.Column == invokedPosition.Column {
+
+			return typeArguments
+		}
+	}
+
+	return nil
+}
+
+// invocationContext describes the invocation which encloses a position.
+type invocationContext struct {
+	// line is the line of the end of the invoked expression
+	line int
+	// invokedPrefix is the text of the line up to and including the end of the invoked expression
+	invokedPrefix string
+	// argumentIndex is the index of the argument the position is in
+	argumentIndex int
+}
+
+// openingBracket is an opening bracket which is not closed yet.
+type openingBracket struct {
+	r             rune
+	line          int
+	column        int
+	argumentIndex int
+}
+
+// findInvocationContext finds the invocation which encloses the given position,
+// by scanning the text up to the position for the unmatched opening parenthesis
+// of the argument list, skipping strings and comments.
+//
+// The text is scanned instead of the program, as the program can usually not be parsed
+// while the arguments of the invocation are being written.
+func findInvocationContext(text string, position protocol.Position) (invocationContext, bool) {
+	lines := strings.Split(text, "\n")
+
+	endLine := int(position.Line)
+	if endLine < 0 || endLine >= len(lines) {
+		return invocationContext{}, false
+	}
+
+	var brackets []openingBracket
+	inString := false
+	inBlockComment := false
+
+	for line := 0; line <= endLine; line++ {
+		runes := []rune(lines[line])
+
+		endColumn := len(runes)
+		if line == endLine && int(position.Character) < endColumn {
+			endColumn = int(position.Character)
+		}
+
+	columns:
+		for column := 0; column < endColumn; column++ {
+			r := runes[column]
+
+			var next rune
+			if column+1 < len(runes) {
+				next = runes[column+1]
+			}
+
+			switch {
+			case inBlockComment:
+				if r == '*' && next == '/' {
+					inBlockComment = false
+					column++
+				}
+
+			case inString:
+				switch r {
+				case '\\':
+					column++
+				case '"':
+					inString = false
+				}
+
+			case r == '/' && next == '/':
+				break columns
+
+			case r == '/' && next == '*':
+				inBlockComment = true
+				column++
+
+			case r == '"':
+				inString = true
+
+			case r == '(' || r == '[' || r == '{':
+				brackets = append(brackets, openingBracket{
+					r:      r,
+					line:   line,
+					column: column,
+				})
+
+			case r == ')' || r == ']' || r == '}':
+				if len(brackets) > 0 {
+					brackets = brackets[:len(brackets)-1]
+				}
+
+			case r == ',':
+				if len(brackets) > 0 {
+					brackets[len(brackets)-1].argumentIndex++
+				}
+			}
+		}
+
+		// Strings cannot span multiple lines
+
+		inString = false
+	}
+
+	// Find the innermost argument list.
+	// The position might be in an array literal which is an argument,
+	// but not in a block, e.g. of a function expression
+
+	for i := len(brackets) - 1; i >= 0; i-- {
+		bracket := brackets[i]
+
+		switch bracket.r {
+		case '[':
+			continue
+
+		case '{':
+			return invocationContext{}, false
+
+		case '(':
+			runes := []rune(lines[bracket.line])
+
+			invokedEnd := findInvokedExpressionEnd(runes, bracket.column)
+			if invokedEnd < 0 {
+				return invocationContext{}, false
+			}
+
+			return invocationContext{
+				line:          bracket.line,
+				invokedPrefix: string(runes[:invokedEnd+1]),
+				argumentIndex: bracket.argumentIndex,
+			}, true
+		}
+	}
+
+	return invocationContext{}, false
+}
+
+// findInvokedExpressionEnd returns the column of the end of the invoked expression
+// before the opening parenthesis of an argument list at the given column,
+// skipping the type arguments, if any.
+//
+// Returns -1 if there is no invoked expression.
+func findInvokedExpressionEnd(runes []rune, openingParenthesisColumn int) int {
+	column := skipSpaceBackwards(runes, openingParenthesisColumn-1)
+
+	if column >= 0 && runes[column] == '>' {
+		depth := 1
+		for depth > 0 {
+			column--
+			if column < 0 {
+				return -1
+			}
+
+			switch runes[column] {
+			case '>':
+				depth++
+			case '<':
+				depth--
+			}
+		}
+		column = skipSpaceBackwards(runes, column-1)
+	}
+
+	if column < 0 || !isIdentifierRune(runes[column]) && runes[column] != ')' {
+		return -1
+	}
+
+	return column
+}
+
+func skipSpaceBackwards(runes []rune, column int) int {
+	for column >= 0 && (runes[column] == ' ' || runes[column] == '\t') {
+		column--
+	}
+	return column
+}
@@ -0,0 +1,348 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+
+	"github.com/onflow/cadence/languageserver/protocol"
+)
+
+// DocumentSymbol returns the outline of the given document, i.e. a tree of the declarations:
+// The composites, interfaces, functions, and transactions, and their members.
+func (s *Server) DocumentSymbol(
+	_ protocol.Conn,
+	params *protocol.DocumentSymbolParams,
+) ([]*protocol.DocumentSymbol, error) {
+
+	program, err := s.parseFile(uriToFilename(params.TextDocument.URI))
+	if err != nil {
+		return nil, nil
+	}
+
+	symbols := programSymbols(program)
+
+	result := make([]*protocol.DocumentSymbol, len(symbols))
+	for i := range symbols {
+		result[i] = &symbols[i]
+	}
+
+	return result, nil
+}
+
+// WorkspaceSymbol returns the declarations of all Cadence files in the workspace
+// whose name contains the given query.
+func (s *Server) WorkspaceSymbol(
+	_ protocol.Conn,
+	params *protocol.WorkspaceSymbolParams,
+) ([]*protocol.SymbolInformation, error) {
+
+	query := strings.ToLower(params.Query)
+
+	var result []*protocol.SymbolInformation
+
+	var addSymbols func(uri protocol.DocumentUri, containerName string, symbols []protocol.DocumentSymbol)
+	addSymbols = func(uri protocol.DocumentUri, containerName string, symbols []protocol.DocumentSymbol) {
+		for _, symbol := range symbols {
+			if strings.Contains(strings.ToLower(symbol.Name), query) {
+				result = append(result, &protocol.SymbolInformation{
+					Name: symbol.Name,
+					Kind: symbol.Kind,
+					Location: protocol.Location{
+						URI:   uri,
+						Range: symbol.SelectionRange,
+					},
+					ContainerName: containerName,
+				})
+			}
+
+			addSymbols(uri, symbol.Name, symbol.Children)
+		}
+	}
+
+	for _, filename := range s.workspaceFilenames() {
+		program, err := s.parseFile(filename)
+		if err != nil {
+			continue
+		}
+
+		addSymbols(filenameToURI(filename), "", programSymbols(program))
+	}
+
+	return result, nil
+}
+
+// programSymbols returns the symbols for the declarations of the given program.
+func programSymbols(program *ast.Program) []protocol.DocumentSymbol {
+	var symbols []protocol.DocumentSymbol
+
+	for _, declaration := range program.Declarations {
+		switch declaration := declaration.(type) {
+		case *ast.CompositeDeclaration:
+			symbols = append(symbols, compositeSymbol(declaration))
+
+		case *ast.InterfaceDeclaration:
+			symbols = append(symbols, interfaceSymbol(declaration))
+
+		case *ast.FunctionDeclaration:
+			symbols = append(symbols, functionSymbol(declaration, protocol.Function))
+
+		case *ast.TransactionDeclaration:
+			symbols = append(symbols, transactionSymbol(declaration))
+		}
+	}
+
+	return symbols
+}
+
+func compositeSymbol(declaration *ast.CompositeDeclaration) protocol.DocumentSymbol {
+	var children []protocol.DocumentSymbol
+
+	// The fields of events are declared as parameters of the initializer
+
+	if declaration.CompositeKind == common.CompositeKindEvent {
+		for _, initializer := range declaration.Members.Initializers() {
+			for _, parameter := range initializer.ParameterList.Parameters {
+				children = append(children, parameterSymbol(parameter))
+			}
+		}
+	} else {
+		children = membersSymbols(declaration.Members)
+	}
+
+	for _, nestedDeclaration := range declaration.CompositeDeclarations {
+		children = append(children, compositeSymbol(nestedDeclaration))
+	}
+
+	for _, nestedDeclaration := range declaration.InterfaceDeclarations {
+		children = append(children, interfaceSymbol(nestedDeclaration))
+	}
+
+	sortSymbols(children)
+
+	return protocol.DocumentSymbol{
+		Name:           declaration.Identifier.Identifier,
+		Detail:         declaration.DeclarationKind().Keywords(),
+		Kind:           compositeSymbolKind(declaration.CompositeKind),
+		Range:          astToProtocolRange(declaration.StartPosition(), declaration.EndPosition()),
+		SelectionRange: astToProtocolRange(declaration.Identifier.StartPosition(), declaration.Identifier.EndPosition()),
+		Children:       children,
+	}
+}
+
+func interfaceSymbol(declaration *ast.InterfaceDeclaration) protocol.DocumentSymbol {
+	children := membersSymbols(declaration.Members)
+
+	for _, nestedDeclaration := range declaration.CompositeDeclarations {
+		children = append(children, compositeSymbol(nestedDeclaration))
+	}
+
+	for _, nestedDeclaration := range declaration.InterfaceDeclarations {
+		children = append(children, interfaceSymbol(nestedDeclaration))
+	}
+
+	sortSymbols(children)
+
+	return protocol.DocumentSymbol{
+		Name:           declaration.Identifier.Identifier,
+		Detail:         declaration.DeclarationKind().Keywords(),
+		Kind:           protocol.Interface,
+		Range:          astToProtocolRange(declaration.StartPosition(), declaration.EndPosition()),
+		SelectionRange: astToProtocolRange(declaration.Identifier.StartPosition(), declaration.Identifier.EndPosition()),
+		Children:       children,
+	}
+}
+
+func transactionSymbol(declaration *ast.TransactionDeclaration) protocol.DocumentSymbol {
+	var children []protocol.DocumentSymbol
+
+	for _, field := range declaration.Fields {
+		children = append(children, fieldSymbol(field))
+	}
+
+	if declaration.Prepare != nil {
+		children = append(children, specialFunctionSymbol(declaration.Prepare, "prepare"))
+	}
+
+	if declaration.Execute != nil {
+		children = append(children, specialFunctionSymbol(declaration.Execute, "execute"))
+	}
+
+	// Transactions have no name, so select the keyword
+
+	startPosition := declaration.StartPosition()
+
+	return protocol.DocumentSymbol{
+		Name:           "transaction",
+		Kind:           protocol.Namespace,
+		Range:          astToProtocolRange(startPosition, declaration.EndPosition()),
+		SelectionRange: astToProtocolRange(startPosition, startPosition.Shifted(len("transaction")-1)),
+		Children:       children,
+	}
+}
+
+func membersSymbols(members *ast.Members) []protocol.DocumentSymbol {
+	var symbols []protocol.DocumentSymbol
+
+	for _, field := range members.Fields {
+		symbols = append(symbols, fieldSymbol(field))
+	}
+
+	for _, enumCase := range members.EnumCases {
+		symbols = append(symbols, protocol.DocumentSymbol{
+			Name:           enumCase.Identifier.Identifier,
+			Kind:           protocol.EnumMember,
+			Range:          astToProtocolRange(enumCase.StartPosition(), enumCase.EndPosition()),
+			SelectionRange: astToProtocolRange(enumCase.Identifier.StartPosition(), enumCase.Identifier.EndPosition()),
+		})
+	}
+
+	for _, initializer := range members.Initializers() {
+		symbols = append(symbols, specialFunctionSymbol(initializer, "init"))
+	}
+
+	for _, destructor := range members.Destructors() {
+		symbols = append(symbols, specialFunctionSymbol(destructor, "destroy"))
+	}
+
+	for _, function := range members.Functions {
+		symbols = append(symbols, functionSymbol(function, protocol.Method))
+	}
+
+	return symbols
+}
+
+func fieldSymbol(field *ast.FieldDeclaration) protocol.DocumentSymbol {
+	return protocol.DocumentSymbol{
+		Name:           field.Identifier.Identifier,
+		Detail:         field.TypeAnnotation.String(),
+		Kind:           protocol.Field,
+		Range:          astToProtocolRange(field.StartPosition(), field.EndPosition()),
+		SelectionRange: astToProtocolRange(field.Identifier.StartPosition(), field.Identifier.EndPosition()),
+	}
+}
+
+func parameterSymbol(parameter *ast.Parameter) protocol.DocumentSymbol {
+	return protocol.DocumentSymbol{
+		Name:           parameter.Identifier.Identifier,
+		Detail:         parameter.TypeAnnotation.String(),
+		Kind:           protocol.Field,
+		Range:          astToProtocolRange(parameter.StartPosition(), parameter.EndPosition()),
+		SelectionRange: astToProtocolRange(parameter.Identifier.StartPosition(), parameter.Identifier.EndPosition()),
+	}
+}
+
+func functionSymbol(function *ast.FunctionDeclaration, kind protocol.SymbolKind) protocol.DocumentSymbol {
+	return protocol.DocumentSymbol{
+		Name:           function.Identifier.Identifier,
+		Detail:         functionDetail(function),
+		Kind:           kind,
+		Range:          astToProtocolRange(function.StartPosition(), function.EndPosition()),
+		SelectionRange: astToProtocolRange(function.Identifier.StartPosition(), function.Identifier.EndPosition()),
+	}
+}
+
+// specialFunctionSymbol returns the symbol for the given special function,
+// e.g. an initializer, which is declared with the given keyword.
+func specialFunctionSymbol(function *ast.SpecialFunctionDeclaration, keyword string) protocol.DocumentSymbol {
+	kind := protocol.Method
+	if function.DeclarationKind == common.DeclarationKindInitializer {
+		kind = protocol.Constructor
+	}
+
+	startPosition := function.Identifier.StartPosition()
+
+	return protocol.DocumentSymbol{
+		Name:           keyword,
+		Detail:         functionDetail(function.FunctionDeclaration),
+		Kind:           kind,
+		Range:          astToProtocolRange(function.StartPosition(), function.EndPosition()),
+		SelectionRange: astToProtocolRange(startPosition, startPosition.Shifted(len(keyword)-1)),
+	}
+}
+
+// functionDetail returns the parameters and the return type of the given function,
+// e.g. `(label a: Int): String`.
+func functionDetail(function *ast.FunctionDeclaration) string {
+	var builder strings.Builder
+
+	builder.WriteRune('(')
+
+	if function.ParameterList != nil {
+		for i, parameter := range function.ParameterList.Parameters {
+			if i > 0 {
+				builder.WriteString(", ")
+			}
+			if parameter.Label != "" {
+				builder.WriteString(parameter.Label)
+				builder.WriteRune(' ')
+			}
+			builder.WriteString(parameter.Identifier.Identifier)
+			builder.WriteString(": ")
+			builder.WriteString(parameter.TypeAnnotation.String())
+		}
+	}
+
+	builder.WriteRune(')')
+
+	if function.ReturnTypeAnnotation != nil {
+		returnType := function.ReturnTypeAnnotation.String()
+		if returnType != "" && returnType != "Void" {
+			builder.WriteString(": ")
+			builder.WriteString(returnType)
+		}
+	}
+
+	return builder.String()
+}
+
+func compositeSymbolKind(compositeKind common.CompositeKind) protocol.SymbolKind {
+	switch compositeKind {
+	case common.CompositeKindStructure:
+		return protocol.Struct
+
+	case common.CompositeKindContract:
+		return protocol.Module
+
+	case common.CompositeKindEvent:
+		return protocol.Event
+
+	case common.CompositeKindEnum:
+		return protocol.Enum
+
+	default:
+		return protocol.Class
+	}
+}
+
+// sortSymbols sorts the given symbols by their position in the document.
+func sortSymbols(symbols []protocol.DocumentSymbol) {
+	sort.SliceStable(symbols, func(i, j int) bool {
+		a := symbols[i].Range.Start
+		b := symbols[j].Range.Start
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Character < b.Character
+	})
+}
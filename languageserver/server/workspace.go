@@ -0,0 +1,185 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/parser"
+	"github.com/onflow/cadence/runtime/sema"
+
+	"github.com/onflow/cadence/languageserver/protocol"
+)
+
+// cadenceFileExtension is the extension of Cadence source files.
+const cadenceFileExtension = ".cdc"
+
+// checkedFile is a parsed and checked file of the workspace.
+type checkedFile struct {
+	checker *sema.Checker
+	text    string
+	err     error
+}
+
+// newChecker returns a new checker for the given program of the given document.
+func newChecker(program *ast.Program, uri protocol.DocumentUri) (*sema.Checker, error) {
+	return sema.NewChecker(
+		program,
+		runtime.FileLocation(string(uri)),
+		sema.WithPredeclaredValues(valueDeclarations),
+		sema.WithPredeclaredTypes(typeDeclarations),
+		sema.WithRangesEnabled(true),
+	)
+}
+
+// checkFile returns the checker and the text of the file with the given name.
+//
+// The checkers of open documents are used as-is,
+// other files are read from disk, parsed, and checked.
+func (s *Server) checkFile(conn protocol.Conn, filename string) checkedFile {
+	uri := filenameToURI(filename)
+
+	if checker, ok := s.checkers[uri]; ok {
+		if doc, ok := s.documents[uri]; ok {
+			return checkedFile{
+				checker: checker,
+				text:    doc.text,
+			}
+		}
+	}
+
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return checkedFile{err: err}
+	}
+	text := string(data)
+
+	program, _, err := parser.ParseProgram(text)
+	if err != nil {
+		return checkedFile{err: err}
+	}
+
+	_ = program.ResolveImports(func(location ast.Location) (*ast.Program, error) {
+		return s.resolveImport(conn, filename, location)
+	})
+
+	checker, err := newChecker(program, uri)
+	if err != nil {
+		return checkedFile{err: err}
+	}
+
+	// Programs with semantic errors are still useful,
+	// e.g. to find the occurrences of valid declarations
+
+	_ = checker.Check()
+
+	return checkedFile{
+		checker: checker,
+		text:    text,
+	}
+}
+
+// parseFile parses the file with the given name.
+//
+// The text of open documents is used instead of the file contents,
+// as they might have been changed.
+func (s *Server) parseFile(filename string) (*ast.Program, error) {
+	uri := filenameToURI(filename)
+
+	if doc, ok := s.documents[uri]; ok {
+		program, _, err := parser.ParseProgram(doc.text)
+		if err != nil {
+			// Fall back to the last successfully parsed program
+			if checker, ok := s.checkers[uri]; ok {
+				return checker.Program, nil
+			}
+		}
+		return program, err
+	}
+
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	program, _, err := parser.ParseProgram(string(data))
+	return program, err
+}
+
+// workspaceFilenames returns the names of all Cadence files in the workspace,
+// i.e. the open documents and the files in the root folder of the workspace, if any.
+func (s *Server) workspaceFilenames() []string {
+	seen := map[string]bool{}
+	var filenames []string
+
+	add := func(filename string) {
+		if seen[filename] {
+			return
+		}
+		seen[filename] = true
+		filenames = append(filenames, filename)
+	}
+
+	for uri := range s.documents {
+		add(uriToFilename(uri))
+	}
+
+	if s.rootPath != "" {
+		_ = filepath.Walk(s.rootPath, func(filename string, info os.FileInfo, err error) error {
+			if err != nil {
+				return nil
+			}
+
+			if info.IsDir() {
+				// Skip hidden directories and dependencies
+				name := info.Name()
+				if filename != s.rootPath &&
+					(strings.HasPrefix(name, ".") || name == "node_modules") {
+
+					return filepath.SkipDir
+				}
+				return nil
+			}
+
+			if filepath.Ext(filename) == cadenceFileExtension {
+				add(filename)
+			}
+
+			return nil
+		})
+	}
+
+	sort.Strings(filenames)
+
+	return filenames
+}
+
+func uriToFilename(uri protocol.DocumentUri) string {
+	return strings.TrimPrefix(string(uri), "file://")
+}
+
+func filenameToURI(filename string) protocol.DocumentUri {
+	return protocol.DocumentUri("file://" + filename)
+}
@@ -87,3 +87,37 @@ func (f *FieldDeclaration) DeclarationKind() common.DeclarationKind {
 func (f *FieldDeclaration) DeclarationAccess() Access {
 	return f.Access
 }
+
+// EnumCaseDeclaration
+
+type EnumCaseDeclaration struct {
+	Access     Access
+	Identifier Identifier
+	StartPos   Position
+}
+
+func (d *EnumCaseDeclaration) Accept(visitor Visitor) Repr {
+	return visitor.VisitEnumCaseDeclaration(d)
+}
+
+func (*EnumCaseDeclaration) isDeclaration() {}
+
+func (d *EnumCaseDeclaration) DeclarationIdentifier() *Identifier {
+	return &d.Identifier
+}
+
+func (d *EnumCaseDeclaration) DeclarationKind() common.DeclarationKind {
+	return common.DeclarationKindEnumCase
+}
+
+func (d *EnumCaseDeclaration) DeclarationAccess() Access {
+	return d.Access
+}
+
+func (d *EnumCaseDeclaration) StartPosition() Position {
+	return d.StartPos
+}
+
+func (d *EnumCaseDeclaration) EndPosition() Position {
+	return d.Identifier.EndPosition()
+}
@@ -120,6 +120,48 @@ func (e *StringExpression) String() string {
 	return ""
 }
 
+// StringTemplateExpression
+//
+// A string template is a string literal with interpolated expressions,
+// e.g. `"balance: \(balance)"`.
+//
+// Values are the literal parts of the string template.
+// There is always one more value than there are expressions,
+// i.e. the expression at index i is interpolated between the values at index i and i+1.
+//
+type StringTemplateExpression struct {
+	Values      []string
+	Expressions []Expression
+	Range
+}
+
+func (*StringTemplateExpression) isExpression() {}
+
+func (*StringTemplateExpression) isIfStatementTest() {}
+
+func (e *StringTemplateExpression) Accept(visitor Visitor) Repr {
+	return e.AcceptExp(visitor)
+}
+
+func (e *StringTemplateExpression) AcceptExp(visitor ExpressionVisitor) Repr {
+	return visitor.VisitStringTemplateExpression(e)
+}
+
+func (e *StringTemplateExpression) String() string {
+	var builder strings.Builder
+	builder.WriteRune('"')
+	for i, value := range e.Values {
+		if i > 0 {
+			builder.WriteString(`\(`)
+			builder.WriteString(e.Expressions[i-1].String())
+			builder.WriteRune(')')
+		}
+		builder.WriteString(value)
+	}
+	builder.WriteRune('"')
+	return builder.String()
+}
+
 // IntegerExpression
 
 type IntegerExpression struct {
@@ -0,0 +1,155 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ast
+
+import (
+	"sort"
+)
+
+// functionRange is the range of lines of a function declaration.
+//
+type functionRange struct {
+	name      string
+	startLine int
+	endLine   int
+}
+
+// FunctionIndex finds the function declarations of a program which contain a line.
+//
+type FunctionIndex struct {
+	functions []functionRange
+}
+
+// NewFunctionIndex returns a new index of all function declarations in the given program,
+// including the functions of composites and interfaces, and the functions of transactions.
+//
+func NewFunctionIndex(program *Program) *FunctionIndex {
+	index := &FunctionIndex{}
+
+	for _, declaration := range program.FunctionDeclarations() {
+		index.addFunction("", declaration)
+	}
+
+	for _, declaration := range program.CompositeDeclarations() {
+		index.addComposite("", declaration)
+	}
+
+	for _, declaration := range program.InterfaceDeclarations() {
+		index.addInterface("", declaration)
+	}
+
+	for _, declaration := range program.TransactionDeclarations() {
+		const prefix = "transaction."
+		if declaration.Prepare != nil {
+			index.addSpecialFunction(prefix, declaration.Prepare)
+		}
+		if declaration.Execute != nil {
+			index.addSpecialFunction(prefix, declaration.Execute)
+		}
+	}
+
+	// Sort the functions by size, so that the innermost function is found first
+
+	sort.SliceStable(index.functions, func(i, j int) bool {
+		a := index.functions[i]
+		b := index.functions[j]
+		return a.endLine-a.startLine < b.endLine-b.startLine
+	})
+
+	return index
+}
+
+func (index *FunctionIndex) addFunction(prefix string, declaration *FunctionDeclaration) {
+	index.functions = append(
+		index.functions,
+		functionRange{
+			name:      prefix + declaration.Identifier.Identifier,
+			startLine: declaration.StartPosition().Line,
+			endLine:   declaration.EndPosition().Line,
+		},
+	)
+}
+
+func (index *FunctionIndex) addSpecialFunction(prefix string, declaration *SpecialFunctionDeclaration) {
+	name := declaration.Identifier.Identifier
+	if name == "" {
+		name = declaration.DeclarationKind.Keywords()
+	}
+
+	index.functions = append(
+		index.functions,
+		functionRange{
+			name:      prefix + name,
+			startLine: declaration.StartPosition().Line,
+			endLine:   declaration.EndPosition().Line,
+		},
+	)
+}
+
+func (index *FunctionIndex) addMembers(prefix string, members *Members) {
+	for _, function := range members.SpecialFunctions {
+		index.addSpecialFunction(prefix, function)
+	}
+
+	for _, function := range members.Functions {
+		index.addFunction(prefix, function)
+	}
+}
+
+func (index *FunctionIndex) addComposite(prefix string, declaration *CompositeDeclaration) {
+	prefix += declaration.Identifier.Identifier + "."
+
+	index.addMembers(prefix, declaration.Members)
+
+	for _, nestedDeclaration := range declaration.CompositeDeclarations {
+		index.addComposite(prefix, nestedDeclaration)
+	}
+
+	for _, nestedDeclaration := range declaration.InterfaceDeclarations {
+		index.addInterface(prefix, nestedDeclaration)
+	}
+}
+
+func (index *FunctionIndex) addInterface(prefix string, declaration *InterfaceDeclaration) {
+	prefix += declaration.Identifier.Identifier + "."
+
+	index.addMembers(prefix, declaration.Members)
+
+	for _, nestedDeclaration := range declaration.CompositeDeclarations {
+		index.addComposite(prefix, nestedDeclaration)
+	}
+
+	for _, nestedDeclaration := range declaration.InterfaceDeclarations {
+		index.addInterface(prefix, nestedDeclaration)
+	}
+}
+
+// Find returns the name of the innermost function declaration which contains the given line.
+// If no function contains the line, e.g. for the initializer of a global variable,
+// the empty string is returned.
+//
+func (index *FunctionIndex) Find(line int) string {
+	for _, function := range index.functions {
+		if function.startLine <= line && line <= function.endLine {
+			return function.name
+		}
+	}
+
+	return ""
+}
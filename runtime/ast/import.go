@@ -160,6 +160,74 @@ func init() {
 	gob.Register(AddressLocation([]byte{}))
 }
 
+// AddressContractLocation
+
+// AddressContractLocation is the location of a contract
+// which is deployed to an account under a name.
+//
+type AddressContractLocation struct {
+	AddressLocation AddressLocation
+	Name            string
+}
+
+func (l AddressContractLocation) String() string {
+	return fmt.Sprintf("%s.%s", l.AddressLocation, l.Name)
+}
+
+func (l AddressContractLocation) ID() LocationID {
+	return LocationID(fmt.Sprintf(
+		"%s.%s",
+		l.AddressLocation.ID(),
+		l.Name,
+	))
+}
+
+func (l AddressContractLocation) ToAddress() common.Address {
+	return l.AddressLocation.ToAddress()
+}
+
+func init() {
+	gob.Register(AddressContractLocation{})
+}
+
+// TypeIDLocationID returns the location ID which is used as the prefix
+// of the type IDs of the types declared in the given location.
+//
+// Contracts deployed to an account under a name are declared in the namespace
+// of the account, i.e. their type IDs do not include the name of the contract twice.
+//
+func TypeIDLocationID(location Location) LocationID {
+	if contractLocation, ok := location.(AddressContractLocation); ok {
+		return contractLocation.AddressLocation.ID()
+	}
+
+	return location.ID()
+}
+
+// TransactionLocation
+
+const TransactionPrefix = "T"
+
+type TransactionLocation []byte
+
+func (l TransactionLocation) ID() LocationID {
+	return LocationID(fmt.Sprintf(
+		"%s.%s",
+		TransactionPrefix,
+		l.String(),
+	))
+}
+
+func (l TransactionLocation) String() string {
+	return hex.EncodeToString(l)
+}
+
+func init() {
+	// NOTE: register under the name the type had when it was declared
+	// in the runtime package, so legacy encoded values can still be decoded
+	gob.RegisterName("github.com/onflow/cadence/runtime.TransactionLocation", TransactionLocation{})
+}
+
 // HasImportLocation
 
 type HasImportLocation interface {
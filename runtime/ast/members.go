@@ -38,6 +38,8 @@ type Members struct {
 	Functions    []*FunctionDeclaration
 	// Use `FunctionsByIdentifier()` instead
 	_functionsByIdentifier map[string]*FunctionDeclaration
+	// Only valid in enum declarations
+	EnumCases []*EnumCaseDeclaration
 }
 
 func (m *Members) FieldsByIdentifier() map[string]*FieldDeclaration {
@@ -48,6 +48,11 @@ const (
 	OperationCast
 	OperationFailableCast
 	OperationForceCast
+	OperationBitwiseOr
+	OperationBitwiseXor
+	OperationBitwiseLeftShift
+	OperationBitwiseRightShift
+	OperationBitwiseNot
 )
 
 func (s Operation) Symbol() string {
@@ -92,6 +97,16 @@ func (s Operation) Symbol() string {
 		return "as?"
 	case OperationForceCast:
 		return "as!"
+	case OperationBitwiseOr:
+		return "|"
+	case OperationBitwiseXor:
+		return "^"
+	case OperationBitwiseLeftShift:
+		return "<<"
+	case OperationBitwiseRightShift:
+		return ">>"
+	case OperationBitwiseNot:
+		return "~"
 	}
 
 	panic(errors.NewUnreachableError())
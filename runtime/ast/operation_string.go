@@ -47,11 +47,16 @@ func _() {
 	_ = x[OperationCast-18]
 	_ = x[OperationFailableCast-19]
 	_ = x[OperationForceCast-20]
+	_ = x[OperationBitwiseOr-21]
+	_ = x[OperationBitwiseXor-22]
+	_ = x[OperationBitwiseLeftShift-23]
+	_ = x[OperationBitwiseRightShift-24]
+	_ = x[OperationBitwiseNot-25]
 }
 
-const _Operation_name = "OperationUnknownOperationOrOperationAndOperationEqualOperationUnequalOperationLessOperationGreaterOperationLessEqualOperationGreaterEqualOperationPlusOperationMinusOperationMulOperationDivOperationModOperationConcatOperationNegateOperationNilCoalesceOperationMoveOperationCastOperationFailableCastOperationForceCast"
+const _Operation_name = "OperationUnknownOperationOrOperationAndOperationEqualOperationUnequalOperationLessOperationGreaterOperationLessEqualOperationGreaterEqualOperationPlusOperationMinusOperationMulOperationDivOperationModOperationConcatOperationNegateOperationNilCoalesceOperationMoveOperationCastOperationFailableCastOperationForceCastOperationBitwiseOrOperationBitwiseXorOperationBitwiseLeftShiftOperationBitwiseRightShiftOperationBitwiseNot"
 
-var _Operation_index = [...]uint16{0, 16, 27, 39, 53, 69, 82, 98, 116, 137, 150, 164, 176, 188, 200, 215, 230, 250, 263, 276, 297, 315}
+var _Operation_index = [...]uint16{0, 16, 27, 39, 53, 69, 82, 98, 116, 137, 150, 164, 176, 188, 200, 215, 230, 250, 263, 276, 297, 315, 333, 352, 377, 403, 422}
 
 func (i Operation) String() string {
 	if i < 0 || i >= Operation(len(_Operation_index)-1) {
@@ -137,6 +137,34 @@ func (s *ForStatement) EndPosition() Position {
 	return s.Block.EndPosition()
 }
 
+// SwitchStatement
+
+type SwitchStatement struct {
+	Expression Expression
+	Cases      []*SwitchCase
+	Range
+}
+
+func (*SwitchStatement) isStatement() {}
+
+func (s *SwitchStatement) Accept(visitor Visitor) Repr {
+	return visitor.VisitSwitchStatement(s)
+}
+
+// SwitchCase
+
+// NOTE: the expressions of the default case are nil
+//
+type SwitchCase struct {
+	Expressions []Expression
+	Statements  []Statement
+	Range
+}
+
+func (s *SwitchCase) IsDefault() bool {
+	return s.Expressions == nil
+}
+
 // EmitStatement
 
 type EmitStatement struct {
@@ -221,3 +221,27 @@ func (t *RestrictedType) String() string {
 	builder.WriteRune('}')
 	return builder.String()
 }
+
+// InstantiationType represents an instantiation of a generic (nominal) type
+
+type InstantiationType struct {
+	Type          Type
+	TypeArguments []*TypeAnnotation
+	Range
+}
+
+func (*InstantiationType) isType() {}
+
+func (t *InstantiationType) String() string {
+	var builder strings.Builder
+	builder.WriteString(t.Type.String())
+	builder.WriteRune('<')
+	for i, typeArgument := range t.TypeArguments {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(typeArgument.String())
+	}
+	builder.WriteRune('>')
+	return builder.String()
+}
@@ -47,6 +47,7 @@ type StatementVisitor interface {
 	VisitIfStatement(*IfStatement) Repr
 	VisitWhileStatement(*WhileStatement) Repr
 	VisitForStatement(*ForStatement) Repr
+	VisitSwitchStatement(*SwitchStatement) Repr
 	VisitEmitStatement(*EmitStatement) Repr
 	VisitVariableDeclaration(*VariableDeclaration) Repr
 	VisitAssignmentStatement(*AssignmentStatement) Repr
@@ -70,6 +71,7 @@ type ExpressionVisitor interface {
 	VisitBinaryExpression(*BinaryExpression) Repr
 	VisitFunctionExpression(*FunctionExpression) Repr
 	VisitStringExpression(*StringExpression) Repr
+	VisitStringTemplateExpression(*StringTemplateExpression) Repr
 	VisitCastingExpression(*CastingExpression) Repr
 	VisitCreateExpression(*CreateExpression) Repr
 	VisitDestroyExpression(*DestroyExpression) Repr
@@ -88,6 +90,7 @@ type Visitor interface {
 	VisitCompositeDeclaration(*CompositeDeclaration) Repr
 	VisitInterfaceDeclaration(*InterfaceDeclaration) Repr
 	VisitFieldDeclaration(*FieldDeclaration) Repr
+	VisitEnumCaseDeclaration(*EnumCaseDeclaration) Repr
 	VisitCondition(*Condition) Repr
 	VisitImportDeclaration(*ImportDeclaration) Repr
 	VisitTransactionDeclaration(*TransactionDeclaration) Repr
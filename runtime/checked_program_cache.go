@@ -0,0 +1,132 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"sync"
+
+	"golang.org/x/crypto/sha3"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+type codeHash [32]byte
+
+func newCodeHash(code []byte) codeHash {
+	return sha3.Sum256(code)
+}
+
+// checkedProgram is an imported program which passed semantic analysis.
+//
+type checkedProgram struct {
+	// codeHash is the hash of the code the program was parsed from
+	codeHash codeHash
+	checker  *sema.Checker
+	// imports are the checkers of the programs imported by the program,
+	// i.e. the checkers the program was checked against
+	imports map[ast.LocationID]*sema.Checker
+}
+
+// checkedProgramCache caches imported programs which passed semantic analysis,
+// so that imported programs are only checked once, instead of in every execution.
+//
+// A cached program is only used if the code at its location has the same hash,
+// and the programs it imports are the same cached programs it was checked against.
+//
+type checkedProgramCache struct {
+	mutex    sync.Mutex
+	programs map[ast.LocationID]*checkedProgram
+}
+
+func newCheckedProgramCache() *checkedProgramCache {
+	return &checkedProgramCache{
+		programs: map[ast.LocationID]*checkedProgram{},
+	}
+}
+
+// get returns the checker of the cached program at the given location,
+// if the program has the given code hash and was checked against the given imported checkers.
+// Otherwise nil is returned.
+//
+func (c *checkedProgramCache) get(
+	locationID ast.LocationID,
+	hash codeHash,
+	imports map[ast.LocationID]*sema.Checker,
+) *sema.Checker {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	program, ok := c.programs[locationID]
+	if !ok || program.codeHash != hash {
+		return nil
+	}
+
+	if len(program.imports) != len(imports) {
+		return nil
+	}
+
+	for importLocationID, importChecker := range program.imports {
+		if imports[importLocationID] != importChecker {
+			return nil
+		}
+	}
+
+	return program.checker
+}
+
+// set caches the given program for the given location,
+// replacing the previously cached program, if any.
+//
+func (c *checkedProgramCache) set(locationID ast.LocationID, program *checkedProgram) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.programs[locationID] = program
+}
+
+// invalidate removes the cached program for the given location,
+// and all cached programs which directly or indirectly import it.
+//
+func (c *checkedProgramCache) invalidate(locationID ast.LocationID) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	invalidated := map[ast.LocationID]bool{
+		locationID: true,
+	}
+	delete(c.programs, locationID)
+
+	// Remove the dependents until no more programs are removed
+
+	for removed := true; removed; {
+		removed = false
+
+		for dependentLocationID, program := range c.programs {
+			for importLocationID := range program.imports {
+				if invalidated[importLocationID] {
+					invalidated[dependentLocationID] = true
+					delete(c.programs, dependentLocationID)
+					removed = true
+					break
+				}
+			}
+		}
+	}
+}
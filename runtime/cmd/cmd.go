@@ -137,7 +137,10 @@ func standardLibraryFunctions() stdlib.StandardLibraryFunctions {
 	return append(stdlib.BuiltinFunctions, stdlib.HelperFunctions...)
 }
 
-func PrepareInterpreter(filename string) (*interpreter.Interpreter, *sema.Checker, func(error)) {
+func PrepareInterpreter(
+	filename string,
+	options ...interpreter.Option,
+) (*interpreter.Interpreter, *sema.Checker, func(error)) {
 
 	codeBytes, err := ioutil.ReadFile(filename)
 
@@ -150,11 +153,16 @@ func PrepareInterpreter(filename string) (*interpreter.Interpreter, *sema.Checke
 
 	inter, err := interpreter.NewInterpreter(
 		checker,
-		interpreter.WithPredefinedValues(values),
-		interpreter.WithUUIDHandler(func() uint64 {
-			defer func() { uuid++ }()
-			return uuid
-		}),
+		append(
+			[]interpreter.Option{
+				interpreter.WithPredefinedValues(values),
+				interpreter.WithUUIDHandler(func() uint64 {
+					defer func() { uuid++ }()
+					return uuid
+				}),
+			},
+			options...,
+		)...,
 	)
 	must(err)
 
@@ -0,0 +1,240 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package debug
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/dap"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/parser"
+)
+
+// Serve runs a debug adapter which communicates over the standard input and output.
+// The launched programs are executed using the runtime, see Launch.
+//
+func Serve() {
+	server := dap.NewServer(os.Stdin, os.Stdout, Launch)
+
+	err := server.Serve()
+	if err != nil {
+		// NOTE: the standard output is used for the protocol
+		_, _ = fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// Launch prepares the program in the file given in the launch arguments for the execution using the runtime.
+//
+// Programs which declare a transaction are executed as transactions, all other programs are executed as scripts.
+// Imports of files are resolved relative to the directory of the program,
+// and the storage of accounts is kept in memory.
+//
+func Launch(arguments dap.LaunchArguments, debugger *interpreter.Debugger, output io.Writer) (dap.Program, error) {
+	path, err := filepath.Abs(arguments.Program)
+	if err != nil {
+		return nil, err
+	}
+
+	code, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, _, err := parser.ParseProgram(string(code))
+	if err != nil {
+		return nil, err
+	}
+
+	signers := make([]runtime.Address, 0, len(arguments.Signers))
+	for _, signer := range arguments.Signers {
+		address, err := parseAddress(signer)
+		if err != nil {
+			return nil, err
+		}
+		signers = append(signers, address)
+	}
+
+	encodedArguments := make([][]byte, 0, len(arguments.Arguments))
+	for _, argument := range arguments.Arguments {
+		encodedArguments = append(encodedArguments, argument)
+	}
+
+	p := &program{
+		path:          path,
+		directory:     filepath.Dir(path),
+		code:          code,
+		isTransaction: len(parsed.TransactionDeclarations()) > 0,
+		arguments:     encodedArguments,
+		runtime:       runtime.NewInterpreterRuntime(runtime.WithDebugger(debugger)),
+	}
+
+	p.runtimeInterface = &runtimeInterface{
+		program: p,
+		output:  output,
+		signers: signers,
+		storage: map[storageKey][]byte{},
+	}
+
+	return p, nil
+}
+
+func parseAddress(address string) (runtime.Address, error) {
+	b, err := hex.DecodeString(strings.TrimPrefix(address, "0x"))
+	if err != nil {
+		return runtime.Address{}, fmt.Errorf("invalid signer address: %s", address)
+	}
+	return common.BytesToAddress(b), nil
+}
+
+type program struct {
+	path             string
+	directory        string
+	code             []byte
+	isTransaction    bool
+	arguments        [][]byte
+	runtime          runtime.Runtime
+	runtimeInterface *runtimeInterface
+}
+
+func (p *program) Location(path string) ast.Location {
+	if path == p.path {
+		return runtime.FileLocation(path)
+	}
+
+	relativePath, err := filepath.Rel(p.directory, path)
+	if err != nil {
+		return runtime.StringLocation(path)
+	}
+	return runtime.StringLocation(relativePath)
+}
+
+func (p *program) Path(location ast.Location) string {
+	switch location := location.(type) {
+	case runtime.FileLocation:
+		return string(location)
+
+	case runtime.StringLocation:
+		return filepath.Join(p.directory, string(location))
+
+	default:
+		return string(location.ID())
+	}
+}
+
+func (p *program) Run() error {
+	location := runtime.FileLocation(p.path)
+
+	if p.isTransaction {
+		return p.runtime.ExecuteTransaction(p.code, p.arguments, p.runtimeInterface, location)
+	}
+
+	value, err := p.runtime.ExecuteScript(p.code, p.arguments, p.runtimeInterface, location)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := value.(cadence.Void); ok {
+		return nil
+	}
+
+	encoded, err := jsoncdc.Encode(value)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(p.runtimeInterface.output, "Result: %s\n", encoded)
+	return err
+}
+
+type storageKey struct {
+	controller string
+	owner      string
+	key        string
+}
+
+// runtimeInterface is the interface to the runtime for debugged programs.
+//
+type runtimeInterface struct {
+	runtime.EmptyRuntimeInterface
+	program *program
+	output  io.Writer
+	signers []runtime.Address
+	storage map[storageKey][]byte
+	uuid    uint64
+}
+
+var _ runtime.Interface = &runtimeInterface{}
+
+func (i *runtimeInterface) ResolveImport(location runtime.Location) ([]byte, error) {
+	switch location := location.(type) {
+	case runtime.StringLocation, runtime.FileLocation:
+		return ioutil.ReadFile(i.program.Path(location))
+
+	default:
+		return nil, fmt.Errorf("cannot import `%s`. only files are supported", location)
+	}
+}
+
+func (i *runtimeInterface) ValueExists(controller, owner, key []byte) (bool, error) {
+	_, ok := i.storage[storageKey{string(controller), string(owner), string(key)}]
+	return ok, nil
+}
+
+func (i *runtimeInterface) GetValue(controller, owner, key []byte) ([]byte, error) {
+	return i.storage[storageKey{string(controller), string(owner), string(key)}], nil
+}
+
+func (i *runtimeInterface) SetValue(controller, owner, key, value []byte) error {
+	storageKey := storageKey{string(controller), string(owner), string(key)}
+	if len(value) == 0 {
+		delete(i.storage, storageKey)
+	} else {
+		i.storage[storageKey] = value
+	}
+	return nil
+}
+
+func (i *runtimeInterface) GetSigningAccounts() []runtime.Address {
+	return i.signers
+}
+
+func (i *runtimeInterface) Log(message string) {
+	_, _ = fmt.Fprintln(i.output, message)
+}
+
+func (i *runtimeInterface) GenerateUUID() uint64 {
+	i.uuid++
+	return i.uuid
+}
+
+func (i *runtimeInterface) DecodeArgument(b []byte, _ cadence.Type) (cadence.Value, error) {
+	return jsoncdc.Decode(b)
+}
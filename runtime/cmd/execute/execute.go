@@ -19,25 +19,68 @@
 package execute
 
 import (
+	"flag"
+	"os"
+
 	"github.com/onflow/cadence/runtime/cmd"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/profiler"
 )
 
 // Execute parses the given filename and prints any syntax errors.
 // If there are no syntax errors, the program is interpreted.
 // If after the interpretation a global function `main` is defined, it will be called.
 // The program may call the function `log` to print a value.
+//
+// If the flag `-profile` is given, a pprof profile of the execution is written to the given file.
 func Execute(args []string) {
 
+	flags := flag.NewFlagSet("execute", flag.ExitOnError)
+	profileFilename := flags.String("profile", "", "write a pprof profile of the execution to the given file")
+
+	// NOTE: the flag set exits on error
+	_ = flags.Parse(args)
+	args = flags.Args()
+
 	if len(args) < 1 {
 		cmd.ExitWithError("no input file")
 	}
 
-	inter, _, must := cmd.PrepareInterpreter(args[0])
+	var options []interpreter.Option
+
+	var prof *profiler.Profiler
+	if *profileFilename != "" {
+		prof = profiler.NewProfiler()
+		options = prof.InterpreterOptions()
+	}
+
+	inter, _, must := cmd.PrepareInterpreter(args[0], options...)
+
+	if _, hasMain := inter.Globals["main"]; hasMain {
+		_, err := inter.Invoke("main")
+		must(err)
+	}
+
+	if prof != nil {
+		prof.Stop()
+		writeProfile(prof, *profileFilename)
+	}
+}
 
-	if _, hasMain := inter.Globals["main"]; !hasMain {
-		return
+func writeProfile(prof *profiler.Profiler, filename string) {
+	file, err := os.Create(filename)
+	if err != nil {
+		cmd.ExitWithError(err.Error())
 	}
 
-	_, err := inter.Invoke("main")
-	must(err)
+	err = prof.WriteProfile(file)
+	if err != nil {
+		_ = file.Close()
+		cmd.ExitWithError(err.Error())
+	}
+
+	err = file.Close()
+	if err != nil {
+		cmd.ExitWithError(err.Error())
+	}
 }
@@ -21,13 +21,18 @@ package main
 import (
 	"os"
 
+	"github.com/onflow/cadence/runtime/cmd/debug"
 	"github.com/onflow/cadence/runtime/cmd/execute"
 )
 
 func main() {
-	if len(os.Args) > 1 {
+	switch {
+	case len(os.Args) > 1 && os.Args[1] == "-dap":
+		// Run a debug adapter, which communicates over the standard input and output
+		debug.Serve()
+	case len(os.Args) > 1:
 		execute.Execute(os.Args[1:])
-	} else {
+	default:
 		execute.RunREPL()
 	}
 }
@@ -32,6 +32,7 @@ const (
 	CompositeKindResource
 	CompositeKindContract
 	CompositeKindEvent
+	CompositeKindEnum
 )
 
 var AllCompositeKinds = []CompositeKind{
@@ -39,6 +40,7 @@ var AllCompositeKinds = []CompositeKind{
 	CompositeKindResource,
 	CompositeKindContract,
 	CompositeKindEvent,
+	CompositeKindEnum,
 }
 
 var CompositeKindsWithBody = []CompositeKind{
@@ -57,6 +59,8 @@ func (k CompositeKind) Name() string {
 		return "contract"
 	case CompositeKindEvent:
 		return "event"
+	case CompositeKindEnum:
+		return "enum"
 	}
 
 	panic(errors.NewUnreachableError())
@@ -72,6 +76,8 @@ func (k CompositeKind) Keyword() string {
 		return "contract"
 	case CompositeKindEvent:
 		return "event"
+	case CompositeKindEnum:
+		return "enum"
 	}
 
 	panic(errors.NewUnreachableError())
@@ -102,6 +108,12 @@ func (k CompositeKind) DeclarationKind(isInterface bool) DeclarationKind {
 			return DeclarationKindUnknown
 		}
 		return DeclarationKindEvent
+
+	case CompositeKindEnum:
+		if isInterface {
+			return DeclarationKindUnknown
+		}
+		return DeclarationKindEnum
 	}
 
 	panic(errors.NewUnreachableError())
@@ -150,7 +162,8 @@ func (k CompositeKind) SupportsInterfaces() bool {
 
 		return true
 
-	case CompositeKindEvent:
+	case CompositeKindEvent,
+		CompositeKindEnum:
 		return false
 	}
 
@@ -0,0 +1,59 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+//go:generate stringer -type=ComputationKind
+
+// ComputationKind is the kind of an operation which is metered.
+//
+// The computation of an operation is its intensity, e.g. the number of elements of an array,
+// multiplied by the weight of its kind.
+//
+type ComputationKind int
+
+const (
+	ComputationKindUnknown ComputationKind = iota
+	// ComputationKindStatement is the kind of statements, the intensity is always 1
+	ComputationKindStatement
+	// ComputationKindLoop is the kind of loop iterations, the intensity is always 1
+	ComputationKindLoop
+	// ComputationKindFunctionInvocation is the kind of function invocations, the intensity is always 1
+	ComputationKindFunctionInvocation
+	// ComputationKindArrayOperation is the kind of array operations,
+	// the intensity is the number of elements involved
+	ComputationKindArrayOperation
+	// ComputationKindDictionaryOperation is the kind of dictionary operations,
+	// the intensity is the number of entries involved
+	ComputationKindDictionaryOperation
+	// ComputationKindStringOperation is the kind of string operations,
+	// the intensity is the number of bytes involved
+	ComputationKindStringOperation
+	// ComputationKindStorageRead is the kind of storage reads,
+	// the intensity is the number of bytes read
+	ComputationKindStorageRead
+	// ComputationKindStorageWrite is the kind of storage writes,
+	// the intensity is the number of bytes written
+	ComputationKindStorageWrite
+	// ComputationKindValueEncoding is the kind of encodings of stored values,
+	// the intensity is the number of bytes encoded
+	ComputationKindValueEncoding
+	// ComputationKindCryptoOperation is the kind of cryptographic operations, e.g. hashing,
+	// the intensity is the number of bytes of the input
+	ComputationKindCryptoOperation
+)
@@ -52,6 +52,8 @@ const (
 	DeclarationKindPrepare
 	DeclarationKindExecute
 	DeclarationKindTypeParameter
+	DeclarationKindEnum
+	DeclarationKindEnumCase
 )
 
 func (k DeclarationKind) IsTypeDeclaration() bool {
@@ -63,7 +65,8 @@ func (k DeclarationKind) IsTypeDeclaration() bool {
 		DeclarationKindStructureInterface,
 		DeclarationKindResourceInterface,
 		DeclarationKindContractInterface,
-		DeclarationKindTypeParameter:
+		DeclarationKindTypeParameter,
+		DeclarationKindEnum:
 
 		return true
 
@@ -122,6 +125,10 @@ func (k DeclarationKind) Name() string {
 		return "execute"
 	case DeclarationKindTypeParameter:
 		return "type parameter"
+	case DeclarationKindEnum:
+		return "enum"
+	case DeclarationKindEnumCase:
+		return "enum case"
 	case DeclarationKindUnknown:
 		return "unknown"
 	}
@@ -167,6 +174,10 @@ func (k DeclarationKind) Keywords() string {
 		return "prepare"
 	case DeclarationKindExecute:
 		return "execute"
+	case DeclarationKindEnum:
+		return "enum"
+	case DeclarationKindEnumCase:
+		return "case"
 	default:
 		return ""
 	}
@@ -128,6 +128,38 @@ func (t *IntervalST) search(x *node, p Position) (*Interval, interface{}) {
 	return nil, nil
 }
 
+// SearchAll returns the values of all intervals which contain the given position.
+//
+func (t *IntervalST) SearchAll(p Position) []interface{} {
+	var result []interface{}
+	t.searchAll(t.root, p, &result)
+	return result
+}
+
+func (t *IntervalST) searchAll(x *node, p Position, result *[]interface{}) {
+	if x == nil {
+		return
+	}
+
+	// The left subtree may only contain matching intervals
+	// if its maximum endpoint is not before the position
+
+	if x.left.Max().Compare(p) >= 0 {
+		t.searchAll(x.left, p, result)
+	}
+
+	if x.interval.Contains(p) {
+		*result = append(*result, x.value)
+	}
+
+	// The intervals in the right subtree start at or after this interval,
+	// so they may only contain the position if this interval starts before it
+
+	if x.interval.Min.Compare(p) <= 0 {
+		t.searchAll(x.right, p, result)
+	}
+}
+
 func (t *IntervalST) Values() []interface{} {
 	return t.root.Values()
 }
@@ -238,3 +238,51 @@ func TestIntervalST2(t *testing.T) {
 		assert.NotNil(t, res)
 	}
 }
+
+func TestIntervalSTSearchAll(t *testing.T) {
+
+	intervals := []Interval{
+		NewInterval(lineAndColumn{1, 0}, lineAndColumn{10, 0}),
+		NewInterval(lineAndColumn{2, 0}, lineAndColumn{5, 0}),
+		NewInterval(lineAndColumn{3, 0}, lineAndColumn{4, 0}),
+		NewInterval(lineAndColumn{4, 5}, lineAndColumn{8, 0}),
+		NewInterval(lineAndColumn{6, 0}, lineAndColumn{7, 0}),
+		NewInterval(lineAndColumn{11, 0}, lineAndColumn{12, 0}),
+	}
+
+	for i := 0; i < 10; i++ {
+
+		st := &IntervalST{}
+
+		rand.Shuffle(len(intervals), func(i, j int) {
+			intervals[i], intervals[j] = intervals[j], intervals[i]
+		})
+
+		for _, interval := range intervals {
+			st.Put(interval, interval)
+		}
+
+		for _, position := range []lineAndColumn{
+			{0, 0},
+			{3, 5},
+			{4, 5},
+			{6, 0},
+			{10, 5},
+			{11, 5},
+		} {
+			var expected []Interval
+			for _, interval := range intervals {
+				if interval.Contains(position) {
+					expected = append(expected, interval)
+				}
+			}
+
+			var actual []Interval
+			for _, value := range st.SearchAll(position) {
+				actual = append(actual, value.(Interval))
+			}
+
+			assert.ElementsMatch(t, expected, actual)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+//go:generate stringer -type=MemoryKind
+
+// MemoryKind is the kind of a value allocation which is metered.
+//
+// The memory used by an allocation is its amount, e.g. the number of elements of an array,
+// multiplied by the estimated size of a unit of its kind.
+//
+type MemoryKind int
+
+const (
+	MemoryKindUnknown MemoryKind = iota
+	// MemoryKindString is the kind of string values,
+	// the amount is the number of bytes
+	MemoryKindString
+	// MemoryKindArray is the kind of array values,
+	// the amount is the number of elements
+	MemoryKindArray
+	// MemoryKindDictionary is the kind of dictionary values,
+	// the amount is the number of entries
+	MemoryKindDictionary
+	// MemoryKindComposite is the kind of composite values, the amount is always 1
+	MemoryKindComposite
+	// MemoryKindBigInt is the kind of arbitrary-precision integer values,
+	// the amount is the number of bytes
+	MemoryKindBigInt
+)
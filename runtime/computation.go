@@ -0,0 +1,184 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"math"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/profiler"
+)
+
+type ComputationKind = common.ComputationKind
+
+// ComputationWeights are the weights of the computation kinds,
+// i.e. the computation used per unit of intensity of an operation of the kind.
+//
+// Operations of kinds without a weight do not use any computation.
+//
+type ComputationWeights map[ComputationKind]uint64
+
+// DefaultComputationWeights are the computation weights used
+// if the runtime interface does not provide any:
+// Each statement, loop iteration, and function invocation uses one unit of computation.
+//
+var DefaultComputationWeights = ComputationWeights{
+	common.ComputationKindStatement:          1,
+	common.ComputationKindLoop:               1,
+	common.ComputationKindFunctionInvocation: 1,
+}
+
+// computationMeter meters the weighted computation used by an execution
+// and enforces the computation limit.
+//
+type computationMeter struct {
+	weights ComputationWeights
+	// limit is the computation limit. A value of 0 means there is no limit
+	limit uint64
+	used  uint64
+	// profiler is the profiler the computation is reported to, if any
+	profiler *profiler.Profiler
+}
+
+func newComputationMeter(runtimeInterface Interface, profiler *profiler.Profiler) *computationMeter {
+	weights := runtimeInterface.GetComputationWeights()
+	if weights == nil {
+		weights = DefaultComputationWeights
+	}
+
+	return &computationMeter{
+		weights:  weights,
+		limit:    runtimeInterface.GetComputationLimit(),
+		profiler: profiler,
+	}
+}
+
+// meter adds the computation of an operation with the given kind and intensity.
+//
+// A ComputationLimitExceededError is returned if the used computation exceeds the limit.
+//
+func (m *computationMeter) meter(kind ComputationKind, intensity uint) error {
+	return m.meterAt(nil, 0, kind, intensity)
+}
+
+// meterAt is like meter, but when profiling, the computation is attributed
+// to the given line of the program of the given interpreter.
+// If no interpreter is given, the computation is attributed to the current statement.
+//
+func (m *computationMeter) meterAt(
+	inter *interpreter.Interpreter,
+	line int,
+	kind ComputationKind,
+	intensity uint,
+) error {
+	weight := m.weights[kind]
+	if weight == 0 || intensity == 0 {
+		return nil
+	}
+
+	// Saturate on overflow
+
+	computation := weight * uint64(intensity)
+	if computation/uint64(intensity) != weight {
+		computation = math.MaxUint64
+	}
+
+	if m.profiler != nil {
+		if inter == nil {
+			m.profiler.ReportCurrentComputation(computation)
+		} else {
+			m.profiler.ReportComputation(inter, line, computation)
+		}
+	}
+
+	if m.used > math.MaxUint64-computation {
+		m.used = math.MaxUint64
+	} else {
+		m.used += computation
+	}
+
+	if m.limit > 0 && m.used > m.limit {
+		return ComputationLimitExceededError{
+			Limit: m.limit,
+		}
+	}
+
+	return nil
+}
+
+// mustMeter is like meter, but panics if the computation limit is exceeded.
+// It is used in the interpreter handlers, where the panic is turned into an error.
+//
+func (m *computationMeter) mustMeter(kind ComputationKind, intensity uint) {
+	err := m.meter(kind, intensity)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// mustMeterAt is like meterAt, but panics if the computation limit is exceeded.
+//
+func (m *computationMeter) mustMeterAt(
+	inter *interpreter.Interpreter,
+	line int,
+	kind ComputationKind,
+	intensity uint,
+) {
+	err := m.meterAt(inter, line, kind, intensity)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// interpreterOptions returns the interpreter options which meter
+// the computation of the interpreted program.
+//
+func (m *computationMeter) interpreterOptions() []interpreter.Option {
+	return []interpreter.Option{
+		interpreter.WithOnStatementHandler(
+			func(statement *interpreter.Statement) {
+				if m.profiler != nil {
+					m.profiler.ReportStatement(statement.Interpreter, statement.Line)
+				}
+				m.mustMeterAt(
+					statement.Interpreter,
+					statement.Line,
+					common.ComputationKindStatement,
+					1,
+				)
+			},
+		),
+		interpreter.WithOnLoopIterationHandler(
+			func(inter *interpreter.Interpreter, line int) {
+				m.mustMeterAt(inter, line, common.ComputationKindLoop, 1)
+			},
+		),
+		interpreter.WithOnFunctionInvocationHandler(
+			func(inter *interpreter.Interpreter, line int) {
+				m.mustMeterAt(inter, line, common.ComputationKindFunctionInvocation, 1)
+			},
+		),
+		interpreter.WithOnMeterComputationHandler(
+			func(_ *interpreter.Interpreter, kind common.ComputationKind, intensity uint) {
+				m.mustMeter(kind, intensity)
+			},
+		),
+	}
+}
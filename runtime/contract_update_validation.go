@@ -0,0 +1,520 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"fmt"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+)
+
+// ContractUpdateValidator validates that the new code of a contract
+// is compatible with the old code, i.e. that values of the types declared
+// in the old code, which may be stored, can still be decoded and used
+// with the types declared in the new code.
+//
+// The validation compares the declarations of the old and the new program:
+//
+// - Composite and interface declarations must not be removed
+// - The kind of a declaration must not change, e.g. from resource to struct
+// - Fields of composites must not be removed or added,
+//   and the types of fields must not change
+// - The raw type of an enum must not change, and enum cases must not be
+//   removed, renamed, or reordered, as stored enum values refer to cases by index.
+//   New cases may only be added at the end
+//
+// Event declarations are not validated, as events are never stored.
+//
+type ContractUpdateValidator struct {
+	oldProgram             *ast.Program
+	newProgram             *ast.Program
+	contractsReinitialized bool
+	errors                 []error
+}
+
+type ContractUpdateValidatorOption func(*ContractUpdateValidator)
+
+// WithContractsReinitialized returns a contract update validator option which
+// declares if the contracts are initialized again when the code is updated.
+//
+// If the contracts are initialized again, the stored contract values are replaced,
+// so the fields of the contracts themselves may change.
+//
+func WithContractsReinitialized(reinitialized bool) ContractUpdateValidatorOption {
+	return func(validator *ContractUpdateValidator) {
+		validator.contractsReinitialized = reinitialized
+	}
+}
+
+// NewContractUpdateValidator returns a new validator for the update
+// from the old program to the new program.
+//
+func NewContractUpdateValidator(
+	oldProgram *ast.Program,
+	newProgram *ast.Program,
+	options ...ContractUpdateValidatorOption,
+) *ContractUpdateValidator {
+	validator := &ContractUpdateValidator{
+		oldProgram: oldProgram,
+		newProgram: newProgram,
+	}
+
+	for _, option := range options {
+		option(validator)
+	}
+
+	return validator
+}
+
+// Validate returns a ContractUpdateError which contains all incompatibilities
+// between the old and the new program, or nil if the update is valid.
+//
+func (v *ContractUpdateValidator) Validate(location Location) error {
+	v.errors = nil
+
+	programRange := ast.Range{
+		StartPos: v.newProgram.StartPosition(),
+		EndPos:   v.newProgram.EndPosition(),
+	}
+
+	v.checkNestedDeclarations(
+		"",
+		v.oldProgram.CompositeDeclarations(),
+		v.oldProgram.InterfaceDeclarations(),
+		v.newProgram.CompositeDeclarations(),
+		v.newProgram.InterfaceDeclarations(),
+		programRange,
+	)
+
+	if len(v.errors) == 0 {
+		return nil
+	}
+
+	return &ContractUpdateError{
+		Location: location,
+		Errors:   v.errors,
+	}
+}
+
+func (v *ContractUpdateValidator) report(err error) {
+	v.errors = append(v.errors, err)
+}
+
+func (v *ContractUpdateValidator) checkNestedDeclarations(
+	prefix string,
+	oldCompositeDeclarations []*ast.CompositeDeclaration,
+	oldInterfaceDeclarations []*ast.InterfaceDeclaration,
+	newCompositeDeclarations []*ast.CompositeDeclaration,
+	newInterfaceDeclarations []*ast.InterfaceDeclaration,
+	parentRange ast.Range,
+) {
+	newDeclarations := map[string]ast.Declaration{}
+
+	for _, declaration := range newCompositeDeclarations {
+		newDeclarations[declaration.Identifier.Identifier] = declaration
+	}
+
+	for _, declaration := range newInterfaceDeclarations {
+		newDeclarations[declaration.Identifier.Identifier] = declaration
+	}
+
+	for _, oldDeclaration := range oldCompositeDeclarations {
+
+		// Events are never stored
+
+		if oldDeclaration.CompositeKind == common.CompositeKindEvent {
+			continue
+		}
+
+		name := prefix + oldDeclaration.Identifier.Identifier
+
+		newDeclaration, ok := v.checkDeclaration(name, oldDeclaration, newDeclarations, parentRange)
+		if !ok {
+			continue
+		}
+
+		newCompositeDeclaration := newDeclaration.(*ast.CompositeDeclaration)
+
+		if !v.contractsReinitialized ||
+			oldDeclaration.CompositeKind != common.CompositeKindContract {
+
+			v.checkFields(name, oldDeclaration, newCompositeDeclaration)
+		}
+
+		if oldDeclaration.CompositeKind == common.CompositeKindEnum {
+			v.checkEnum(name, oldDeclaration, newCompositeDeclaration)
+		}
+
+		v.checkNestedDeclarations(
+			name+".",
+			oldDeclaration.CompositeDeclarations,
+			oldDeclaration.InterfaceDeclarations,
+			newCompositeDeclaration.CompositeDeclarations,
+			newCompositeDeclaration.InterfaceDeclarations,
+			newCompositeDeclaration.Range,
+		)
+	}
+
+	for _, oldDeclaration := range oldInterfaceDeclarations {
+
+		name := prefix + oldDeclaration.Identifier.Identifier
+
+		newDeclaration, ok := v.checkDeclaration(name, oldDeclaration, newDeclarations, parentRange)
+		if !ok {
+			continue
+		}
+
+		newInterfaceDeclaration := newDeclaration.(*ast.InterfaceDeclaration)
+
+		v.checkNestedDeclarations(
+			name+".",
+			oldDeclaration.CompositeDeclarations,
+			oldDeclaration.InterfaceDeclarations,
+			newInterfaceDeclaration.CompositeDeclarations,
+			newInterfaceDeclaration.InterfaceDeclarations,
+			newInterfaceDeclaration.Range,
+		)
+	}
+}
+
+// checkDeclaration checks that the old declaration still exists in the new declarations,
+// and that its kind did not change. It returns the new declaration, if it is compatible.
+//
+func (v *ContractUpdateValidator) checkDeclaration(
+	name string,
+	oldDeclaration ast.Declaration,
+	newDeclarations map[string]ast.Declaration,
+	parentRange ast.Range,
+) (ast.Declaration, bool) {
+
+	oldKind := oldDeclaration.DeclarationKind()
+
+	newDeclaration, ok := newDeclarations[oldDeclaration.DeclarationIdentifier().Identifier]
+	if !ok {
+		v.report(&MissingDeclarationError{
+			Kind:  oldKind,
+			Name:  name,
+			Range: parentRange,
+		})
+		return nil, false
+	}
+
+	newKind := newDeclaration.DeclarationKind()
+
+	if newKind != oldKind {
+		v.report(&DeclarationKindChangeError{
+			Name:    name,
+			OldKind: oldKind,
+			NewKind: newKind,
+			Range:   ast.NewRangeFromPositioned(newDeclaration.DeclarationIdentifier()),
+		})
+		return nil, false
+	}
+
+	return newDeclaration, true
+}
+
+func (v *ContractUpdateValidator) checkFields(
+	name string,
+	oldDeclaration *ast.CompositeDeclaration,
+	newDeclaration *ast.CompositeDeclaration,
+) {
+	oldFields := oldDeclaration.Members.FieldsByIdentifier()
+	newFields := newDeclaration.Members.FieldsByIdentifier()
+
+	for _, oldField := range oldDeclaration.Members.Fields {
+		fieldName := oldField.Identifier.Identifier
+
+		newField, ok := newFields[fieldName]
+		if !ok {
+			v.report(&MissingFieldError{
+				DeclarationName: name,
+				FieldName:       fieldName,
+				Range:           ast.NewRangeFromPositioned(newDeclaration.Identifier),
+			})
+			continue
+		}
+
+		// NOTE: types are compared syntactically, so equivalent types
+		// which are written differently, e.g. qualified and unqualified, are rejected
+
+		oldType := oldField.TypeAnnotation.String()
+		newType := newField.TypeAnnotation.String()
+
+		if oldType != newType {
+			v.report(&FieldTypeChangeError{
+				DeclarationName: name,
+				FieldName:       fieldName,
+				OldType:         oldType,
+				NewType:         newType,
+				Range:           ast.NewRangeFromPositioned(newField.TypeAnnotation),
+			})
+		}
+	}
+
+	for _, newField := range newDeclaration.Members.Fields {
+		fieldName := newField.Identifier.Identifier
+
+		if _, ok := oldFields[fieldName]; ok {
+			continue
+		}
+
+		v.report(&ExtraneousFieldError{
+			DeclarationName: name,
+			FieldName:       fieldName,
+			Range:           newField.Range,
+		})
+	}
+}
+
+// checkEnum checks that the raw type of the enum did not change,
+// and that the existing cases are unchanged and in the same order.
+//
+func (v *ContractUpdateValidator) checkEnum(
+	name string,
+	oldDeclaration *ast.CompositeDeclaration,
+	newDeclaration *ast.CompositeDeclaration,
+) {
+	// NOTE: like field types, raw types are compared syntactically
+
+	oldRawType := enumRawTypeString(oldDeclaration)
+	newRawType := enumRawTypeString(newDeclaration)
+
+	if oldRawType != newRawType {
+		v.report(&EnumRawTypeChangeError{
+			DeclarationName: name,
+			OldType:         oldRawType,
+			NewType:         newRawType,
+			Range:           ast.NewRangeFromPositioned(newDeclaration.Identifier),
+		})
+	}
+
+	newCases := newDeclaration.Members.EnumCases
+
+	for index, oldCase := range oldDeclaration.Members.EnumCases {
+		oldCaseName := oldCase.Identifier.Identifier
+
+		if index >= len(newCases) {
+			v.report(&MissingEnumCaseError{
+				DeclarationName: name,
+				CaseName:        oldCaseName,
+				Range:           ast.NewRangeFromPositioned(newDeclaration.Identifier),
+			})
+			continue
+		}
+
+		newCase := newCases[index]
+		newCaseName := newCase.Identifier.Identifier
+
+		if newCaseName != oldCaseName {
+			v.report(&EnumCaseMismatchError{
+				DeclarationName: name,
+				Index:           index,
+				OldCaseName:     oldCaseName,
+				NewCaseName:     newCaseName,
+				Range:           ast.NewRangeFromPositioned(newCase.Identifier),
+			})
+		}
+	}
+}
+
+func enumRawTypeString(declaration *ast.CompositeDeclaration) string {
+	if len(declaration.Conformances) == 0 {
+		return ""
+	}
+	return declaration.Conformances[0].String()
+}
+
+// ContractUpdateError is returned when the new code of a contract is incompatible with the old code.
+//
+type ContractUpdateError struct {
+	Location Location
+	Errors   []error
+}
+
+func (e *ContractUpdateError) Error() string {
+	return fmt.Sprintf("cannot update contract code of %s", e.Location)
+}
+
+func (e *ContractUpdateError) ChildErrors() []error {
+	return e.Errors
+}
+
+// InvalidOldContractCodeError is returned when the old code of a contract cannot be parsed anymore,
+// so the update cannot be validated.
+//
+type InvalidOldContractCodeError struct {
+	Location Location
+	Err      error
+}
+
+func (e *InvalidOldContractCodeError) Error() string {
+	return fmt.Sprintf(
+		"cannot update contract code of %s: old code cannot be parsed: %s",
+		e.Location,
+		e.Err.Error(),
+	)
+}
+
+func (e *InvalidOldContractCodeError) Unwrap() error {
+	return e.Err
+}
+
+// MissingDeclarationError is reported when a composite or interface declaration is removed.
+//
+type MissingDeclarationError struct {
+	Kind common.DeclarationKind
+	Name string
+	ast.Range
+}
+
+func (e *MissingDeclarationError) Error() string {
+	return fmt.Sprintf(
+		"missing %s declaration `%s`",
+		e.Kind.Name(),
+		e.Name,
+	)
+}
+
+// DeclarationKindChangeError is reported when the kind of a declaration changes.
+//
+type DeclarationKindChangeError struct {
+	Name    string
+	OldKind common.DeclarationKind
+	NewKind common.DeclarationKind
+	ast.Range
+}
+
+func (e *DeclarationKindChangeError) Error() string {
+	return fmt.Sprintf(
+		"cannot change declaration `%s` from %s to %s",
+		e.Name,
+		e.OldKind.Name(),
+		e.NewKind.Name(),
+	)
+}
+
+// MissingFieldError is reported when a field of a composite is removed.
+//
+type MissingFieldError struct {
+	DeclarationName string
+	FieldName       string
+	ast.Range
+}
+
+func (e *MissingFieldError) Error() string {
+	return fmt.Sprintf(
+		"missing field `%s` in declaration `%s`",
+		e.FieldName,
+		e.DeclarationName,
+	)
+}
+
+// ExtraneousFieldError is reported when a field is added to a composite.
+// Stored values of the composite have no value for the field.
+//
+type ExtraneousFieldError struct {
+	DeclarationName string
+	FieldName       string
+	ast.Range
+}
+
+func (e *ExtraneousFieldError) Error() string {
+	return fmt.Sprintf(
+		"cannot add field `%s` to declaration `%s`",
+		e.FieldName,
+		e.DeclarationName,
+	)
+}
+
+// FieldTypeChangeError is reported when the type of a field of a composite changes.
+//
+type FieldTypeChangeError struct {
+	DeclarationName string
+	FieldName       string
+	OldType         string
+	NewType         string
+	ast.Range
+}
+
+func (e *FieldTypeChangeError) Error() string {
+	return fmt.Sprintf(
+		"cannot change type of field `%s` in declaration `%s` from `%s` to `%s`",
+		e.FieldName,
+		e.DeclarationName,
+		e.OldType,
+		e.NewType,
+	)
+}
+
+// EnumRawTypeChangeError is reported when the raw type of an enum changes.
+//
+type EnumRawTypeChangeError struct {
+	DeclarationName string
+	OldType         string
+	NewType         string
+	ast.Range
+}
+
+func (e *EnumRawTypeChangeError) Error() string {
+	return fmt.Sprintf(
+		"cannot change raw type of enum `%s` from `%s` to `%s`",
+		e.DeclarationName,
+		e.OldType,
+		e.NewType,
+	)
+}
+
+// MissingEnumCaseError is reported when a case of an enum is removed.
+//
+type MissingEnumCaseError struct {
+	DeclarationName string
+	CaseName        string
+	ast.Range
+}
+
+func (e *MissingEnumCaseError) Error() string {
+	return fmt.Sprintf(
+		"missing case `%s` in enum `%s`",
+		e.CaseName,
+		e.DeclarationName,
+	)
+}
+
+// EnumCaseMismatchError is reported when a case of an enum is renamed, reordered,
+// or when a new case is inserted before an existing case.
+//
+type EnumCaseMismatchError struct {
+	DeclarationName string
+	Index           int
+	OldCaseName     string
+	NewCaseName     string
+	ast.Range
+}
+
+func (e *EnumCaseMismatchError) Error() string {
+	return fmt.Sprintf(
+		"cannot change case %d of enum `%s` from `%s` to `%s`",
+		e.Index,
+		e.DeclarationName,
+		e.OldCaseName,
+		e.NewCaseName,
+	)
+}
@@ -0,0 +1,591 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/parser"
+	"github.com/onflow/cadence/runtime/tests/utils"
+)
+
+func validateTestContractUpdate(
+	t *testing.T,
+	oldCode string,
+	newCode string,
+	options ...ContractUpdateValidatorOption,
+) []error {
+
+	oldProgram, _, err := parser.ParseProgram(oldCode)
+	require.NoError(t, err)
+
+	newProgram, _, err := parser.ParseProgram(newCode)
+	require.NoError(t, err)
+
+	location := AddressContractLocation{
+		AddressLocation: AddressLocation{0x1},
+		Name:            "Test",
+	}
+
+	err = NewContractUpdateValidator(oldProgram, newProgram, options...).
+		Validate(location)
+	if err == nil {
+		return nil
+	}
+
+	require.IsType(t, &ContractUpdateError{}, err)
+	updateErr := err.(*ContractUpdateError)
+
+	assert.Equal(t, location, updateErr.Location)
+
+	return updateErr.Errors
+}
+
+func TestContractUpdateValidation(t *testing.T) {
+
+	const oldCode = `
+      pub contract Test {
+          pub var count: Int
+
+          pub resource R {
+              pub let id: UInt64
+              pub var tags: {String: [Int]}
+
+              init(id: UInt64) {
+                  self.id = id
+                  self.tags = {}
+              }
+          }
+
+          pub struct interface I {}
+
+          pub event Created(id: UInt64)
+
+          init() {
+              self.count = 0
+          }
+      }
+    `
+
+	t.Run("unchanged, functions changed", func(t *testing.T) {
+
+		errs := validateTestContractUpdate(t,
+			oldCode,
+			`
+              pub contract Test {
+                  pub var count: Int
+
+                  pub resource R {
+                      pub let id: UInt64
+                      pub var tags: {String: [Int]}
+
+                      init(id: UInt64) {
+                          self.id = id
+                          self.tags = {"new": []}
+                      }
+
+                      pub fun hello(): String {
+                          return "hello"
+                      }
+                  }
+
+                  pub struct interface I {
+                      pub fun test()
+                  }
+
+                  pub struct S {}
+
+                  init() {
+                      self.count = 1
+                  }
+              }
+            `,
+		)
+
+		require.Empty(t, errs)
+	})
+
+	t.Run("removed nested type", func(t *testing.T) {
+
+		errs := validateTestContractUpdate(t,
+			oldCode,
+			`
+              pub contract Test {
+                  pub var count: Int
+
+                  pub resource R {
+                      pub let id: UInt64
+                      pub var tags: {String: [Int]}
+
+                      init(id: UInt64) {
+                          self.id = id
+                          self.tags = {}
+                      }
+                  }
+
+                  init() {
+                      self.count = 0
+                  }
+              }
+            `,
+		)
+
+		require.Len(t, errs, 1)
+		require.IsType(t, &MissingDeclarationError{}, errs[0])
+
+		err := errs[0].(*MissingDeclarationError)
+		assert.Equal(t, "Test.I", err.Name)
+		assert.Equal(t, common.DeclarationKindStructureInterface, err.Kind)
+		assert.Equal(t, 2, err.StartPos.Line)
+	})
+
+	t.Run("changed kind", func(t *testing.T) {
+
+		errs := validateTestContractUpdate(t,
+			oldCode,
+			`
+              pub contract Test {
+                  pub var count: Int
+
+                  pub struct R {
+                      pub let id: UInt64
+                      pub var tags: {String: [Int]}
+
+                      init(id: UInt64) {
+                          self.id = id
+                          self.tags = {}
+                      }
+                  }
+
+                  pub resource interface I {}
+
+                  init() {
+                      self.count = 0
+                  }
+              }
+            `,
+		)
+
+		require.Len(t, errs, 2)
+
+		require.IsType(t, &DeclarationKindChangeError{}, errs[0])
+		err := errs[0].(*DeclarationKindChangeError)
+		assert.Equal(t, "Test.R", err.Name)
+		assert.Equal(t, common.DeclarationKindResource, err.OldKind)
+		assert.Equal(t, common.DeclarationKindStructure, err.NewKind)
+		assert.Equal(t,
+			ast.Range{
+				StartPos: ast.Position{Offset: 102, Line: 5, Column: 29},
+				EndPos:   ast.Position{Offset: 102, Line: 5, Column: 29},
+			},
+			err.Range,
+		)
+
+		require.IsType(t, &DeclarationKindChangeError{}, errs[1])
+		assert.Equal(t, "Test.I", errs[1].(*DeclarationKindChangeError).Name)
+	})
+
+	t.Run("changed fields", func(t *testing.T) {
+
+		errs := validateTestContractUpdate(t,
+			oldCode,
+			`
+              pub contract Test {
+                  pub var count: UInt
+
+                  pub resource R {
+                      pub var tags: {String: [String]}
+                      pub let name: String
+
+                      init(id: UInt64) {
+                          self.tags = {}
+                          self.name = ""
+                      }
+                  }
+
+                  pub struct interface I {}
+
+                  init() {
+                      self.count = 0
+                  }
+              }
+            `,
+		)
+
+		require.Len(t, errs, 4)
+
+		require.IsType(t, &FieldTypeChangeError{}, errs[0])
+		typeChangeErr := errs[0].(*FieldTypeChangeError)
+		assert.Equal(t, "Test", typeChangeErr.DeclarationName)
+		assert.Equal(t, "count", typeChangeErr.FieldName)
+		assert.Equal(t, "Int", typeChangeErr.OldType)
+		assert.Equal(t, "UInt", typeChangeErr.NewType)
+		assert.Equal(t, 3, typeChangeErr.StartPos.Line)
+
+		require.IsType(t, &MissingFieldError{}, errs[1])
+		missingErr := errs[1].(*MissingFieldError)
+		assert.Equal(t, "Test.R", missingErr.DeclarationName)
+		assert.Equal(t, "id", missingErr.FieldName)
+
+		require.IsType(t, &FieldTypeChangeError{}, errs[2])
+		assert.Equal(t, "tags", errs[2].(*FieldTypeChangeError).FieldName)
+		assert.Equal(t, 6, errs[2].(*FieldTypeChangeError).StartPos.Line)
+
+		require.IsType(t, &ExtraneousFieldError{}, errs[3])
+		extraneousErr := errs[3].(*ExtraneousFieldError)
+		assert.Equal(t, "name", extraneousErr.FieldName)
+		assert.Equal(t, 7, extraneousErr.StartPos.Line)
+	})
+
+	t.Run("changed contract fields, reinitialized", func(t *testing.T) {
+
+		errs := validateTestContractUpdate(t,
+			oldCode,
+			`
+              pub contract Test {
+                  pub let name: String
+
+                  pub resource R {
+                      pub let id: UInt64
+                      pub var tags: {String: [Int]}
+
+                      init(id: UInt64) {
+                          self.id = id
+                          self.tags = {}
+                      }
+                  }
+
+                  pub struct interface I {}
+
+                  init() {
+                      self.name = "test"
+                  }
+              }
+            `,
+			WithContractsReinitialized(true),
+		)
+
+		require.Empty(t, errs)
+	})
+
+	t.Run("removed contract", func(t *testing.T) {
+
+		errs := validateTestContractUpdate(t,
+			oldCode,
+			`
+              pub contract Other {}
+            `,
+			WithContractsReinitialized(true),
+		)
+
+		require.Len(t, errs, 1)
+		require.IsType(t, &MissingDeclarationError{}, errs[0])
+		assert.Equal(t, "Test", errs[0].(*MissingDeclarationError).Name)
+	})
+}
+
+func TestContractUpdateValidationEnums(t *testing.T) {
+
+	const oldCode = `
+      pub contract Test {
+          pub enum E: UInt8 {
+              pub case a
+              pub case b
+              pub case c
+          }
+      }
+    `
+
+	t.Run("cases appended", func(t *testing.T) {
+
+		errs := validateTestContractUpdate(t,
+			oldCode,
+			`
+              pub contract Test {
+                  pub enum E: UInt8 {
+                      pub case a
+                      pub case b
+                      pub case c
+                      pub case d
+                  }
+              }
+            `,
+		)
+
+		require.Empty(t, errs)
+	})
+
+	t.Run("case removed", func(t *testing.T) {
+
+		errs := validateTestContractUpdate(t,
+			oldCode,
+			`
+              pub contract Test {
+                  pub enum E: UInt8 {
+                      pub case a
+                      pub case b
+                  }
+              }
+            `,
+		)
+
+		require.Len(t, errs, 1)
+		require.IsType(t, &MissingEnumCaseError{}, errs[0])
+		missingErr := errs[0].(*MissingEnumCaseError)
+		assert.Equal(t, "Test.E", missingErr.DeclarationName)
+		assert.Equal(t, "c", missingErr.CaseName)
+	})
+
+	t.Run("cases reordered and inserted", func(t *testing.T) {
+
+		errs := validateTestContractUpdate(t,
+			oldCode,
+			`
+              pub contract Test {
+                  pub enum E: UInt8 {
+                      pub case b
+                      pub case a
+                      pub case new
+                      pub case c
+                  }
+              }
+            `,
+		)
+
+		require.Len(t, errs, 3)
+
+		for i, expected := range []struct{ old, new string }{
+			{"a", "b"},
+			{"b", "a"},
+			{"c", "new"},
+		} {
+			require.IsType(t, &EnumCaseMismatchError{}, errs[i])
+			mismatchErr := errs[i].(*EnumCaseMismatchError)
+			assert.Equal(t, i, mismatchErr.Index)
+			assert.Equal(t, expected.old, mismatchErr.OldCaseName)
+			assert.Equal(t, expected.new, mismatchErr.NewCaseName)
+		}
+	})
+
+	t.Run("raw type changed", func(t *testing.T) {
+
+		errs := validateTestContractUpdate(t,
+			oldCode,
+			`
+              pub contract Test {
+                  pub enum E: UInt16 {
+                      pub case a
+                      pub case b
+                      pub case c
+                  }
+              }
+            `,
+		)
+
+		require.Len(t, errs, 1)
+		require.IsType(t, &EnumRawTypeChangeError{}, errs[0])
+		rawTypeErr := errs[0].(*EnumRawTypeChangeError)
+		assert.Equal(t, "UInt8", rawTypeErr.OldType)
+		assert.Equal(t, "UInt16", rawTypeErr.NewType)
+	})
+}
+
+func TestRuntimeContractUpdateValidation(t *testing.T) {
+
+	address := common.BytesToAddress([]byte{0x1})
+
+	oldCode := []byte(`
+      pub contract Test {
+          pub resource R {
+              pub let id: Int
+
+              init(id: Int) {
+                  self.id = id
+              }
+          }
+      }
+    `)
+
+	newCode := []byte(`
+      pub contract Test {
+          pub resource R {
+              pub let id: String
+
+              init(id: String) {
+                  self.id = id
+              }
+          }
+      }
+    `)
+
+	t.Run("AuthAccount.setCode", func(t *testing.T) {
+
+		runtime := NewInterpreterRuntime()
+
+		accountCode := oldCode
+
+		runtimeInterface := &testRuntimeInterface{
+			storage: newTestStorage(),
+			getSigningAccounts: func() []Address {
+				return []Address{address}
+			},
+			getAccountCode: func(_ Address) ([]byte, error) {
+				return accountCode, nil
+			},
+			updateAccountCode: func(_ Address, code []byte, _ bool) error {
+				accountCode = code
+				return nil
+			},
+			emitEvent: func(event cadence.Event) {},
+		}
+
+		tx := []byte(fmt.Sprintf(
+			`
+              transaction {
+                  prepare(signer: AuthAccount) {
+                      signer.setCode("%s".decodeHex())
+                  }
+              }
+            `,
+			hex.EncodeToString(newCode),
+		))
+
+		err := runtime.ExecuteTransaction(tx, nil, runtimeInterface, utils.TestLocation)
+		require.Error(t, err)
+
+		require.IsType(t, Error{}, err)
+		err = err.(Error).Err
+
+		require.IsType(t, &ContractUpdateError{}, err)
+		require.IsType(t,
+			&FieldTypeChangeError{},
+			err.(*ContractUpdateError).Errors[0],
+		)
+
+		assert.Equal(t, oldCode, accountCode)
+	})
+
+	t.Run("AuthAccount.contracts.update", func(t *testing.T) {
+
+		runtime := NewInterpreterRuntime()
+
+		contractCode := oldCode
+
+		runtimeInterface := &testRuntimeInterface{
+			storage: newTestStorage(),
+			getSigningAccounts: func() []Address {
+				return []Address{address}
+			},
+			getAccountContractCode: func(_ Address, _ string) ([]byte, error) {
+				return contractCode, nil
+			},
+			updateAccountContractCode: func(_ Address, _ string, code []byte) error {
+				contractCode = code
+				return nil
+			},
+			emitEvent: func(event cadence.Event) {},
+		}
+
+		tx := []byte(fmt.Sprintf(
+			`
+              transaction {
+                  prepare(signer: AuthAccount) {
+                      signer.contracts.update(name: "Test", code: "%s".decodeHex())
+                  }
+              }
+            `,
+			hex.EncodeToString(newCode),
+		))
+
+		err := runtime.ExecuteTransaction(tx, nil, runtimeInterface, utils.TestLocation)
+		require.Error(t, err)
+
+		require.IsType(t, Error{}, err)
+		err = err.(Error).Err
+
+		require.IsType(t, &ContractUpdateError{}, err)
+
+		assert.Equal(t, oldCode, contractCode)
+	})
+
+	t.Run("old code cannot be parsed", func(t *testing.T) {
+
+		runtime := NewInterpreterRuntime()
+
+		// NOTE: `enum` became a keyword after this code was deployed
+
+		unparsableOldCode := []byte(`
+          pub contract Test {
+              pub resource R {
+                  pub let enum: Int
+
+                  init(enum: Int) {
+                      self.enum = enum
+                  }
+              }
+          }
+        `)
+
+		accountCode := unparsableOldCode
+
+		runtimeInterface := &testRuntimeInterface{
+			storage: newTestStorage(),
+			getSigningAccounts: func() []Address {
+				return []Address{address}
+			},
+			getAccountCode: func(_ Address) ([]byte, error) {
+				return accountCode, nil
+			},
+			updateAccountCode: func(_ Address, code []byte, _ bool) error {
+				accountCode = code
+				return nil
+			},
+			emitEvent: func(event cadence.Event) {},
+		}
+
+		tx := []byte(fmt.Sprintf(
+			`
+              transaction {
+                  prepare(signer: AuthAccount) {
+                      signer.setCode("%s".decodeHex())
+                  }
+              }
+            `,
+			hex.EncodeToString(newCode),
+		))
+
+		err := runtime.ExecuteTransaction(tx, nil, runtimeInterface, utils.TestLocation)
+		require.Error(t, err)
+
+		require.IsType(t, Error{}, err)
+		err = err.(Error).Err
+
+		require.IsType(t, &InvalidOldContractCodeError{}, err)
+
+		assert.Equal(t, unparsableOldCode, accountCode)
+	})
+}
@@ -20,11 +20,17 @@ package runtime
 
 import (
 	"fmt"
+	goRuntime "runtime"
 	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
 	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/stdlib"
 )
 
 // exportType converts a runtime type to its corresponding Go representation.
@@ -92,6 +98,8 @@ func exportType(typ sema.Type) cadence.Type {
 		return exportFunctionType(t)
 	case *sema.AddressType:
 		return cadence.AddressType{}
+	case *sema.MetaType:
+		return cadence.MetaType{}
 	}
 
 	panic(fmt.Sprintf("cannot convert type of type %T", typ))
@@ -165,6 +173,13 @@ func exportCompositeType(t *sema.CompositeType) cadence.Type {
 			Identifier: t.Identifier,
 			Fields:     fields,
 		}
+	case common.CompositeKindEnum:
+		return cadence.EnumType{
+			TypeID:     id,
+			Identifier: t.Identifier,
+			RawType:    exportType(t.EnumRawType),
+			Fields:     fields,
+		}
 	}
 
 	panic(fmt.Sprintf("cannot convert type %v of unknown kind %v", t, t.Kind))
@@ -200,3 +215,347 @@ func exportFunctionType(t *sema.FunctionType) cadence.Type {
 		ReturnType: convertedReturnType,
 	}.WithID(string(t.ID()))
 }
+
+// importTypeID returns the type with the given type ID, as returned by `sema.Type.ID`,
+// e.g. the type ID of an exported type value.
+//
+// Types declared in programs are resolved using the given interpreter,
+// which loads the declaring program if necessary.
+//
+func importTypeID(typeID string, inter *interpreter.Interpreter) (sema.Type, error) {
+	parser := &typeIDParser{
+		typeID: typeID,
+		inter:  inter,
+	}
+
+	ty, err := parser.parseType()
+	if err != nil {
+		return nil, err
+	}
+
+	if parser.offset != len(typeID) {
+		return nil, parser.error()
+	}
+
+	return ty, nil
+}
+
+// builtinTypes are the built-in types which are neither base types nor native composite types,
+// but which are the types of values, e.g. the type of the current block
+//
+var builtinTypes = map[sema.TypeID]sema.Type{}
+
+func init() {
+	for _, ty := range []sema.Type{
+		&sema.AuthAccountContractsType{},
+		&sema.AuthAccountKeysType{},
+		&sema.PublicAccountKeysType{},
+		&stdlib.BlockType{},
+		&stdlib.CryptoType{},
+	} {
+		builtinTypes[ty.ID()] = ty
+	}
+}
+
+type typeIDParser struct {
+	typeID string
+	offset int
+	inter  *interpreter.Interpreter
+}
+
+func (p *typeIDParser) error() error {
+	return &InvalidTypeIDError{
+		TypeID: p.typeID,
+		Offset: p.offset,
+	}
+}
+
+// skip advances past the given prefix and returns true,
+// if the remaining type ID starts with it
+//
+func (p *typeIDParser) skip(prefix string) bool {
+	if !strings.HasPrefix(p.typeID[p.offset:], prefix) {
+		return false
+	}
+	p.offset += len(prefix)
+	return true
+}
+
+func (p *typeIDParser) expect(prefix string) error {
+	if !p.skip(prefix) {
+		return p.error()
+	}
+	return nil
+}
+
+func (p *typeIDParser) parseType() (sema.Type, error) {
+
+	// reference types
+
+	for _, authorized := range []bool{true, false} {
+		prefix := "&"
+		if authorized {
+			prefix = "auth &"
+		}
+
+		if p.skip(prefix) {
+			referencedType, err := p.parseType()
+			if err != nil {
+				return nil, err
+			}
+
+			return &sema.ReferenceType{
+				Authorized: authorized,
+				Type:       referencedType,
+			}, nil
+		}
+	}
+
+	ty, err := p.parsePrimaryType()
+	if err != nil {
+		return nil, err
+	}
+
+	// optional and restricted types
+
+	for {
+		switch {
+		case p.skip("?"):
+			ty = &sema.OptionalType{
+				Type: ty,
+			}
+
+		case p.skip("{"):
+			var restrictions []*sema.InterfaceType
+
+			for !p.skip("}") {
+				if len(restrictions) > 0 {
+					err := p.expect(",")
+					if err != nil {
+						return nil, err
+					}
+				}
+
+				start := p.offset
+				restriction, err := p.resolveNominalType(p.scanNominalTypeID(), start)
+				if err != nil {
+					return nil, err
+				}
+
+				interfaceType, ok := restriction.(*sema.InterfaceType)
+				if !ok {
+					return nil, p.error()
+				}
+
+				restrictions = append(restrictions, interfaceType)
+			}
+
+			ty = &sema.RestrictedType{
+				Type:         ty,
+				Restrictions: restrictions,
+			}
+
+		default:
+			return ty, nil
+		}
+	}
+}
+
+func (p *typeIDParser) parsePrimaryType() (sema.Type, error) {
+	switch {
+	case p.skip("["):
+		elementType, err := p.parseType()
+		if err != nil {
+			return nil, err
+		}
+
+		if p.skip(";") {
+			start := p.offset
+			for p.offset < len(p.typeID) && isDecimalDigit(p.typeID[p.offset]) {
+				p.offset++
+			}
+
+			size, err := strconv.ParseUint(p.typeID[start:p.offset], 10, 64)
+			if err != nil {
+				return nil, p.error()
+			}
+
+			err = p.expect("]")
+			if err != nil {
+				return nil, err
+			}
+
+			return &sema.ConstantSizedType{
+				Type: elementType,
+				Size: size,
+			}, nil
+		}
+
+		err = p.expect("]")
+		if err != nil {
+			return nil, err
+		}
+
+		return &sema.VariableSizedType{
+			Type: elementType,
+		}, nil
+
+	case p.skip("{"):
+		keyType, err := p.parseType()
+		if err != nil {
+			return nil, err
+		}
+
+		err = p.expect(":")
+		if err != nil {
+			return nil, err
+		}
+
+		valueType, err := p.parseType()
+		if err != nil {
+			return nil, err
+		}
+
+		err = p.expect("}")
+		if err != nil {
+			return nil, err
+		}
+
+		return &sema.DictionaryType{
+			KeyType:   keyType,
+			ValueType: valueType,
+		}, nil
+
+	default:
+		start := p.offset
+		typeID := p.scanNominalTypeID()
+
+		if typeID == "Capability" {
+			return p.parseCapabilityType()
+		}
+
+		return p.resolveNominalType(typeID, start)
+	}
+}
+
+func (p *typeIDParser) parseCapabilityType() (sema.Type, error) {
+	if !p.skip("<") {
+		return &sema.CapabilityType{}, nil
+	}
+
+	borrowType, err := p.parseType()
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.expect(">")
+	if err != nil {
+		return nil, err
+	}
+
+	return &sema.CapabilityType{
+		BorrowType: borrowType,
+	}, nil
+}
+
+// scanNominalTypeID advances past the type ID of a nominal type and returns it
+//
+func (p *typeIDParser) scanNominalTypeID() string {
+	start := p.offset
+	for p.offset < len(p.typeID) && isTypeIDCharacter(p.typeID[p.offset]) {
+		p.offset++
+	}
+	return p.typeID[start:p.offset]
+}
+
+// resolveNominalType returns the base type, built-in type,
+// or composite or interface type declared in a program with the given type ID,
+// which started at the given offset
+//
+func (p *typeIDParser) resolveNominalType(typeID string, start int) (sema.Type, error) {
+	if typeID == "" {
+		return nil, p.error()
+	}
+
+	if ty, ok := sema.BaseType(typeID); ok {
+		return ty, nil
+	}
+
+	if ty, ok := builtinTypes[sema.TypeID(typeID)]; ok {
+		return ty, nil
+	}
+
+	if ty := sema.NativeCompositeType(sema.TypeID(typeID)); ty != nil {
+		return ty, nil
+	}
+
+	ty, err := p.programType(typeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if ty == nil {
+		// report the start of the unknown type
+		p.offset = start
+		return nil, p.error()
+	}
+
+	return ty, nil
+}
+
+// programType returns the composite or interface type with the given type ID,
+// which is declared in a program, if any.
+//
+// Loading the declaring program may fail, e.g. if the location does not exist.
+// Panics are recovered and returned as errors.
+//
+func (p *typeIDParser) programType(typeID string) (ty sema.Type, err error) {
+	location := ast.LocationFromTypeID(typeID)
+	if location == nil || p.inter == nil {
+		return nil, nil
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			// don't recover Go errors
+			if goErr, ok := r.(goRuntime.Error); ok {
+				panic(goErr)
+			}
+
+			loadErr, ok := r.(error)
+			if !ok {
+				loadErr = fmt.Errorf("%v", r)
+			}
+
+			err = &InvalidTypeIDError{
+				TypeID: p.typeID,
+				Offset: p.offset,
+				Err:    loadErr,
+			}
+		}
+	}()
+
+	if compositeType := p.inter.GetCompositeType(location, sema.TypeID(typeID)); compositeType != nil {
+		return compositeType, nil
+	}
+
+	if interfaceType := p.inter.GetInterfaceType(location, sema.TypeID(typeID)); interfaceType != nil {
+		return interfaceType, nil
+	}
+
+	return nil, nil
+}
+
+func isDecimalDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// isTypeIDCharacter returns true if the given character may occur in the type ID of a nominal type,
+// i.e. in an identifier, a location, or a separator of the two
+//
+func isTypeIDCharacter(c byte) bool {
+	return c == '_' || c == '.' ||
+		isDecimalDigit(c) ||
+		(c >= 'a' && c <= 'z') ||
+		(c >= 'A' && c <= 'Z')
+}
@@ -105,11 +105,18 @@ func exportValueWithInterpreter(value interpreter.Value, inter *interpreter.Inte
 		return exportDictionaryValue(v, inter)
 	case interpreter.AddressValue:
 		return cadence.NewAddress(v)
+	case interpreter.TypeValue:
+		return exportTypeValue(v, inter)
 	}
 
 	panic(fmt.Sprintf("cannot convert value of type %T", value))
 }
 
+func exportTypeValue(v interpreter.TypeValue, inter *interpreter.Interpreter) cadence.TypeValue {
+	ty := inter.ConvertStaticToSemaType(v.Type)
+	return cadence.NewTypeValue(string(ty.ID()))
+}
+
 func exportSomeValue(v *interpreter.SomeValue, inter *interpreter.Interpreter) cadence.Value {
 	if v.Value == nil {
 		return cadence.NewOptional(nil)
@@ -158,9 +165,11 @@ func exportCompositeValue(v *interpreter.CompositeValue, inter *interpreter.Inte
 		return cadence.NewResource(fields).WithType(t.(cadence.ResourceType))
 	case common.CompositeKindEvent:
 		return cadence.NewEvent(fields).WithType(t.(cadence.EventType))
+	case common.CompositeKindEnum:
+		return cadence.NewEnum(fields).WithType(t.(cadence.EnumType))
 	}
 
-	panic(fmt.Errorf("invalid composite kind `%s`, must be Struct, Resource or Event", staticType.Kind))
+	panic(fmt.Errorf("invalid composite kind `%s`, must be Struct, Resource, Event or Enum", staticType.Kind))
 }
 
 func exportDictionaryValue(v *interpreter.DictionaryValue, inter *interpreter.Interpreter) cadence.Value {
@@ -183,103 +192,140 @@ func exportDictionaryValue(v *interpreter.DictionaryValue, inter *interpreter.In
 }
 
 // importValue converts a Cadence value to a runtime value.
-func importValue(value cadence.Value) interpreter.Value {
+//
+// The interpreter is used to resolve the types of imported type values.
+//
+func importValue(value cadence.Value, inter *interpreter.Interpreter) (interpreter.Value, error) {
 	switch v := value.(type) {
 	case cadence.Void:
-		return interpreter.VoidValue{}
+		return interpreter.VoidValue{}, nil
 	case cadence.Optional:
-		return importOptionalValue(v)
+		return importOptionalValue(v, inter)
 	case cadence.Bool:
-		return interpreter.BoolValue(v)
+		return interpreter.BoolValue(v), nil
 	case cadence.String:
-		return interpreter.NewStringValue(string(v))
+		return interpreter.NewStringValue(string(v)), nil
 	case cadence.Bytes:
-		return interpreter.ByteSliceToByteArrayValue(v)
+		return interpreter.ByteSliceToByteArrayValue(v), nil
 	case cadence.Address:
-		return interpreter.NewAddressValueFromBytes(v.Bytes())
+		return interpreter.NewAddressValueFromBytes(v.Bytes()), nil
 	case cadence.Int:
-		return interpreter.NewIntValueFromBigInt(v.Big())
+		return interpreter.NewIntValueFromBigInt(v.Big()), nil
 	case cadence.Int8:
-		return interpreter.Int8Value(v)
+		return interpreter.Int8Value(v), nil
 	case cadence.Int16:
-		return interpreter.Int16Value(v)
+		return interpreter.Int16Value(v), nil
 	case cadence.Int32:
-		return interpreter.Int32Value(v)
+		return interpreter.Int32Value(v), nil
 	case cadence.Int64:
-		return interpreter.Int64Value(v)
+		return interpreter.Int64Value(v), nil
 	case cadence.Int128:
-		return interpreter.NewInt128ValueFromBigInt(v.Big())
+		return interpreter.NewInt128ValueFromBigInt(v.Big()), nil
 	case cadence.Int256:
-		return interpreter.NewInt256ValueFromBigInt(v.Big())
+		return interpreter.NewInt256ValueFromBigInt(v.Big()), nil
 	case cadence.UInt:
-		return interpreter.NewUIntValueFromBigInt(v.Big())
+		return interpreter.NewUIntValueFromBigInt(v.Big()), nil
 	case cadence.UInt8:
-		return interpreter.UInt8Value(v)
+		return interpreter.UInt8Value(v), nil
 	case cadence.UInt16:
-		return interpreter.UInt16Value(v)
+		return interpreter.UInt16Value(v), nil
 	case cadence.UInt32:
-		return interpreter.UInt32Value(v)
+		return interpreter.UInt32Value(v), nil
 	case cadence.UInt64:
-		return interpreter.UInt64Value(v)
+		return interpreter.UInt64Value(v), nil
 	case cadence.UInt128:
-		return interpreter.NewUInt128ValueFromBigInt(v.Big())
+		return interpreter.NewUInt128ValueFromBigInt(v.Big()), nil
 	case cadence.UInt256:
-		return interpreter.NewUInt256ValueFromBigInt(v.Big())
+		return interpreter.NewUInt256ValueFromBigInt(v.Big()), nil
 	case cadence.Word8:
-		return interpreter.Word8Value(v)
+		return interpreter.Word8Value(v), nil
 	case cadence.Word16:
-		return interpreter.Word16Value(v)
+		return interpreter.Word16Value(v), nil
 	case cadence.Word32:
-		return interpreter.Word32Value(v)
+		return interpreter.Word32Value(v), nil
 	case cadence.Word64:
-		return interpreter.Word64Value(v)
+		return interpreter.Word64Value(v), nil
 	case cadence.Fix64:
-		return interpreter.Fix64Value(v)
+		return interpreter.Fix64Value(v), nil
 	case cadence.UFix64:
-		return interpreter.UFix64Value(v)
+		return interpreter.UFix64Value(v), nil
 	case cadence.Array:
-		return importArrayValue(v)
+		return importArrayValue(v, inter)
 	case cadence.Dictionary:
-		return importDictionaryValue(v)
+		return importDictionaryValue(v, inter)
 	case cadence.Struct:
-		return importCompositeValue(common.CompositeKindStructure, v.StructType.ID(), v.StructType.Fields, v.Fields)
+		return importCompositeValue(common.CompositeKindStructure, v.StructType.ID(), v.StructType.Fields, v.Fields, inter)
 	case cadence.Resource:
-		return importCompositeValue(common.CompositeKindResource, v.ResourceType.ID(), v.ResourceType.Fields, v.Fields)
+		return importCompositeValue(common.CompositeKindResource, v.ResourceType.ID(), v.ResourceType.Fields, v.Fields, inter)
 	case cadence.Event:
-		return importCompositeValue(common.CompositeKindEvent, v.EventType.ID(), v.EventType.Fields, v.Fields)
+		return importCompositeValue(common.CompositeKindEvent, v.EventType.ID(), v.EventType.Fields, v.Fields, inter)
+	case cadence.Enum:
+		return importCompositeValue(common.CompositeKindEnum, v.EnumType.ID(), v.EnumType.Fields, v.Fields, inter)
+	case cadence.TypeValue:
+		return importTypeValue(v, inter)
 	}
 
-	panic(fmt.Sprintf("cannot convert value of type %T", value))
+	return nil, fmt.Errorf("cannot import value of type %T", value)
 }
 
-func importOptionalValue(v cadence.Optional) interpreter.Value {
+func importTypeValue(v cadence.TypeValue, inter *interpreter.Interpreter) (interpreter.Value, error) {
+	ty, err := importTypeID(v.StaticType, inter)
+	if err != nil {
+		return nil, err
+	}
+
+	return interpreter.TypeValue{
+		Type: interpreter.ConvertSemaToStaticType(ty),
+	}, nil
+}
+
+func importOptionalValue(v cadence.Optional, inter *interpreter.Interpreter) (interpreter.Value, error) {
 	if v.Value == nil {
-		return interpreter.NilValue{}
+		return interpreter.NilValue{}, nil
+	}
+
+	innerValue, err := importValue(v.Value, inter)
+	if err != nil {
+		return nil, err
 	}
 
-	innerValue := importValue(v.Value)
-	return interpreter.NewSomeValueOwningNonCopying(innerValue)
+	return interpreter.NewSomeValueOwningNonCopying(innerValue), nil
 }
 
-func importArrayValue(v cadence.Array) *interpreter.ArrayValue {
+func importArrayValue(v cadence.Array, inter *interpreter.Interpreter) (*interpreter.ArrayValue, error) {
 	values := make([]interpreter.Value, len(v.Values))
 
 	for i, elem := range v.Values {
-		values[i] = importValue(elem)
+		value, err := importValue(elem, inter)
+		if err != nil {
+			return nil, err
+		}
+
+		values[i] = value
 	}
 
-	return interpreter.NewArrayValueUnownedNonCopying(values...)
+	return interpreter.NewArrayValueUnownedNonCopying(values...), nil
 }
 
-func importDictionaryValue(v cadence.Dictionary) *interpreter.DictionaryValue {
+func importDictionaryValue(v cadence.Dictionary, inter *interpreter.Interpreter) (*interpreter.DictionaryValue, error) {
 	keysAndValues := make([]interpreter.Value, len(v.Pairs)*2)
 
 	for i, pair := range v.Pairs {
-		keysAndValues[i*2] = importValue(pair.Key)
-		keysAndValues[i*2+1] = importValue(pair.Value)
+		key, err := importValue(pair.Key, inter)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := importValue(pair.Value, inter)
+		if err != nil {
+			return nil, err
+		}
+
+		keysAndValues[i*2] = key
+		keysAndValues[i*2+1] = value
 	}
 
-	return interpreter.NewDictionaryValueUnownedNonCopying(keysAndValues...)
+	return interpreter.NewDictionaryValueUnownedNonCopying(keysAndValues...), nil
 }
 
 func importCompositeValue(
@@ -287,13 +333,18 @@ func importCompositeValue(
 	typeID string,
 	fieldTypes []cadence.Field,
 	fieldValues []cadence.Value,
-) *interpreter.CompositeValue {
+	inter *interpreter.Interpreter,
+) (*interpreter.CompositeValue, error) {
 	fields := make(map[string]interpreter.Value, len(fieldTypes))
 
 	for i := 0; i < len(fieldTypes) && i < len(fieldValues); i++ {
 		fieldType := fieldTypes[i]
-		fieldValue := fieldValues[i]
-		fields[fieldType.Identifier] = importValue(fieldValue)
+		fieldValue, err := importValue(fieldValues[i], inter)
+		if err != nil {
+			return nil, err
+		}
+
+		fields[fieldType.Identifier] = fieldValue
 	}
 
 	return &interpreter.CompositeValue{
@@ -301,5 +352,5 @@ func importCompositeValue(
 		Kind:     kind,
 		TypeID:   sema.TypeID(typeID),
 		Fields:   fields,
-	}
+	}, nil
 }
@@ -199,7 +199,8 @@ func TestExportValue(t *testing.T) {
 			assert.Equal(t, tt.expected, actual)
 
 			if !tt.skipReverse {
-				original := importValue(actual)
+				original, err := importValue(actual, nil)
+				require.NoError(t, err)
 				assert.Equal(t, tt.value, original)
 			}
 		})
@@ -480,6 +481,35 @@ func TestExportNestedResourceValueFromScript(t *testing.T) {
 	assert.Equal(t, expected, actual)
 }
 
+func TestExportEnumValue(t *testing.T) {
+	script := `
+        access(all) enum Foo: UInt8 {
+            access(all) case bar
+            access(all) case baz
+        }
+
+        access(all) fun main(): Foo {
+            return Foo.baz
+        }
+    `
+
+	actual := exportValueFromScript(t, script)
+	expected := cadence.NewEnum([]cadence.Value{cadence.NewUInt8(1)}).
+		WithType(cadence.EnumType{
+			TypeID:     fooTypeID,
+			Identifier: fooID,
+			RawType:    cadence.UInt8Type{},
+			Fields: []cadence.Field{
+				{
+					Identifier: "rawValue",
+					Type:       cadence.UInt8Type{},
+				},
+			},
+		})
+
+	assert.Equal(t, expected, actual)
+}
+
 func TestExportEventValue(t *testing.T) {
 	script := `
         access(all) event Foo(bar: Int)
@@ -512,6 +542,7 @@ func exportEventFromScript(t *testing.T, script string) cadence.Event {
 
 	_, err := rt.ExecuteScript(
 		[]byte(script),
+		nil,
 		inter,
 		testLocation,
 	)
@@ -529,6 +560,7 @@ func exportValueFromScript(t *testing.T, script string) cadence.Value {
 
 	value, err := rt.ExecuteScript(
 		[]byte(script),
+		nil,
 		&EmptyRuntimeInterface{},
 		testLocation,
 	)
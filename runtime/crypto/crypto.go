@@ -0,0 +1,228 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package crypto provides a pure Go implementation of the cryptographic primitives
+// of the standard library, i.e. hashing and signature verification.
+//
+// Embedders of the runtime may use it to implement the corresponding functions
+// of the runtime interface, e.g. in tests.
+//
+// Signature verification is implemented using the Go standard library,
+// so only ECDSA on the P-256 curve is supported.
+// Verification of ECDSA_Secp256k1 signatures is left to the embedder.
+//
+package crypto
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"crypto/sha512"
+	"fmt"
+	"math/big"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// DomainTagLength is the length of domain tags.
+// Shorter tags are padded with zeros.
+//
+const DomainTagLength = 32
+
+// Hash returns the digest of hashing the given data using the given hash algorithm.
+//
+func Hash(data []byte, hashAlgorithm HashAlgorithm) ([]byte, error) {
+	switch hashAlgorithm {
+	case HashAlgorithmSHA2_256:
+		digest := sha256.Sum256(data)
+		return digest[:], nil
+
+	case HashAlgorithmSHA2_384:
+		digest := sha512.Sum384(data)
+		return digest[:], nil
+
+	case HashAlgorithmSHA3_256:
+		digest := sha3.Sum256(data)
+		return digest[:], nil
+
+	case HashAlgorithmSHA3_384:
+		digest := sha3.Sum384(data)
+		return digest[:], nil
+	}
+
+	return nil, UnsupportedHashAlgorithmError{
+		HashAlgorithm: hashAlgorithm,
+	}
+}
+
+// VerifySignature returns true if the given signature is a valid signature
+// of the given tag and signed data, for the given public key,
+// signature algorithm, and hash algorithm.
+//
+// The signed message is the domain tag, padded with zeros to `DomainTagLength` bytes,
+// followed by the signed data.
+//
+// Public keys are encoded as the concatenation of the coordinates of the curve point,
+// and signatures are encoded as the concatenation of the two integers r and s.
+// Both are big-endian, and padded with zeros to the byte length of the curve.
+//
+// An error is returned if the algorithms are not supported (e.g. ECDSA_Secp256k1),
+// if the tag is too long, or if the public key is invalid.
+// An invalid signature is not an error, but results in false.
+//
+func VerifySignature(
+	signature []byte,
+	tag string,
+	signedData []byte,
+	publicKey []byte,
+	signatureAlgorithm SignatureAlgorithm,
+	hashAlgorithm HashAlgorithm,
+) (bool, error) {
+
+	curve, err := signatureAlgorithmCurve(signatureAlgorithm)
+	if err != nil {
+		return false, err
+	}
+
+	message, err := taggedMessage(tag, signedData)
+	if err != nil {
+		return false, err
+	}
+
+	digest, err := Hash(message, hashAlgorithm)
+	if err != nil {
+		return false, err
+	}
+
+	params := curve.Params()
+	byteSize := (params.BitSize + 7) / 8
+
+	x, y, err := decodePublicKey(publicKey, curve, byteSize)
+	if err != nil {
+		return false, err
+	}
+
+	if len(signature) != 2*byteSize {
+		return false, nil
+	}
+
+	r := new(big.Int).SetBytes(signature[:byteSize])
+	s := new(big.Int).SetBytes(signature[byteSize:])
+
+	key := &ecdsa.PublicKey{
+		Curve: curve,
+		X:     x,
+		Y:     y,
+	}
+
+	return ecdsa.Verify(key, digest, r, s), nil
+}
+
+func signatureAlgorithmCurve(signatureAlgorithm SignatureAlgorithm) (elliptic.Curve, error) {
+	switch signatureAlgorithm {
+	case SignatureAlgorithmECDSA_P256:
+		return elliptic.P256(), nil
+	}
+
+	// NOTE: ECDSA_Secp256k1 is not supported by the standard library
+
+	return nil, UnsupportedSignatureAlgorithmError{
+		SignatureAlgorithm: signatureAlgorithm,
+	}
+}
+
+func taggedMessage(tag string, data []byte) ([]byte, error) {
+	if len(tag) > DomainTagLength {
+		return nil, InvalidDomainTagError{
+			Tag: tag,
+		}
+	}
+
+	message := make([]byte, DomainTagLength+len(data))
+	copy(message, tag)
+	copy(message[DomainTagLength:], data)
+
+	return message, nil
+}
+
+func decodePublicKey(publicKey []byte, curve elliptic.Curve, byteSize int) (x, y *big.Int, err error) {
+	if len(publicKey) != 2*byteSize {
+		return nil, nil, InvalidPublicKeyError{
+			Message: fmt.Sprintf(
+				"expected %d bytes, got %d",
+				2*byteSize,
+				len(publicKey),
+			),
+		}
+	}
+
+	x = new(big.Int).SetBytes(publicKey[:byteSize])
+	y = new(big.Int).SetBytes(publicKey[byteSize:])
+
+	if !curve.IsOnCurve(x, y) {
+		return nil, nil, InvalidPublicKeyError{
+			Message: "point is not on curve",
+		}
+	}
+
+	return x, y, nil
+}
+
+// UnsupportedHashAlgorithmError
+
+type UnsupportedHashAlgorithmError struct {
+	HashAlgorithm HashAlgorithm
+}
+
+func (e UnsupportedHashAlgorithmError) Error() string {
+	return fmt.Sprintf("unsupported hash algorithm: %s", e.HashAlgorithm)
+}
+
+// UnsupportedSignatureAlgorithmError
+
+type UnsupportedSignatureAlgorithmError struct {
+	SignatureAlgorithm SignatureAlgorithm
+}
+
+func (e UnsupportedSignatureAlgorithmError) Error() string {
+	return fmt.Sprintf("unsupported signature algorithm: %s", e.SignatureAlgorithm)
+}
+
+// InvalidDomainTagError
+
+type InvalidDomainTagError struct {
+	Tag string
+}
+
+func (e InvalidDomainTagError) Error() string {
+	return fmt.Sprintf(
+		"invalid domain tag %q: must be at most %d bytes long",
+		e.Tag,
+		DomainTagLength,
+	)
+}
+
+// InvalidPublicKeyError
+
+type InvalidPublicKeyError struct {
+	Message string
+}
+
+func (e InvalidPublicKeyError) Error() string {
+	return fmt.Sprintf("invalid public key: %s", e.Message)
+}
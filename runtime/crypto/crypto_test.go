@@ -0,0 +1,212 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crypto
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHash(t *testing.T) {
+
+	t.Parallel()
+
+	data := []byte("abc")
+
+	expected := map[HashAlgorithm]string{
+		HashAlgorithmSHA2_256: "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad",
+		HashAlgorithmSHA2_384: "cb00753f45a35e8bb5a03d699ac65007272c32ab0eded1631a8b605a43ff5bed" +
+			"8086072ba1e7cc2358baeca134c825a7",
+		HashAlgorithmSHA3_256: "3a985da74fe225b2045c172d6bd390bd855f086e3e9d525b46bfe24511431532",
+		HashAlgorithmSHA3_384: "ec01498288516fc926459f58e2c6ad8df9b473cb0fc08c2596da7cf0e49be4b2" +
+			"98d88cea927ac7f539f1edf228376d25",
+	}
+
+	for _, hashAlgorithm := range HashAlgorithms {
+
+		t.Run(hashAlgorithm.Name(), func(t *testing.T) {
+
+			digest, err := Hash(data, hashAlgorithm)
+			require.NoError(t, err)
+
+			assert.Equal(t,
+				expected[hashAlgorithm],
+				hex.EncodeToString(digest),
+			)
+		})
+	}
+
+	t.Run("unsupported", func(t *testing.T) {
+
+		_, err := Hash(data, HashAlgorithmUnknown)
+		require.IsType(t, UnsupportedHashAlgorithmError{}, err)
+	})
+}
+
+func encodeInts(byteSize int, ints ...*big.Int) []byte {
+	result := make([]byte, 0, byteSize*len(ints))
+	for _, i := range ints {
+		bytes := make([]byte, byteSize)
+		i.FillBytes(bytes)
+		result = append(result, bytes...)
+	}
+	return result
+}
+
+func TestVerifySignature(t *testing.T) {
+
+	t.Parallel()
+
+	const tag = "FLOW-V0.0-user"
+
+	signedData := []byte("hello, world")
+
+	message, err := taggedMessage(tag, signedData)
+	require.NoError(t, err)
+
+	t.Run("ECDSA_P256", func(t *testing.T) {
+
+		privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+
+		publicKey := encodeInts(32, privateKey.X, privateKey.Y)
+
+		for _, hashAlgorithm := range HashAlgorithms {
+
+			t.Run(hashAlgorithm.Name(), func(t *testing.T) {
+
+				digest, err := Hash(message, hashAlgorithm)
+				require.NoError(t, err)
+
+				r, s, err := ecdsa.Sign(rand.Reader, privateKey, digest)
+				require.NoError(t, err)
+
+				signature := encodeInts(32, r, s)
+
+				valid, err := VerifySignature(
+					signature,
+					tag,
+					signedData,
+					publicKey,
+					SignatureAlgorithmECDSA_P256,
+					hashAlgorithm,
+				)
+				require.NoError(t, err)
+				assert.True(t, valid)
+
+				valid, err = VerifySignature(
+					signature,
+					tag,
+					[]byte("hello, world!"),
+					publicKey,
+					SignatureAlgorithmECDSA_P256,
+					hashAlgorithm,
+				)
+				require.NoError(t, err)
+				assert.False(t, valid)
+			})
+		}
+	})
+
+	t.Run("malformed signature", func(t *testing.T) {
+
+		privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+
+		valid, err := VerifySignature(
+			[]byte{1, 2, 3},
+			tag,
+			signedData,
+			encodeInts(32, privateKey.X, privateKey.Y),
+			SignatureAlgorithmECDSA_P256,
+			HashAlgorithmSHA3_256,
+		)
+		require.NoError(t, err)
+		assert.False(t, valid)
+	})
+
+	t.Run("invalid public key", func(t *testing.T) {
+
+		_, err := VerifySignature(
+			make([]byte, 64),
+			tag,
+			signedData,
+			encodeInts(32, big.NewInt(1), big.NewInt(1)),
+			SignatureAlgorithmECDSA_P256,
+			HashAlgorithmSHA3_256,
+		)
+		require.IsType(t, InvalidPublicKeyError{}, err)
+
+		_, err = VerifySignature(
+			make([]byte, 64),
+			tag,
+			signedData,
+			[]byte{1, 2, 3},
+			SignatureAlgorithmECDSA_P256,
+			HashAlgorithmSHA3_256,
+		)
+		require.IsType(t, InvalidPublicKeyError{}, err)
+	})
+
+	t.Run("invalid tag", func(t *testing.T) {
+
+		_, err := VerifySignature(
+			make([]byte, 64),
+			"this tag is definitely longer than 32 bytes",
+			signedData,
+			make([]byte, 64),
+			SignatureAlgorithmECDSA_P256,
+			HashAlgorithmSHA3_256,
+		)
+		require.IsType(t, InvalidDomainTagError{}, err)
+	})
+
+	t.Run("unsupported signature algorithm", func(t *testing.T) {
+
+		_, err := VerifySignature(
+			make([]byte, 64),
+			tag,
+			signedData,
+			make([]byte, 64),
+			SignatureAlgorithmUnknown,
+			HashAlgorithmSHA3_256,
+		)
+		require.IsType(t, UnsupportedSignatureAlgorithmError{}, err)
+	})
+
+	t.Run("ECDSA_Secp256k1 is left to the host", func(t *testing.T) {
+
+		_, err := VerifySignature(
+			make([]byte, 64),
+			tag,
+			signedData,
+			make([]byte, 64),
+			SignatureAlgorithmECDSA_Secp256k1,
+			HashAlgorithmSHA3_256,
+		)
+		require.IsType(t, UnsupportedSignatureAlgorithmError{}, err)
+	})
+}
@@ -0,0 +1,67 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crypto
+
+import (
+	"github.com/onflow/cadence/runtime/errors"
+)
+
+//go:generate stringer -type=HashAlgorithm
+
+// HashAlgorithm is a hash algorithm which can be used in the standard library.
+//
+type HashAlgorithm uint8
+
+const (
+	HashAlgorithmUnknown HashAlgorithm = iota
+	HashAlgorithmSHA2_256
+	HashAlgorithmSHA2_384
+	HashAlgorithmSHA3_256
+	HashAlgorithmSHA3_384
+)
+
+// HashAlgorithms are the supported hash algorithms.
+//
+// NOTE: the order is the order of the cases of the `HashAlgorithm` enum in the standard library,
+// i.e. the raw value of a case is the index of the algorithm, so existing entries must not be reordered
+//
+var HashAlgorithms = []HashAlgorithm{
+	HashAlgorithmSHA2_256,
+	HashAlgorithmSHA2_384,
+	HashAlgorithmSHA3_256,
+	HashAlgorithmSHA3_384,
+}
+
+// Name returns the name of the hash algorithm,
+// which is the name of the case of the `HashAlgorithm` enum in the standard library
+//
+func (a HashAlgorithm) Name() string {
+	switch a {
+	case HashAlgorithmSHA2_256:
+		return "SHA2_256"
+	case HashAlgorithmSHA2_384:
+		return "SHA2_384"
+	case HashAlgorithmSHA3_256:
+		return "SHA3_256"
+	case HashAlgorithmSHA3_384:
+		return "SHA3_384"
+	}
+
+	panic(errors.NewUnreachableError())
+}
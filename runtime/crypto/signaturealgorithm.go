@@ -0,0 +1,59 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crypto
+
+import (
+	"github.com/onflow/cadence/runtime/errors"
+)
+
+//go:generate stringer -type=SignatureAlgorithm
+
+// SignatureAlgorithm is a signature algorithm which can be used in the standard library.
+//
+type SignatureAlgorithm uint8
+
+const (
+	SignatureAlgorithmUnknown SignatureAlgorithm = iota
+	SignatureAlgorithmECDSA_P256
+	SignatureAlgorithmECDSA_Secp256k1
+)
+
+// SignatureAlgorithms are the supported signature algorithms.
+//
+// NOTE: the order is the order of the cases of the `SignatureAlgorithm` enum in the standard library,
+// i.e. the raw value of a case is the index of the algorithm, so existing entries must not be reordered
+//
+var SignatureAlgorithms = []SignatureAlgorithm{
+	SignatureAlgorithmECDSA_P256,
+	SignatureAlgorithmECDSA_Secp256k1,
+}
+
+// Name returns the name of the signature algorithm,
+// which is the name of the case of the `SignatureAlgorithm` enum in the standard library
+//
+func (a SignatureAlgorithm) Name() string {
+	switch a {
+	case SignatureAlgorithmECDSA_P256:
+		return "ECDSA_P256"
+	case SignatureAlgorithmECDSA_Secp256k1:
+		return "ECDSA_Secp256k1"
+	}
+
+	panic(errors.NewUnreachableError())
+}
@@ -0,0 +1,596 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package dap implements a server for the Debug Adapter Protocol,
+// which allows debugging programs from editors.
+//
+package dap
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+// Program is a program launched by the server.
+//
+type Program interface {
+	// Location returns the location of the program code in the source file with the given path.
+	Location(path string) ast.Location
+	// Path returns the path of the source file of the program code with the given location.
+	Path(location ast.Location) string
+	// Run executes the program and returns when the execution has finished.
+	Run() error
+}
+
+// Launcher prepares the program given in the launch arguments, which is executed using the given debugger.
+// The output of the program should be written to the given writer.
+//
+type Launcher func(arguments LaunchArguments, debugger *interpreter.Debugger, output io.Writer) (Program, error)
+
+// threadID is the ID of the only thread
+//
+const threadID = 1
+
+const topLevelFrameName = "<top-level>"
+
+// Server is a debug adapter for programs which are launched by a launcher.
+//
+// The server handles the requests read from the reader, and writes responses and events to the writer.
+//
+type Server struct {
+	reader      *bufio.Reader
+	writer      io.Writer
+	writeMutex  sync.Mutex
+	seq         int
+	launcher    Launcher
+	debugger    *interpreter.Debugger
+	program     Program
+	breakpoints map[string][]int
+	// references are the values and scopes which can be referenced in variables requests.
+	// Reference n refers to element n-1. References are only valid while the execution is paused
+	references []interface{}
+	done       chan struct{}
+}
+
+type variableScope map[string]interpreter.Value
+
+func NewServer(reader io.Reader, writer io.Writer, launcher Launcher) *Server {
+	return &Server{
+		reader:      bufio.NewReader(reader),
+		writer:      writer,
+		launcher:    launcher,
+		debugger:    interpreter.NewDebugger(),
+		breakpoints: map[string][]int{},
+		done:        make(chan struct{}),
+	}
+}
+
+// Serve handles requests until the client disconnects or the reader is closed.
+//
+func (s *Server) Serve() error {
+	defer close(s.done)
+
+	for {
+		content, err := ReadMessage(s.reader)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		var request Request
+		err = json.Unmarshal(content, &request)
+		if err != nil {
+			return err
+		}
+
+		if request.Type != "request" {
+			continue
+		}
+
+		disconnect, err := s.handleRequest(&request)
+		if err != nil {
+			return err
+		}
+		if disconnect {
+			return nil
+		}
+	}
+}
+
+func (s *Server) handleRequest(request *Request) (disconnect bool, err error) {
+	var body interface{}
+
+	switch request.Command {
+	case "initialize":
+		body = Capabilities{
+			SupportsConfigurationDoneRequest: true,
+			SupportsEvaluateForHovers:        true,
+		}
+
+	case "launch":
+		err = s.launch(request)
+		if err != nil {
+			return false, s.sendErrorResponse(request, err)
+		}
+
+		err = s.sendResponse(request, nil)
+		if err != nil {
+			return false, err
+		}
+
+		// The client configures the breakpoints after it received the initialized event
+		return false, s.sendEvent("initialized", nil)
+
+	case "setBreakpoints":
+		body, err = s.setBreakpoints(request)
+
+	case "configurationDone":
+		if s.program == nil {
+			err = fmt.Errorf("program is not launched")
+			break
+		}
+
+		// Respond before starting the execution,
+		// so the response is sent before any stopped event
+
+		err = s.sendResponse(request, nil)
+		if err != nil {
+			return false, err
+		}
+
+		go s.forwardStops()
+		go s.run()
+
+		return false, nil
+
+	case "threads":
+		body = ThreadsResponseBody{
+			Threads: []Thread{
+				{ID: threadID, Name: "main"},
+			},
+		}
+
+	case "stackTrace":
+		body = s.stackTrace()
+
+	case "scopes":
+		body, err = s.scopes(request)
+
+	case "variables":
+		body, err = s.variables(request)
+
+	case "evaluate":
+		body, err = s.evaluate(request)
+
+	case "continue":
+		return false, s.resume(request, s.debugger.Continue, ContinueResponseBody{AllThreadsContinued: true})
+
+	case "next":
+		return false, s.resume(request, s.debugger.StepOver, nil)
+
+	case "stepIn":
+		return false, s.resume(request, s.debugger.StepInto, nil)
+
+	case "stepOut":
+		return false, s.resume(request, s.debugger.StepOut, nil)
+
+	case "pause":
+		s.debugger.RequestPause()
+
+	case "disconnect":
+		return true, s.sendResponse(request, nil)
+
+	default:
+		err = fmt.Errorf("unsupported command: %s", request.Command)
+	}
+
+	if err != nil {
+		return false, s.sendErrorResponse(request, err)
+	}
+
+	return false, s.sendResponse(request, body)
+}
+
+func (s *Server) launch(request *Request) error {
+	if s.program != nil {
+		return fmt.Errorf("program is already launched")
+	}
+
+	var arguments LaunchArguments
+	err := json.Unmarshal(request.Arguments, &arguments)
+	if err != nil {
+		return err
+	}
+
+	output := &outputWriter{
+		server:   s,
+		category: "stdout",
+	}
+
+	program, err := s.launcher(arguments, s.debugger, output)
+	if err != nil {
+		return err
+	}
+
+	s.program = program
+
+	return nil
+}
+
+func (s *Server) run() {
+	exitCode := 0
+
+	err := s.program.Run()
+	if err != nil {
+		exitCode = 1
+		_ = s.sendEvent("output", OutputEventBody{
+			Category: "stderr",
+			Output:   err.Error() + "\n",
+		})
+	}
+
+	_ = s.sendEvent("exited", ExitedEventBody{ExitCode: exitCode})
+	_ = s.sendEvent("terminated", nil)
+}
+
+// forwardStops sends a stopped event each time the debugger pauses the execution.
+//
+func (s *Server) forwardStops() {
+	for {
+		select {
+		case stop := <-s.debugger.Stops():
+			_ = s.sendEvent("stopped", StoppedEventBody{
+				Reason:            stopReason(stop.Reason),
+				ThreadID:          threadID,
+				AllThreadsStopped: true,
+			})
+		case <-s.done:
+			return
+		}
+	}
+}
+
+func stopReason(reason interpreter.StopReason) string {
+	switch reason {
+	case interpreter.StopReasonBreakpoint:
+		return "breakpoint"
+	case interpreter.StopReasonStep:
+		return "step"
+	case interpreter.StopReasonPause:
+		return "pause"
+	default:
+		return "unknown"
+	}
+}
+
+// resume responds to the request and then resumes the execution.
+// The response is sent first, so it is sent before the next stopped event.
+//
+func (s *Server) resume(request *Request, resume func(), body interface{}) error {
+	s.references = nil
+
+	err := s.sendResponse(request, body)
+	if err != nil {
+		return err
+	}
+
+	resume()
+
+	return nil
+}
+
+func (s *Server) setBreakpoints(request *Request) (interface{}, error) {
+	if s.program == nil {
+		return nil, fmt.Errorf("program is not launched")
+	}
+
+	var arguments SetBreakpointsArguments
+	err := json.Unmarshal(request.Arguments, &arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	path := arguments.Source.Path
+	location := s.program.Location(path)
+
+	for _, line := range s.breakpoints[path] {
+		s.debugger.RemoveBreakpoint(location, line)
+	}
+
+	lines := make([]int, 0, len(arguments.Breakpoints))
+	breakpoints := make([]Breakpoint, 0, len(arguments.Breakpoints))
+
+	for _, breakpoint := range arguments.Breakpoints {
+		s.debugger.AddBreakpoint(location, breakpoint.Line)
+		lines = append(lines, breakpoint.Line)
+
+		breakpoints = append(breakpoints, Breakpoint{
+			Verified: true,
+			Line:     breakpoint.Line,
+			Source:   arguments.Source,
+		})
+	}
+
+	s.breakpoints[path] = lines
+
+	return SetBreakpointsResponseBody{
+		Breakpoints: breakpoints,
+	}, nil
+}
+
+func (s *Server) stackTrace() StackTraceResponseBody {
+	callStack := s.debugger.CallStack()
+
+	frames := make([]StackFrame, 0, len(callStack))
+
+	for i, frame := range callStack {
+		name := frame.Function
+		if name == "" {
+			name = topLevelFrameName
+		}
+
+		path := s.program.Path(frame.Location())
+
+		frames = append(frames, StackFrame{
+			ID:   i + 1,
+			Name: name,
+			Source: Source{
+				Name: filepath.Base(path),
+				Path: path,
+			},
+			Line:   frame.Line,
+			Column: 1,
+		})
+	}
+
+	return StackTraceResponseBody{
+		StackFrames: frames,
+		TotalFrames: len(frames),
+	}
+}
+
+// frame returns the frame of the paused execution with the given ID.
+// Frame IDs start at 1, for the innermost frame.
+//
+func (s *Server) frame(id int) (*interpreter.StackFrame, error) {
+	callStack := s.debugger.CallStack()
+	if callStack == nil {
+		return nil, fmt.Errorf("execution is not paused")
+	}
+
+	// The ID is omitted when the expression should be evaluated in the innermost frame
+
+	if id == 0 {
+		id = 1
+	}
+
+	if id < 1 || id > len(callStack) {
+		return nil, fmt.Errorf("invalid frame: %d", id)
+	}
+
+	return callStack[id-1], nil
+}
+
+func (s *Server) scopes(request *Request) (interface{}, error) {
+	var arguments ScopesArguments
+	err := json.Unmarshal(request.Arguments, &arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	frame, err := s.frame(arguments.FrameID)
+	if err != nil {
+		return nil, err
+	}
+
+	return ScopesResponseBody{
+		Scopes: []Scope{
+			{
+				Name:               "Locals",
+				VariablesReference: s.reference(variableScope(frame.Locals())),
+			},
+			{
+				Name:               "Globals",
+				VariablesReference: s.reference(variableScope(frame.Globals())),
+			},
+		},
+	}, nil
+}
+
+// reference returns a new reference to the given scope or value.
+//
+func (s *Server) reference(referenced interface{}) int {
+	s.references = append(s.references, referenced)
+	return len(s.references)
+}
+
+// valueReference returns a new reference to the given value, if it has children,
+// or 0 otherwise.
+//
+func (s *Server) valueReference(value interpreter.Value) int {
+	switch value := value.(type) {
+	case *interpreter.ArrayValue,
+		*interpreter.DictionaryValue,
+		*interpreter.CompositeValue:
+
+		return s.reference(value)
+
+	case *interpreter.SomeValue:
+		return s.valueReference(value.Value)
+
+	default:
+		return 0
+	}
+}
+
+func (s *Server) variables(request *Request) (interface{}, error) {
+	var arguments VariablesArguments
+	err := json.Unmarshal(request.Arguments, &arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	index := arguments.VariablesReference - 1
+	if index < 0 || index >= len(s.references) {
+		return nil, fmt.Errorf("invalid variables reference: %d", arguments.VariablesReference)
+	}
+
+	var variables []Variable
+
+	addVariable := func(name string, value interpreter.Value) {
+		variables = append(variables, Variable{
+			Name:               name,
+			Value:              fmt.Sprint(value),
+			VariablesReference: s.valueReference(value),
+		})
+	}
+
+	addVariables := func(values map[string]interpreter.Value) {
+		names := make([]string, 0, len(values))
+		for name := range values {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			addVariable(name, values[name])
+		}
+	}
+
+	switch referenced := s.references[index].(type) {
+	case variableScope:
+		addVariables(referenced)
+
+	case *interpreter.ArrayValue:
+		for i, value := range referenced.Values {
+			addVariable(fmt.Sprintf("[%d]", i), value)
+		}
+
+	case *interpreter.DictionaryValue:
+		for _, key := range referenced.Keys.Values {
+			keyString := key.(interpreter.HasKeyString).KeyString()
+			value := referenced.Entries[keyString]
+			addVariable(fmt.Sprintf("[%s]", key), value)
+		}
+
+	case *interpreter.CompositeValue:
+		addVariables(referenced.Fields)
+	}
+
+	return VariablesResponseBody{
+		Variables: variables,
+	}, nil
+}
+
+func (s *Server) evaluate(request *Request) (interface{}, error) {
+	var arguments EvaluateArguments
+	err := json.Unmarshal(request.Arguments, &arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	frame, err := s.frame(arguments.FrameID)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := s.debugger.Evaluate(frame, arguments.Expression)
+	if err != nil {
+		return nil, err
+	}
+
+	return EvaluateResponseBody{
+		Result:             fmt.Sprint(value),
+		VariablesReference: s.valueReference(value),
+	}, nil
+}
+
+func (s *Server) sendResponse(request *Request, body interface{}) error {
+	return s.send(&Response{
+		ProtocolMessage: ProtocolMessage{Type: "response"},
+		RequestSeq:      request.Seq,
+		Success:         true,
+		Command:         request.Command,
+		Body:            body,
+	})
+}
+
+func (s *Server) sendErrorResponse(request *Request, err error) error {
+	return s.send(&Response{
+		ProtocolMessage: ProtocolMessage{Type: "response"},
+		RequestSeq:      request.Seq,
+		Success:         false,
+		Command:         request.Command,
+		Message:         err.Error(),
+	})
+}
+
+func (s *Server) sendEvent(event string, body interface{}) error {
+	return s.send(&Event{
+		ProtocolMessage: ProtocolMessage{Type: "event"},
+		Event:           event,
+		Body:            body,
+	})
+}
+
+// send writes the given message, which is numbered with the next sequence number.
+// Messages are sent from the goroutine handling requests, and the goroutines of the execution.
+//
+func (s *Server) send(message interface{}) error {
+	s.writeMutex.Lock()
+	defer s.writeMutex.Unlock()
+
+	s.seq++
+
+	switch message := message.(type) {
+	case *Response:
+		message.Seq = s.seq
+	case *Event:
+		message.Seq = s.seq
+	}
+
+	return WriteMessage(s.writer, message)
+}
+
+// outputWriter sends all output as output events of the given category.
+//
+type outputWriter struct {
+	server   *Server
+	category string
+}
+
+func (w *outputWriter) Write(p []byte) (int, error) {
+	err := w.server.sendEvent("output", OutputEventBody{
+		Category: w.category,
+		Output:   string(p),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -0,0 +1,350 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dap
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/tests/utils"
+)
+
+func TestMessageRoundTrip(t *testing.T) {
+
+	t.Parallel()
+
+	var buffer bytes.Buffer
+
+	event := &Event{
+		ProtocolMessage: ProtocolMessage{Seq: 1, Type: "event"},
+		Event:           "stopped",
+		Body: StoppedEventBody{
+			Reason:   "breakpoint",
+			ThreadID: 1,
+		},
+	}
+
+	err := WriteMessage(&buffer, event)
+	require.NoError(t, err)
+
+	err = WriteMessage(&buffer, event)
+	require.NoError(t, err)
+
+	reader := bufio.NewReader(&buffer)
+
+	for i := 0; i < 2; i++ {
+		content, err := ReadMessage(reader)
+		require.NoError(t, err)
+
+		assert.JSONEq(t,
+			`{"seq":1,"type":"event","event":"stopped","body":{"reason":"breakpoint","threadId":1,"allThreadsStopped":false}}`,
+			string(content),
+		)
+	}
+
+	_, err = ReadMessage(reader)
+	require.Equal(t, io.EOF, err)
+}
+
+type testProgram struct {
+	path        string
+	interpreter *interpreter.Interpreter
+	output      io.Writer
+}
+
+func (p *testProgram) Location(_ string) ast.Location {
+	return utils.TestLocation
+}
+
+func (p *testProgram) Path(_ ast.Location) string {
+	return p.path
+}
+
+func (p *testProgram) Run() error {
+	err := p.interpreter.Interpret()
+	if err != nil {
+		return err
+	}
+
+	value, err := p.interpreter.Invoke("main")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(p.output, "%s\n", value)
+	return err
+}
+
+func newTestLauncher(t *testing.T, code string) Launcher {
+	return func(arguments LaunchArguments, debugger *interpreter.Debugger, output io.Writer) (Program, error) {
+		checker, err := utils.ParseAndCheck(t, code)
+		if err != nil {
+			return nil, err
+		}
+
+		inter, err := interpreter.NewInterpreter(
+			checker,
+			interpreter.WithDebugger(debugger),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return &testProgram{
+			path:        arguments.Program,
+			interpreter: inter,
+			output:      output,
+		}, nil
+	}
+}
+
+type testMessage struct {
+	Type       string          `json:"type"`
+	RequestSeq int             `json:"request_seq"`
+	Command    string          `json:"command"`
+	Success    bool            `json:"success"`
+	Message    string          `json:"message"`
+	Event      string          `json:"event"`
+	Body       json.RawMessage `json:"body"`
+}
+
+type testClient struct {
+	t      *testing.T
+	reader *bufio.Reader
+	writer io.Writer
+	seq    int
+}
+
+func (c *testClient) request(command string, arguments interface{}) int {
+	c.seq++
+
+	encodedArguments, err := json.Marshal(arguments)
+	require.NoError(c.t, err)
+
+	err = WriteMessage(c.writer, &Request{
+		ProtocolMessage: ProtocolMessage{
+			Seq:  c.seq,
+			Type: "request",
+		},
+		Command:   command,
+		Arguments: encodedArguments,
+	})
+	require.NoError(c.t, err)
+
+	return c.seq
+}
+
+func (c *testClient) read() testMessage {
+	content, err := ReadMessage(c.reader)
+	require.NoError(c.t, err)
+
+	var message testMessage
+	err = json.Unmarshal(content, &message)
+	require.NoError(c.t, err)
+
+	return message
+}
+
+// expectResponse reads the next message, which must be a successful response to the given request,
+// and decodes its body into the given value, if any.
+//
+func (c *testClient) expectResponse(seq int, command string, body interface{}) {
+	message := c.read()
+	require.Equal(c.t, "response", message.Type)
+	require.Equal(c.t, seq, message.RequestSeq)
+	require.Equal(c.t, command, message.Command)
+	require.True(c.t, message.Success, message.Message)
+
+	if body != nil {
+		err := json.Unmarshal(message.Body, body)
+		require.NoError(c.t, err)
+	}
+}
+
+// expectEvent reads the next message, which must be the given event,
+// and decodes its body into the given value, if any.
+//
+func (c *testClient) expectEvent(event string, body interface{}) {
+	message := c.read()
+	require.Equal(c.t, "event", message.Type)
+	require.Equal(c.t, event, message.Event)
+
+	if body != nil {
+		err := json.Unmarshal(message.Body, body)
+		require.NoError(c.t, err)
+	}
+}
+
+func TestServer(t *testing.T) {
+
+	t.Parallel()
+
+	const code = `
+pub fun add(_ a: Int, _ b: Int): Int {
+    let sum = a + b
+    return sum
+}
+
+pub fun main(): Int {
+    let x = 1
+    let y = add(x, 2)
+    return y
+}
+`
+
+	requestReader, requestWriter := io.Pipe()
+	responseReader, responseWriter := io.Pipe()
+
+	server := NewServer(requestReader, responseWriter, newTestLauncher(t, code))
+
+	served := make(chan error, 1)
+	go func() {
+		served <- server.Serve()
+	}()
+
+	client := &testClient{
+		t:      t,
+		reader: bufio.NewReader(responseReader),
+		writer: requestWriter,
+	}
+
+	var capabilities Capabilities
+	seq := client.request("initialize", nil)
+	client.expectResponse(seq, "initialize", &capabilities)
+	assert.True(t, capabilities.SupportsConfigurationDoneRequest)
+
+	seq = client.request("launch", LaunchArguments{Program: "test.cdc"})
+	client.expectResponse(seq, "launch", nil)
+	client.expectEvent("initialized", nil)
+
+	var breakpoints SetBreakpointsResponseBody
+	seq = client.request("setBreakpoints", SetBreakpointsArguments{
+		Source:      Source{Path: "test.cdc"},
+		Breakpoints: []SourceBreakpoint{{Line: 3}},
+	})
+	client.expectResponse(seq, "setBreakpoints", &breakpoints)
+	require.Len(t, breakpoints.Breakpoints, 1)
+	assert.True(t, breakpoints.Breakpoints[0].Verified)
+	assert.Equal(t, 3, breakpoints.Breakpoints[0].Line)
+
+	seq = client.request("configurationDone", nil)
+	client.expectResponse(seq, "configurationDone", nil)
+
+	var stopped StoppedEventBody
+	client.expectEvent("stopped", &stopped)
+	assert.Equal(t, "breakpoint", stopped.Reason)
+	assert.Equal(t, threadID, stopped.ThreadID)
+
+	var threads ThreadsResponseBody
+	seq = client.request("threads", nil)
+	client.expectResponse(seq, "threads", &threads)
+	assert.Equal(t, []Thread{{ID: threadID, Name: "main"}}, threads.Threads)
+
+	var stackTrace StackTraceResponseBody
+	seq = client.request("stackTrace", StackTraceArguments{ThreadID: threadID})
+	client.expectResponse(seq, "stackTrace", &stackTrace)
+	assert.Equal(t,
+		[]StackFrame{
+			{
+				ID:     1,
+				Name:   "add",
+				Source: Source{Name: "test.cdc", Path: "test.cdc"},
+				Line:   3,
+				Column: 1,
+			},
+			{
+				ID:     2,
+				Name:   "main",
+				Source: Source{Name: "test.cdc", Path: "test.cdc"},
+				Line:   9,
+				Column: 1,
+			},
+		},
+		stackTrace.StackFrames,
+	)
+
+	var scopes ScopesResponseBody
+	seq = client.request("scopes", ScopesArguments{FrameID: 1})
+	client.expectResponse(seq, "scopes", &scopes)
+	require.Len(t, scopes.Scopes, 2)
+	assert.Equal(t, "Locals", scopes.Scopes[0].Name)
+
+	var variables VariablesResponseBody
+	seq = client.request("variables", VariablesArguments{
+		VariablesReference: scopes.Scopes[0].VariablesReference,
+	})
+	client.expectResponse(seq, "variables", &variables)
+	assert.Equal(t,
+		[]Variable{
+			{Name: "a", Value: "1"},
+			{Name: "b", Value: "2"},
+		},
+		variables.Variables,
+	)
+
+	var evaluated EvaluateResponseBody
+	seq = client.request("evaluate", EvaluateArguments{
+		Expression: "x",
+		FrameID:    2,
+	})
+	client.expectResponse(seq, "evaluate", &evaluated)
+	assert.Equal(t, "1", evaluated.Result)
+
+	seq = client.request("evaluate", EvaluateArguments{
+		Expression: "x",
+		FrameID:    1,
+	})
+	message := client.read()
+	assert.Equal(t, seq, message.RequestSeq)
+	assert.False(t, message.Success)
+	assert.NotEmpty(t, message.Message)
+
+	seq = client.request("next", nil)
+	client.expectResponse(seq, "next", nil)
+
+	client.expectEvent("stopped", &stopped)
+	assert.Equal(t, "step", stopped.Reason)
+
+	seq = client.request("continue", nil)
+	client.expectResponse(seq, "continue", nil)
+
+	var output OutputEventBody
+	client.expectEvent("output", &output)
+	assert.Equal(t, OutputEventBody{Category: "stdout", Output: "3\n"}, output)
+
+	var exited ExitedEventBody
+	client.expectEvent("exited", &exited)
+	assert.Equal(t, 0, exited.ExitCode)
+
+	client.expectEvent("terminated", nil)
+
+	seq = client.request("disconnect", nil)
+	client.expectResponse(seq, "disconnect", nil)
+
+	require.NoError(t, <-served)
+}
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/errors"
 	"github.com/onflow/cadence/runtime/interpreter"
 	"github.com/onflow/cadence/runtime/sema"
@@ -61,6 +62,36 @@ func (e ComputationLimitExceededError) Error() string {
 	)
 }
 
+// MemoryLimitExceededError
+
+type MemoryLimitExceededError struct {
+	Limit uint64
+}
+
+func (e MemoryLimitExceededError) Error() string {
+	return fmt.Sprintf(
+		"memory limit exceeded: %d",
+		e.Limit,
+	)
+}
+
+// StorageCapacityExceededError
+
+type StorageCapacityExceededError struct {
+	Address         Address
+	StorageUsed     uint64
+	StorageCapacity uint64
+}
+
+func (e StorageCapacityExceededError) Error() string {
+	return fmt.Sprintf(
+		"storage capacity exceeded for account %s: %d bytes used, capacity is %d bytes",
+		e.Address,
+		e.StorageUsed,
+		e.StorageCapacity,
+	)
+}
+
 // InvalidTransactionCountError
 
 type InvalidTransactionCountError struct {
@@ -123,6 +154,138 @@ func (e *InvalidTransactionArgumentError) Error() string {
 	return fmt.Sprintf("invalid argument at index %d", e.Index)
 }
 
+// InvalidScriptParameterCountError
+
+type InvalidScriptParameterCountError struct {
+	Expected int
+	Actual   int
+}
+
+func (e InvalidScriptParameterCountError) Error() string {
+	return fmt.Sprintf(
+		"parameter count mismatch for script: expected %d, got %d",
+		e.Expected,
+		e.Actual,
+	)
+}
+
+// InvalidEnumRawValueError
+
+type InvalidEnumRawValueError struct {
+	Type     *sema.CompositeType
+	RawValue interpreter.Value
+}
+
+func (e *InvalidEnumRawValueError) Error() string {
+	return fmt.Sprintf(
+		"invalid raw value for enum `%s`: %s",
+		e.Type.QualifiedString(),
+		e.RawValue,
+	)
+}
+
+// InvalidTypeIDError
+
+type InvalidTypeIDError struct {
+	TypeID string
+	Offset int
+	Err    error
+}
+
+func (e *InvalidTypeIDError) Unwrap() error {
+	return e.Err
+}
+
+func (e *InvalidTypeIDError) Error() string {
+	message := fmt.Sprintf(
+		"invalid type ID `%s` at offset %d",
+		e.TypeID,
+		e.Offset,
+	)
+
+	if e.Err != nil {
+		message = fmt.Sprintf("%s: %s", message, e.Err)
+	}
+
+	return message
+}
+
+// InvalidEntryPointTypeError
+
+type InvalidEntryPointTypeError struct {
+	DeclarationKind common.DeclarationKind
+	Type            sema.Type
+}
+
+func (e InvalidEntryPointTypeError) Error() string {
+	return fmt.Sprintf(
+		"invalid entry point: expected function declaration `main`, got %s of type `%s`",
+		e.DeclarationKind.Name(),
+		e.Type.QualifiedString(),
+	)
+}
+
+// InvalidScriptArgumentError
+
+type InvalidScriptArgumentError struct {
+	Index int
+	Err   error
+}
+
+func (e *InvalidScriptArgumentError) Unwrap() error {
+	return e.Err
+}
+
+func (e *InvalidScriptArgumentError) Error() string {
+	return fmt.Sprintf("invalid argument at index %d", e.Index)
+}
+
+// ContractAlreadyExistsError
+
+type ContractAlreadyExistsError struct {
+	Address Address
+	Name    string
+}
+
+func (e ContractAlreadyExistsError) Error() string {
+	return fmt.Sprintf(
+		"cannot add contract: contract `%s` already exists in account %s",
+		e.Name,
+		e.Address,
+	)
+}
+
+// ContractNotFoundError
+
+type ContractNotFoundError struct {
+	Address Address
+	Name    string
+}
+
+func (e ContractNotFoundError) Error() string {
+	return fmt.Sprintf(
+		"cannot update contract: contract `%s` does not exist in account %s",
+		e.Name,
+		e.Address,
+	)
+}
+
+// InvalidContractDeploymentError
+
+type InvalidContractDeploymentError struct {
+	Name     string
+	Declared []string
+}
+
+func (e InvalidContractDeploymentError) Error() string {
+	return fmt.Sprintf(
+		"invalid contract deployment: code must declare exactly one contract or contract interface named `%s`, got %d: %s",
+		e.Name,
+		len(e.Declared),
+		strings.Join(e.Declared, ", "),
+	)
+}
+
 // InvalidTypeAssignmentError
 
 type InvalidTypeAssignmentError struct {
@@ -138,3 +301,24 @@ func (e *InvalidTypeAssignmentError) Unwrap() error {
 func (e *InvalidTypeAssignmentError) Error() string {
 	return fmt.Sprintf("cannot assign type %s to %s", e.Type, e.Value)
 }
+
+// InvalidHostFunctionArgumentError
+
+type InvalidHostFunctionArgumentError struct {
+	FunctionName  string
+	ParameterName string
+	Err           error
+}
+
+func (e *InvalidHostFunctionArgumentError) Unwrap() error {
+	return e.Err
+}
+
+func (e *InvalidHostFunctionArgumentError) Error() string {
+	return fmt.Sprintf(
+		"invalid argument for parameter `%s` of function `%s`: %s",
+		e.ParameterName,
+		e.FunctionName,
+		e.Err,
+	)
+}
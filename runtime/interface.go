@@ -19,10 +19,32 @@
 package runtime
 
 import (
+	"math"
+
 	"github.com/onflow/cadence"
 	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/crypto"
+)
+
+type (
+	HashAlgorithm      = crypto.HashAlgorithm
+	SignatureAlgorithm = crypto.SignatureAlgorithm
 )
 
+// AccountKey is a key of an account.
+//
+// The weight is the key weight in Cadence (`UFix64`) in fixed-point representation,
+// i.e. it is scaled by 10^8, so a weight of 1000.0 is represented as 100_000_000_000.
+//
+type AccountKey struct {
+	KeyIndex           int
+	PublicKey          []byte
+	SignatureAlgorithm SignatureAlgorithm
+	HashAlgorithm      HashAlgorithm
+	Weight             uint64
+	IsRevoked          bool
+}
+
 type Interface interface {
 	// ResolveImport resolves an import of a program.
 	ResolveImport(Location) ([]byte, error)
@@ -37,13 +59,40 @@ type Interface interface {
 	// CreateAccount creates a new account with the given public keys and code.
 	CreateAccount(publicKeys [][]byte) (address Address, err error)
 	// AddAccountKey appends a key to an account.
+	//
+	// Deprecated: AddAccountKey is only used by the deprecated `AuthAccount.addPublicKey`.
+	// Use AddStructuredAccountKey, which is used by `AuthAccount.keys.add`.
 	AddAccountKey(address Address, publicKey []byte) error
+	// AddStructuredAccountKey appends a key with the given algorithms and weight to an account,
+	// and returns the added key. The weight is in fixed-point representation, see AccountKey.
+	AddStructuredAccountKey(
+		address Address,
+		publicKey []byte,
+		signatureAlgorithm SignatureAlgorithm,
+		hashAlgorithm HashAlgorithm,
+		weight uint64,
+	) (*AccountKey, error)
+	// GetAccountKey returns the key of an account at the given index,
+	// or nil if the account has no key at the given index.
+	GetAccountKey(address Address, keyIndex int) (*AccountKey, error)
+	// RevokeAccountKey revokes the key of an account at the given index,
+	// and returns the revoked key, or nil if the account has no key at the given index.
+	RevokeAccountKey(address Address, keyIndex int) (*AccountKey, error)
 	// RemoveAccountKey removes a key from an account by index.
 	RemoveAccountKey(address Address, index int) (publicKey []byte, err error)
 	// CheckCode checks the validity of the code.
 	CheckCode(address Address, code []byte) (err error)
+	// GetAccountCode returns the code associated with an account.
+	GetAccountCode(address Address) (code []byte, err error)
 	// UpdateAccountCode updates the code associated with an account.
 	UpdateAccountCode(address Address, code []byte, checkPermission bool) (err error)
+	// GetAccountContractCode returns the code of the contract deployed to an account under the given name.
+	// Returned code is empty if no contract is deployed under the name.
+	GetAccountContractCode(address Address, name string) (code []byte, err error)
+	// UpdateAccountContractCode updates the code of the contract deployed to an account under the given name.
+	UpdateAccountContractCode(address Address, name string, code []byte) (err error)
+	// RemoveAccountContractCode removes the code of the contract deployed to an account under the given name.
+	RemoveAccountContractCode(address Address, name string) (err error)
 	// GetSigningAccounts returns the signing accounts.
 	GetSigningAccounts() []Address
 	// Log logs a string.
@@ -52,12 +101,41 @@ type Interface interface {
 	EmitEvent(cadence.Event)
 	// ValueExists returns true if the given key exists in the storage, controlled and owned by the given accounts.
 	ValueExists(owner, controller, key []byte) (exists bool, err error)
+	// GetValueKeys returns the keys of all values in the storage, controlled and owned by the given accounts.
+	GetValueKeys(owner, controller []byte) (keys [][]byte, err error)
+	// GetStorageCapacity returns the maximum number of bytes the values stored in an account may use.
+	GetStorageCapacity(address Address) (capacity uint64, err error)
 	// GenerateUUID is called to generate a UUID.
 	GenerateUUID() uint64
 	// GetComputationLimit returns the computation limit. A value <= 0 means there is no limit
 	GetComputationLimit() uint64
+	// GetComputationWeights returns the weights of the computation kinds,
+	// i.e. how much computation an operation uses per unit of intensity.
+	// If no weights are returned, DefaultComputationWeights are used.
+	GetComputationWeights() ComputationWeights
+	// SetComputationUsed is called after a script or transaction was executed,
+	// with the total weighted computation used by the execution.
+	SetComputationUsed(used uint64)
+	// GetMemoryLimit returns the limit of the estimated memory in bytes
+	// which may be allocated by an execution. A value <= 0 means there is no limit
+	GetMemoryLimit() uint64
+	// SetMemoryUsed is called after a script or transaction was executed,
+	// with the total estimated memory in bytes allocated by the execution.
+	SetMemoryUsed(used uint64)
 	// DecodeArgument decodes a transaction argument against the given type.
 	DecodeArgument(b []byte, t cadence.Type) (cadence.Value, error)
+	// Hash returns the digest of hashing the given data using the given hash algorithm.
+	Hash(data []byte, hashAlgorithm HashAlgorithm) ([]byte, error)
+	// VerifySignature returns true if the given signature was produced by signing the given tag and data
+	// using the given public key, signature algorithm, and hash algorithm.
+	VerifySignature(
+		signature []byte,
+		tag string,
+		signedData []byte,
+		publicKey []byte,
+		signatureAlgorithm SignatureAlgorithm,
+		hashAlgorithm HashAlgorithm,
+	) (bool, error)
 }
 
 type EmptyRuntimeInterface struct{}
@@ -78,6 +156,14 @@ func (i *EmptyRuntimeInterface) ValueExists(controller, owner, key []byte) (exis
 	return false, nil
 }
 
+func (i *EmptyRuntimeInterface) GetValueKeys(owner, controller []byte) (keys [][]byte, err error) {
+	return nil, nil
+}
+
+func (i *EmptyRuntimeInterface) GetStorageCapacity(address Address) (capacity uint64, err error) {
+	return math.MaxUint64, nil
+}
+
 func (i *EmptyRuntimeInterface) GetValue(controller, owner, key []byte) (value []byte, err error) {
 	return nil, nil
 }
@@ -94,6 +180,24 @@ func (i *EmptyRuntimeInterface) AddAccountKey(address Address, publicKey []byte)
 	return nil
 }
 
+func (i *EmptyRuntimeInterface) AddStructuredAccountKey(
+	address Address,
+	publicKey []byte,
+	signatureAlgorithm SignatureAlgorithm,
+	hashAlgorithm HashAlgorithm,
+	weight uint64,
+) (*AccountKey, error) {
+	return nil, nil
+}
+
+func (i *EmptyRuntimeInterface) GetAccountKey(address Address, keyIndex int) (*AccountKey, error) {
+	return nil, nil
+}
+
+func (i *EmptyRuntimeInterface) RevokeAccountKey(address Address, keyIndex int) (*AccountKey, error) {
+	return nil, nil
+}
+
 func (i *EmptyRuntimeInterface) RemoveAccountKey(address Address, index int) (publicKey []byte, err error) {
 	return nil, nil
 }
@@ -102,10 +206,26 @@ func (i *EmptyRuntimeInterface) CheckCode(address Address, code []byte) error {
 	return nil
 }
 
+func (i *EmptyRuntimeInterface) GetAccountCode(address Address) (code []byte, err error) {
+	return nil, nil
+}
+
 func (i *EmptyRuntimeInterface) UpdateAccountCode(address Address, code []byte, checkPermission bool) error {
 	return nil
 }
 
+func (i *EmptyRuntimeInterface) GetAccountContractCode(address Address, name string) (code []byte, err error) {
+	return nil, nil
+}
+
+func (i *EmptyRuntimeInterface) UpdateAccountContractCode(address Address, name string, code []byte) error {
+	return nil
+}
+
+func (i *EmptyRuntimeInterface) RemoveAccountContractCode(address Address, name string) error {
+	return nil
+}
+
 func (i *EmptyRuntimeInterface) GetSigningAccounts() []Address {
 	return nil
 }
@@ -122,6 +242,40 @@ func (i *EmptyRuntimeInterface) GetComputationLimit() uint64 {
 	return 0
 }
 
+func (i *EmptyRuntimeInterface) GetComputationWeights() ComputationWeights {
+	return nil
+}
+
+func (i *EmptyRuntimeInterface) SetComputationUsed(_ uint64) {}
+
+func (i *EmptyRuntimeInterface) GetMemoryLimit() uint64 {
+	return 0
+}
+
+func (i *EmptyRuntimeInterface) SetMemoryUsed(_ uint64) {}
+
 func (i *EmptyRuntimeInterface) DecodeArgument(b []byte, t cadence.Type) (cadence.Value, error) {
 	return nil, nil
 }
+
+func (i *EmptyRuntimeInterface) Hash(data []byte, hashAlgorithm HashAlgorithm) ([]byte, error) {
+	return crypto.Hash(data, hashAlgorithm)
+}
+
+func (i *EmptyRuntimeInterface) VerifySignature(
+	signature []byte,
+	tag string,
+	signedData []byte,
+	publicKey []byte,
+	signatureAlgorithm SignatureAlgorithm,
+	hashAlgorithm HashAlgorithm,
+) (bool, error) {
+	return crypto.VerifySignature(
+		signature,
+		tag,
+		signedData,
+		publicKey,
+		signatureAlgorithm,
+		hashAlgorithm,
+	)
+}
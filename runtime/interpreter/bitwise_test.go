@@ -0,0 +1,218 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBitwiseInt8(t *testing.T) {
+
+	tests := []struct {
+		a, b                      Int8Value
+		or, xor, and, left, right Int8Value
+	}{
+		{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+		{0x0f, 0x01, 0x0f, 0x0e, 0x01, 0x1e, 0x07},
+		{0x40, 0x01, 0x41, 0x41, 0x00, -0x80, 0x20},
+		{-0x80, 0x07, -0x79, -0x79, 0x00, 0x00, -0x01},
+		{-0x01, 0x08, -0x01, -0x09, 0x08, 0x00, -0x01},
+		{0x7f, 0x10, 0x7f, 0x6f, 0x10, 0x00, 0x00},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.or, test.a.BitwiseOr(test.b))
+		assert.Equal(t, test.xor, test.a.BitwiseXor(test.b))
+		assert.Equal(t, test.and, test.a.BitwiseAnd(test.b))
+		assert.Equal(t, test.left, test.a.BitwiseLeftShift(test.b))
+		assert.Equal(t, test.right, test.a.BitwiseRightShift(test.b))
+	}
+
+	assert.Equal(t, Int8Value(-0x01), Int8Value(0x00).BitwiseNot())
+	assert.Equal(t, Int8Value(-0x80), Int8Value(0x7f).BitwiseNot())
+
+	assert.Panics(t, func() {
+		Int8Value(1).BitwiseLeftShift(Int8Value(-1))
+	})
+	assert.Panics(t, func() {
+		Int8Value(1).BitwiseRightShift(Int8Value(-1))
+	})
+}
+
+func TestBitwiseUInt8(t *testing.T) {
+
+	tests := []struct {
+		a, b                      UInt8Value
+		or, xor, and, left, right UInt8Value
+	}{
+		{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+		{0x0f, 0x01, 0x0f, 0x0e, 0x01, 0x1e, 0x07},
+		{0x80, 0x01, 0x81, 0x81, 0x00, 0x00, 0x40},
+		{0xff, 0x04, 0xff, 0xfb, 0x04, 0xf0, 0x0f},
+		{0xff, 0x08, 0xff, 0xf7, 0x08, 0x00, 0x00},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.or, test.a.BitwiseOr(test.b))
+		assert.Equal(t, test.xor, test.a.BitwiseXor(test.b))
+		assert.Equal(t, test.and, test.a.BitwiseAnd(test.b))
+		assert.Equal(t, test.left, test.a.BitwiseLeftShift(test.b))
+		assert.Equal(t, test.right, test.a.BitwiseRightShift(test.b))
+	}
+
+	assert.Equal(t, UInt8Value(0xff), UInt8Value(0x00).BitwiseNot())
+	assert.Equal(t, UInt8Value(0xf0), UInt8Value(0x0f).BitwiseNot())
+}
+
+func TestBitwiseWord64(t *testing.T) {
+
+	assert.Equal(t,
+		Word64Value(0x8000000000000000),
+		Word64Value(1).BitwiseLeftShift(Word64Value(63)),
+	)
+	assert.Equal(t,
+		Word64Value(0),
+		Word64Value(1).BitwiseLeftShift(Word64Value(64)),
+	)
+	assert.Equal(t,
+		Word64Value(0xfffffffffffffffe),
+		Word64Value(1).BitwiseNot(),
+	)
+}
+
+func TestBitwiseInt(t *testing.T) {
+
+	assert.Equal(t,
+		NewIntValueFromInt64(0x0f),
+		NewIntValueFromInt64(0x0e).BitwiseOr(NewIntValueFromInt64(0x01)),
+	)
+	assert.Equal(t,
+		NewIntValueFromInt64(-0x02),
+		NewIntValueFromInt64(-0x01).BitwiseXor(NewIntValueFromInt64(0x01)),
+	)
+	assert.Equal(t,
+		NewIntValueFromInt64(0x08),
+		NewIntValueFromInt64(-0x08).BitwiseAnd(NewIntValueFromInt64(0x0f)),
+	)
+	assert.Equal(t,
+		NewIntValueFromInt64(-0x04),
+		NewIntValueFromInt64(-0x07).BitwiseRightShift(NewIntValueFromInt64(1)),
+	)
+	assert.Equal(t,
+		NewIntValueFromInt64(-0x01),
+		NewIntValueFromInt64(0).BitwiseNot(),
+	)
+
+	// left shifts are not truncated
+
+	expected := int128("0x10000000000000000")
+	actual := NewIntValueFromInt64(1).BitwiseLeftShift(NewIntValueFromInt64(64))
+	assert.Zero(t, expected.BigInt.Cmp(actual.(IntValue).BigInt))
+
+	assert.Panics(t, func() {
+		NewIntValueFromInt64(1).BitwiseLeftShift(NewIntValueFromInt64(-1))
+	})
+
+	// left shifts are limited in size
+
+	assert.PanicsWithValue(t, OverflowError{}, func() {
+		NewIntValueFromInt64(1).BitwiseLeftShift(NewIntValueFromInt64(10000000000))
+	})
+}
+
+func TestBitwiseUInt(t *testing.T) {
+
+	assert.Equal(t,
+		NewUIntValueFromUint64(0x40),
+		NewUIntValueFromUint64(0x80).BitwiseRightShift(NewUIntValueFromUint64(1)),
+	)
+
+	assert.Panics(t, func() {
+		NewUIntValueFromUint64(1).BitwiseNot()
+	})
+
+	assert.PanicsWithValue(t, OverflowError{}, func() {
+		NewUIntValueFromUint64(1).BitwiseLeftShift(NewUIntValueFromUint64(10000000000))
+	})
+}
+
+func TestBitwiseUInt128(t *testing.T) {
+
+	tests := []struct {
+		a, b, left UInt128Value
+	}{
+		{
+			uint128("0x1"),
+			uint128("0x7f"),
+			uint128("0x80000000000000000000000000000000"),
+		},
+		{
+			uint128("0x1"),
+			uint128("0x80"),
+			uint128("0x0"),
+		},
+		{
+			uint128("0xffffffffffffffffffffffffffffffff"),
+			uint128("0x40"),
+			uint128("0xffffffffffffffff0000000000000000"),
+		},
+	}
+
+	for _, test := range tests {
+		assert.True(t, bool(test.left.Equal(test.a.BitwiseLeftShift(test.b))))
+	}
+
+	assert.True(t,
+		bool(
+			uint128("0xffffffffffffffffffffffffffffffff").
+				Equal(uint128("0x0").BitwiseNot()),
+		),
+	)
+}
+
+func TestBitwiseInt256(t *testing.T) {
+
+	assert.True(t,
+		bool(
+			int256("-0x8000000000000000000000000000000000000000000000000000000000000000").
+				Equal(int256("0x1").BitwiseLeftShift(int256("0xff"))),
+		),
+	)
+	assert.True(t,
+		bool(
+			int256("-0x1").
+				Equal(int256("-0x8000000000000000000000000000000000000000000000000000000000000000").
+					BitwiseRightShift(int256("0x100"))),
+		),
+	)
+	assert.True(t,
+		bool(
+			int256("-0x1").
+				Equal(int256("0x0").BitwiseNot()),
+		),
+	)
+	assert.True(t,
+		bool(
+			uint256("0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff").
+				Equal(uint256("0x0").BitwiseNot()),
+		),
+	)
+}
@@ -0,0 +1,554 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/raviqqe/hamt"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/parser"
+)
+
+//go:generate stringer -type=StopReason
+
+// StopReason is the reason why the debugger paused the execution.
+//
+type StopReason int
+
+const (
+	StopReasonUnknown StopReason = iota
+	StopReasonBreakpoint
+	StopReasonStep
+	StopReasonPause
+)
+
+// Stop is sent by the debugger when the execution is paused,
+// before the statement at the given line is executed.
+//
+type Stop struct {
+	Reason      StopReason
+	Interpreter *Interpreter
+	Line        int
+}
+
+type stepMode int
+
+const (
+	stepModeNone stepMode = iota
+	stepModeInto
+	stepModeOver
+	stepModeOut
+)
+
+// StackFrame is a frame of the call stack of a paused program.
+//
+type StackFrame struct {
+	Interpreter *Interpreter
+	// Function is the name of the function of the frame,
+	// or the empty string if the frame is the top-level of the program
+	Function   string
+	Line       int
+	activation hamt.Map
+}
+
+// Location returns the location of the program of the frame.
+//
+func (f *StackFrame) Location() ast.Location {
+	return f.Interpreter.Checker.Location
+}
+
+// Locals returns the values of the local variables which are visible in the frame,
+// i.e. the variables of the activation which are not globals.
+//
+func (f *StackFrame) Locals() map[string]Value {
+	globals := map[*Variable]struct{}{}
+	for _, variable := range f.Interpreter.Globals {
+		globals[variable] = struct{}{}
+	}
+
+	locals := map[string]Value{}
+
+	activation := f.activation
+	for {
+		var entry hamt.Entry
+		var value interface{}
+		entry, value, activation = activation.FirstRest()
+		if entry == nil {
+			break
+		}
+
+		variable := value.(*Variable)
+		if _, ok := globals[variable]; ok {
+			continue
+		}
+
+		name := string(entry.(common.StringEntry))
+		locals[name] = variable.Value
+	}
+
+	return locals
+}
+
+// Globals returns the values of the global variables of the program of the frame.
+// Functions, including the constructors of composites, are omitted.
+//
+func (f *StackFrame) Globals() map[string]Value {
+	globals := map[string]Value{}
+
+	for name, variable := range f.Interpreter.Globals {
+		if _, ok := variable.Value.(FunctionValue); ok {
+			continue
+		}
+		globals[name] = variable.Value
+	}
+
+	return globals
+}
+
+// Debugger pauses the execution of programs at breakpoints and steps,
+// and allows inspecting the call stack and the variables of the paused program.
+//
+// The program is executed in a separate goroutine. When the execution is paused,
+// a Stop is sent on the channel returned by Stops, and the execution blocks
+// until it is resumed using Continue, StepInto, StepOver, or StepOut.
+//
+type Debugger struct {
+	stops     chan Stop
+	continues chan struct{}
+
+	mutex          sync.Mutex
+	breakpoints    map[ast.LocationID]map[int]struct{}
+	stepMode       stepMode
+	stepDepth      int
+	pauseRequested bool
+	stop           *Stop
+	callStack      []*StackFrame
+
+	// frames is the call stack of the executing program,
+	// and runs is the number of nested runs of statements.
+	// They are only accessed by the executing goroutine,
+	// or while the execution is paused
+	frames    []*StackFrame
+	runs      int
+	functions map[ast.LocationID]*ast.FunctionIndex
+}
+
+func NewDebugger() *Debugger {
+	return &Debugger{
+		stops:       make(chan Stop, 1),
+		continues:   make(chan struct{}),
+		breakpoints: map[ast.LocationID]map[int]struct{}{},
+		functions:   map[ast.LocationID]*ast.FunctionIndex{},
+	}
+}
+
+// Stops returns the channel on which a Stop is sent each time the execution is paused.
+//
+func (d *Debugger) Stops() <-chan Stop {
+	return d.stops
+}
+
+// AddBreakpoint adds a breakpoint for the given line of the program with the given location.
+//
+func (d *Debugger) AddBreakpoint(location ast.Location, line int) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	locationID := location.ID()
+
+	lines, ok := d.breakpoints[locationID]
+	if !ok {
+		lines = map[int]struct{}{}
+		d.breakpoints[locationID] = lines
+	}
+	lines[line] = struct{}{}
+}
+
+// RemoveBreakpoint removes the breakpoint for the given line of the program with the given location, if any.
+//
+func (d *Debugger) RemoveBreakpoint(location ast.Location, line int) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	delete(d.breakpoints[location.ID()], line)
+}
+
+// ClearBreakpoints removes all breakpoints of the program with the given location.
+//
+func (d *Debugger) ClearBreakpoints(location ast.Location) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	delete(d.breakpoints, location.ID())
+}
+
+// Breakpoints returns the lines of all breakpoints of the program with the given location, in order.
+//
+func (d *Debugger) Breakpoints(location ast.Location) []int {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	lines := d.breakpoints[location.ID()]
+
+	result := make([]int, 0, len(lines))
+	for line := range lines {
+		result = append(result, line)
+	}
+	sort.Ints(result)
+
+	return result
+}
+
+// RequestPause requests the execution to be paused before the next statement.
+//
+func (d *Debugger) RequestPause() {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.pauseRequested = true
+}
+
+// Paused returns the current stop, if the execution is paused, or nil otherwise.
+//
+func (d *Debugger) Paused() *Stop {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	return d.stop
+}
+
+// Continue resumes the paused execution until the next breakpoint is reached.
+//
+func (d *Debugger) Continue() {
+	d.resume(stepModeNone)
+}
+
+// StepInto resumes the paused execution until the next statement,
+// which may be the first statement of a called function.
+//
+func (d *Debugger) StepInto() {
+	d.resume(stepModeInto)
+}
+
+// StepOver resumes the paused execution until the next statement of the current function,
+// or the next statement of the caller, if the current function returns.
+//
+func (d *Debugger) StepOver() {
+	d.resume(stepModeOver)
+}
+
+// StepOut resumes the paused execution until the current function returns
+// and the next statement of the caller is reached.
+//
+func (d *Debugger) StepOut() {
+	d.resume(stepModeOut)
+}
+
+func (d *Debugger) resume(mode stepMode) {
+	d.mutex.Lock()
+
+	if d.stop == nil {
+		d.mutex.Unlock()
+		return
+	}
+
+	d.stepMode = mode
+	d.stepDepth = len(d.frames)
+	d.stop = nil
+	d.callStack = nil
+
+	d.mutex.Unlock()
+
+	d.continues <- struct{}{}
+}
+
+// CallStack returns the call stack of the paused execution, starting with the innermost frame.
+// If the execution is not paused, nil is returned.
+//
+func (d *Debugger) CallStack() []*StackFrame {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	return d.callStack
+}
+
+// Evaluate evaluates the given expression in the context of the given frame of the paused execution.
+//
+// Only a restricted set of expressions is supported: identifiers, member accesses, index accesses,
+// and literals. The expression is not type-checked.
+//
+func (d *Debugger) Evaluate(frame *StackFrame, code string) (result Value, err error) {
+	expression, _, err := parser.ParseExpression(code)
+	if err != nil {
+		return nil, err
+	}
+
+	defer recoverErrors(func(internalErr error) {
+		err = internalErr
+	})
+
+	return d.evaluate(frame, expression), nil
+}
+
+func (d *Debugger) evaluate(frame *StackFrame, expression ast.Expression) Value {
+	switch expression := expression.(type) {
+	case *ast.IdentifierExpression:
+		name := expression.Identifier.Identifier
+		result := frame.activation.Find(common.StringEntry(name))
+		if result == nil {
+			panic(&NotDeclaredError{
+				ExpectedKind: common.DeclarationKindVariable,
+				Name:         name,
+			})
+		}
+		return result.(*Variable).Value
+
+	case *ast.MemberExpression:
+		target := d.evaluate(frame, expression.Expression)
+		if expression.Optional {
+			switch typedTarget := target.(type) {
+			case NilValue:
+				return typedTarget
+			case *SomeValue:
+				target = typedTarget.Value
+			}
+		}
+
+		memberAccessibleValue, ok := target.(MemberAccessibleValue)
+		if !ok {
+			panic(&UnsupportedEvaluationError{Expression: expression})
+		}
+
+		locationRange := frame.locationRange(expression)
+		result := memberAccessibleValue.GetMember(frame.Interpreter, locationRange, expression.Identifier.Identifier)
+		if result == nil {
+			panic(&NotDeclaredError{
+				ExpectedKind: common.DeclarationKindField,
+				Name:         expression.Identifier.Identifier,
+			})
+		}
+		if expression.Optional {
+			return NewSomeValueOwningNonCopying(result)
+		}
+		return result
+
+	case *ast.IndexExpression:
+		target := d.evaluate(frame, expression.TargetExpression)
+		indexableValue, ok := target.(ValueIndexableValue)
+		if !ok {
+			panic(&UnsupportedEvaluationError{Expression: expression})
+		}
+
+		index := d.evaluate(frame, expression.IndexingExpression)
+		locationRange := frame.locationRange(expression)
+		return indexableValue.Get(frame.Interpreter, locationRange, index)
+
+	case *ast.IntegerExpression:
+		return NewIntValueFromBigInt(expression.Value)
+
+	case *ast.StringExpression:
+		return NewStringValue(expression.Value)
+
+	case *ast.BoolExpression:
+		return BoolValue(expression.Value)
+
+	case *ast.NilExpression:
+		return NilValue{}
+
+	default:
+		panic(&UnsupportedEvaluationError{Expression: expression})
+	}
+}
+
+func (f *StackFrame) locationRange(hasPosition ast.HasPosition) LocationRange {
+	return LocationRange{
+		Location: f.Location(),
+		Range:    ast.NewRangeFromPositioned(hasPosition),
+	}
+}
+
+// enterRun is called when the interpreter starts running statements.
+//
+func (d *Debugger) enterRun() {
+	d.runs++
+}
+
+// leaveRun is called when the interpreter stops running statements,
+// either because all statements were run, or because of a panic.
+//
+// When the outermost run ends, the execution is finished:
+// The call stack is cleared, as frames are not popped when functions are aborted by a panic,
+// and the step state is reset, so it does not affect the next execution.
+//
+func (d *Debugger) leaveRun() {
+	d.runs--
+	if d.runs > 0 {
+		return
+	}
+
+	d.frames = nil
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.stepMode = stepModeNone
+	d.stepDepth = 0
+	d.pauseRequested = false
+}
+
+// pushFrame is called when an interpreted function is invoked.
+//
+func (d *Debugger) pushFrame(interpreter *Interpreter, callerActivation hamt.Map) {
+	if len(d.frames) > 0 {
+		d.frames[len(d.frames)-1].activation = callerActivation
+	}
+
+	d.frames = append(d.frames, &StackFrame{
+		Interpreter: interpreter,
+	})
+}
+
+// popFrame is called when an interpreted function returns.
+//
+func (d *Debugger) popFrame() {
+	count := len(d.frames)
+	if count < 1 {
+		return
+	}
+	d.frames[count-1] = nil
+	d.frames = d.frames[:count-1]
+}
+
+// onStatement is called before the given statement is executed.
+// If the execution should be paused, it blocks until the execution is resumed.
+//
+func (d *Debugger) onStatement(statement *Statement) {
+	inter := statement.Interpreter
+	line := statement.Line
+
+	depth := len(d.frames)
+	if depth > 0 {
+		frame := d.frames[depth-1]
+		if frame.Interpreter == inter {
+			frame.Line = line
+		}
+	}
+
+	d.mutex.Lock()
+
+	reason := d.stopReason(inter.Checker.Location.ID(), line, depth)
+	if reason == StopReasonUnknown {
+		d.mutex.Unlock()
+		return
+	}
+
+	stop := Stop{
+		Reason:      reason,
+		Interpreter: inter,
+		Line:        line,
+	}
+
+	d.stepMode = stepModeNone
+	d.pauseRequested = false
+	d.stop = &stop
+	d.callStack = d.snapshotCallStack(inter, line)
+
+	d.mutex.Unlock()
+
+	d.stops <- stop
+	<-d.continues
+}
+
+// stopReason returns the reason why the execution should be paused
+// before the statement at the given line, or StopReasonUnknown if it should not be paused.
+//
+// NOTE: assumes the mutex is locked
+//
+func (d *Debugger) stopReason(locationID ast.LocationID, line int, depth int) StopReason {
+	if _, ok := d.breakpoints[locationID][line]; ok {
+		return StopReasonBreakpoint
+	}
+
+	switch d.stepMode {
+	case stepModeInto:
+		return StopReasonStep
+	case stepModeOver:
+		if depth <= d.stepDepth {
+			return StopReasonStep
+		}
+	case stepModeOut:
+		if depth < d.stepDepth {
+			return StopReasonStep
+		}
+	}
+
+	if d.pauseRequested {
+		return StopReasonPause
+	}
+
+	return StopReasonUnknown
+}
+
+// snapshotCallStack returns the current call stack, starting with the innermost frame.
+// Statements which are not executed in a function, e.g. the top-level of a program,
+// get a separate frame.
+//
+func (d *Debugger) snapshotCallStack(inter *Interpreter, line int) []*StackFrame {
+	frames := make([]*StackFrame, 0, len(d.frames)+1)
+
+	count := len(d.frames)
+	if count == 0 || d.frames[count-1].Interpreter != inter {
+		frames = append(frames, &StackFrame{
+			Interpreter: inter,
+			Line:        line,
+		})
+	}
+
+	for i := count - 1; i >= 0; i-- {
+		frame := *d.frames[i]
+		frames = append(frames, &frame)
+	}
+
+	// The activation of the innermost frame is the current activation
+
+	frames[0].activation = inter.activations.CurrentOrNew()
+
+	for _, frame := range frames {
+		frame.Function = d.functionName(frame.Interpreter, frame.Line)
+	}
+
+	return frames
+}
+
+func (d *Debugger) functionName(inter *Interpreter, line int) string {
+	location := inter.Checker.Location
+	locationID := location.ID()
+
+	functions, ok := d.functions[locationID]
+	if !ok {
+		functions = ast.NewFunctionIndex(inter.Checker.Program)
+		d.functions[locationID] = functions
+	}
+
+	return functions.Find(line)
+}
@@ -0,0 +1,896 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/fxamacker/cbor/v2"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+var cborDecMode = func() cbor.DecMode {
+	mode, err := cbor.DecOptions{
+		DupMapKey:        cbor.DupMapKeyEnforcedAPF,
+		IndefLength:      cbor.IndefLengthForbidden,
+		MaxNestedLevels:  256,
+		MaxArrayElements: 134217728,
+		MaxMapPairs:      134217728,
+	}.DecMode()
+	if err != nil {
+		panic(err)
+	}
+	return mode
+}()
+
+// UnsupportedEncodingVersionError is returned when data
+// was encoded with a version that is not known.
+//
+type UnsupportedEncodingVersionError struct {
+	Version uint16
+}
+
+func (e UnsupportedEncodingVersionError) Error() string {
+	return fmt.Sprintf("unsupported encoding version: %d", e.Version)
+}
+
+// DecodingError is returned when encoded data is invalid.
+//
+type DecodingError struct {
+	Message string
+}
+
+func (e DecodingError) Error() string {
+	return fmt.Sprintf("failed to decode value: %s", e.Message)
+}
+
+func newDecodingError(format string, a ...interface{}) DecodingError {
+	return DecodingError{
+		Message: fmt.Sprintf(format, a...),
+	}
+}
+
+// EncodingVersion returns the version the given data was encoded with,
+// and the encoded content without the version prefix.
+//
+// Data without a version prefix is legacy data, encoded with encoding/gob.
+//
+func EncodingVersion(data []byte) (version uint16, content []byte) {
+	if len(data) < encodingVersionPrefixLength || data[0] != encodingVersionMarker {
+		return EncodingVersionLegacy, data
+	}
+
+	version = binary.BigEndian.Uint16(data[1:encodingVersionPrefixLength])
+	return version, data[encodingVersionPrefixLength:]
+}
+
+// DecodeValue decodes the given data, which was encoded with EncodeValue,
+// or with any previous encoding version.
+//
+// The given owner is set as the owner of the decoded value and all its nested values.
+//
+func DecodeValue(data []byte, owner *common.Address) (Value, error) {
+	version, content := EncodingVersion(data)
+
+	switch version {
+	case EncodingVersionLegacy:
+		return decodeLegacyValue(content, owner)
+
+	case EncodingVersion1:
+		var decoded interface{}
+		err := cborDecMode.Unmarshal(content, &decoded)
+		if err != nil {
+			return nil, err
+		}
+
+		return decoder{owner: owner}.decodeValue(decoded)
+
+	default:
+		return nil, UnsupportedEncodingVersionError{Version: version}
+	}
+}
+
+// decodeLegacyValue decodes the given data, which was encoded with encoding/gob.
+//
+func decodeLegacyValue(data []byte, owner *common.Address) (Value, error) {
+	var value Value
+	err := gob.NewDecoder(bytes.NewReader(data)).Decode(&value)
+	if err != nil {
+		return nil, err
+	}
+
+	value.SetOwner(owner)
+
+	return value, nil
+}
+
+type decoder struct {
+	owner *common.Address
+}
+
+func (d decoder) decodeValue(v interface{}) (Value, error) {
+	switch v := v.(type) {
+
+	case nil:
+		return NilValue{}, nil
+
+	case bool:
+		return BoolValue(v), nil
+
+	case string:
+		return NewStringValue(v), nil
+
+	case []interface{}:
+		return d.decodeArray(v)
+
+	case cbor.Tag:
+		return d.decodeTaggedValue(v)
+
+	default:
+		return nil, newDecodingError("unsupported data: %T", v)
+	}
+}
+
+func (d decoder) decodeTaggedValue(v cbor.Tag) (Value, error) {
+	switch v.Number {
+
+	case cborTagVoidValue:
+		return VoidValue{}, nil
+
+	case cborTagDictionaryValue:
+		return d.decodeDictionary(v.Content)
+
+	case cborTagSomeValue:
+		value, err := d.decodeValue(v.Content)
+		if err != nil {
+			return nil, err
+		}
+		return &SomeValue{
+			Value: value,
+			Owner: d.owner,
+		}, nil
+
+	case cborTagAddressValue:
+		return decodeAddress(v.Content)
+
+	case cborTagCompositeValue:
+		return d.decodeComposite(v.Content)
+
+	case cborTagPathValue:
+		return decodePath(v)
+
+	case cborTagCapabilityValue:
+		return decodeCapability(v.Content)
+
+	case cborTagLinkValue:
+		return decodeLink(v.Content)
+
+	case cborTagStorageReferenceValue:
+		return d.decodeStorageReference(v.Content)
+
+	case cborTagTypeValue:
+		return decodeType(v.Content)
+
+	// Numbers
+
+	case cborTagIntValue:
+		bigInt, err := decodeBigInt(v.Content)
+		if err != nil {
+			return nil, err
+		}
+		return NewIntValueFromBigInt(bigInt), nil
+
+	case cborTagInt8Value:
+		value, err := decodeInt64(v.Content, math.MinInt8, math.MaxInt8)
+		if err != nil {
+			return nil, err
+		}
+		return Int8Value(value), nil
+
+	case cborTagInt16Value:
+		value, err := decodeInt64(v.Content, math.MinInt16, math.MaxInt16)
+		if err != nil {
+			return nil, err
+		}
+		return Int16Value(value), nil
+
+	case cborTagInt32Value:
+		value, err := decodeInt64(v.Content, math.MinInt32, math.MaxInt32)
+		if err != nil {
+			return nil, err
+		}
+		return Int32Value(value), nil
+
+	case cborTagInt64Value:
+		value, err := decodeInt64(v.Content, math.MinInt64, math.MaxInt64)
+		if err != nil {
+			return nil, err
+		}
+		return Int64Value(value), nil
+
+	case cborTagInt128Value:
+		bigInt, err := decodeBigInt(v.Content)
+		if err != nil {
+			return nil, err
+		}
+		return NewInt128ValueFromBigInt(bigInt), nil
+
+	case cborTagInt256Value:
+		bigInt, err := decodeBigInt(v.Content)
+		if err != nil {
+			return nil, err
+		}
+		return NewInt256ValueFromBigInt(bigInt), nil
+
+	case cborTagUIntValue:
+		bigInt, err := decodeBigInt(v.Content)
+		if err != nil {
+			return nil, err
+		}
+		return NewUIntValueFromBigInt(bigInt), nil
+
+	case cborTagUInt8Value:
+		value, err := decodeUint64(v.Content, math.MaxUint8)
+		if err != nil {
+			return nil, err
+		}
+		return UInt8Value(value), nil
+
+	case cborTagUInt16Value:
+		value, err := decodeUint64(v.Content, math.MaxUint16)
+		if err != nil {
+			return nil, err
+		}
+		return UInt16Value(value), nil
+
+	case cborTagUInt32Value:
+		value, err := decodeUint64(v.Content, math.MaxUint32)
+		if err != nil {
+			return nil, err
+		}
+		return UInt32Value(value), nil
+
+	case cborTagUInt64Value:
+		value, err := decodeUint64(v.Content, math.MaxUint64)
+		if err != nil {
+			return nil, err
+		}
+		return UInt64Value(value), nil
+
+	case cborTagUInt128Value:
+		bigInt, err := decodeBigInt(v.Content)
+		if err != nil {
+			return nil, err
+		}
+		return NewUInt128ValueFromBigInt(bigInt), nil
+
+	case cborTagUInt256Value:
+		bigInt, err := decodeBigInt(v.Content)
+		if err != nil {
+			return nil, err
+		}
+		return NewUInt256ValueFromBigInt(bigInt), nil
+
+	case cborTagWord8Value:
+		value, err := decodeUint64(v.Content, math.MaxUint8)
+		if err != nil {
+			return nil, err
+		}
+		return Word8Value(value), nil
+
+	case cborTagWord16Value:
+		value, err := decodeUint64(v.Content, math.MaxUint16)
+		if err != nil {
+			return nil, err
+		}
+		return Word16Value(value), nil
+
+	case cborTagWord32Value:
+		value, err := decodeUint64(v.Content, math.MaxUint32)
+		if err != nil {
+			return nil, err
+		}
+		return Word32Value(value), nil
+
+	case cborTagWord64Value:
+		value, err := decodeUint64(v.Content, math.MaxUint64)
+		if err != nil {
+			return nil, err
+		}
+		return Word64Value(value), nil
+
+	case cborTagFix64Value:
+		value, err := decodeInt64(v.Content, math.MinInt64, math.MaxInt64)
+		if err != nil {
+			return nil, err
+		}
+		return Fix64Value(value), nil
+
+	case cborTagUFix64Value:
+		value, err := decodeUint64(v.Content, math.MaxUint64)
+		if err != nil {
+			return nil, err
+		}
+		return UFix64Value(value), nil
+
+	default:
+		return nil, newDecodingError("unsupported tag: %d", v.Number)
+	}
+}
+
+func (d decoder) decodeValues(v interface{}) ([]Value, error) {
+	encodedValues, ok := v.([]interface{})
+	if !ok {
+		return nil, newDecodingError("invalid values encoding: %T", v)
+	}
+
+	values := make([]Value, len(encodedValues))
+
+	for i, encodedValue := range encodedValues {
+		value, err := d.decodeValue(encodedValue)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+
+	return values, nil
+}
+
+func (d decoder) decodeArray(v []interface{}) (*ArrayValue, error) {
+	values, err := d.decodeValues(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ArrayValue{
+		Values: values,
+		Owner:  d.owner,
+	}, nil
+}
+
+func (d decoder) decodeDictionary(v interface{}) (*DictionaryValue, error) {
+	encoded, ok := v.([]interface{})
+	if !ok || len(encoded) != 2 {
+		return nil, newDecodingError("invalid dictionary encoding")
+	}
+
+	keys, err := d.decodeValues(encoded[0])
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := d.decodeValues(encoded[1])
+	if err != nil {
+		return nil, err
+	}
+
+	if len(keys) != len(values) {
+		return nil, newDecodingError(
+			"invalid dictionary encoding: %d keys, %d values",
+			len(keys),
+			len(values),
+		)
+	}
+
+	entries := make(map[string]Value, len(keys))
+
+	for i, key := range keys {
+		keyStringValue, ok := key.(HasKeyString)
+		if !ok {
+			return nil, newDecodingError("invalid dictionary key: %T", key)
+		}
+		entries[keyStringValue.KeyString()] = values[i]
+	}
+
+	return &DictionaryValue{
+		Keys: &ArrayValue{
+			Values: keys,
+			Owner:  d.owner,
+		},
+		Entries: entries,
+		Owner:   d.owner,
+	}, nil
+}
+
+func (d decoder) decodeComposite(v interface{}) (*CompositeValue, error) {
+	encoded, ok := v.([]interface{})
+	if !ok || len(encoded) != 4 {
+		return nil, newDecodingError("invalid composite encoding")
+	}
+
+	location, err := decodeLocation(encoded[0])
+	if err != nil {
+		return nil, err
+	}
+
+	typeID, ok := encoded[1].(string)
+	if !ok {
+		return nil, newDecodingError("invalid composite type ID: %T", encoded[1])
+	}
+
+	// NOTE: enums are the composite kind with the highest value
+
+	kind, err := decodeUint64(encoded[2], uint64(common.CompositeKindEnum))
+	if err != nil {
+		return nil, err
+	}
+
+	encodedFields, ok := encoded[3].([]interface{})
+	if !ok || len(encodedFields)%2 != 0 {
+		return nil, newDecodingError("invalid composite fields encoding")
+	}
+
+	fields := make(map[string]Value, len(encodedFields)/2)
+
+	for i := 0; i < len(encodedFields); i += 2 {
+		name, ok := encodedFields[i].(string)
+		if !ok {
+			return nil, newDecodingError("invalid composite field name: %T", encodedFields[i])
+		}
+
+		value, err := d.decodeValue(encodedFields[i+1])
+		if err != nil {
+			return nil, err
+		}
+
+		fields[name] = value
+	}
+
+	// NOTE: functions and the destructor are linked in on-demand
+
+	return &CompositeValue{
+		Location: location,
+		TypeID:   sema.TypeID(typeID),
+		Kind:     common.CompositeKind(kind),
+		Fields:   fields,
+		Owner:    d.owner,
+	}, nil
+}
+
+func (d decoder) decodeStorageReference(v interface{}) (*StorageReferenceValue, error) {
+	encoded, ok := v.([]interface{})
+	if !ok || len(encoded) != 3 {
+		return nil, newDecodingError("invalid storage reference encoding")
+	}
+
+	authorized, ok := encoded[0].(bool)
+	if !ok {
+		return nil, newDecodingError("invalid storage reference authorization: %T", encoded[0])
+	}
+
+	targetStorageAddress, err := decodeAddressBytes(encoded[1])
+	if err != nil {
+		return nil, err
+	}
+
+	targetKey, ok := encoded[2].(string)
+	if !ok {
+		return nil, newDecodingError("invalid storage reference key: %T", encoded[2])
+	}
+
+	return &StorageReferenceValue{
+		Authorized:           authorized,
+		TargetStorageAddress: targetStorageAddress,
+		TargetKey:            targetKey,
+		Owner:                d.owner,
+	}, nil
+}
+
+func decodeAddressBytes(v interface{}) (common.Address, error) {
+	b, ok := v.([]byte)
+	if !ok || len(b) > common.AddressLength {
+		return common.Address{}, newDecodingError("invalid address encoding")
+	}
+
+	return common.BytesToAddress(b), nil
+}
+
+func decodeAddress(v interface{}) (AddressValue, error) {
+	address, err := decodeAddressBytes(v)
+	if err != nil {
+		return AddressValue{}, err
+	}
+
+	return NewAddressValue(address), nil
+}
+
+func decodePath(v interface{}) (PathValue, error) {
+	tag, ok := v.(cbor.Tag)
+	if !ok || tag.Number != cborTagPathValue {
+		return PathValue{}, newDecodingError("invalid path encoding")
+	}
+
+	encoded, ok := tag.Content.([]interface{})
+	if !ok || len(encoded) != 2 {
+		return PathValue{}, newDecodingError("invalid path encoding")
+	}
+
+	domain, err := decodeUint64(encoded[0], math.MaxUint8)
+	if err != nil {
+		return PathValue{}, err
+	}
+
+	identifier, ok := encoded[1].(string)
+	if !ok {
+		return PathValue{}, newDecodingError("invalid path identifier: %T", encoded[1])
+	}
+
+	return PathValue{
+		Domain:     common.PathDomain(domain),
+		Identifier: identifier,
+	}, nil
+}
+
+func decodeCapability(v interface{}) (CapabilityValue, error) {
+	encoded, ok := v.([]interface{})
+	if !ok || len(encoded) < 2 || len(encoded) > 3 {
+		return CapabilityValue{}, newDecodingError("invalid capability encoding")
+	}
+
+	address, err := decodeAddress(encoded[0])
+	if err != nil {
+		return CapabilityValue{}, err
+	}
+
+	path, err := decodePath(encoded[1])
+	if err != nil {
+		return CapabilityValue{}, err
+	}
+
+	// The borrow type is optional, untyped capabilities have none
+
+	var borrowType StaticType
+	if len(encoded) > 2 {
+		borrowType, err = decodeStaticType(encoded[2])
+		if err != nil {
+			return CapabilityValue{}, err
+		}
+	}
+
+	return CapabilityValue{
+		Address:    address,
+		Path:       path,
+		BorrowType: borrowType,
+	}, nil
+}
+
+func decodeLink(v interface{}) (LinkValue, error) {
+	encoded, ok := v.([]interface{})
+	if !ok || len(encoded) != 2 {
+		return LinkValue{}, newDecodingError("invalid link encoding")
+	}
+
+	targetPath, err := decodePath(encoded[0])
+	if err != nil {
+		return LinkValue{}, err
+	}
+
+	staticType, err := decodeStaticType(encoded[1])
+	if err != nil {
+		return LinkValue{}, err
+	}
+
+	return LinkValue{
+		TargetPath: targetPath,
+		Type:       staticType,
+	}, nil
+}
+
+func decodeType(v interface{}) (TypeValue, error) {
+	staticType, err := decodeStaticType(v)
+	if err != nil {
+		return TypeValue{}, err
+	}
+
+	return TypeValue{
+		Type: staticType,
+	}, nil
+}
+
+func decodeInt64(v interface{}, min, max int64) (int64, error) {
+	var value int64
+
+	switch v := v.(type) {
+	case int64:
+		value = v
+	case uint64:
+		if v > math.MaxInt64 {
+			return 0, newDecodingError("integer out of range: %d", v)
+		}
+		value = int64(v)
+	default:
+		return 0, newDecodingError("invalid integer encoding: %T", v)
+	}
+
+	if value < min || value > max {
+		return 0, newDecodingError("integer out of range: %d", value)
+	}
+
+	return value, nil
+}
+
+func decodeUint64(v interface{}, max uint64) (uint64, error) {
+	value, ok := v.(uint64)
+	if !ok {
+		return 0, newDecodingError("invalid unsigned integer encoding: %T", v)
+	}
+
+	if value > max {
+		return 0, newDecodingError("unsigned integer out of range: %d", value)
+	}
+
+	return value, nil
+}
+
+func decodeBigInt(v interface{}) (*big.Int, error) {
+	tag, ok := v.(cbor.Tag)
+	if !ok {
+		return nil, newDecodingError("invalid big integer encoding: %T", v)
+	}
+
+	b, ok := tag.Content.([]byte)
+	if !ok {
+		return nil, newDecodingError("invalid bignum encoding: %T", tag.Content)
+	}
+
+	result := new(big.Int).SetBytes(b)
+
+	switch tag.Number {
+	case cborTagPositiveBignum:
+		return result, nil
+
+	case cborTagNegativeBignum:
+		// -1 - n
+		result.Add(result, big.NewInt(1))
+		return result.Neg(result), nil
+
+	default:
+		return nil, newDecodingError("invalid bignum tag: %d", tag.Number)
+	}
+}
+
+func decodeLocation(v interface{}) (ast.Location, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	tag, ok := v.(cbor.Tag)
+	if !ok {
+		return nil, newDecodingError("invalid location encoding: %T", v)
+	}
+
+	switch tag.Number {
+	case cborTagStringLocation:
+		s, ok := tag.Content.(string)
+		if !ok {
+			return nil, newDecodingError("invalid string location encoding: %T", tag.Content)
+		}
+		return ast.StringLocation(s), nil
+
+	case cborTagAddressLocation:
+		b, ok := tag.Content.([]byte)
+		if !ok {
+			return nil, newDecodingError("invalid address location encoding: %T", tag.Content)
+		}
+		return ast.AddressLocation(b), nil
+
+	case cborTagTransactionLocation:
+		b, ok := tag.Content.([]byte)
+		if !ok {
+			return nil, newDecodingError("invalid transaction location encoding: %T", tag.Content)
+		}
+		return ast.TransactionLocation(b), nil
+
+	case cborTagAddressContractLocation:
+		content, ok := tag.Content.([]interface{})
+		if !ok || len(content) != 2 {
+			return nil, newDecodingError("invalid address contract location encoding: %T", tag.Content)
+		}
+		b, ok := content[0].([]byte)
+		if !ok {
+			return nil, newDecodingError("invalid address contract location address encoding: %T", content[0])
+		}
+		name, ok := content[1].(string)
+		if !ok {
+			return nil, newDecodingError("invalid address contract location name encoding: %T", content[1])
+		}
+		return ast.AddressContractLocation{
+			AddressLocation: ast.AddressLocation(b),
+			Name:            name,
+		}, nil
+
+	default:
+		return nil, newDecodingError("unsupported location tag: %d", tag.Number)
+	}
+}
+
+func decodeStaticType(v interface{}) (StaticType, error) {
+	tag, ok := v.(cbor.Tag)
+	if !ok {
+		return nil, newDecodingError("invalid static type encoding: %T", v)
+	}
+
+	switch tag.Number {
+	case cborTagPrimitiveStaticType:
+		name, ok := tag.Content.(string)
+		if !ok {
+			return nil, newDecodingError("invalid primitive static type encoding: %T", tag.Content)
+		}
+
+		ty, ok := sema.BaseType(name)
+		if !ok {
+			return nil, newDecodingError("unknown primitive static type: %s", name)
+		}
+
+		return TypeStaticType{Type: ty}, nil
+
+	case cborTagCompositeStaticType:
+		location, typeID, err := decodeNominalStaticType(tag.Content)
+		if err != nil {
+			return nil, err
+		}
+		return CompositeStaticType{
+			Location: location,
+			TypeID:   typeID,
+		}, nil
+
+	case cborTagInterfaceStaticType:
+		location, typeID, err := decodeNominalStaticType(tag.Content)
+		if err != nil {
+			return nil, err
+		}
+		return InterfaceStaticType{
+			Location: location,
+			TypeID:   typeID,
+		}, nil
+
+	case cborTagVariableSizedStaticType:
+		elementType, err := decodeStaticType(tag.Content)
+		if err != nil {
+			return nil, err
+		}
+		return VariableSizedStaticType{
+			Type: elementType,
+		}, nil
+
+	case cborTagConstantSizedStaticType:
+		encoded, ok := tag.Content.([]interface{})
+		if !ok || len(encoded) != 2 {
+			return nil, newDecodingError("invalid constant sized static type encoding")
+		}
+		elementType, err := decodeStaticType(encoded[0])
+		if err != nil {
+			return nil, err
+		}
+		size, err := decodeUint64(encoded[1], math.MaxUint64)
+		if err != nil {
+			return nil, err
+		}
+		return ConstantSizedStaticType{
+			Type: elementType,
+			Size: size,
+		}, nil
+
+	case cborTagDictionaryStaticType:
+		encoded, ok := tag.Content.([]interface{})
+		if !ok || len(encoded) != 2 {
+			return nil, newDecodingError("invalid dictionary static type encoding")
+		}
+		keyType, err := decodeStaticType(encoded[0])
+		if err != nil {
+			return nil, err
+		}
+		valueType, err := decodeStaticType(encoded[1])
+		if err != nil {
+			return nil, err
+		}
+		return DictionaryStaticType{
+			KeyType:   keyType,
+			ValueType: valueType,
+		}, nil
+
+	case cborTagOptionalStaticType:
+		innerType, err := decodeStaticType(tag.Content)
+		if err != nil {
+			return nil, err
+		}
+		return OptionalStaticType{
+			Type: innerType,
+		}, nil
+
+	case cborTagRestrictedStaticType:
+		encoded, ok := tag.Content.([]interface{})
+		if !ok || len(encoded) != 2 {
+			return nil, newDecodingError("invalid restricted static type encoding")
+		}
+		restrictedType, err := decodeStaticType(encoded[0])
+		if err != nil {
+			return nil, err
+		}
+		encodedRestrictions, ok := encoded[1].([]interface{})
+		if !ok {
+			return nil, newDecodingError("invalid restricted static type restrictions encoding")
+		}
+		restrictions := make([]InterfaceStaticType, len(encodedRestrictions))
+		for i, encodedRestriction := range encodedRestrictions {
+			restriction, err := decodeStaticType(encodedRestriction)
+			if err != nil {
+				return nil, err
+			}
+			interfaceType, ok := restriction.(InterfaceStaticType)
+			if !ok {
+				return nil, newDecodingError("invalid restriction: %s", restriction)
+			}
+			restrictions[i] = interfaceType
+		}
+		return RestrictedStaticType{
+			Type:         restrictedType,
+			Restrictions: restrictions,
+		}, nil
+
+	case cborTagReferenceStaticType:
+		encoded, ok := tag.Content.([]interface{})
+		if !ok || len(encoded) != 2 {
+			return nil, newDecodingError("invalid reference static type encoding")
+		}
+		authorized, ok := encoded[0].(bool)
+		if !ok {
+			return nil, newDecodingError("invalid reference static type authorization: %T", encoded[0])
+		}
+		referencedType, err := decodeStaticType(encoded[1])
+		if err != nil {
+			return nil, err
+		}
+		return ReferenceStaticType{
+			Authorized: authorized,
+			Type:       referencedType,
+		}, nil
+
+	default:
+		return nil, newDecodingError("unsupported static type tag: %d", tag.Number)
+	}
+}
+
+func decodeNominalStaticType(v interface{}) (ast.Location, sema.TypeID, error) {
+	encoded, ok := v.([]interface{})
+	if !ok || len(encoded) != 2 {
+		return nil, "", newDecodingError("invalid nominal static type encoding")
+	}
+
+	location, err := decodeLocation(encoded[0])
+	if err != nil {
+		return nil, "", err
+	}
+
+	typeID, ok := encoded[1].(string)
+	if !ok {
+		return nil, "", newDecodingError("invalid nominal static type ID: %T", encoded[1])
+	}
+
+	return location, sema.TypeID(typeID), nil
+}
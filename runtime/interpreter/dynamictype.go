@@ -19,6 +19,7 @@
 package interpreter
 
 import (
+	"github.com/onflow/cadence/runtime/errors"
 	"github.com/onflow/cadence/runtime/sema"
 )
 
@@ -149,7 +150,10 @@ func (PublishedDynamicType) IsDynamicType() {}
 
 // FunctionDynamicType
 
-type FunctionDynamicType struct{}
+type FunctionDynamicType struct {
+	// NOTE: the function type is not available for host functions
+	FuncType *sema.FunctionType
+}
 
 func (FunctionDynamicType) IsDynamicType() {}
 
@@ -161,7 +165,10 @@ func (PathDynamicType) IsDynamicType() {}
 
 // CapabilityDynamicType
 
-type CapabilityDynamicType struct{}
+type CapabilityDynamicType struct {
+	// BorrowType is the borrow type of the capability, if any
+	BorrowType sema.Type
+}
 
 func (CapabilityDynamicType) IsDynamicType() {}
 
@@ -171,8 +178,195 @@ type AuthAccountDynamicType struct{}
 
 func (AuthAccountDynamicType) IsDynamicType() {}
 
+// AuthAccountContractsDynamicType
+
+type AuthAccountContractsDynamicType struct{}
+
+func (AuthAccountContractsDynamicType) IsDynamicType() {}
+
+// AuthAccountKeysDynamicType
+
+type AuthAccountKeysDynamicType struct{}
+
+func (AuthAccountKeysDynamicType) IsDynamicType() {}
+
+// PublicAccountKeysDynamicType
+
+type PublicAccountKeysDynamicType struct{}
+
+func (PublicAccountKeysDynamicType) IsDynamicType() {}
+
+// AccountKeyDynamicType
+
+type AccountKeyDynamicType struct{}
+
+func (AccountKeyDynamicType) IsDynamicType() {}
+
+// DeployedContractDynamicType
+
+type DeployedContractDynamicType struct{}
+
+func (DeployedContractDynamicType) IsDynamicType() {}
+
 // PublicAccountDynamicType
 
 type PublicAccountDynamicType struct{}
 
 func (PublicAccountDynamicType) IsDynamicType() {}
+
+// MetaTypeDynamicType
+
+type MetaTypeDynamicType struct{}
+
+func (MetaTypeDynamicType) IsDynamicType() {}
+
+// ConvertDynamicToSemaType returns the static type (`sema.Type`)
+// which describes values of the given dynamic type.
+//
+// Array and dictionary values have no static element type,
+// so the element type is inferred from the dynamic types of the elements:
+// If all elements have the same type, it is the element type,
+// otherwise it is `AnyStruct` or `AnyResource`.
+// The element type of empty arrays and dictionaries is `Never`.
+//
+// NOTE: the inferred type may differ from the declared type of the value,
+// e.g. the type of an array with the declared type `[AnyStruct]`,
+// which only contains integers, is `[Int]`.
+//
+func ConvertDynamicToSemaType(dynamicType DynamicType) sema.Type {
+	switch t := dynamicType.(type) {
+	case VoidDynamicType:
+		return &sema.VoidType{}
+
+	case StringDynamicType:
+		return &sema.StringType{}
+
+	case BoolDynamicType:
+		return &sema.BoolType{}
+
+	case AddressDynamicType:
+		return &sema.AddressType{}
+
+	case PathDynamicType:
+		return &sema.PathType{}
+
+	case CapabilityDynamicType:
+		return &sema.CapabilityType{
+			BorrowType: t.BorrowType,
+		}
+
+	case AuthAccountDynamicType:
+		return &sema.AuthAccountType{}
+
+	case AuthAccountContractsDynamicType:
+		return &sema.AuthAccountContractsType{}
+
+	case AuthAccountKeysDynamicType:
+		return &sema.AuthAccountKeysType{}
+
+	case PublicAccountKeysDynamicType:
+		return &sema.PublicAccountKeysType{}
+
+	case AccountKeyDynamicType:
+		return &sema.AccountKeyType{}
+
+	case DeployedContractDynamicType:
+		return &sema.DeployedContractType{}
+
+	case PublicAccountDynamicType:
+		return &sema.PublicAccountType{}
+
+	case MetaTypeDynamicType:
+		return &sema.MetaType{}
+
+	case NumberDynamicType:
+		return t.StaticType
+
+	case CompositeDynamicType:
+		return t.StaticType
+
+	case ArrayDynamicType:
+		elementTypes := make([]sema.Type, len(t.ElementTypes))
+		for i, elementType := range t.ElementTypes {
+			elementTypes[i] = ConvertDynamicToSemaType(elementType)
+		}
+
+		return &sema.VariableSizedType{
+			Type: commonSemaType(elementTypes),
+		}
+
+	case DictionaryDynamicType:
+		keyTypes := make([]sema.Type, len(t.EntryTypes))
+		valueTypes := make([]sema.Type, len(t.EntryTypes))
+		for i, entryType := range t.EntryTypes {
+			keyTypes[i] = ConvertDynamicToSemaType(entryType.KeyType)
+			valueTypes[i] = ConvertDynamicToSemaType(entryType.ValueType)
+		}
+
+		return &sema.DictionaryType{
+			KeyType:   commonSemaType(keyTypes),
+			ValueType: commonSemaType(valueTypes),
+		}
+
+	case NilDynamicType:
+		return &sema.OptionalType{
+			Type: &sema.NeverType{},
+		}
+
+	case SomeDynamicType:
+		return &sema.OptionalType{
+			Type: ConvertDynamicToSemaType(t.InnerType),
+		}
+
+	case ReferenceDynamicType:
+		return &sema.ReferenceType{
+			Authorized: t.Authorized(),
+			Type:       ConvertDynamicToSemaType(t.InnerType()),
+		}
+
+	case FunctionDynamicType:
+		// NOTE: the function type of host functions is not available
+		if t.FuncType == nil {
+			return &sema.AnyStructType{}
+		}
+		return t.FuncType
+
+	default:
+		panic(errors.NewUnreachableError())
+	}
+}
+
+// commonSemaType returns the type of all given types, if they are all equal.
+// Otherwise it returns `AnyResource` if any of the types is a resource type,
+// and `AnyStruct` if not.
+//
+// If no types are given, the result is `Never`.
+//
+func commonSemaType(types []sema.Type) sema.Type {
+	if len(types) == 0 {
+		return &sema.NeverType{}
+	}
+
+	firstType := types[0]
+	allEqual := true
+	isResource := false
+
+	for _, ty := range types {
+		if !ty.Equal(firstType) {
+			allEqual = false
+		}
+		if ty.IsResourceType() {
+			isResource = true
+		}
+	}
+
+	if allEqual {
+		return firstType
+	}
+
+	if isResource {
+		return &sema.AnyResourceType{}
+	}
+
+	return &sema.AnyStructType{}
+}
@@ -0,0 +1,665 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/fxamacker/cbor/v2"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+// Encoding versions
+//
+// Values are stored as a version prefix followed by the encoded value.
+// The prefix starts with a zero byte, which never starts a gob stream,
+// so data written before the introduction of versions (version 0)
+// can still be told apart and decoded.
+//
+// NOTE: never change the format of an existing version.
+// Introduce a new version instead, and keep decoding the old ones.
+
+const (
+	// EncodingVersionLegacy is the version of data encoded with encoding/gob
+	EncodingVersionLegacy uint16 = 0
+	// EncodingVersion1 is the version of the CBOR-based format
+	EncodingVersion1 uint16 = 1
+
+	// CurrentEncodingVersion is the version used when encoding values
+	CurrentEncodingVersion = EncodingVersion1
+)
+
+const encodingVersionMarker byte = 0x0
+
+const encodingVersionPrefixLength = 3
+
+// CBOR tag numbers
+//
+// The numbers are in the range of unassigned tags (see RFC 7049)
+// and must never be reused for a different kind of value or type.
+
+const (
+	cborTagVoidValue = 128 + iota
+	cborTagDictionaryValue
+	cborTagSomeValue
+	cborTagAddressValue
+	cborTagCompositeValue
+	cborTagPathValue
+	cborTagCapabilityValue
+	cborTagLinkValue
+	cborTagStorageReferenceValue
+	cborTagTypeValue
+)
+
+// Locations
+const (
+	cborTagStringLocation = 159 + iota
+	cborTagAddressLocation
+	cborTagTransactionLocation
+	cborTagAddressContractLocation
+)
+
+// Numbers
+const (
+	cborTagIntValue = 170 + iota
+	cborTagInt8Value
+	cborTagInt16Value
+	cborTagInt32Value
+	cborTagInt64Value
+	cborTagInt128Value
+	cborTagInt256Value
+
+	cborTagUIntValue
+	cborTagUInt8Value
+	cborTagUInt16Value
+	cborTagUInt32Value
+	cborTagUInt64Value
+	cborTagUInt128Value
+	cborTagUInt256Value
+
+	cborTagWord8Value
+	cborTagWord16Value
+	cborTagWord32Value
+	cborTagWord64Value
+
+	cborTagFix64Value
+	cborTagUFix64Value
+)
+
+// Static types
+const (
+	cborTagPrimitiveStaticType = 200 + iota
+	cborTagCompositeStaticType
+	cborTagInterfaceStaticType
+	cborTagVariableSizedStaticType
+	cborTagConstantSizedStaticType
+	cborTagDictionaryStaticType
+	cborTagOptionalStaticType
+	cborTagRestrictedStaticType
+	cborTagReferenceStaticType
+)
+
+// Bignums, see RFC 7049, section 2.4.2
+const (
+	cborTagPositiveBignum = 2
+	cborTagNegativeBignum = 3
+)
+
+var cborEncMode = func() cbor.EncMode {
+	mode, err := cbor.CanonicalEncOptions().EncMode()
+	if err != nil {
+		panic(err)
+	}
+	return mode
+}()
+
+// EncodingUnsupportedValueError is returned when a value cannot be encoded,
+// for example because it is a function or an account.
+//
+type EncodingUnsupportedValueError struct {
+	Value Value
+	Path  []string
+}
+
+func (e EncodingUnsupportedValueError) Error() string {
+	return fmt.Sprintf(
+		"cannot encode unsupported value at path [%s]: %T",
+		strings.Join(e.Path, ", "),
+		e.Value,
+	)
+}
+
+// EncodeValue returns the deterministic encoding of the given value,
+// prefixed with the current encoding version.
+//
+// The owner of the value and its nested values is not encoded,
+// it is provided again when the value is decoded.
+//
+func EncodeValue(value Value) ([]byte, error) {
+	prepared, err := prepareValue(value, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := cborEncMode.Marshal(prepared)
+	if err != nil {
+		return nil, err
+	}
+
+	return encodeVersion(CurrentEncodingVersion, content), nil
+}
+
+func encodeVersion(version uint16, content []byte) []byte {
+	result := make([]byte, encodingVersionPrefixLength, encodingVersionPrefixLength+len(content))
+	result[0] = encodingVersionMarker
+	binary.BigEndian.PutUint16(result[1:], version)
+	return append(result, content...)
+}
+
+// prepareValue converts the given value into a representation
+// which can be marshalled to CBOR, i.e. built-in Go types and CBOR tags.
+//
+// The path is only used for error reporting.
+//
+func prepareValue(value Value, path []string) (interface{}, error) {
+	switch v := value.(type) {
+
+	case NilValue:
+		return nil, nil
+
+	case VoidValue:
+		return cbor.Tag{
+			Number:  cborTagVoidValue,
+			Content: nil,
+		}, nil
+
+	case BoolValue:
+		return bool(v), nil
+
+	case *StringValue:
+		return v.Str, nil
+
+	case AddressValue:
+		return cbor.Tag{
+			Number:  cborTagAddressValue,
+			Content: v[:],
+		}, nil
+
+	case *ArrayValue:
+		return prepareValues(v.Values, path)
+
+	case *DictionaryValue:
+		return prepareDictionaryValue(v, path)
+
+	case *SomeValue:
+		content, err := prepareValue(v.Value, path)
+		if err != nil {
+			return nil, err
+		}
+		return cbor.Tag{
+			Number:  cborTagSomeValue,
+			Content: content,
+		}, nil
+
+	case *CompositeValue:
+		return prepareCompositeValue(v, path)
+
+	case PathValue:
+		return preparePathValue(v), nil
+
+	case CapabilityValue:
+		return prepareCapabilityValue(v)
+
+	case LinkValue:
+		staticType, err := prepareStaticType(v.Type)
+		if err != nil {
+			return nil, err
+		}
+		return cbor.Tag{
+			Number: cborTagLinkValue,
+			Content: []interface{}{
+				preparePathValue(v.TargetPath),
+				staticType,
+			},
+		}, nil
+
+	case *StorageReferenceValue:
+		return cbor.Tag{
+			Number: cborTagStorageReferenceValue,
+			Content: []interface{}{
+				v.Authorized,
+				v.TargetStorageAddress[:],
+				v.TargetKey,
+			},
+		}, nil
+
+	case TypeValue:
+		staticType, err := prepareStaticType(v.Type)
+		if err != nil {
+			return nil, err
+		}
+		return cbor.Tag{
+			Number:  cborTagTypeValue,
+			Content: staticType,
+		}, nil
+
+	// Numbers
+
+	case IntValue:
+		return prepareBigInt(cborTagIntValue, v.BigInt), nil
+
+	case Int8Value:
+		return prepareInt64(cborTagInt8Value, int64(v)), nil
+
+	case Int16Value:
+		return prepareInt64(cborTagInt16Value, int64(v)), nil
+
+	case Int32Value:
+		return prepareInt64(cborTagInt32Value, int64(v)), nil
+
+	case Int64Value:
+		return prepareInt64(cborTagInt64Value, int64(v)), nil
+
+	case Int128Value:
+		return prepareBigInt(cborTagInt128Value, v.BigInt), nil
+
+	case Int256Value:
+		return prepareBigInt(cborTagInt256Value, v.BigInt), nil
+
+	case UIntValue:
+		return prepareBigInt(cborTagUIntValue, v.BigInt), nil
+
+	case UInt8Value:
+		return prepareUint64(cborTagUInt8Value, uint64(v)), nil
+
+	case UInt16Value:
+		return prepareUint64(cborTagUInt16Value, uint64(v)), nil
+
+	case UInt32Value:
+		return prepareUint64(cborTagUInt32Value, uint64(v)), nil
+
+	case UInt64Value:
+		return prepareUint64(cborTagUInt64Value, uint64(v)), nil
+
+	case UInt128Value:
+		return prepareBigInt(cborTagUInt128Value, v.BigInt), nil
+
+	case UInt256Value:
+		return prepareBigInt(cborTagUInt256Value, v.BigInt), nil
+
+	case Word8Value:
+		return prepareUint64(cborTagWord8Value, uint64(v)), nil
+
+	case Word16Value:
+		return prepareUint64(cborTagWord16Value, uint64(v)), nil
+
+	case Word32Value:
+		return prepareUint64(cborTagWord32Value, uint64(v)), nil
+
+	case Word64Value:
+		return prepareUint64(cborTagWord64Value, uint64(v)), nil
+
+	case Fix64Value:
+		return prepareInt64(cborTagFix64Value, int64(v)), nil
+
+	case UFix64Value:
+		return prepareUint64(cborTagUFix64Value, uint64(v)), nil
+
+	default:
+		return nil, EncodingUnsupportedValueError{
+			Value: value,
+			Path:  path,
+		}
+	}
+}
+
+func prepareValues(values []Value, path []string) ([]interface{}, error) {
+	result := make([]interface{}, len(values))
+
+	for i, value := range values {
+		valuePath := append(path[:len(path):len(path)], fmt.Sprint(i))
+
+		prepared, err := prepareValue(value, valuePath)
+		if err != nil {
+			return nil, err
+		}
+
+		result[i] = prepared
+	}
+
+	return result, nil
+}
+
+// prepareDictionaryValue encodes the keys in their order of insertion,
+// and the values in the same order as the keys.
+//
+func prepareDictionaryValue(v *DictionaryValue, path []string) (interface{}, error) {
+	keys, err := prepareValues(v.Keys.Values, append(path[:len(path):len(path)], "keys"))
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]interface{}, len(v.Keys.Values))
+
+	for i, key := range v.Keys.Values {
+		keyString := dictionaryKey(key)
+
+		valuePath := append(path[:len(path):len(path)], keyString)
+
+		prepared, err := prepareValue(v.Entries[keyString], valuePath)
+		if err != nil {
+			return nil, err
+		}
+
+		values[i] = prepared
+	}
+
+	return cbor.Tag{
+		Number:  cborTagDictionaryValue,
+		Content: []interface{}{keys, values},
+	}, nil
+}
+
+// prepareCompositeValue encodes the fields in increasing order of their names.
+//
+// NOTE: functions and the destructor are *not* encoded, they are linked in on-demand.
+//
+func prepareCompositeValue(v *CompositeValue, path []string) (interface{}, error) {
+	location, err := prepareLocation(v.Location)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldNames := make([]string, 0, len(v.Fields))
+
+	for name := range v.Fields {
+		fieldNames = append(fieldNames, name)
+	}
+
+	sort.Strings(fieldNames)
+
+	fields := make([]interface{}, 0, len(fieldNames)*2)
+
+	for _, name := range fieldNames {
+		prepared, err := prepareValue(v.Fields[name], append(path[:len(path):len(path)], name))
+		if err != nil {
+			return nil, err
+		}
+
+		fields = append(fields, name, prepared)
+	}
+
+	return cbor.Tag{
+		Number: cborTagCompositeValue,
+		Content: []interface{}{
+			location,
+			string(v.TypeID),
+			uint(v.Kind),
+			fields,
+		},
+	}, nil
+}
+
+// prepareCapabilityValue prepares the given capability value.
+//
+// The borrow type is only encoded for typed capabilities,
+// so untyped capabilities are encoded like before typed capabilities were introduced.
+//
+func prepareCapabilityValue(v CapabilityValue) (interface{}, error) {
+	content := []interface{}{
+		v.Address[:],
+		preparePathValue(v.Path),
+	}
+
+	if v.BorrowType != nil {
+		borrowType, err := prepareStaticType(v.BorrowType)
+		if err != nil {
+			return nil, err
+		}
+		content = append(content, borrowType)
+	}
+
+	return cbor.Tag{
+		Number:  cborTagCapabilityValue,
+		Content: content,
+	}, nil
+}
+
+func preparePathValue(v PathValue) cbor.Tag {
+	return cbor.Tag{
+		Number: cborTagPathValue,
+		Content: []interface{}{
+			uint(v.Domain),
+			v.Identifier,
+		},
+	}
+}
+
+func prepareInt64(tag uint64, value int64) cbor.Tag {
+	return cbor.Tag{
+		Number:  tag,
+		Content: value,
+	}
+}
+
+func prepareUint64(tag uint64, value uint64) cbor.Tag {
+	return cbor.Tag{
+		Number:  tag,
+		Content: value,
+	}
+}
+
+// prepareBigInt encodes the integer as a bignum (see RFC 7049, section 2.4.2),
+// nested in the given tag
+//
+func prepareBigInt(tag uint64, value *big.Int) cbor.Tag {
+	var bignum cbor.Tag
+
+	if value.Sign() < 0 {
+		// -1 - n
+		n := new(big.Int).Neg(value)
+		n.Sub(n, big.NewInt(1))
+		bignum = cbor.Tag{
+			Number:  cborTagNegativeBignum,
+			Content: n.Bytes(),
+		}
+	} else {
+		bignum = cbor.Tag{
+			Number:  cborTagPositiveBignum,
+			Content: value.Bytes(),
+		}
+	}
+
+	return cbor.Tag{
+		Number:  tag,
+		Content: bignum,
+	}
+}
+
+func prepareLocation(location ast.Location) (interface{}, error) {
+	switch l := location.(type) {
+	case nil:
+		return nil, nil
+
+	case ast.StringLocation:
+		return cbor.Tag{
+			Number:  cborTagStringLocation,
+			Content: string(l),
+		}, nil
+
+	case ast.AddressLocation:
+		return cbor.Tag{
+			Number:  cborTagAddressLocation,
+			Content: []byte(l),
+		}, nil
+
+	case ast.TransactionLocation:
+		return cbor.Tag{
+			Number:  cborTagTransactionLocation,
+			Content: []byte(l),
+		}, nil
+
+	case ast.AddressContractLocation:
+		return cbor.Tag{
+			Number: cborTagAddressContractLocation,
+			Content: []interface{}{
+				[]byte(l.AddressLocation),
+				l.Name,
+			},
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported location: %T", location)
+	}
+}
+
+func prepareStaticType(t StaticType) (interface{}, error) {
+	switch v := t.(type) {
+	case TypeStaticType:
+		name := v.Type.String()
+		if baseType, ok := sema.BaseType(name); !ok || !baseType.Equal(v.Type) {
+			return nil, fmt.Errorf("unsupported static type: %s", v)
+		}
+
+		return cbor.Tag{
+			Number:  cborTagPrimitiveStaticType,
+			Content: name,
+		}, nil
+
+	case CompositeStaticType:
+		location, err := prepareLocation(v.Location)
+		if err != nil {
+			return nil, err
+		}
+		return cbor.Tag{
+			Number: cborTagCompositeStaticType,
+			Content: []interface{}{
+				location,
+				string(v.TypeID),
+			},
+		}, nil
+
+	case InterfaceStaticType:
+		location, err := prepareLocation(v.Location)
+		if err != nil {
+			return nil, err
+		}
+		return cbor.Tag{
+			Number: cborTagInterfaceStaticType,
+			Content: []interface{}{
+				location,
+				string(v.TypeID),
+			},
+		}, nil
+
+	case VariableSizedStaticType:
+		elementType, err := prepareStaticType(v.Type)
+		if err != nil {
+			return nil, err
+		}
+		return cbor.Tag{
+			Number:  cborTagVariableSizedStaticType,
+			Content: elementType,
+		}, nil
+
+	case ConstantSizedStaticType:
+		elementType, err := prepareStaticType(v.Type)
+		if err != nil {
+			return nil, err
+		}
+		return cbor.Tag{
+			Number: cborTagConstantSizedStaticType,
+			Content: []interface{}{
+				elementType,
+				v.Size,
+			},
+		}, nil
+
+	case DictionaryStaticType:
+		keyType, err := prepareStaticType(v.KeyType)
+		if err != nil {
+			return nil, err
+		}
+		valueType, err := prepareStaticType(v.ValueType)
+		if err != nil {
+			return nil, err
+		}
+		return cbor.Tag{
+			Number: cborTagDictionaryStaticType,
+			Content: []interface{}{
+				keyType,
+				valueType,
+			},
+		}, nil
+
+	case OptionalStaticType:
+		innerType, err := prepareStaticType(v.Type)
+		if err != nil {
+			return nil, err
+		}
+		return cbor.Tag{
+			Number:  cborTagOptionalStaticType,
+			Content: innerType,
+		}, nil
+
+	case RestrictedStaticType:
+		restrictedType, err := prepareStaticType(v.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		restrictions := make([]interface{}, len(v.Restrictions))
+		for i, restriction := range v.Restrictions {
+			restrictions[i], err = prepareStaticType(restriction)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return cbor.Tag{
+			Number: cborTagRestrictedStaticType,
+			Content: []interface{}{
+				restrictedType,
+				restrictions,
+			},
+		}, nil
+
+	case ReferenceStaticType:
+		referencedType, err := prepareStaticType(v.Type)
+		if err != nil {
+			return nil, err
+		}
+		return cbor.Tag{
+			Number: cborTagReferenceStaticType,
+			Content: []interface{}{
+				v.Authorized,
+				referencedType,
+			},
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported static type: %T", t)
+	}
+}
@@ -0,0 +1,358 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"bytes"
+	"encoding/gob"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/tests/utils"
+)
+
+func testEncodeDecode(t *testing.T, value Value) Value {
+	owner := common.Address{0x1}
+
+	encoded, err := EncodeValue(value)
+	require.NoError(t, err)
+
+	version, _ := EncodingVersion(encoded)
+	require.Equal(t, CurrentEncodingVersion, version)
+
+	decoded, err := DecodeValue(encoded, &owner)
+	require.NoError(t, err)
+
+	reencoded, err := EncodeValue(decoded)
+	require.NoError(t, err)
+
+	assert.Equal(t, encoded, reencoded)
+
+	if equatable, ok := value.(EquatableValue); ok {
+		assert.True(t, bool(equatable.Equal(decoded)))
+	}
+
+	return decoded
+}
+
+func TestEncodeDecodeSimpleValues(t *testing.T) {
+
+	largeBigInt, ok := new(big.Int).SetString("-100000000000000000000000000000000000000", 10)
+	require.True(t, ok)
+
+	values := []Value{
+		NilValue{},
+		VoidValue{},
+		BoolValue(true),
+		BoolValue(false),
+		NewStringValue(""),
+		NewStringValue("test"),
+		NewAddressValueFromBytes([]byte{0x1, 0x2}),
+		NewIntValueFromInt64(0),
+		NewIntValueFromInt64(-1),
+		NewIntValueFromInt64(42),
+		NewIntValueFromBigInt(largeBigInt),
+		Int8Value(math.MinInt8),
+		Int16Value(math.MinInt16),
+		Int32Value(math.MaxInt32),
+		Int64Value(math.MinInt64),
+		NewInt128ValueFromBigInt(largeBigInt),
+		NewInt256ValueFromInt64(-42),
+		NewUIntValueFromUint64(math.MaxUint64),
+		UInt8Value(math.MaxUint8),
+		UInt16Value(math.MaxUint16),
+		UInt32Value(math.MaxUint32),
+		UInt64Value(math.MaxUint64),
+		NewUInt128ValueFromInt64(1),
+		NewUInt256ValueFromInt64(2),
+		Word8Value(1),
+		Word16Value(2),
+		Word32Value(3),
+		Word64Value(math.MaxUint64),
+		Fix64Value(-1),
+		UFix64Value(1),
+		PathValue{Domain: common.PathDomainStorage, Identifier: "foo"},
+		CapabilityValue{
+			Address: NewAddressValueFromBytes([]byte{0x1}),
+			Path:    PathValue{Domain: common.PathDomainPublic, Identifier: "bar"},
+		},
+	}
+
+	for _, value := range values {
+		decoded := testEncodeDecode(t, value)
+		assert.Equal(t, value.DynamicType(nil), decoded.DynamicType(nil))
+	}
+}
+
+func TestEncodeDecodeContainerValues(t *testing.T) {
+
+	owner := common.Address{0x1}
+
+	t.Run("array", func(t *testing.T) {
+
+		value := NewArrayValueUnownedNonCopying(
+			NewStringValue("a"),
+			NewArrayValueUnownedNonCopying(BoolValue(true)),
+			NilValue{},
+		)
+
+		decoded := testEncodeDecode(t, value).(*ArrayValue)
+
+		require.Len(t, decoded.Values, 3)
+		assert.Equal(t, &owner, decoded.GetOwner())
+		assert.Equal(t, &owner, decoded.Values[1].GetOwner())
+	})
+
+	t.Run("dictionary", func(t *testing.T) {
+
+		value := NewDictionaryValueUnownedNonCopying(
+			NewStringValue("b"), NewIntValueFromInt64(2),
+			NewStringValue("a"), NewIntValueFromInt64(1),
+		)
+
+		decoded := testEncodeDecode(t, value).(*DictionaryValue)
+
+		assert.Equal(t,
+			[]Value{NewStringValue("b"), NewStringValue("a")},
+			decoded.Keys.Values,
+		)
+		assert.Equal(t, &owner, decoded.GetOwner())
+		assert.Equal(t, NewIntValueFromInt64(1), decoded.Entries["a"])
+	})
+
+	t.Run("some", func(t *testing.T) {
+
+		value := NewSomeValueOwningNonCopying(NewStringValue("a"))
+
+		decoded := testEncodeDecode(t, value).(*SomeValue)
+
+		assert.Equal(t, NewStringValue("a"), decoded.Value)
+		assert.Equal(t, &owner, decoded.GetOwner())
+	})
+
+	t.Run("composite", func(t *testing.T) {
+
+		value := newTestCompositeValue(common.Address{0x2})
+		value.Fields["b"] = NewStringValue("b")
+		value.Fields["a"] = NewIntValueFromInt64(1)
+
+		decoded := testEncodeDecode(t, value).(*CompositeValue)
+
+		assert.Equal(t, value.Location, decoded.Location)
+		assert.Equal(t, value.TypeID, decoded.TypeID)
+		assert.Equal(t, value.Kind, decoded.Kind)
+		assert.Equal(t, &owner, decoded.GetOwner())
+		assert.Len(t, decoded.Fields, 2)
+	})
+
+	t.Run("enum", func(t *testing.T) {
+
+		value := newTestCompositeValue(common.Address{0x2})
+		value.Kind = common.CompositeKindEnum
+		value.Fields[sema.EnumRawValueFieldName] = UInt8Value(1)
+
+		decoded := testEncodeDecode(t, value).(*CompositeValue)
+
+		assert.Equal(t, common.CompositeKindEnum, decoded.Kind)
+		assert.Equal(t, UInt8Value(1), decoded.Fields[sema.EnumRawValueFieldName])
+	})
+
+	t.Run("link", func(t *testing.T) {
+
+		value := LinkValue{
+			TargetPath: PathValue{Domain: common.PathDomainStorage, Identifier: "foo"},
+			Type: ReferenceStaticType{
+				Authorized: true,
+				Type: RestrictedStaticType{
+					Type: OptionalStaticType{
+						Type: DictionaryStaticType{
+							KeyType: TypeStaticType{Type: &sema.StringType{}},
+							ValueType: ConstantSizedStaticType{
+								Type: VariableSizedStaticType{
+									Type: CompositeStaticType{
+										Location: ast.AddressContractLocation{
+											AddressLocation: ast.AddressLocation{0x1},
+											Name:            "C",
+										},
+										TypeID: "A.0000000000000001.C.R",
+									},
+								},
+								Size: 2,
+							},
+						},
+					},
+					Restrictions: []InterfaceStaticType{
+						{
+							Location: ast.TransactionLocation{0x2},
+							TypeID:   "T.02.I",
+						},
+					},
+				},
+			},
+		}
+
+		decoded := testEncodeDecode(t, value)
+
+		assert.Equal(t, value, decoded)
+	})
+
+	t.Run("type", func(t *testing.T) {
+
+		value := TypeValue{
+			Type: VariableSizedStaticType{
+				Type: OptionalStaticType{
+					Type: TypeStaticType{Type: &sema.MetaType{}},
+				},
+			},
+		}
+
+		decoded := testEncodeDecode(t, value)
+
+		assert.Equal(t, value, decoded)
+	})
+}
+
+func TestEncodeDecodeCapabilityValue(t *testing.T) {
+
+	t.Run("untyped", func(t *testing.T) {
+
+		value := CapabilityValue{
+			Address: NewAddressValueFromBytes([]byte{0x1}),
+			Path:    PathValue{Domain: common.PathDomainPublic, Identifier: "foo"},
+		}
+
+		decoded := testEncodeDecode(t, value)
+
+		assert.Equal(t, value, decoded)
+
+		// Untyped capabilities are encoded without a borrow type
+
+		prepared, err := prepareValue(value, nil)
+		require.NoError(t, err)
+
+		require.IsType(t, cbor.Tag{}, prepared)
+		assert.Len(t, prepared.(cbor.Tag).Content, 2)
+	})
+
+	t.Run("typed", func(t *testing.T) {
+
+		value := CapabilityValue{
+			Address: NewAddressValueFromBytes([]byte{0x1}),
+			Path:    PathValue{Domain: common.PathDomainPublic, Identifier: "foo"},
+			BorrowType: ReferenceStaticType{
+				Authorized: true,
+				Type: CompositeStaticType{
+					Location: ast.AddressLocation{0x1},
+					TypeID:   "A.0000000000000001.C.R",
+				},
+			},
+		}
+
+		decoded := testEncodeDecode(t, value)
+
+		assert.Equal(t, value, decoded)
+	})
+}
+
+func TestEncodeDecodeDeterministic(t *testing.T) {
+
+	owner := common.Address{0x1}
+
+	newValue := func(names ...string) *CompositeValue {
+		value := newTestCompositeValue(owner)
+		for i, name := range names {
+			value.Fields[name] = NewIntValueFromInt64(int64(i))
+		}
+		return value
+	}
+
+	first, err := EncodeValue(newValue("a", "b", "c"))
+	require.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		other, err := EncodeValue(newValue("a", "b", "c"))
+		require.NoError(t, err)
+		require.Equal(t, first, other)
+	}
+}
+
+func TestEncodeUnsupportedValue(t *testing.T) {
+
+	value := NewArrayValueUnownedNonCopying(
+		NewHostFunctionValue(nil),
+	)
+
+	_, err := EncodeValue(value)
+	require.Error(t, err)
+
+	require.IsType(t, EncodingUnsupportedValueError{}, err)
+	assert.Equal(t, []string{"0"}, err.(EncodingUnsupportedValueError).Path)
+}
+
+func TestDecodeUnsupportedVersion(t *testing.T) {
+
+	data := encodeVersion(math.MaxUint16, []byte{0xf6})
+
+	_, err := DecodeValue(data, nil)
+	require.Equal(t, UnsupportedEncodingVersionError{Version: math.MaxUint16}, err)
+}
+
+func TestDecodeLegacyValue(t *testing.T) {
+
+	owner := common.Address{0x1}
+
+	var value Value = NewArrayValueUnownedNonCopying(
+		&CompositeValue{
+			Location: utils.TestLocation,
+			TypeID:   "Test",
+			Kind:     common.CompositeKindStructure,
+			Fields: map[string]Value{
+				"a": NewStringValue("a"),
+			},
+		},
+	)
+
+	// NOTE: the legacy encoding requires an owner
+	value.SetOwner(&common.Address{0x2})
+
+	var w bytes.Buffer
+	err := gob.NewEncoder(&w).Encode(&value)
+	require.NoError(t, err)
+
+	version, _ := EncodingVersion(w.Bytes())
+	require.Equal(t, EncodingVersionLegacy, version)
+
+	decoded, err := DecodeValue(w.Bytes(), &owner)
+	require.NoError(t, err)
+
+	array := decoded.(*ArrayValue)
+	assert.Equal(t, &owner, array.GetOwner())
+
+	composite := array.Values[0].(*CompositeValue)
+	assert.Equal(t, NewStringValue("a"), composite.Fields["a"])
+	assert.Equal(t, &owner, composite.GetOwner())
+}
@@ -145,6 +145,22 @@ func (e *DereferenceError) Error() string {
 	return "dereference failed"
 }
 
+// ArrayIndexOutOfBoundsError
+
+type ArrayIndexOutOfBoundsError struct {
+	Index    int
+	MaxIndex int
+	LocationRange
+}
+
+func (e ArrayIndexOutOfBoundsError) Error() string {
+	return fmt.Sprintf(
+		"array index out of bounds: got %d, expected max %d",
+		e.Index,
+		e.MaxIndex,
+	)
+}
+
 // OverflowError
 
 type OverflowError struct{}
@@ -161,6 +177,14 @@ func (e UnderflowError) Error() string {
 	return "underflow"
 }
 
+// NegativeShiftError
+
+type NegativeShiftError struct{}
+
+func (e NegativeShiftError) Error() string {
+	return "negative shift"
+}
+
 // UnderflowError
 
 type DivisionByZeroError struct{}
@@ -281,3 +305,16 @@ func (e *CyclicLinkError) Error() string {
 		paths,
 	)
 }
+
+// UnsupportedEvaluationError
+
+type UnsupportedEvaluationError struct {
+	Expression ast.Expression
+}
+
+func (e *UnsupportedEvaluationError) Error() string {
+	return fmt.Sprintf(
+		"cannot evaluate expression in debugger: %s",
+		e.Expression,
+	)
+}
@@ -69,8 +69,10 @@ func (f InterpretedFunctionValue) String() string {
 
 func (InterpretedFunctionValue) IsValue() {}
 
-func (InterpretedFunctionValue) DynamicType(_ *Interpreter) DynamicType {
-	return FunctionDynamicType{}
+func (f InterpretedFunctionValue) DynamicType(_ *Interpreter) DynamicType {
+	return FunctionDynamicType{
+		FuncType: f.Type,
+	}
 }
 
 func (f InterpretedFunctionValue) Copy() Value {
@@ -160,8 +162,8 @@ func (f BoundFunctionValue) String() string {
 
 func (BoundFunctionValue) IsValue() {}
 
-func (BoundFunctionValue) DynamicType(_ *Interpreter) DynamicType {
-	return FunctionDynamicType{}
+func (f BoundFunctionValue) DynamicType(inter *Interpreter) DynamicType {
+	return f.Function.DynamicType(inter)
 }
 
 func (f BoundFunctionValue) Copy() Value {
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"math/big"
 	goRuntime "runtime"
+	"strings"
 
 	"github.com/raviqqe/hamt"
 
@@ -102,6 +103,28 @@ type OnFunctionInvocationFunc func(
 	line int,
 )
 
+// OnMeterComputationFunc is a function that is triggered when an operation is about to be performed
+// whose computation depends on the size of the values involved, e.g. an array concatenation.
+//
+// The intensity is the size of the operation, e.g. the number of elements.
+//
+type OnMeterComputationFunc func(
+	inter *Interpreter,
+	kind common.ComputationKind,
+	intensity uint,
+)
+
+// OnMeterMemoryFunc is a function that is triggered when a value is about to be allocated,
+// e.g. an array, a string, or an arbitrary-precision integer.
+//
+// The amount is the size of the allocation, e.g. the number of elements.
+//
+type OnMeterMemoryFunc func(
+	inter *Interpreter,
+	kind common.MemoryKind,
+	amount uint,
+)
+
 // StorageExistenceHandlerFunc is a function that handles storage existence checks.
 //
 type StorageExistenceHandlerFunc func(
@@ -127,6 +150,27 @@ type StorageWriteHandlerFunc func(
 	value OptionalValue,
 )
 
+// StorageEnumerationHandlerFunc is a function that handles the enumeration of storage keys.
+//
+type StorageEnumerationHandlerFunc func(
+	inter *Interpreter,
+	storageAddress common.Address,
+) []string
+
+// StorageUsedHandlerFunc is a function that handles queries of the storage used by an account.
+//
+type StorageUsedHandlerFunc func(
+	inter *Interpreter,
+	storageAddress common.Address,
+) uint64
+
+// StorageCapacityHandlerFunc is a function that handles queries of the storage capacity of an account.
+//
+type StorageCapacityHandlerFunc func(
+	inter *Interpreter,
+	storageAddress common.Address,
+) uint64
+
 // StorageKeyHandlerFunc is a function that handles storage indexing types.
 //
 type StorageKeyHandlerFunc func(
@@ -210,14 +254,20 @@ type Interpreter struct {
 	onStatement                    OnStatementFunc
 	onLoopIteration                OnLoopIterationFunc
 	onFunctionInvocation           OnFunctionInvocationFunc
+	onMeterComputation             OnMeterComputationFunc
+	onMeterMemory                  OnMeterMemoryFunc
 	storageExistenceHandler        StorageExistenceHandlerFunc
 	storageReadHandler             StorageReadHandlerFunc
 	storageWriteHandler            StorageWriteHandlerFunc
 	storageKeyHandler              StorageKeyHandlerFunc
+	storageEnumerationHandler      StorageEnumerationHandlerFunc
+	storageUsedHandler             StorageUsedHandlerFunc
+	storageCapacityHandler         StorageCapacityHandlerFunc
 	injectedCompositeFieldsHandler InjectedCompositeFieldsHandlerFunc
 	contractValueHandler           ContractValueHandlerFunc
 	importProgramHandler           ImportProgramHandlerFunc
 	uuidHandler                    UUIDHandlerFunc
+	debugger                       *Debugger
 }
 
 type Option func(*Interpreter) error
@@ -262,6 +312,36 @@ func WithOnFunctionInvocationHandler(handler OnFunctionInvocationFunc) Option {
 	}
 }
 
+// WithOnMeterComputationHandler returns an interpreter option which sets
+// the given function as the computation metering handler.
+//
+func WithOnMeterComputationHandler(handler OnMeterComputationFunc) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.SetOnMeterComputationHandler(handler)
+		return nil
+	}
+}
+
+// WithOnMeterMemoryHandler returns an interpreter option which sets
+// the given function as the memory metering handler.
+//
+func WithOnMeterMemoryHandler(handler OnMeterMemoryFunc) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.SetOnMeterMemoryHandler(handler)
+		return nil
+	}
+}
+
+// WithDebugger returns an interpreter option which sets
+// the given debugger.
+//
+func WithDebugger(debugger *Debugger) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.SetDebugger(debugger)
+		return nil
+	}
+}
+
 // WithPredefinedValues returns an interpreter option which declares
 // the given the predefined values.
 //
@@ -310,6 +390,36 @@ func WithStorageWriteHandler(handler StorageWriteHandlerFunc) Option {
 	}
 }
 
+// WithStorageEnumerationHandler returns an interpreter option which sets the given function
+// as the function that is used when the keys of stored values are enumerated.
+//
+func WithStorageEnumerationHandler(handler StorageEnumerationHandlerFunc) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.SetStorageEnumerationHandler(handler)
+		return nil
+	}
+}
+
+// WithStorageUsedHandler returns an interpreter option which sets the given function
+// as the function that is used when the storage used by an account is queried.
+//
+func WithStorageUsedHandler(handler StorageUsedHandlerFunc) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.SetStorageUsedHandler(handler)
+		return nil
+	}
+}
+
+// WithStorageCapacityHandler returns an interpreter option which sets the given function
+// as the function that is used when the storage capacity of an account is queried.
+//
+func WithStorageCapacityHandler(handler StorageCapacityHandlerFunc) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.SetStorageCapacityHandler(handler)
+		return nil
+	}
+}
+
 // WithStorageKeyHandler returns an interpreter option which sets the given function
 // as the function that is used when a stored value is written.
 //
@@ -442,6 +552,26 @@ func (interpreter *Interpreter) SetOnFunctionInvocationHandler(function OnFuncti
 	interpreter.onFunctionInvocation = function
 }
 
+// SetOnMeterComputationHandler sets the function that is triggered when an operation is about to be performed
+// whose computation depends on the size of the values involved.
+//
+func (interpreter *Interpreter) SetOnMeterComputationHandler(function OnMeterComputationFunc) {
+	interpreter.onMeterComputation = function
+}
+
+// SetOnMeterMemoryHandler sets the function that is triggered when a value is about to be allocated.
+//
+func (interpreter *Interpreter) SetOnMeterMemoryHandler(function OnMeterMemoryFunc) {
+	interpreter.onMeterMemory = function
+}
+
+// SetDebugger sets the debugger which is notified before each statement is executed,
+// and which may pause the execution.
+//
+func (interpreter *Interpreter) SetDebugger(debugger *Debugger) {
+	interpreter.debugger = debugger
+}
+
 // SetStorageExistenceHandler sets the function that is used when a storage key is checked for existence.
 //
 func (interpreter *Interpreter) SetStorageExistenceHandler(function StorageExistenceHandlerFunc) {
@@ -460,6 +590,24 @@ func (interpreter *Interpreter) SetStorageWriteHandler(function StorageWriteHand
 	interpreter.storageWriteHandler = function
 }
 
+// SetStorageEnumerationHandler sets the function that is used when the keys of stored values are enumerated.
+//
+func (interpreter *Interpreter) SetStorageEnumerationHandler(function StorageEnumerationHandlerFunc) {
+	interpreter.storageEnumerationHandler = function
+}
+
+// SetStorageUsedHandler sets the function that is used when the storage used by an account is queried.
+//
+func (interpreter *Interpreter) SetStorageUsedHandler(function StorageUsedHandlerFunc) {
+	interpreter.storageUsedHandler = function
+}
+
+// SetStorageCapacityHandler sets the function that is used when the storage capacity of an account is queried.
+//
+func (interpreter *Interpreter) SetStorageCapacityHandler(function StorageCapacityHandlerFunc) {
+	interpreter.storageCapacityHandler = function
+}
+
 // SetStorageKeyHandler sets the function that is used when a storage is indexed.
 //
 func (interpreter *Interpreter) SetStorageKeyHandler(function StorageKeyHandlerFunc) {
@@ -590,6 +738,12 @@ func (interpreter *Interpreter) runUntilNextStatement(t Trampoline) (interface{}
 }
 
 func (interpreter *Interpreter) runAllStatements(t Trampoline) interface{} {
+
+	if interpreter.debugger != nil {
+		interpreter.debugger.enterRun()
+		defer interpreter.debugger.leaveRun()
+	}
+
 	for {
 		result, statement := interpreter.runUntilNextStatement(t)
 		if statement == nil {
@@ -600,6 +754,10 @@ func (interpreter *Interpreter) runAllStatements(t Trampoline) interface{} {
 			interpreter.onStatement(statement)
 		}
 
+		if interpreter.debugger != nil {
+			interpreter.debugger.onStatement(statement)
+		}
+
 		result = statement.Trampoline.Resume()
 		if continuation, ok := result.(func() Trampoline); ok {
 			t = continuation()
@@ -1213,6 +1371,77 @@ func (interpreter *Interpreter) VisitForStatement(statement *ast.ForStatement) a
 		})
 }
 
+func (interpreter *Interpreter) VisitSwitchStatement(switchStatement *ast.SwitchStatement) ast.Repr {
+
+	visitCaseStatements := func(switchCase *ast.SwitchCase) Trampoline {
+		// case scope: each case gets an activation record
+		interpreter.activations.PushCurrent()
+
+		return interpreter.visitStatements(switchCase.Statements).
+			FlatMap(func(value interface{}) Trampoline {
+				interpreter.activations.Pop()
+
+				// A break statement exits the switch statement
+				if _, ok := value.(loopBreak); ok {
+					return Done{}
+				}
+
+				return Done{Result: value}
+			})
+	}
+
+	var visitCase func(i int, testValue Value) Trampoline
+	visitCase = func(i int, testValue Value) Trampoline {
+
+		// If no case matched, the switch statement has no effect
+
+		if i >= len(switchStatement.Cases) {
+			return Done{}
+		}
+
+		switchCase := switchStatement.Cases[i]
+
+		// The default case is the last case,
+		// so it is taken if no other case matched
+
+		if switchCase.IsDefault() {
+			return visitCaseStatements(switchCase)
+		}
+
+		return interpreter.testSwitchCaseExpressions(switchCase.Expressions, testValue).
+			FlatMap(func(result interface{}) Trampoline {
+				if result.(BoolValue) {
+					return visitCaseStatements(switchCase)
+				}
+
+				return visitCase(i+1, testValue)
+			})
+	}
+
+	return switchStatement.Expression.Accept(interpreter).(Trampoline).
+		FlatMap(func(result interface{}) Trampoline {
+			return visitCase(0, result.(Value))
+		})
+}
+
+// testSwitchCaseExpressions evaluates the case expressions in order,
+// until one is equal to the given test value.
+//
+func (interpreter *Interpreter) testSwitchCaseExpressions(expressions []ast.Expression, testValue Value) Trampoline {
+	if len(expressions) == 0 {
+		return Done{Result: BoolValue(false)}
+	}
+
+	return expressions[0].Accept(interpreter).(Trampoline).
+		FlatMap(func(result interface{}) Trampoline {
+			if interpreter.testEqual(testValue, result.(Value)) {
+				return Done{Result: BoolValue(true)}
+			}
+
+			return interpreter.testSwitchCaseExpressions(expressions[1:], testValue)
+		})
+}
+
 func (interpreter *Interpreter) visitPotentialStorageRemoval(expression ast.Expression) Trampoline {
 	movingStorageIndexExpression := interpreter.movingStorageIndexExpression(expression)
 	if movingStorageIndexExpression == nil {
@@ -1420,6 +1649,13 @@ func (interpreter *Interpreter) indexExpressionGetterSetter(indexExpression *ast
 								return typedResult.Get(interpreter, locationRange, indexingValue)
 							},
 							set: func(value Value) {
+								// Setting a dictionary entry may insert a new entry
+								if _, ok := typedResult.(*DictionaryValue); ok {
+									if _, ok := value.(*SomeValue); ok {
+										interpreter.reportMemory(common.MemoryKindDictionary, 1)
+									}
+								}
+
 								typedResult.Set(interpreter, locationRange, indexingValue, value)
 							},
 						},
@@ -1489,7 +1725,7 @@ func (interpreter *Interpreter) VisitBinaryExpression(expression *ast.BinaryExpr
 				tuple := result.(valueTuple)
 				left := tuple.left.(NumberValue)
 				right := tuple.right.(NumberValue)
-				return left.Plus(right)
+				return interpreter.reportBigIntegerAllocation(left.Plus(right))
 			})
 
 	case ast.OperationMinus:
@@ -1498,7 +1734,7 @@ func (interpreter *Interpreter) VisitBinaryExpression(expression *ast.BinaryExpr
 				tuple := result.(valueTuple)
 				left := tuple.left.(NumberValue)
 				right := tuple.right.(NumberValue)
-				return left.Minus(right)
+				return interpreter.reportBigIntegerAllocation(left.Minus(right))
 			})
 
 	case ast.OperationMod:
@@ -1507,7 +1743,7 @@ func (interpreter *Interpreter) VisitBinaryExpression(expression *ast.BinaryExpr
 				tuple := result.(valueTuple)
 				left := tuple.left.(NumberValue)
 				right := tuple.right.(NumberValue)
-				return left.Mod(right)
+				return interpreter.reportBigIntegerAllocation(left.Mod(right))
 			})
 
 	case ast.OperationMul:
@@ -1516,7 +1752,7 @@ func (interpreter *Interpreter) VisitBinaryExpression(expression *ast.BinaryExpr
 				tuple := result.(valueTuple)
 				left := tuple.left.(NumberValue)
 				right := tuple.right.(NumberValue)
-				return left.Mul(right)
+				return interpreter.reportBigIntegerAllocation(left.Mul(right))
 			})
 
 	case ast.OperationDiv:
@@ -1525,7 +1761,7 @@ func (interpreter *Interpreter) VisitBinaryExpression(expression *ast.BinaryExpr
 				tuple := result.(valueTuple)
 				left := tuple.left.(NumberValue)
 				right := tuple.right.(NumberValue)
-				return left.Div(right)
+				return interpreter.reportBigIntegerAllocation(left.Div(right))
 			})
 
 	case ast.OperationLess:
@@ -1640,10 +1876,55 @@ func (interpreter *Interpreter) VisitBinaryExpression(expression *ast.BinaryExpr
 		return interpreter.visitBinaryOperation(expression).
 			Map(func(result interface{}) interface{} {
 				tuple := result.(valueTuple)
+
+				// The ampersand is also the bitwise AND operator for integers
+
+				if left, ok := tuple.left.(IntegerValue); ok {
+					right := tuple.right.(IntegerValue)
+					return interpreter.reportBigIntegerAllocation(left.BitwiseAnd(right))
+				}
+
 				left := tuple.left.(ConcatenatableValue)
 				right := tuple.right.(ConcatenatableValue)
+				interpreter.reportConcatenation(left, right)
 				return left.Concat(right)
 			})
+
+	case ast.OperationBitwiseOr:
+		return interpreter.visitBinaryOperation(expression).
+			Map(func(result interface{}) interface{} {
+				tuple := result.(valueTuple)
+				left := tuple.left.(IntegerValue)
+				right := tuple.right.(IntegerValue)
+				return interpreter.reportBigIntegerAllocation(left.BitwiseOr(right))
+			})
+
+	case ast.OperationBitwiseXor:
+		return interpreter.visitBinaryOperation(expression).
+			Map(func(result interface{}) interface{} {
+				tuple := result.(valueTuple)
+				left := tuple.left.(IntegerValue)
+				right := tuple.right.(IntegerValue)
+				return interpreter.reportBigIntegerAllocation(left.BitwiseXor(right))
+			})
+
+	case ast.OperationBitwiseLeftShift:
+		return interpreter.visitBinaryOperation(expression).
+			Map(func(result interface{}) interface{} {
+				tuple := result.(valueTuple)
+				left := tuple.left.(IntegerValue)
+				right := tuple.right.(IntegerValue)
+				return interpreter.reportBigIntegerAllocation(left.BitwiseLeftShift(right))
+			})
+
+	case ast.OperationBitwiseRightShift:
+		return interpreter.visitBinaryOperation(expression).
+			Map(func(result interface{}) interface{} {
+				tuple := result.(valueTuple)
+				left := tuple.left.(IntegerValue)
+				right := tuple.right.(IntegerValue)
+				return interpreter.reportBigIntegerAllocation(left.BitwiseRightShift(right))
+			})
 	}
 
 	panic(&unsupportedOperation{
@@ -1673,9 +1954,36 @@ func (interpreter *Interpreter) testEqual(left, right Value) BoolValue {
 		return BoolValue(ok)
 
 	case *CompositeValue:
+		// Enum cases are equal if they are of the same type and have the same raw value
+
+		right, ok := right.(*CompositeValue)
+		if ok &&
+			left.Kind == common.CompositeKindEnum &&
+			right.Kind == common.CompositeKindEnum &&
+			left.TypeID == right.TypeID {
+
+			return interpreter.testEqual(
+				left.Fields[sema.EnumRawValueFieldName],
+				right.Fields[sema.EnumRawValueFieldName],
+			)
+		}
+
 		// TODO: call `equals` if RHS is composite
 		return false
 
+	case TypeValue:
+		// Type values are equal if the types they represent are equal
+
+		right, ok := right.(TypeValue)
+		if !ok {
+			return false
+		}
+
+		leftType := interpreter.ConvertStaticToSemaType(left.Type)
+		rightType := interpreter.ConvertStaticToSemaType(right.Type)
+
+		return BoolValue(leftType.Equal(rightType))
+
 	case *ArrayValue,
 		*DictionaryValue:
 		// TODO:
@@ -1697,7 +2005,11 @@ func (interpreter *Interpreter) VisitUnaryExpression(expression *ast.UnaryExpres
 
 			case ast.OperationMinus:
 				integerValue := value.(NumberValue)
-				return integerValue.Negate()
+				return interpreter.reportBigIntegerAllocation(integerValue.Negate())
+
+			case ast.OperationBitwiseNot:
+				integerValue := value.(IntegerValue)
+				return integerValue.BitwiseNot()
 
 			case ast.OperationMove:
 				return value
@@ -1791,16 +2103,80 @@ func (interpreter *Interpreter) convertToFixedPointBigInt(expression *ast.FixedP
 }
 
 func (interpreter *Interpreter) VisitStringExpression(expression *ast.StringExpression) ast.Repr {
+	interpreter.reportMemory(common.MemoryKindString, len(expression.Value))
+
 	value := NewStringValue(expression.Value)
 
 	return Done{Result: value}
 }
 
+func (interpreter *Interpreter) VisitStringTemplateExpression(expression *ast.StringTemplateExpression) ast.Repr {
+	return interpreter.visitExpressionsNonCopying(expression.Expressions).
+		FlatMap(func(result interface{}) Trampoline {
+			values := result.(*ArrayValue)
+
+			var builder strings.Builder
+
+			// Meter each part before it is appended,
+			// so the limits are enforced before the whole string is allocated
+
+			writePart := func(part string) {
+				interpreter.reportComputation(
+					common.ComputationKindStringOperation,
+					len(part),
+				)
+				interpreter.reportMemory(
+					common.MemoryKindString,
+					len(part),
+				)
+
+				builder.WriteString(part)
+			}
+
+			for i, value := range expression.Values {
+				if i > 0 {
+					writePart(interpolatedValueString(values.Values[i-1]))
+				}
+				writePart(value)
+			}
+
+			return Done{Result: NewStringValue(builder.String())}
+		})
+}
+
+// interpolatedValueString returns the string representation of a value
+// which is interpolated in a string template.
+//
+// Strings are interpolated as-is, all other values use their canonical string representation.
+//
+func interpolatedValueString(value Value) string {
+	switch value := value.(type) {
+	case *StringValue:
+		return value.Str
+
+	case fmt.Stringer:
+		return value.String()
+
+	default:
+		// References have no canonical string representation
+		return fmt.Sprint(value)
+	}
+}
+
 func (interpreter *Interpreter) VisitArrayExpression(expression *ast.ArrayExpression) ast.Repr {
 	return interpreter.visitExpressionsNonCopying(expression.Values).
 		FlatMap(func(result interface{}) Trampoline {
 			values := result.(*ArrayValue)
 
+			interpreter.reportComputation(
+				common.ComputationKindArrayOperation,
+				values.Count(),
+			)
+			interpreter.reportMemory(
+				common.MemoryKindArray,
+				values.Count(),
+			)
+
 			argumentTypes := interpreter.Checker.Elaboration.ArrayExpressionArgumentTypes[expression]
 			elementType := interpreter.Checker.Elaboration.ArrayExpressionElementType[expression]
 
@@ -1821,8 +2197,19 @@ func (interpreter *Interpreter) VisitDictionaryExpression(expression *ast.Dictio
 			entryTypes := interpreter.Checker.Elaboration.DictionaryExpressionEntryTypes[expression]
 			dictionaryType := interpreter.Checker.Elaboration.DictionaryExpressionType[expression]
 
+			entries := result.([]DictionaryEntryValues)
+
+			interpreter.reportComputation(
+				common.ComputationKindDictionaryOperation,
+				len(entries),
+			)
+			interpreter.reportMemory(
+				common.MemoryKindDictionary,
+				len(entries),
+			)
+
 			newDictionary := NewDictionaryValueUnownedNonCopying()
-			for i, dictionaryEntryValues := range result.([]DictionaryEntryValues) {
+			for i, dictionaryEntryValues := range entries {
 				entryType := entryTypes[i]
 
 				key := interpreter.copyAndConvert(
@@ -1865,9 +2252,9 @@ func (interpreter *Interpreter) VisitMemberExpression(expression *ast.MemberExpr
 				}
 			}
 
-			value := result.(MemberAccessibleValue)
+			value := result.(Value)
 			locationRange := interpreter.locationRange(expression)
-			resultValue := value.GetMember(interpreter, locationRange, expression.Identifier.Identifier)
+			resultValue := interpreter.getMember(value, locationRange, expression.Identifier.Identifier)
 
 			// If the member access is optional chaining, only wrap the result value
 			// in an optional, if it is not already an optional value
@@ -1881,6 +2268,23 @@ func (interpreter *Interpreter) VisitMemberExpression(expression *ast.MemberExpr
 		})
 }
 
+// getMember returns the member with the given name of the given value.
+//
+// The built-in members which are available for values of all types (e.g. `getType`)
+// cannot be declared by composites, so they are handled first
+//
+func (interpreter *Interpreter) getMember(self Value, locationRange LocationRange, identifier string) Value {
+	switch identifier {
+	case sema.GetTypeFunctionName:
+		return interpreter.getTypeFunction(self)
+
+	case sema.IsInstanceFunctionName:
+		return interpreter.isInstanceFunction(self)
+	}
+
+	return self.(MemberAccessibleValue).GetMember(interpreter, locationRange, identifier)
+}
+
 func (interpreter *Interpreter) VisitIndexExpression(expression *ast.IndexExpression) ast.Repr {
 	return expression.TargetExpression.Accept(interpreter).(Trampoline).
 		FlatMap(func(result interface{}) Trampoline {
@@ -2026,7 +2430,7 @@ func (interpreter *Interpreter) functionValueInvocationTrampoline(
 			parameterType := parameterTypes[i]
 			argumentCopies[i] = interpreter.copyAndConvert(argument, argumentType, parameterType)
 		} else {
-			argumentCopies[i] = argument.Copy()
+			argumentCopies[i] = interpreter.copyValue(argument)
 		}
 	}
 
@@ -2053,6 +2457,18 @@ func (interpreter *Interpreter) invokeInterpretedFunction(
 	invocation Invocation,
 ) Trampoline {
 
+	// Record the call in the debugger's call stack,
+	// together with the caller's activation, so its variables can be inspected.
+	// NOTE: the caller's activation must be determined before the function's activation is pushed
+
+	if interpreter.debugger != nil {
+		var callerActivation hamt.Map
+		if invocation.Interpreter != nil {
+			callerActivation = invocation.Interpreter.activations.CurrentOrNew()
+		}
+		interpreter.debugger.pushFrame(interpreter, callerActivation)
+	}
+
 	// Start a new activation record.
 	// Lexical scope: use the function declaration's activation record,
 	// not the current one (which would be dynamic scope)
@@ -2063,7 +2479,15 @@ func (interpreter *Interpreter) invokeInterpretedFunction(
 		interpreter.declareVariable(sema.SelfIdentifier, invocation.Self)
 	}
 
-	return interpreter.invokeInterpretedFunctionActivated(function, invocation.Arguments)
+	trampoline := interpreter.invokeInterpretedFunctionActivated(function, invocation.Arguments)
+
+	if interpreter.debugger != nil {
+		trampoline = trampoline.Then(func(_ interface{}) {
+			interpreter.debugger.popFrame()
+		})
+	}
+
+	return trampoline
 }
 
 // NOTE: assumes the function's activation (or an extension of it) is pushed!
@@ -2397,6 +2821,8 @@ func (interpreter *Interpreter) declareCompositeValue(
 				fields[ResourceUUIDMemberName] = UInt64Value(uuid)
 			}
 
+			interpreter.reportMemory(common.MemoryKindComposite, 1)
+
 			value := &CompositeValue{
 				Location:       location,
 				TypeID:         typeID,
@@ -2434,16 +2860,27 @@ func (interpreter *Interpreter) declareCompositeValue(
 	)
 
 	// Contract declarations declare a value / instance (singleton),
+	// enum declarations declare a constructor which looks up the case for a raw value,
 	// for all other composite kinds, the constructor is declared
 
-	if declaration.CompositeKind == common.CompositeKindContract {
+	switch declaration.CompositeKind {
+	case common.CompositeKindContract:
 		contract := interpreter.contractValueHandler(interpreter, compositeType, constructor)
 		contract.NestedValues = members
 		value = contract
 		// NOTE: variable value is also set in the constructor function: it needs to be available
 		// for nested declarations, which might be invoked when the constructor is invoked
 		variable.Value = value
-	} else {
+
+	case common.CompositeKindEnum:
+		value = interpreter.enumConstructorFunction(
+			compositeType,
+			declaration.Members.EnumCases,
+			functions,
+		)
+		variable.Value = value
+
+	default:
 		constructor.Members = members
 		value = constructor
 		variable.Value = value
@@ -2452,6 +2889,71 @@ func (interpreter *Interpreter) declareCompositeValue(
 	return lexicalScope, value
 }
 
+// enumConstructorFunction returns the constructor function for an enum.
+//
+// The enum cases are created once and are available as members of the constructor.
+// The raw value of each case is its index, converted to the raw type of the enum.
+// The checker ensures that the indices of all cases fit into the raw type.
+//
+// The constructor returns the case with the given raw value, if any, or nil.
+//
+func (interpreter *Interpreter) enumConstructorFunction(
+	compositeType *sema.CompositeType,
+	enumCases []*ast.EnumCaseDeclaration,
+	functions map[string]FunctionValue,
+) HostFunctionValue {
+
+	location := interpreter.Checker.Location
+	typeID := compositeType.ID()
+
+	caseValues := make([]*CompositeValue, len(enumCases))
+	constructorMembers := make(map[string]Value, len(enumCases))
+
+	for i, enumCase := range enumCases {
+
+		rawValue := interpreter.convert(
+			NewIntValueFromInt64(int64(i)),
+			&sema.IntType{},
+			compositeType.EnumRawType,
+		)
+
+		caseValue := &CompositeValue{
+			Location: location,
+			TypeID:   typeID,
+			Kind:     common.CompositeKindEnum,
+			Fields: map[string]Value{
+				sema.EnumRawValueFieldName: rawValue,
+			},
+			Functions: functions,
+			// NOTE: new value has no owner
+			Owner: nil,
+		}
+
+		caseValues[i] = caseValue
+		constructorMembers[enumCase.Identifier.Identifier] = caseValue
+	}
+
+	constructor := NewHostFunctionValue(
+		func(invocation Invocation) Trampoline {
+			rawValue := invocation.Arguments[0].(EquatableValue)
+
+			for _, caseValue := range caseValues {
+				caseRawValue := caseValue.Fields[sema.EnumRawValueFieldName]
+
+				if rawValue.Equal(caseRawValue) {
+					return Done{Result: NewSomeValueOwningNonCopying(interpreter.copyValue(caseValue))}
+				}
+			}
+
+			return Done{Result: NilValue{}}
+		},
+	)
+
+	constructor.Members = constructorMembers
+
+	return constructor
+}
+
 func (interpreter *Interpreter) compositeInitializerFunction(
 	compositeDeclaration *ast.CompositeDeclaration,
 	lexicalScope hamt.Map,
@@ -2630,8 +3132,13 @@ func (interpreter *Interpreter) VisitFieldDeclaration(_ *ast.FieldDeclaration) a
 	panic(errors.NewUnreachableError())
 }
 
+func (interpreter *Interpreter) VisitEnumCaseDeclaration(_ *ast.EnumCaseDeclaration) ast.Repr {
+	// enum cases are declared in `enumConstructorFunction`
+	panic(errors.NewUnreachableError())
+}
+
 func (interpreter *Interpreter) copyAndConvert(value Value, valueType, targetType sema.Type) Value {
-	return interpreter.convertAndBox(value.Copy(), valueType, targetType)
+	return interpreter.convertAndBox(interpreter.copyValue(value), valueType, targetType)
 }
 
 // convertAndBox converts a value to a target type, and boxes in optionals and any value, if necessary
@@ -2999,14 +3506,20 @@ func (interpreter *Interpreter) ensureLoaded(location ast.Location, loadProgram
 		WithOnStatementHandler(interpreter.onStatement),
 		WithOnLoopIterationHandler(interpreter.onLoopIteration),
 		WithOnFunctionInvocationHandler(interpreter.onFunctionInvocation),
+		WithOnMeterComputationHandler(interpreter.onMeterComputation),
+		WithOnMeterMemoryHandler(interpreter.onMeterMemory),
 		WithStorageExistenceHandler(interpreter.storageExistenceHandler),
 		WithStorageReadHandler(interpreter.storageReadHandler),
 		WithStorageWriteHandler(interpreter.storageWriteHandler),
 		WithStorageKeyHandler(interpreter.storageKeyHandler),
+		WithStorageEnumerationHandler(interpreter.storageEnumerationHandler),
+		WithStorageUsedHandler(interpreter.storageUsedHandler),
+		WithStorageCapacityHandler(interpreter.storageCapacityHandler),
 		WithInjectedCompositeFieldsHandler(interpreter.injectedCompositeFieldsHandler),
 		WithContractValueHandler(interpreter.contractValueHandler),
 		WithImportProgramHandler(interpreter.importProgramHandler),
 		WithUUIDHandler(interpreter.uuidHandler),
+		WithDebugger(interpreter.debugger),
 		WithAllInterpreters(interpreter.allInterpreters),
 		WithAllCheckers(interpreter.allCheckers),
 		withTypeCodes(interpreter.typeCodes),
@@ -3305,6 +3818,18 @@ func (interpreter *Interpreter) readStored(storageAddress common.Address, key st
 	return interpreter.storageReadHandler(interpreter, storageAddress, key)
 }
 
+func (interpreter *Interpreter) storedKeys(storageAddress common.Address) []string {
+	return interpreter.storageEnumerationHandler(interpreter, storageAddress)
+}
+
+func (interpreter *Interpreter) storageUsed(storageAddress common.Address) uint64 {
+	return interpreter.storageUsedHandler(interpreter, storageAddress)
+}
+
+func (interpreter *Interpreter) storageCapacity(storageAddress common.Address) uint64 {
+	return interpreter.storageCapacityHandler(interpreter, storageAddress)
+}
+
 func (interpreter *Interpreter) writeStored(storageAddress common.Address, key string, value OptionalValue) {
 	value.SetOwner(&storageAddress)
 
@@ -3355,6 +3880,14 @@ func (interpreter *Interpreter) defineBaseFunctions() {
 			panic(errors.NewUnreachableError())
 		}
 	}
+
+	err := interpreter.ImportValue(
+		(&sema.MetaType{}).String(),
+		interpreter.metaTypeFunction(),
+	)
+	if err != nil {
+		panic(errors.NewUnreachableError())
+	}
 }
 
 func (interpreter *Interpreter) newConverterFunction(converter ValueConverter) FunctionValue {
@@ -3413,6 +3946,113 @@ func IsSubType(subType DynamicType, superType sema.Type) bool {
 			return false
 		}
 
+	case DeployedContractDynamicType:
+		switch superType.(type) {
+		case *sema.DeployedContractType, *sema.AnyStructType:
+			return true
+
+		default:
+			return false
+		}
+
+	case PathDynamicType:
+		switch superType.(type) {
+		case *sema.PathType, *sema.AnyStructType:
+			return true
+
+		default:
+			return false
+		}
+
+	case CapabilityDynamicType:
+		switch superType.(type) {
+		case *sema.CapabilityType:
+			capabilityType := &sema.CapabilityType{
+				BorrowType: typedSubType.BorrowType,
+			}
+			return sema.IsSubType(capabilityType, superType)
+
+		case *sema.AnyStructType:
+			return true
+
+		default:
+			return false
+		}
+
+	case AuthAccountDynamicType:
+		switch superType.(type) {
+		case *sema.AuthAccountType, *sema.AnyStructType:
+			return true
+
+		default:
+			return false
+		}
+
+	case AuthAccountContractsDynamicType:
+		switch superType.(type) {
+		case *sema.AuthAccountContractsType, *sema.AnyStructType:
+			return true
+
+		default:
+			return false
+		}
+
+	case AuthAccountKeysDynamicType:
+		switch superType.(type) {
+		case *sema.AuthAccountKeysType, *sema.AnyStructType:
+			return true
+
+		default:
+			return false
+		}
+
+	case PublicAccountKeysDynamicType:
+		switch superType.(type) {
+		case *sema.PublicAccountKeysType, *sema.AnyStructType:
+			return true
+
+		default:
+			return false
+		}
+
+	case AccountKeyDynamicType:
+		switch superType.(type) {
+		case *sema.AccountKeyType, *sema.AnyStructType:
+			return true
+
+		default:
+			return false
+		}
+
+	case PublicAccountDynamicType:
+		switch superType.(type) {
+		case *sema.PublicAccountType, *sema.AnyStructType:
+			return true
+
+		default:
+			return false
+		}
+
+	case MetaTypeDynamicType:
+		switch superType.(type) {
+		case *sema.MetaType, *sema.AnyStructType:
+			return true
+
+		default:
+			return false
+		}
+
+	case FunctionDynamicType:
+		if _, ok := superType.(*sema.AnyStructType); ok {
+			return true
+		}
+
+		if typedSubType.FuncType == nil {
+			return false
+		}
+
+		return sema.IsSubType(typedSubType.FuncType, superType)
+
 	case NumberDynamicType:
 		return sema.IsSubType(typedSubType.StaticType, superType)
 
@@ -3517,6 +4157,38 @@ func storageKey(path PathValue) string {
 	return fmt.Sprintf("%s\x1F%s", path.Domain.Identifier(), path.Identifier)
 }
 
+// storagePath returns the path for the given storage key,
+// and false if the key is not the key of a path in the given domain.
+//
+func storagePath(key string, domain common.PathDomain) (PathValue, bool) {
+	parts := strings.SplitN(key, "\x1F", 2)
+	if len(parts) != 2 || parts[0] != domain.Identifier() {
+		return PathValue{}, false
+	}
+
+	return PathValue{
+		Domain:     domain,
+		Identifier: parts[1],
+	}, true
+}
+
+// storedPaths returns the paths of all values stored in the given domain,
+// in the order of their storage keys.
+//
+func (interpreter *Interpreter) storedPaths(address common.Address, domain common.PathDomain) []PathValue {
+	var paths []PathValue
+
+	for _, key := range interpreter.storedKeys(address) {
+		path, ok := storagePath(key, domain)
+		if !ok {
+			continue
+		}
+		paths = append(paths, path)
+	}
+
+	return paths
+}
+
 func mustPathDomain(
 	path PathValue,
 	locationRange LocationRange,
@@ -3748,10 +4420,12 @@ func (interpreter *Interpreter) authAccountLinkFunction(addressValue AddressValu
 
 		// Write new value
 
+		borrowType := ConvertSemaToStaticType(referenceType)
+
 		storedValue := NewSomeValueOwningNonCopying(
 			LinkValue{
 				TargetPath: targetPath,
-				Type:       ConvertSemaToStaticType(referenceType),
+				Type:       borrowType,
 			},
 		)
 
@@ -3761,10 +4435,14 @@ func (interpreter *Interpreter) authAccountLinkFunction(addressValue AddressValu
 			storedValue,
 		)
 
+		// The capability is for the new capability path, not the target path,
+		// so it is subject to the link, e.g. it is revoked when the link is removed
+
 		returnValue := NewSomeValueOwningNonCopying(
 			CapabilityValue{
-				Address: addressValue,
-				Path:    targetPath,
+				Address:    addressValue,
+				Path:       newCapabilityPath,
+				BorrowType: borrowType,
 			},
 		)
 
@@ -3813,6 +4491,69 @@ func (interpreter *Interpreter) authAccountGetLinkTargetFunction(addressValue Ad
 	})
 }
 
+// authAccountPathsValue returns the array of the paths of all values
+// stored in the given domain of the account.
+//
+func (interpreter *Interpreter) authAccountPathsValue(addressValue AddressValue, domain common.PathDomain) *ArrayValue {
+	paths := interpreter.storedPaths(addressValue.ToAddress(), domain)
+
+	values := make([]Value, len(paths))
+	for i, path := range paths {
+		values[i] = path
+	}
+
+	return NewArrayValueUnownedNonCopying(values...)
+}
+
+func (interpreter *Interpreter) authAccountForEachStoredFunction(addressValue AddressValue) HostFunctionValue {
+	return NewHostFunctionValue(func(invocation Invocation) Trampoline {
+
+		address := addressValue.ToAddress()
+
+		function := invocation.Arguments[0].(FunctionValue)
+
+		// Determine the paths before iterating,
+		// so the function may save and load values
+
+		paths := interpreter.storedPaths(address, common.PathDomainStorage)
+
+		var iterate func(index int) Trampoline
+		iterate = func(index int) Trampoline {
+			if index >= len(paths) {
+				return Done{Result: VoidValue{}}
+			}
+
+			path := paths[index]
+
+			// Skip values which were removed by a previous invocation of the function
+
+			someValue, ok := interpreter.readStored(address, storageKey(path)).(*SomeValue)
+			if !ok {
+				return iterate(index + 1)
+			}
+
+			dynamicType := someValue.Value.DynamicType(interpreter)
+			typeValue := TypeValue{
+				Type: ConvertSemaToStaticType(ConvertDynamicToSemaType(dynamicType)),
+			}
+
+			return function.Invoke(Invocation{
+				Arguments:     []Value{path, typeValue},
+				LocationRange: invocation.LocationRange,
+				Interpreter:   interpreter,
+			}).FlatMap(func(result interface{}) Trampoline {
+				if !result.(BoolValue) {
+					return Done{Result: VoidValue{}}
+				}
+
+				return iterate(index + 1)
+			})
+		}
+
+		return iterate(0)
+	})
+}
+
 func (interpreter *Interpreter) authAccountUnlinkFunction(addressValue AddressValue) HostFunctionValue {
 	return NewHostFunctionValue(func(invocation Invocation) Trampoline {
 
@@ -3842,13 +4583,23 @@ func (interpreter *Interpreter) authAccountUnlinkFunction(addressValue AddressVa
 	})
 }
 
-func (interpreter *Interpreter) capabilityBorrowFunction(addressValue AddressValue, pathValue PathValue) HostFunctionValue {
+func (interpreter *Interpreter) capabilityBorrowFunction(
+	addressValue AddressValue,
+	pathValue PathValue,
+	borrowType StaticType,
+) HostFunctionValue {
 	return NewHostFunctionValue(func(invocation Invocation) Trampoline {
 
+		wantedBorrowType, ok := interpreter.capabilityWantedBorrowType(borrowType, invocation)
+		if !ok {
+			return Done{Result: NilValue{}}
+		}
+
 		targetStorageKey, authorized :=
 			interpreter.getCapabilityFinalTargetStorageKey(
 				addressValue,
 				pathValue,
+				wantedBorrowType,
 				invocation,
 			)
 
@@ -3870,13 +4621,23 @@ func (interpreter *Interpreter) capabilityBorrowFunction(addressValue AddressVal
 	})
 }
 
-func (interpreter *Interpreter) capabilityCheckFunction(addressValue AddressValue, pathValue PathValue) HostFunctionValue {
+func (interpreter *Interpreter) capabilityCheckFunction(
+	addressValue AddressValue,
+	pathValue PathValue,
+	borrowType StaticType,
+) HostFunctionValue {
 	return NewHostFunctionValue(func(invocation Invocation) Trampoline {
 
+		wantedBorrowType, ok := interpreter.capabilityWantedBorrowType(borrowType, invocation)
+		if !ok {
+			return Done{Result: BoolValue(false)}
+		}
+
 		targetStorageKey, _ :=
 			interpreter.getCapabilityFinalTargetStorageKey(
 				addressValue,
 				pathValue,
+				wantedBorrowType,
 				invocation,
 			)
 
@@ -3886,17 +4647,19 @@ func (interpreter *Interpreter) capabilityCheckFunction(addressValue AddressValu
 	})
 }
 
-func (interpreter *Interpreter) getCapabilityFinalTargetStorageKey(
-	addressValue AddressValue,
-	path PathValue,
+// capabilityWantedBorrowType returns the type the capability is borrowed or checked as:
+// The type argument of the invocation, if any,
+// or the borrow type of the capability.
+//
+// If the capability has a borrow type, the type argument must be a subtype of it,
+// otherwise false is returned. The checker only ensures this if the static type
+// of the capability has a borrow type, which may be less specific than the run-time one,
+// e.g. when a typed capability is used as an untyped `Capability`.
+//
+func (interpreter *Interpreter) capabilityWantedBorrowType(
+	borrowType StaticType,
 	invocation Invocation,
-) (
-	finalStorageKey string,
-	authorized bool,
-) {
-	address := addressValue.ToAddress()
-
-	key := storageKey(path)
+) (*sema.ReferenceType, bool) {
 
 	// `Invocation.TypeParameterTypes` is a map, so get the first
 	// element / type by iterating over the values of the map.
@@ -3907,10 +4670,35 @@ func (interpreter *Interpreter) getCapabilityFinalTargetStorageKey(
 	}
 
 	if wantedType == nil {
-		panic(errors.NewUnreachableError())
+		if borrowType == nil {
+			panic(errors.NewUnreachableError())
+		}
+
+		wantedType = interpreter.ConvertStaticToSemaType(borrowType)
+
+	} else if borrowType != nil {
+		allowedType := interpreter.ConvertStaticToSemaType(borrowType)
+
+		if !sema.IsSubType(wantedType, allowedType) {
+			return nil, false
+		}
 	}
 
-	wantedReferenceType := wantedType.(*sema.ReferenceType)
+	return wantedType.(*sema.ReferenceType), true
+}
+
+func (interpreter *Interpreter) getCapabilityFinalTargetStorageKey(
+	addressValue AddressValue,
+	path PathValue,
+	wantedReferenceType *sema.ReferenceType,
+	invocation Invocation,
+) (
+	finalStorageKey string,
+	authorized bool,
+) {
+	address := addressValue.ToAddress()
+
+	key := storageKey(path)
 
 	seenKeys := map[string]struct{}{}
 	paths := []PathValue{path}
@@ -3938,9 +4726,9 @@ func (interpreter *Interpreter) getCapabilityFinalTargetStorageKey(
 
 			if link, ok := value.Value.(LinkValue); ok {
 
-				allowedType := interpreter.convertStaticToSemaType(link.Type)
+				allowedType := interpreter.ConvertStaticToSemaType(link.Type)
 
-				if !sema.IsSubType(allowedType, wantedType) {
+				if !sema.IsSubType(allowedType, wantedReferenceType) {
 					return "", false
 				}
 
@@ -3958,14 +4746,14 @@ func (interpreter *Interpreter) getCapabilityFinalTargetStorageKey(
 	}
 }
 
-func (interpreter *Interpreter) convertStaticToSemaType(staticType StaticType) sema.Type {
+func (interpreter *Interpreter) ConvertStaticToSemaType(staticType StaticType) sema.Type {
 	return ConvertStaticToSemaType(
 		staticType,
 		func(location ast.Location, typeID sema.TypeID) *sema.InterfaceType {
-			return interpreter.getInterfaceType(location, typeID)
+			return interpreter.GetInterfaceType(location, typeID)
 		},
 		func(location ast.Location, typeID sema.TypeID) *sema.CompositeType {
-			return interpreter.getCompositeType(location, typeID)
+			return interpreter.GetCompositeType(location, typeID)
 		},
 	)
 }
@@ -3984,12 +4772,27 @@ func (interpreter *Interpreter) getElaboration(location ast.Location) *sema.Elab
 	return inter.allCheckers[locationID].Elaboration
 }
 
-func (interpreter *Interpreter) getCompositeType(location ast.Location, typeID sema.TypeID) *sema.CompositeType {
+// GetCompositeType returns the composite type with the given type ID,
+// which is either a built-in composite type or declared in the program at the given location.
+//
+// The program is loaded if necessary.
+//
+func (interpreter *Interpreter) GetCompositeType(location ast.Location, typeID sema.TypeID) *sema.CompositeType {
+	// Built-in composite types are not declared in a program
+	if compositeType := sema.NativeCompositeType(typeID); compositeType != nil {
+		return compositeType
+	}
+
 	elaboration := interpreter.getElaboration(location)
 	return elaboration.CompositeTypes[typeID]
 }
 
-func (interpreter *Interpreter) getInterfaceType(location ast.Location, typeID sema.TypeID) *sema.InterfaceType {
+// GetInterfaceType returns the interface type with the given type ID,
+// which is declared in the program at the given location.
+//
+// The program is loaded if necessary.
+//
+func (interpreter *Interpreter) GetInterfaceType(location ast.Location, typeID sema.TypeID) *sema.InterfaceType {
 	elaboration := interpreter.getElaboration(location)
 	return elaboration.InterfaceTypes[typeID]
 }
@@ -4011,3 +4814,220 @@ func (interpreter *Interpreter) reportFunctionInvocation(pos ast.HasPosition) {
 	line := pos.StartPosition().Line
 	interpreter.onFunctionInvocation(interpreter, line)
 }
+
+// reportConcatenation reports the computation and memory of concatenating the given values,
+// which are both proportional to the size of the result.
+//
+func (interpreter *Interpreter) reportConcatenation(left, right ConcatenatableValue) {
+	switch left := left.(type) {
+	case *StringValue:
+		right := right.(*StringValue)
+		length := len(left.Str) + len(right.Str)
+		interpreter.reportComputation(common.ComputationKindStringOperation, length)
+		interpreter.reportMemory(common.MemoryKindString, length)
+
+	case *ArrayValue:
+		right := right.(*ArrayValue)
+		count := left.Count() + right.Count()
+		interpreter.reportComputation(common.ComputationKindArrayOperation, count)
+		interpreter.reportMemory(common.MemoryKindArray, count)
+	}
+}
+
+// reportComputation reports the computation of the given kind and intensity.
+// Negative intensities are reported as zero.
+//
+func (interpreter *Interpreter) reportComputation(kind common.ComputationKind, intensity int) {
+	if interpreter.onMeterComputation == nil {
+		return
+	}
+
+	if intensity < 0 {
+		intensity = 0
+	}
+
+	interpreter.onMeterComputation(interpreter, kind, uint(intensity))
+}
+
+// ReportComputation reports the computation of the given kind and intensity,
+// e.g. of a host function.
+// Negative intensities are reported as zero.
+//
+func (interpreter *Interpreter) ReportComputation(kind common.ComputationKind, intensity int) {
+	interpreter.reportComputation(kind, intensity)
+}
+
+// reportMemory reports the allocation of the given kind and amount.
+// Negative amounts are reported as zero.
+//
+func (interpreter *Interpreter) reportMemory(kind common.MemoryKind, amount int) {
+	if interpreter.onMeterMemory == nil {
+		return
+	}
+
+	if amount < 0 {
+		amount = 0
+	}
+
+	interpreter.onMeterMemory(interpreter, kind, uint(amount))
+}
+
+// reportBigIntegerAllocation reports the memory of the given value,
+// if it is an arbitrary-precision integer, and returns the value.
+//
+func (interpreter *Interpreter) reportBigIntegerAllocation(value Value) Value {
+	if interpreter.onMeterMemory == nil {
+		return value
+	}
+
+	var bigInt *big.Int
+
+	switch value := value.(type) {
+	case IntValue:
+		bigInt = value.BigInt
+	case Int128Value:
+		bigInt = value.BigInt
+	case Int256Value:
+		bigInt = value.BigInt
+	case UIntValue:
+		bigInt = value.BigInt
+	case UInt128Value:
+		bigInt = value.BigInt
+	case UInt256Value:
+		bigInt = value.BigInt
+	default:
+		return value
+	}
+
+	interpreter.reportMemory(
+		common.MemoryKindBigInt,
+		len(bigInt.Bytes()),
+	)
+
+	return value
+}
+
+// copyValue returns a copy of the given value.
+//
+// The memory of the copy is reported before the value is copied,
+// see reportCopy.
+//
+func (interpreter *Interpreter) copyValue(value Value) Value {
+	interpreter.reportCopy(value)
+	return value.Copy()
+}
+
+// reportCopy reports the memory of copying the given value,
+// which is proportional to the number of array elements, dictionary entries,
+// and composites that are copied, including the nested ones.
+//
+// Resources and contracts are not copied, so they are not reported.
+//
+func (interpreter *Interpreter) reportCopy(value Value) {
+	if interpreter.onMeterMemory == nil {
+		return
+	}
+
+	switch value := value.(type) {
+	case *SomeValue:
+		interpreter.reportCopy(value.Value)
+
+	case *ArrayValue:
+		interpreter.reportMemory(common.MemoryKindArray, value.Count())
+
+		for _, element := range value.Values {
+			interpreter.reportCopy(element)
+		}
+
+	case *DictionaryValue:
+		interpreter.reportMemory(common.MemoryKindDictionary, value.Count())
+
+		for _, key := range value.Keys.Values {
+			interpreter.reportCopy(key)
+		}
+
+		for _, entry := range value.Entries {
+			interpreter.reportCopy(entry)
+		}
+
+	case *CompositeValue:
+		switch value.Kind {
+		case common.CompositeKindResource, common.CompositeKindContract:
+			return
+		}
+
+		interpreter.reportMemory(common.MemoryKindComposite, 1)
+
+		for _, field := range value.Fields {
+			interpreter.reportCopy(field)
+		}
+	}
+}
+
+// metaTypeFunction returns the function `Type<T>()`,
+// which returns the type value for the given type argument
+//
+func (interpreter *Interpreter) metaTypeFunction() HostFunctionValue {
+	return NewHostFunctionValue(func(invocation Invocation) Trampoline {
+
+		// `Invocation.TypeParameterTypes` is a map, so get the first
+		// element / type by iterating over the values of the map.
+
+		var ty sema.Type
+		for _, ty = range invocation.TypeParameterTypes {
+			break
+		}
+
+		if ty == nil {
+			panic(errors.NewUnreachableError())
+		}
+
+		return Done{
+			Result: TypeValue{
+				Type: ConvertSemaToStaticType(ty),
+			},
+		}
+	})
+}
+
+func (interpreter *Interpreter) metaTypeIsSubtypeFunction(typeValue TypeValue) HostFunctionValue {
+	return NewHostFunctionValue(func(invocation Invocation) Trampoline {
+		otherTypeValue := invocation.Arguments[0].(TypeValue)
+
+		subType := interpreter.ConvertStaticToSemaType(typeValue.Type)
+		superType := interpreter.ConvertStaticToSemaType(otherTypeValue.Type)
+
+		return Done{Result: BoolValue(sema.IsSubType(subType, superType))}
+	})
+}
+
+// getTypeFunction returns the built-in function `getType`
+// of the given value, which returns the run-time type of the value
+//
+func (interpreter *Interpreter) getTypeFunction(self Value) HostFunctionValue {
+	return NewHostFunctionValue(func(invocation Invocation) Trampoline {
+		dynamicType := self.DynamicType(interpreter)
+		semaType := ConvertDynamicToSemaType(dynamicType)
+
+		return Done{
+			Result: TypeValue{
+				Type: ConvertSemaToStaticType(semaType),
+			},
+		}
+	})
+}
+
+// isInstanceFunction returns the built-in function `isInstance`
+// of the given value, which returns true if the run-time type of the value
+// is a subtype of the given type
+//
+func (interpreter *Interpreter) isInstanceFunction(self Value) HostFunctionValue {
+	return NewHostFunctionValue(func(invocation Invocation) Trampoline {
+		typeValue := invocation.Arguments[0].(TypeValue)
+
+		dynamicType := self.DynamicType(interpreter)
+		staticType := interpreter.ConvertStaticToSemaType(typeValue.Type)
+
+		return Done{Result: BoolValue(IsSubType(dynamicType, staticType))}
+	})
+}
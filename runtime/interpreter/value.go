@@ -261,7 +261,7 @@ func (v *StringValue) Set(_ *Interpreter, _ LocationRange, key Value, value Valu
 	v.Str = sb.String()
 }
 
-func (v *StringValue) GetMember(_ *Interpreter, _ LocationRange, name string) Value {
+func (v *StringValue) GetMember(inter *Interpreter, _ LocationRange, name string) Value {
 	switch name {
 	case "length":
 		count := uniseg.GraphemeClusterCount(v.Str)
@@ -271,6 +271,7 @@ func (v *StringValue) GetMember(_ *Interpreter, _ LocationRange, name string) Va
 		return NewHostFunctionValue(
 			func(invocation Invocation) trampoline.Trampoline {
 				otherValue := invocation.Arguments[0].(ConcatenatableValue)
+				inter.reportConcatenation(v, otherValue)
 				result := v.Concat(otherValue)
 				return trampoline.Done{Result: result}
 			},
@@ -282,6 +283,9 @@ func (v *StringValue) GetMember(_ *Interpreter, _ LocationRange, name string) Va
 				from := invocation.Arguments[0].(IntValue)
 				to := invocation.Arguments[1].(IntValue)
 				result := v.Slice(from, to)
+				length := len(result.(*StringValue).Str)
+				inter.reportComputation(common.ComputationKindStringOperation, length)
+				inter.reportMemory(common.MemoryKindString, length)
 				return trampoline.Done{Result: result}
 			},
 		)
@@ -291,11 +295,21 @@ func (v *StringValue) GetMember(_ *Interpreter, _ LocationRange, name string) Va
 			func(invocation Invocation) trampoline.Trampoline {
 				str := v.Str
 
+				inter.reportComputation(
+					common.ComputationKindStringOperation,
+					len(str),
+				)
+
 				bs, err := hex.DecodeString(str)
 				if err != nil {
 					panic(err)
 				}
 
+				inter.reportMemory(
+					common.MemoryKindArray,
+					len(bs),
+				)
+
 				values := make([]Value, len(str)/2)
 				for i, b := range bs {
 					values[i] = NewIntValueFromInt64(int64(b))
@@ -468,6 +482,19 @@ func (v *ArrayValue) Insert(i int, element Value) {
 	v.Values = append(v.Values[:i], append([]Value{element}, v.Values[i:]...)...)
 }
 
+// checkIndex panics with an ArrayIndexOutOfBoundsError
+// if the given index is not in the range from 0 to the given maximum index.
+//
+func (v *ArrayValue) checkIndex(index, maxIndex int, locationRange LocationRange) {
+	if index < 0 || index > maxIndex {
+		panic(ArrayIndexOutOfBoundsError{
+			Index:         index,
+			MaxIndex:      maxIndex,
+			LocationRange: locationRange,
+		})
+	}
+}
+
 // TODO: unset owner?
 func (v *ArrayValue) Remove(i int) Value {
 	result := v.Values[i]
@@ -510,7 +537,7 @@ func (v *ArrayValue) Contains(needleValue Value) BoolValue {
 	return false
 }
 
-func (v *ArrayValue) GetMember(_ *Interpreter, _ LocationRange, name string) Value {
+func (v *ArrayValue) GetMember(inter *Interpreter, _ LocationRange, name string) Value {
 	switch name {
 	case "length":
 		return NewIntValueFromInt64(int64(v.Count()))
@@ -518,6 +545,7 @@ func (v *ArrayValue) GetMember(_ *Interpreter, _ LocationRange, name string) Val
 	case "append":
 		return NewHostFunctionValue(
 			func(invocation Invocation) trampoline.Trampoline {
+				inter.reportMemory(common.MemoryKindArray, 1)
 				v.Append(invocation.Arguments[0])
 				return trampoline.Done{Result: VoidValue{}}
 			},
@@ -527,6 +555,7 @@ func (v *ArrayValue) GetMember(_ *Interpreter, _ LocationRange, name string) Val
 		return NewHostFunctionValue(
 			func(invocation Invocation) trampoline.Trampoline {
 				otherArray := invocation.Arguments[0].(ConcatenatableValue)
+				inter.reportConcatenation(v, otherArray)
 				result := v.Concat(otherArray)
 				return trampoline.Done{Result: result}
 			},
@@ -537,7 +566,14 @@ func (v *ArrayValue) GetMember(_ *Interpreter, _ LocationRange, name string) Val
 			func(invocation Invocation) trampoline.Trampoline {
 				i := invocation.Arguments[0].(NumberValue).ToInt()
 				element := invocation.Arguments[1]
+				// NOTE: elements may be inserted at the end, i.e. at index count
+				v.checkIndex(i, v.Count(), invocation.LocationRange)
+				inter.reportComputation(
+					common.ComputationKindArrayOperation,
+					v.Count()-i,
+				)
 				v.Insert(i, element)
+				inter.reportMemory(common.MemoryKindArray, 1)
 				return trampoline.Done{Result: VoidValue{}}
 			},
 		)
@@ -546,6 +582,11 @@ func (v *ArrayValue) GetMember(_ *Interpreter, _ LocationRange, name string) Val
 		return NewHostFunctionValue(
 			func(invocation Invocation) trampoline.Trampoline {
 				i := invocation.Arguments[0].(NumberValue).ToInt()
+				v.checkIndex(i, v.Count()-1, invocation.LocationRange)
+				inter.reportComputation(
+					common.ComputationKindArrayOperation,
+					v.Count()-i,
+				)
 				result := v.Remove(i)
 				return trampoline.Done{Result: result}
 			},
@@ -554,6 +595,10 @@ func (v *ArrayValue) GetMember(_ *Interpreter, _ LocationRange, name string) Val
 	case "removeFirst":
 		return NewHostFunctionValue(
 			func(invocation Invocation) trampoline.Trampoline {
+				inter.reportComputation(
+					common.ComputationKindArrayOperation,
+					v.Count(),
+				)
 				result := v.RemoveFirst()
 				return trampoline.Done{Result: result}
 			},
@@ -570,6 +615,10 @@ func (v *ArrayValue) GetMember(_ *Interpreter, _ LocationRange, name string) Val
 	case "contains":
 		return NewHostFunctionValue(
 			func(invocation Invocation) trampoline.Trampoline {
+				inter.reportComputation(
+					common.ComputationKindArrayOperation,
+					v.Count(),
+				)
 				result := v.Contains(invocation.Arguments[0])
 				return trampoline.Done{Result: result}
 			},
@@ -612,6 +661,87 @@ type BigNumberValue interface {
 	ToBigInt() *big.Int
 }
 
+// IntegerValue
+
+type IntegerValue interface {
+	NumberValue
+	BitwiseOr(other IntegerValue) IntegerValue
+	BitwiseXor(other IntegerValue) IntegerValue
+	BitwiseAnd(other IntegerValue) IntegerValue
+	BitwiseLeftShift(other IntegerValue) IntegerValue
+	BitwiseRightShift(other IntegerValue) IntegerValue
+	BitwiseNot() IntegerValue
+}
+
+// bigIntShiftAmount returns the given shift amount as an unsigned integer,
+// saturated at the given maximum.
+//
+// Shifting by a negative amount is not allowed.
+//
+func bigIntShiftAmount(amount *big.Int, max uint) uint {
+	if amount.Sign() < 0 {
+		panic(NegativeShiftError{})
+	}
+	if !amount.IsUint64() || amount.Uint64() > uint64(max) {
+		return max
+	}
+	return uint(amount.Uint64())
+}
+
+// maxBigIntLeftShiftBitLength is the maximum bit length of the result
+// of a left shift of an arbitrary-precision integer.
+//
+// The result is allocated before its memory can be metered,
+// so larger results must be rejected up front.
+//
+const maxBigIntLeftShiftBitLength = 1 << 16
+
+// bigIntLeftShift shifts the given arbitrary-precision integer left by the given amount.
+//
+// Shifting by a negative amount is not allowed,
+// and shifting non-zero values beyond maxBigIntLeftShiftBitLength bits overflows.
+//
+func bigIntLeftShift(value, amount *big.Int) *big.Int {
+	if amount.Sign() < 0 {
+		panic(NegativeShiftError{})
+	}
+
+	res := big.NewInt(0)
+	if value.Sign() == 0 {
+		return res
+	}
+
+	if !amount.IsUint64() ||
+		amount.Uint64() > maxBigIntLeftShiftBitLength ||
+		value.BitLen()+int(amount.Uint64()) > maxBigIntLeftShiftBitLength {
+
+		panic(OverflowError{})
+	}
+
+	return res.Lsh(value, uint(amount.Uint64()))
+}
+
+// truncateBigInt wraps the given integer around to the given number of bits,
+// i.e. it discards all higher bits.
+//
+// If signed is true, the result is interpreted as a two's complement signed integer.
+//
+func truncateBigInt(value *big.Int, bitSize uint, signed bool) *big.Int {
+	mask := big.NewInt(1)
+	mask.Lsh(mask, bitSize)
+	mask.Sub(mask, big.NewInt(1))
+
+	res := big.NewInt(0)
+	res.And(value, mask)
+
+	if signed && res.Bit(int(bitSize)-1) == 1 {
+		res.Sub(res, mask)
+		res.Sub(res, big.NewInt(1))
+	}
+
+	return res
+}
+
 // Int
 
 type IntValue struct {
@@ -755,6 +885,46 @@ func (v IntValue) Equal(other Value) BoolValue {
 	return cmp == 0
 }
 
+func (v IntValue) BitwiseOr(other IntegerValue) IntegerValue {
+	o := other.(IntValue)
+	res := big.NewInt(0)
+	res.Or(v.BigInt, o.BigInt)
+	return IntValue{res}
+}
+
+func (v IntValue) BitwiseXor(other IntegerValue) IntegerValue {
+	o := other.(IntValue)
+	res := big.NewInt(0)
+	res.Xor(v.BigInt, o.BigInt)
+	return IntValue{res}
+}
+
+func (v IntValue) BitwiseAnd(other IntegerValue) IntegerValue {
+	o := other.(IntValue)
+	res := big.NewInt(0)
+	res.And(v.BigInt, o.BigInt)
+	return IntValue{res}
+}
+
+func (v IntValue) BitwiseLeftShift(other IntegerValue) IntegerValue {
+	o := other.(IntValue)
+	res := bigIntLeftShift(v.BigInt, o.BigInt)
+	return IntValue{res}
+}
+
+func (v IntValue) BitwiseRightShift(other IntegerValue) IntegerValue {
+	o := other.(IntValue)
+	res := big.NewInt(0)
+	res.Rsh(v.BigInt, bigIntShiftAmount(o.BigInt, math.MaxUint64))
+	return IntValue{res}
+}
+
+func (v IntValue) BitwiseNot() IntegerValue {
+	res := big.NewInt(0)
+	res.Not(v.BigInt)
+	return IntValue{res}
+}
+
 // Int8Value
 
 type Int8Value int8
@@ -896,6 +1066,41 @@ func (v Int8Value) Equal(other Value) BoolValue {
 	return v == otherInt8
 }
 
+func (v Int8Value) BitwiseOr(other IntegerValue) IntegerValue {
+	o := other.(Int8Value)
+	return v | o
+}
+
+func (v Int8Value) BitwiseXor(other IntegerValue) IntegerValue {
+	o := other.(Int8Value)
+	return v ^ o
+}
+
+func (v Int8Value) BitwiseAnd(other IntegerValue) IntegerValue {
+	o := other.(Int8Value)
+	return v & o
+}
+
+func (v Int8Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
+	o := other.(Int8Value)
+	if o < 0 {
+		panic(NegativeShiftError{})
+	}
+	return v << o
+}
+
+func (v Int8Value) BitwiseRightShift(other IntegerValue) IntegerValue {
+	o := other.(Int8Value)
+	if o < 0 {
+		panic(NegativeShiftError{})
+	}
+	return v >> o
+}
+
+func (v Int8Value) BitwiseNot() IntegerValue {
+	return ^v
+}
+
 func ConvertInt8(value Value, _ *Interpreter) Value {
 	var res int8
 
@@ -1066,6 +1271,41 @@ func (v Int16Value) Equal(other Value) BoolValue {
 	return v == otherInt16
 }
 
+func (v Int16Value) BitwiseOr(other IntegerValue) IntegerValue {
+	o := other.(Int16Value)
+	return v | o
+}
+
+func (v Int16Value) BitwiseXor(other IntegerValue) IntegerValue {
+	o := other.(Int16Value)
+	return v ^ o
+}
+
+func (v Int16Value) BitwiseAnd(other IntegerValue) IntegerValue {
+	o := other.(Int16Value)
+	return v & o
+}
+
+func (v Int16Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
+	o := other.(Int16Value)
+	if o < 0 {
+		panic(NegativeShiftError{})
+	}
+	return v << o
+}
+
+func (v Int16Value) BitwiseRightShift(other IntegerValue) IntegerValue {
+	o := other.(Int16Value)
+	if o < 0 {
+		panic(NegativeShiftError{})
+	}
+	return v >> o
+}
+
+func (v Int16Value) BitwiseNot() IntegerValue {
+	return ^v
+}
+
 func ConvertInt16(value Value, _ *Interpreter) Value {
 	var res int16
 
@@ -1236,6 +1476,41 @@ func (v Int32Value) Equal(other Value) BoolValue {
 	return v == otherInt32
 }
 
+func (v Int32Value) BitwiseOr(other IntegerValue) IntegerValue {
+	o := other.(Int32Value)
+	return v | o
+}
+
+func (v Int32Value) BitwiseXor(other IntegerValue) IntegerValue {
+	o := other.(Int32Value)
+	return v ^ o
+}
+
+func (v Int32Value) BitwiseAnd(other IntegerValue) IntegerValue {
+	o := other.(Int32Value)
+	return v & o
+}
+
+func (v Int32Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
+	o := other.(Int32Value)
+	if o < 0 {
+		panic(NegativeShiftError{})
+	}
+	return v << o
+}
+
+func (v Int32Value) BitwiseRightShift(other IntegerValue) IntegerValue {
+	o := other.(Int32Value)
+	if o < 0 {
+		panic(NegativeShiftError{})
+	}
+	return v >> o
+}
+
+func (v Int32Value) BitwiseNot() IntegerValue {
+	return ^v
+}
+
 func ConvertInt32(value Value, _ *Interpreter) Value {
 	var res int32
 
@@ -1410,6 +1685,41 @@ func (v Int64Value) Equal(other Value) BoolValue {
 	return v == otherInt64
 }
 
+func (v Int64Value) BitwiseOr(other IntegerValue) IntegerValue {
+	o := other.(Int64Value)
+	return v | o
+}
+
+func (v Int64Value) BitwiseXor(other IntegerValue) IntegerValue {
+	o := other.(Int64Value)
+	return v ^ o
+}
+
+func (v Int64Value) BitwiseAnd(other IntegerValue) IntegerValue {
+	o := other.(Int64Value)
+	return v & o
+}
+
+func (v Int64Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
+	o := other.(Int64Value)
+	if o < 0 {
+		panic(NegativeShiftError{})
+	}
+	return v << o
+}
+
+func (v Int64Value) BitwiseRightShift(other IntegerValue) IntegerValue {
+	o := other.(Int64Value)
+	if o < 0 {
+		panic(NegativeShiftError{})
+	}
+	return v >> o
+}
+
+func (v Int64Value) BitwiseNot() IntegerValue {
+	return ^v
+}
+
 func ConvertInt64(value Value, _ *Interpreter) Value {
 	var res int64
 
@@ -1624,6 +1934,47 @@ func (v Int128Value) Equal(other Value) BoolValue {
 	return cmp == 0
 }
 
+func (v Int128Value) BitwiseOr(other IntegerValue) IntegerValue {
+	o := other.(Int128Value)
+	res := big.NewInt(0)
+	res.Or(v.BigInt, o.BigInt)
+	return Int128Value{res}
+}
+
+func (v Int128Value) BitwiseXor(other IntegerValue) IntegerValue {
+	o := other.(Int128Value)
+	res := big.NewInt(0)
+	res.Xor(v.BigInt, o.BigInt)
+	return Int128Value{res}
+}
+
+func (v Int128Value) BitwiseAnd(other IntegerValue) IntegerValue {
+	o := other.(Int128Value)
+	res := big.NewInt(0)
+	res.And(v.BigInt, o.BigInt)
+	return Int128Value{res}
+}
+
+func (v Int128Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
+	o := other.(Int128Value)
+	res := big.NewInt(0)
+	res.Lsh(v.BigInt, bigIntShiftAmount(o.BigInt, 128))
+	return Int128Value{truncateBigInt(res, 128, true)}
+}
+
+func (v Int128Value) BitwiseRightShift(other IntegerValue) IntegerValue {
+	o := other.(Int128Value)
+	res := big.NewInt(0)
+	res.Rsh(v.BigInt, bigIntShiftAmount(o.BigInt, 128))
+	return Int128Value{res}
+}
+
+func (v Int128Value) BitwiseNot() IntegerValue {
+	res := big.NewInt(0)
+	res.Not(v.BigInt)
+	return Int128Value{res}
+}
+
 func ConvertInt128(value Value, _ *Interpreter) Value {
 	var v *big.Int
 
@@ -1832,6 +2183,47 @@ func (v Int256Value) Equal(other Value) BoolValue {
 	return cmp == 0
 }
 
+func (v Int256Value) BitwiseOr(other IntegerValue) IntegerValue {
+	o := other.(Int256Value)
+	res := big.NewInt(0)
+	res.Or(v.BigInt, o.BigInt)
+	return Int256Value{res}
+}
+
+func (v Int256Value) BitwiseXor(other IntegerValue) IntegerValue {
+	o := other.(Int256Value)
+	res := big.NewInt(0)
+	res.Xor(v.BigInt, o.BigInt)
+	return Int256Value{res}
+}
+
+func (v Int256Value) BitwiseAnd(other IntegerValue) IntegerValue {
+	o := other.(Int256Value)
+	res := big.NewInt(0)
+	res.And(v.BigInt, o.BigInt)
+	return Int256Value{res}
+}
+
+func (v Int256Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
+	o := other.(Int256Value)
+	res := big.NewInt(0)
+	res.Lsh(v.BigInt, bigIntShiftAmount(o.BigInt, 256))
+	return Int256Value{truncateBigInt(res, 256, true)}
+}
+
+func (v Int256Value) BitwiseRightShift(other IntegerValue) IntegerValue {
+	o := other.(Int256Value)
+	res := big.NewInt(0)
+	res.Rsh(v.BigInt, bigIntShiftAmount(o.BigInt, 256))
+	return Int256Value{res}
+}
+
+func (v Int256Value) BitwiseNot() IntegerValue {
+	res := big.NewInt(0)
+	res.Not(v.BigInt)
+	return Int256Value{res}
+}
+
 func ConvertInt256(value Value, _ *Interpreter) Value {
 	var v *big.Int
 
@@ -2009,6 +2401,46 @@ func (v UIntValue) Equal(other Value) BoolValue {
 	return cmp == 0
 }
 
+func (v UIntValue) BitwiseOr(other IntegerValue) IntegerValue {
+	o := other.(UIntValue)
+	res := big.NewInt(0)
+	res.Or(v.BigInt, o.BigInt)
+	return UIntValue{res}
+}
+
+func (v UIntValue) BitwiseXor(other IntegerValue) IntegerValue {
+	o := other.(UIntValue)
+	res := big.NewInt(0)
+	res.Xor(v.BigInt, o.BigInt)
+	return UIntValue{res}
+}
+
+func (v UIntValue) BitwiseAnd(other IntegerValue) IntegerValue {
+	o := other.(UIntValue)
+	res := big.NewInt(0)
+	res.And(v.BigInt, o.BigInt)
+	return UIntValue{res}
+}
+
+func (v UIntValue) BitwiseLeftShift(other IntegerValue) IntegerValue {
+	o := other.(UIntValue)
+	res := bigIntLeftShift(v.BigInt, o.BigInt)
+	return UIntValue{res}
+}
+
+func (v UIntValue) BitwiseRightShift(other IntegerValue) IntegerValue {
+	o := other.(UIntValue)
+	res := big.NewInt(0)
+	res.Rsh(v.BigInt, bigIntShiftAmount(o.BigInt, math.MaxUint64))
+	return UIntValue{res}
+}
+
+func (v UIntValue) BitwiseNot() IntegerValue {
+	// The value is unbounded, so the complement
+	// of any value would be negative
+	panic(UnderflowError{})
+}
+
 // UInt8Value
 
 type UInt8Value uint8
@@ -2118,6 +2550,35 @@ func (v UInt8Value) Equal(other Value) BoolValue {
 	return v == otherUInt8
 }
 
+func (v UInt8Value) BitwiseOr(other IntegerValue) IntegerValue {
+	o := other.(UInt8Value)
+	return v | o
+}
+
+func (v UInt8Value) BitwiseXor(other IntegerValue) IntegerValue {
+	o := other.(UInt8Value)
+	return v ^ o
+}
+
+func (v UInt8Value) BitwiseAnd(other IntegerValue) IntegerValue {
+	o := other.(UInt8Value)
+	return v & o
+}
+
+func (v UInt8Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
+	o := other.(UInt8Value)
+	return v << o
+}
+
+func (v UInt8Value) BitwiseRightShift(other IntegerValue) IntegerValue {
+	o := other.(UInt8Value)
+	return v >> o
+}
+
+func (v UInt8Value) BitwiseNot() IntegerValue {
+	return ^v
+}
+
 func ConvertUInt8(value Value, _ *Interpreter) Value {
 	var res uint8
 
@@ -2254,6 +2715,35 @@ func (v UInt16Value) Equal(other Value) BoolValue {
 	return v == otherUInt16
 }
 
+func (v UInt16Value) BitwiseOr(other IntegerValue) IntegerValue {
+	o := other.(UInt16Value)
+	return v | o
+}
+
+func (v UInt16Value) BitwiseXor(other IntegerValue) IntegerValue {
+	o := other.(UInt16Value)
+	return v ^ o
+}
+
+func (v UInt16Value) BitwiseAnd(other IntegerValue) IntegerValue {
+	o := other.(UInt16Value)
+	return v & o
+}
+
+func (v UInt16Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
+	o := other.(UInt16Value)
+	return v << o
+}
+
+func (v UInt16Value) BitwiseRightShift(other IntegerValue) IntegerValue {
+	o := other.(UInt16Value)
+	return v >> o
+}
+
+func (v UInt16Value) BitwiseNot() IntegerValue {
+	return ^v
+}
+
 func ConvertUInt16(value Value, _ *Interpreter) Value {
 	var res uint16
 
@@ -2392,14 +2882,43 @@ func (v UInt32Value) Equal(other Value) BoolValue {
 	return v == otherUInt32
 }
 
-func ConvertUInt32(value Value, _ *Interpreter) Value {
-	var res uint32
+func (v UInt32Value) BitwiseOr(other IntegerValue) IntegerValue {
+	o := other.(UInt32Value)
+	return v | o
+}
 
-	switch value := value.(type) {
-	case BigNumberValue:
-		v := value.ToBigInt()
-		if v.Cmp(sema.UInt32TypeMaxInt) > 0 {
-			panic(OverflowError{})
+func (v UInt32Value) BitwiseXor(other IntegerValue) IntegerValue {
+	o := other.(UInt32Value)
+	return v ^ o
+}
+
+func (v UInt32Value) BitwiseAnd(other IntegerValue) IntegerValue {
+	o := other.(UInt32Value)
+	return v & o
+}
+
+func (v UInt32Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
+	o := other.(UInt32Value)
+	return v << o
+}
+
+func (v UInt32Value) BitwiseRightShift(other IntegerValue) IntegerValue {
+	o := other.(UInt32Value)
+	return v >> o
+}
+
+func (v UInt32Value) BitwiseNot() IntegerValue {
+	return ^v
+}
+
+func ConvertUInt32(value Value, _ *Interpreter) Value {
+	var res uint32
+
+	switch value := value.(type) {
+	case BigNumberValue:
+		v := value.ToBigInt()
+		if v.Cmp(sema.UInt32TypeMaxInt) > 0 {
+			panic(OverflowError{})
 		} else if v.Sign() < 0 {
 			panic(UnderflowError{})
 		}
@@ -2535,6 +3054,35 @@ func (v UInt64Value) Equal(other Value) BoolValue {
 	return v == otherUInt64
 }
 
+func (v UInt64Value) BitwiseOr(other IntegerValue) IntegerValue {
+	o := other.(UInt64Value)
+	return v | o
+}
+
+func (v UInt64Value) BitwiseXor(other IntegerValue) IntegerValue {
+	o := other.(UInt64Value)
+	return v ^ o
+}
+
+func (v UInt64Value) BitwiseAnd(other IntegerValue) IntegerValue {
+	o := other.(UInt64Value)
+	return v & o
+}
+
+func (v UInt64Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
+	o := other.(UInt64Value)
+	return v << o
+}
+
+func (v UInt64Value) BitwiseRightShift(other IntegerValue) IntegerValue {
+	o := other.(UInt64Value)
+	return v >> o
+}
+
+func (v UInt64Value) BitwiseNot() IntegerValue {
+	return ^v
+}
+
 func ConvertUInt64(value Value, _ *Interpreter) Value {
 	var res uint64
 
@@ -2717,6 +3265,47 @@ func (v UInt128Value) Equal(other Value) BoolValue {
 	return cmp == 0
 }
 
+func (v UInt128Value) BitwiseOr(other IntegerValue) IntegerValue {
+	o := other.(UInt128Value)
+	res := big.NewInt(0)
+	res.Or(v.BigInt, o.BigInt)
+	return UInt128Value{res}
+}
+
+func (v UInt128Value) BitwiseXor(other IntegerValue) IntegerValue {
+	o := other.(UInt128Value)
+	res := big.NewInt(0)
+	res.Xor(v.BigInt, o.BigInt)
+	return UInt128Value{res}
+}
+
+func (v UInt128Value) BitwiseAnd(other IntegerValue) IntegerValue {
+	o := other.(UInt128Value)
+	res := big.NewInt(0)
+	res.And(v.BigInt, o.BigInt)
+	return UInt128Value{res}
+}
+
+func (v UInt128Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
+	o := other.(UInt128Value)
+	res := big.NewInt(0)
+	res.Lsh(v.BigInt, bigIntShiftAmount(o.BigInt, 128))
+	return UInt128Value{truncateBigInt(res, 128, false)}
+}
+
+func (v UInt128Value) BitwiseRightShift(other IntegerValue) IntegerValue {
+	o := other.(UInt128Value)
+	res := big.NewInt(0)
+	res.Rsh(v.BigInt, bigIntShiftAmount(o.BigInt, 128))
+	return UInt128Value{res}
+}
+
+func (v UInt128Value) BitwiseNot() IntegerValue {
+	res := big.NewInt(0)
+	res.Xor(v.BigInt, sema.UInt128TypeMaxInt)
+	return UInt128Value{res}
+}
+
 func ConvertUInt128(value Value, _ *Interpreter) Value {
 	var v *big.Int
 
@@ -2895,6 +3484,47 @@ func (v UInt256Value) Equal(other Value) BoolValue {
 	return cmp == 0
 }
 
+func (v UInt256Value) BitwiseOr(other IntegerValue) IntegerValue {
+	o := other.(UInt256Value)
+	res := big.NewInt(0)
+	res.Or(v.BigInt, o.BigInt)
+	return UInt256Value{res}
+}
+
+func (v UInt256Value) BitwiseXor(other IntegerValue) IntegerValue {
+	o := other.(UInt256Value)
+	res := big.NewInt(0)
+	res.Xor(v.BigInt, o.BigInt)
+	return UInt256Value{res}
+}
+
+func (v UInt256Value) BitwiseAnd(other IntegerValue) IntegerValue {
+	o := other.(UInt256Value)
+	res := big.NewInt(0)
+	res.And(v.BigInt, o.BigInt)
+	return UInt256Value{res}
+}
+
+func (v UInt256Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
+	o := other.(UInt256Value)
+	res := big.NewInt(0)
+	res.Lsh(v.BigInt, bigIntShiftAmount(o.BigInt, 256))
+	return UInt256Value{truncateBigInt(res, 256, false)}
+}
+
+func (v UInt256Value) BitwiseRightShift(other IntegerValue) IntegerValue {
+	o := other.(UInt256Value)
+	res := big.NewInt(0)
+	res.Rsh(v.BigInt, bigIntShiftAmount(o.BigInt, 256))
+	return UInt256Value{res}
+}
+
+func (v UInt256Value) BitwiseNot() IntegerValue {
+	res := big.NewInt(0)
+	res.Xor(v.BigInt, sema.UInt256TypeMaxInt)
+	return UInt256Value{res}
+}
+
 func ConvertUInt256(value Value, _ *Interpreter) Value {
 	var v *big.Int
 
@@ -3013,6 +3643,35 @@ func (v Word8Value) Equal(other Value) BoolValue {
 	return v == otherWord8
 }
 
+func (v Word8Value) BitwiseOr(other IntegerValue) IntegerValue {
+	o := other.(Word8Value)
+	return v | o
+}
+
+func (v Word8Value) BitwiseXor(other IntegerValue) IntegerValue {
+	o := other.(Word8Value)
+	return v ^ o
+}
+
+func (v Word8Value) BitwiseAnd(other IntegerValue) IntegerValue {
+	o := other.(Word8Value)
+	return v & o
+}
+
+func (v Word8Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
+	o := other.(Word8Value)
+	return v << o
+}
+
+func (v Word8Value) BitwiseRightShift(other IntegerValue) IntegerValue {
+	o := other.(Word8Value)
+	return v >> o
+}
+
+func (v Word8Value) BitwiseNot() IntegerValue {
+	return ^v
+}
+
 func ConvertWord8(value Value, interpreter *Interpreter) Value {
 	return Word8Value(ConvertUInt8(value, interpreter).(UInt8Value))
 }
@@ -3110,6 +3769,35 @@ func (v Word16Value) Equal(other Value) BoolValue {
 	return v == otherWord16
 }
 
+func (v Word16Value) BitwiseOr(other IntegerValue) IntegerValue {
+	o := other.(Word16Value)
+	return v | o
+}
+
+func (v Word16Value) BitwiseXor(other IntegerValue) IntegerValue {
+	o := other.(Word16Value)
+	return v ^ o
+}
+
+func (v Word16Value) BitwiseAnd(other IntegerValue) IntegerValue {
+	o := other.(Word16Value)
+	return v & o
+}
+
+func (v Word16Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
+	o := other.(Word16Value)
+	return v << o
+}
+
+func (v Word16Value) BitwiseRightShift(other IntegerValue) IntegerValue {
+	o := other.(Word16Value)
+	return v >> o
+}
+
+func (v Word16Value) BitwiseNot() IntegerValue {
+	return ^v
+}
+
 func ConvertWord16(value Value, interpreter *Interpreter) Value {
 	return Word16Value(ConvertUInt16(value, interpreter).(UInt16Value))
 }
@@ -3209,6 +3897,35 @@ func (v Word32Value) Equal(other Value) BoolValue {
 	return v == otherWord32
 }
 
+func (v Word32Value) BitwiseOr(other IntegerValue) IntegerValue {
+	o := other.(Word32Value)
+	return v | o
+}
+
+func (v Word32Value) BitwiseXor(other IntegerValue) IntegerValue {
+	o := other.(Word32Value)
+	return v ^ o
+}
+
+func (v Word32Value) BitwiseAnd(other IntegerValue) IntegerValue {
+	o := other.(Word32Value)
+	return v & o
+}
+
+func (v Word32Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
+	o := other.(Word32Value)
+	return v << o
+}
+
+func (v Word32Value) BitwiseRightShift(other IntegerValue) IntegerValue {
+	o := other.(Word32Value)
+	return v >> o
+}
+
+func (v Word32Value) BitwiseNot() IntegerValue {
+	return ^v
+}
+
 func ConvertWord32(value Value, interpreter *Interpreter) Value {
 	return Word32Value(ConvertUInt32(value, interpreter).(UInt32Value))
 }
@@ -3308,6 +4025,35 @@ func (v Word64Value) Equal(other Value) BoolValue {
 	return v == otherWord64
 }
 
+func (v Word64Value) BitwiseOr(other IntegerValue) IntegerValue {
+	o := other.(Word64Value)
+	return v | o
+}
+
+func (v Word64Value) BitwiseXor(other IntegerValue) IntegerValue {
+	o := other.(Word64Value)
+	return v ^ o
+}
+
+func (v Word64Value) BitwiseAnd(other IntegerValue) IntegerValue {
+	o := other.(Word64Value)
+	return v & o
+}
+
+func (v Word64Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
+	o := other.(Word64Value)
+	return v << o
+}
+
+func (v Word64Value) BitwiseRightShift(other IntegerValue) IntegerValue {
+	o := other.(Word64Value)
+	return v >> o
+}
+
+func (v Word64Value) BitwiseNot() IntegerValue {
+	return ^v
+}
+
 func ConvertWord64(value Value, interpreter *Interpreter) Value {
 	return Word64Value(ConvertUInt64(value, interpreter).(UInt64Value))
 }
@@ -3723,7 +4469,7 @@ func (v *CompositeValue) Destroy(interpreter *Interpreter, locationRange Locatio
 func (*CompositeValue) IsValue() {}
 
 func (v *CompositeValue) DynamicType(interpreter *Interpreter) DynamicType {
-	staticType := interpreter.getCompositeType(v.Location, v.TypeID)
+	staticType := interpreter.GetCompositeType(v.Location, v.TypeID)
 	return CompositeDynamicType{
 		StaticType: staticType,
 	}
@@ -4155,22 +4901,34 @@ func (v *DictionaryValue) String() string {
 	return builder.String()
 }
 
-func (v *DictionaryValue) GetMember(_ *Interpreter, _ LocationRange, name string) Value {
+func (v *DictionaryValue) GetMember(inter *Interpreter, _ LocationRange, name string) Value {
 	switch name {
 	case "length":
 		return NewIntValueFromInt64(int64(v.Count()))
 
 	// TODO: is returning copies correct?
 	case "keys":
-		return v.Keys.Copy()
+		inter.reportComputation(
+			common.ComputationKindDictionaryOperation,
+			v.Count(),
+		)
+		return inter.copyValue(v.Keys)
 
 	// TODO: is returning copies correct?
 	case "values":
+		inter.reportComputation(
+			common.ComputationKindDictionaryOperation,
+			v.Count(),
+		)
+		inter.reportMemory(
+			common.MemoryKindArray,
+			v.Count(),
+		)
 		dictionaryValues := make([]Value, v.Count())
 		i := 0
 		for _, keyValue := range v.Keys.Values {
 			key := dictionaryKey(keyValue)
-			dictionaryValues[i] = v.Entries[key].Copy()
+			dictionaryValues[i] = inter.copyValue(v.Entries[key])
 			i++
 		}
 		return NewArrayValueUnownedNonCopying(dictionaryValues...)
@@ -4180,6 +4938,12 @@ func (v *DictionaryValue) GetMember(_ *Interpreter, _ LocationRange, name string
 			func(invocation Invocation) trampoline.Trampoline {
 				keyValue := invocation.Arguments[0]
 
+				// NOTE: removal scans the keys
+				inter.reportComputation(
+					common.ComputationKindDictionaryOperation,
+					v.Count(),
+				)
+
 				existingValue := v.Remove(keyValue)
 
 				var returnValue Value
@@ -4201,6 +4965,15 @@ func (v *DictionaryValue) GetMember(_ *Interpreter, _ LocationRange, name string
 				keyValue := invocation.Arguments[0]
 				newValue := invocation.Arguments[1]
 
+				inter.reportComputation(
+					common.ComputationKindDictionaryOperation,
+					1,
+				)
+				inter.reportMemory(
+					common.MemoryKindDictionary,
+					1,
+				)
+
 				existingValue := v.Insert(keyValue, newValue)
 
 				var returnValue Value
@@ -4774,17 +5547,23 @@ type AuthAccountValue struct {
 	setCodeFunction         FunctionValue
 	addPublicKeyFunction    FunctionValue
 	removePublicKeyFunction FunctionValue
+	contracts               Value
+	keys                    Value
 }
 
 func NewAuthAccountValue(
 	address AddressValue,
 	setCodeFunction, addPublicKeyFunction, removePublicKeyFunction FunctionValue,
+	contracts Value,
+	keys Value,
 ) AuthAccountValue {
 	return AuthAccountValue{
 		Address:                 address,
 		setCodeFunction:         setCodeFunction,
 		addPublicKeyFunction:    addPublicKeyFunction,
 		removePublicKeyFunction: removePublicKeyFunction,
+		contracts:               contracts,
+		keys:                    keys,
 	}
 }
 
@@ -4858,9 +5637,20 @@ func accountGetCapabilityFunction(
 			}
 		}
 
+		// The type argument, if any, is the borrow type of the capability
+
+		var borrowType StaticType
+		for _, typeParameterType := range invocation.TypeParameterTypes {
+			if typeParameterType != nil {
+				borrowType = ConvertSemaToStaticType(typeParameterType)
+			}
+			break
+		}
+
 		capability := CapabilityValue{
-			Address: addressValue,
-			Path:    path,
+			Address:    addressValue,
+			Path:       path,
+			BorrowType: borrowType,
 		}
 
 		result := NewSomeValueOwningNonCopying(capability)
@@ -4874,6 +5664,18 @@ func (v AuthAccountValue) GetMember(inter *Interpreter, _ LocationRange, name st
 	case "address":
 		return v.Address
 
+	case "contracts":
+		return v.contracts
+
+	case "keys":
+		return v.keys
+
+	case "storageUsed":
+		return UInt64Value(inter.storageUsed(v.Address.ToAddress()))
+
+	case "storageCapacity":
+		return UInt64Value(inter.storageCapacity(v.Address.ToAddress()))
+
 	case "setCode":
 		return v.setCodeFunction
 
@@ -4907,6 +5709,18 @@ func (v AuthAccountValue) GetMember(inter *Interpreter, _ LocationRange, name st
 	case "getCapability":
 		return accountGetCapabilityFunction(v.Address, true)
 
+	case "storagePaths":
+		return inter.authAccountPathsValue(v.Address, common.PathDomainStorage)
+
+	case "publicPaths":
+		return inter.authAccountPathsValue(v.Address, common.PathDomainPublic)
+
+	case "privatePaths":
+		return inter.authAccountPathsValue(v.Address, common.PathDomainPrivate)
+
+	case "forEachStored":
+		return inter.authAccountForEachStoredFunction(v.Address)
+
 	default:
 		panic(errors.NewUnreachableError())
 	}
@@ -4916,16 +5730,361 @@ func (AuthAccountValue) SetMember(_ *Interpreter, _ LocationRange, _ string, _ V
 	panic(errors.NewUnreachableError())
 }
 
+// AuthAccountContractsValue
+
+type AuthAccountContractsValue struct {
+	Address        AddressValue
+	addFunction    FunctionValue
+	updateFunction FunctionValue
+	getFunction    FunctionValue
+	removeFunction FunctionValue
+}
+
+func NewAuthAccountContractsValue(
+	address AddressValue,
+	addFunction, updateFunction, getFunction, removeFunction FunctionValue,
+) AuthAccountContractsValue {
+	return AuthAccountContractsValue{
+		Address:        address,
+		addFunction:    addFunction,
+		updateFunction: updateFunction,
+		getFunction:    getFunction,
+		removeFunction: removeFunction,
+	}
+}
+
+func (AuthAccountContractsValue) IsValue() {}
+
+func (AuthAccountContractsValue) DynamicType(_ *Interpreter) DynamicType {
+	return AuthAccountContractsDynamicType{}
+}
+
+func (v AuthAccountContractsValue) Copy() Value {
+	return v
+}
+
+func (AuthAccountContractsValue) GetOwner() *common.Address {
+	// value is never owned
+	return nil
+}
+
+func (AuthAccountContractsValue) SetOwner(_ *common.Address) {
+	// NO-OP: value cannot be owned
+}
+
+func (v AuthAccountContractsValue) String() string {
+	return fmt.Sprintf("AuthAccount.Contracts(%s)", v.Address)
+}
+
+func (v AuthAccountContractsValue) GetMember(_ *Interpreter, _ LocationRange, name string) Value {
+	switch name {
+	case "add":
+		return v.addFunction
+
+	case "update":
+		return v.updateFunction
+
+	case "get":
+		return v.getFunction
+
+	case "remove":
+		return v.removeFunction
+
+	default:
+		panic(errors.NewUnreachableError())
+	}
+}
+
+func (AuthAccountContractsValue) SetMember(_ *Interpreter, _ LocationRange, _ string, _ Value) {
+	panic(errors.NewUnreachableError())
+}
+
+// DeployedContractValue
+
+type DeployedContractValue struct {
+	Address AddressValue
+	Name    *StringValue
+	Code    *ArrayValue
+}
+
+func NewDeployedContractValue(address AddressValue, name string, code []byte) DeployedContractValue {
+	return DeployedContractValue{
+		Address: address,
+		Name:    NewStringValue(name),
+		Code:    ByteSliceToByteArrayValue(code),
+	}
+}
+
+func (DeployedContractValue) IsValue() {}
+
+func (DeployedContractValue) DynamicType(_ *Interpreter) DynamicType {
+	return DeployedContractDynamicType{}
+}
+
+func (v DeployedContractValue) Copy() Value {
+	return DeployedContractValue{
+		Address: v.Address,
+		Name:    v.Name.Copy().(*StringValue),
+		Code:    v.Code.Copy().(*ArrayValue),
+	}
+}
+
+func (DeployedContractValue) GetOwner() *common.Address {
+	// value is never owned
+	return nil
+}
+
+func (DeployedContractValue) SetOwner(_ *common.Address) {
+	// NO-OP: value cannot be owned
+}
+
+func (v DeployedContractValue) String() string {
+	return fmt.Sprintf("DeployedContract(address: %s, name: %s)", v.Address, v.Name)
+}
+
+func (v DeployedContractValue) GetMember(_ *Interpreter, _ LocationRange, name string) Value {
+	switch name {
+	case "address":
+		return v.Address
+
+	case "name":
+		return v.Name
+
+	case "code":
+		return v.Code
+
+	default:
+		panic(errors.NewUnreachableError())
+	}
+}
+
+func (DeployedContractValue) SetMember(_ *Interpreter, _ LocationRange, _ string, _ Value) {
+	panic(errors.NewUnreachableError())
+}
+
+// AuthAccountKeysValue
+
+type AuthAccountKeysValue struct {
+	Address        AddressValue
+	addFunction    FunctionValue
+	getFunction    FunctionValue
+	revokeFunction FunctionValue
+}
+
+func NewAuthAccountKeysValue(
+	address AddressValue,
+	addFunction, getFunction, revokeFunction FunctionValue,
+) AuthAccountKeysValue {
+	return AuthAccountKeysValue{
+		Address:        address,
+		addFunction:    addFunction,
+		getFunction:    getFunction,
+		revokeFunction: revokeFunction,
+	}
+}
+
+func (AuthAccountKeysValue) IsValue() {}
+
+func (AuthAccountKeysValue) DynamicType(_ *Interpreter) DynamicType {
+	return AuthAccountKeysDynamicType{}
+}
+
+func (v AuthAccountKeysValue) Copy() Value {
+	return v
+}
+
+func (AuthAccountKeysValue) GetOwner() *common.Address {
+	// value is never owned
+	return nil
+}
+
+func (AuthAccountKeysValue) SetOwner(_ *common.Address) {
+	// NO-OP: value cannot be owned
+}
+
+func (v AuthAccountKeysValue) String() string {
+	return fmt.Sprintf("AuthAccount.Keys(%s)", v.Address)
+}
+
+func (v AuthAccountKeysValue) GetMember(_ *Interpreter, _ LocationRange, name string) Value {
+	switch name {
+	case "add":
+		return v.addFunction
+
+	case "get":
+		return v.getFunction
+
+	case "revoke":
+		return v.revokeFunction
+
+	default:
+		panic(errors.NewUnreachableError())
+	}
+}
+
+func (AuthAccountKeysValue) SetMember(_ *Interpreter, _ LocationRange, _ string, _ Value) {
+	panic(errors.NewUnreachableError())
+}
+
+// PublicAccountKeysValue
+
+type PublicAccountKeysValue struct {
+	Address     AddressValue
+	getFunction FunctionValue
+}
+
+func NewPublicAccountKeysValue(
+	address AddressValue,
+	getFunction FunctionValue,
+) PublicAccountKeysValue {
+	return PublicAccountKeysValue{
+		Address:     address,
+		getFunction: getFunction,
+	}
+}
+
+func (PublicAccountKeysValue) IsValue() {}
+
+func (PublicAccountKeysValue) DynamicType(_ *Interpreter) DynamicType {
+	return PublicAccountKeysDynamicType{}
+}
+
+func (v PublicAccountKeysValue) Copy() Value {
+	return v
+}
+
+func (PublicAccountKeysValue) GetOwner() *common.Address {
+	// value is never owned
+	return nil
+}
+
+func (PublicAccountKeysValue) SetOwner(_ *common.Address) {
+	// NO-OP: value cannot be owned
+}
+
+func (v PublicAccountKeysValue) String() string {
+	return fmt.Sprintf("PublicAccount.Keys(%s)", v.Address)
+}
+
+func (v PublicAccountKeysValue) GetMember(_ *Interpreter, _ LocationRange, name string) Value {
+	switch name {
+	case "get":
+		return v.getFunction
+
+	default:
+		panic(errors.NewUnreachableError())
+	}
+}
+
+func (PublicAccountKeysValue) SetMember(_ *Interpreter, _ LocationRange, _ string, _ Value) {
+	panic(errors.NewUnreachableError())
+}
+
+// AccountKeyValue
+
+type AccountKeyValue struct {
+	KeyIndex           IntValue
+	PublicKey          *ArrayValue
+	SignatureAlgorithm *CompositeValue
+	HashAlgorithm      *CompositeValue
+	Weight             UFix64Value
+	IsRevoked          BoolValue
+}
+
+func NewAccountKeyValue(
+	keyIndex IntValue,
+	publicKey *ArrayValue,
+	signatureAlgorithm *CompositeValue,
+	hashAlgorithm *CompositeValue,
+	weight UFix64Value,
+	isRevoked BoolValue,
+) AccountKeyValue {
+	return AccountKeyValue{
+		KeyIndex:           keyIndex,
+		PublicKey:          publicKey,
+		SignatureAlgorithm: signatureAlgorithm,
+		HashAlgorithm:      hashAlgorithm,
+		Weight:             weight,
+		IsRevoked:          isRevoked,
+	}
+}
+
+func (AccountKeyValue) IsValue() {}
+
+func (AccountKeyValue) DynamicType(_ *Interpreter) DynamicType {
+	return AccountKeyDynamicType{}
+}
+
+func (v AccountKeyValue) Copy() Value {
+	return AccountKeyValue{
+		KeyIndex:           v.KeyIndex,
+		PublicKey:          v.PublicKey.Copy().(*ArrayValue),
+		SignatureAlgorithm: v.SignatureAlgorithm.Copy().(*CompositeValue),
+		HashAlgorithm:      v.HashAlgorithm.Copy().(*CompositeValue),
+		Weight:             v.Weight,
+		IsRevoked:          v.IsRevoked,
+	}
+}
+
+func (AccountKeyValue) GetOwner() *common.Address {
+	// value is never owned
+	return nil
+}
+
+func (AccountKeyValue) SetOwner(_ *common.Address) {
+	// NO-OP: value cannot be owned
+}
+
+func (v AccountKeyValue) String() string {
+	return fmt.Sprintf(
+		"AccountKey(keyIndex: %s, weight: %s, isRevoked: %s)",
+		v.KeyIndex,
+		v.Weight,
+		v.IsRevoked,
+	)
+}
+
+func (v AccountKeyValue) GetMember(_ *Interpreter, _ LocationRange, name string) Value {
+	switch name {
+	case "keyIndex":
+		return v.KeyIndex
+
+	case "publicKey":
+		return v.PublicKey
+
+	case "signatureAlgorithm":
+		return v.SignatureAlgorithm
+
+	case "hashAlgorithm":
+		return v.HashAlgorithm
+
+	case "weight":
+		return v.Weight
+
+	case "isRevoked":
+		return v.IsRevoked
+
+	default:
+		panic(errors.NewUnreachableError())
+	}
+}
+
+func (AccountKeyValue) SetMember(_ *Interpreter, _ LocationRange, _ string, _ Value) {
+	panic(errors.NewUnreachableError())
+}
+
 // PublicAccountValue
 
 type PublicAccountValue struct {
 	Address    AddressValue
 	Identifier string
+	keys       Value
 }
 
-func NewPublicAccountValue(address AddressValue) PublicAccountValue {
+func NewPublicAccountValue(address AddressValue, keys Value) PublicAccountValue {
 	return PublicAccountValue{
 		Address: address,
+		keys:    keys,
 	}
 }
 
@@ -4942,7 +6101,7 @@ func (v PublicAccountValue) AddressValue() AddressValue {
 }
 
 func (PublicAccountValue) DynamicType(_ *Interpreter) DynamicType {
-	return AuthAccountDynamicType{}
+	return PublicAccountDynamicType{}
 }
 
 func (v PublicAccountValue) Copy() Value {
@@ -4971,6 +6130,15 @@ func (v PublicAccountValue) GetMember(inter *Interpreter, _ LocationRange, name
 	case "address":
 		return v.Address
 
+	case "keys":
+		return v.keys
+
+	case "storageUsed":
+		return UInt64Value(inter.storageUsed(v.Address.ToAddress()))
+
+	case "storageCapacity":
+		return UInt64Value(inter.storageCapacity(v.Address.ToAddress()))
+
 	case "getCapability":
 		return accountGetCapabilityFunction(v.Address, false)
 
@@ -5033,6 +6201,8 @@ func (v PathValue) String() string {
 type CapabilityValue struct {
 	Address AddressValue
 	Path    PathValue
+	// BorrowType is the reference type the capability was created with, if any
+	BorrowType StaticType
 }
 
 func init() {
@@ -5041,8 +6211,15 @@ func init() {
 
 func (CapabilityValue) IsValue() {}
 
-func (CapabilityValue) DynamicType(_ *Interpreter) DynamicType {
-	return CapabilityDynamicType{}
+func (v CapabilityValue) DynamicType(inter *Interpreter) DynamicType {
+	var borrowType sema.Type
+	if v.BorrowType != nil {
+		borrowType = inter.ConvertStaticToSemaType(v.BorrowType)
+	}
+
+	return CapabilityDynamicType{
+		BorrowType: borrowType,
+	}
 }
 
 func (v CapabilityValue) Copy() Value {
@@ -5073,10 +6250,10 @@ func (v CapabilityValue) String() string {
 func (v CapabilityValue) GetMember(inter *Interpreter, _ LocationRange, name string) Value {
 	switch name {
 	case "borrow":
-		return inter.capabilityBorrowFunction(v.Address, v.Path)
+		return inter.capabilityBorrowFunction(v.Address, v.Path, v.BorrowType)
 
 	case "check":
-		return inter.capabilityCheckFunction(v.Address, v.Path)
+		return inter.capabilityCheckFunction(v.Address, v.Path, v.BorrowType)
 
 	default:
 		panic(errors.NewUnreachableError())
@@ -5128,3 +6305,54 @@ func (v LinkValue) String() string {
 		v.TargetPath,
 	)
 }
+
+// TypeValue
+
+type TypeValue struct {
+	Type StaticType
+}
+
+func (TypeValue) IsValue() {}
+
+func (TypeValue) DynamicType(_ *Interpreter) DynamicType {
+	return MetaTypeDynamicType{}
+}
+
+func (v TypeValue) Copy() Value {
+	return v
+}
+
+func (TypeValue) GetOwner() *common.Address {
+	// value is never owned
+	return nil
+}
+
+func (TypeValue) SetOwner(_ *common.Address) {
+	// NO-OP: value cannot be owned
+}
+
+func (v TypeValue) Destroy(_ *Interpreter, _ LocationRange) trampoline.Trampoline {
+	return trampoline.Done{}
+}
+
+func (v TypeValue) String() string {
+	return fmt.Sprintf("Type<%s>()", v.Type)
+}
+
+func (v TypeValue) GetMember(inter *Interpreter, _ LocationRange, name string) Value {
+	switch name {
+	case sema.MetaTypeIdentifierFieldName:
+		typeID := inter.ConvertStaticToSemaType(v.Type).ID()
+		return NewStringValue(string(typeID))
+
+	case sema.MetaTypeIsSubtypeFunctionName:
+		return inter.metaTypeIsSubtypeFunction(v)
+
+	default:
+		panic(errors.NewUnreachableError())
+	}
+}
+
+func (TypeValue) SetMember(_ *Interpreter, _ LocationRange, _ string, _ Value) {
+	panic(errors.NewUnreachableError())
+}
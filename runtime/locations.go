@@ -19,7 +19,6 @@
 package runtime
 
 import (
-	"encoding/gob"
 	"encoding/hex"
 	"fmt"
 
@@ -27,38 +26,20 @@ import (
 )
 
 type (
-	Location        = ast.Location
-	LocationID      = ast.LocationID
-	StringLocation  = ast.StringLocation
-	AddressLocation = ast.AddressLocation
+	Location                = ast.Location
+	LocationID              = ast.LocationID
+	StringLocation          = ast.StringLocation
+	AddressLocation         = ast.AddressLocation
+	TransactionLocation     = ast.TransactionLocation
+	AddressContractLocation = ast.AddressContractLocation
 )
 
 const (
 	AddressPrefix            = ast.AddressPrefix
-	TransactionPrefix string = "T"
+	TransactionPrefix        = ast.TransactionPrefix
 	ScriptPrefix      string = "S"
 )
 
-// TransactionLocation
-
-type TransactionLocation []byte
-
-func (l TransactionLocation) ID() ast.LocationID {
-	return LocationID(fmt.Sprintf(
-		"%s.%s",
-		TransactionPrefix,
-		l.String(),
-	))
-}
-
-func (l TransactionLocation) String() string {
-	return hex.EncodeToString(l)
-}
-
-func init() {
-	gob.Register(TransactionLocation{})
-}
-
 // ScriptLocation
 
 type ScriptLocation []byte
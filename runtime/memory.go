@@ -0,0 +1,168 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+type MemoryKind = common.MemoryKind
+
+// memoryUnitSizes are the estimated sizes in bytes of a unit of the memory kinds,
+// e.g. of an element of an array.
+//
+var memoryUnitSizes = map[MemoryKind]uint64{
+	common.MemoryKindString:     1,
+	common.MemoryKindArray:      16,
+	common.MemoryKindDictionary: 48,
+	common.MemoryKindComposite:  128,
+	common.MemoryKindBigInt:     1,
+}
+
+// memoryMeter meters the estimated memory allocated by an execution
+// and enforces the memory limit.
+//
+type memoryMeter struct {
+	// limit is the memory limit in bytes. A value of 0 means there is no limit
+	limit uint64
+	used  uint64
+}
+
+func newMemoryMeter(runtimeInterface Interface) *memoryMeter {
+	return &memoryMeter{
+		limit: runtimeInterface.GetMemoryLimit(),
+	}
+}
+
+// meter adds the memory of an allocation with the given kind and amount.
+//
+// A MemoryLimitExceededError is returned if the used memory exceeds the limit.
+//
+func (m *memoryMeter) meter(kind MemoryKind, amount uint) error {
+	unitSize := memoryUnitSizes[kind]
+	if unitSize == 0 || amount == 0 {
+		return nil
+	}
+
+	// Saturate on overflow
+
+	memory := unitSize * uint64(amount)
+	if memory/uint64(amount) != unitSize {
+		memory = math.MaxUint64
+	}
+
+	if m.used > math.MaxUint64-memory {
+		m.used = math.MaxUint64
+	} else {
+		m.used += memory
+	}
+
+	if m.limit > 0 && m.used > m.limit {
+		return MemoryLimitExceededError{
+			Limit: m.limit,
+		}
+	}
+
+	return nil
+}
+
+// mustMeter is like meter, but panics if the memory limit is exceeded.
+// It is used in the interpreter handlers, where the panic is turned into an error.
+//
+func (m *memoryMeter) mustMeter(kind MemoryKind, amount uint) {
+	err := m.meter(kind, amount)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// mustMeterValue meters the memory of a value which was allocated
+// outside of the interpreter, e.g. decoded from storage,
+// including the memory of all values nested in it.
+//
+// It panics if the memory limit is exceeded.
+//
+func (m *memoryMeter) mustMeterValue(value interpreter.Value) {
+	switch value := value.(type) {
+	case *interpreter.SomeValue:
+		m.mustMeterValue(value.Value)
+
+	case *interpreter.StringValue:
+		m.mustMeter(common.MemoryKindString, uint(len(value.Str)))
+
+	case *interpreter.ArrayValue:
+		m.mustMeter(common.MemoryKindArray, uint(value.Count()))
+
+		for _, element := range value.Values {
+			m.mustMeterValue(element)
+		}
+
+	case *interpreter.DictionaryValue:
+		m.mustMeter(common.MemoryKindDictionary, uint(value.Count()))
+
+		for _, key := range value.Keys.Values {
+			m.mustMeterValue(key)
+		}
+
+		for _, entry := range value.Entries {
+			m.mustMeterValue(entry)
+		}
+
+	case *interpreter.CompositeValue:
+		m.mustMeter(common.MemoryKindComposite, 1)
+
+		for _, field := range value.Fields {
+			m.mustMeterValue(field)
+		}
+
+	case interpreter.IntValue:
+		m.mustMeterBigInt(value.BigInt)
+	case interpreter.Int128Value:
+		m.mustMeterBigInt(value.BigInt)
+	case interpreter.Int256Value:
+		m.mustMeterBigInt(value.BigInt)
+	case interpreter.UIntValue:
+		m.mustMeterBigInt(value.BigInt)
+	case interpreter.UInt128Value:
+		m.mustMeterBigInt(value.BigInt)
+	case interpreter.UInt256Value:
+		m.mustMeterBigInt(value.BigInt)
+	}
+}
+
+func (m *memoryMeter) mustMeterBigInt(value *big.Int) {
+	m.mustMeter(common.MemoryKindBigInt, uint(len(value.Bytes())))
+}
+
+// interpreterOptions returns the interpreter options which meter
+// the memory allocated by the interpreted program.
+//
+func (m *memoryMeter) interpreterOptions() []interpreter.Option {
+	return []interpreter.Option{
+		interpreter.WithOnMeterMemoryHandler(
+			func(_ *interpreter.Interpreter, kind common.MemoryKind, amount uint) {
+				m.mustMeter(kind, amount)
+			},
+		),
+	}
+}
@@ -167,12 +167,18 @@ memberOrNestedDeclaration
     | interfaceDeclaration
     | compositeDeclaration
     | eventDeclaration
+    | enumCase
     ;
 
 compositeKind
     : Struct
     | Resource
     | Contract
+    | Enum
+    ;
+
+enumCase
+    : access Case identifier
     ;
 
 // specialFunctionDeclaration is the rule for special function declarations,
@@ -224,6 +230,7 @@ baseType
     | variableSizedType
     | constantSizedType
     | dictionaryType
+    | instantiationType
     ;
 
 typeRestrictions
@@ -253,6 +260,10 @@ dictionaryType
     : '{' keyType=fullType ':' valueType=fullType '}'
     ;
 
+instantiationType
+    : nominalType {p.noWhitespace()}? '<' typeAnnotation (',' typeAnnotation)* '>'
+    ;
+
 block
     : '{' statements '}'
     ;
@@ -289,6 +300,7 @@ statement
     | ifStatement
     | whileStatement
     | forStatement
+    | switchStatement
     | emitStatement
     // NOTE: allow all declarations, even structures, in parser,
     // then check identifier declaration is variable/constant or function
@@ -335,6 +347,18 @@ emitStatement
     : Emit identifier invocation
     ;
 
+switchStatement
+    : Switch expression '{' switchCase* '}'
+    ;
+
+// the statements of a case are terminated by the next case,
+// the default case, or the end of the switch statement
+//
+switchCase
+    : Case expression (',' expression)* ':' statements
+    | Default ':' statements
+    ;
+
 // Variable declarations might be of the form `let|var <- x <- y`
 //
 variableDeclaration
@@ -400,13 +424,32 @@ nilCoalescingExpression
     ;
 
 castingExpression
-    : concatenatingExpression
+    : bitwiseOrExpression
     | castingExpression castingOp typeAnnotation
     ;
 
+bitwiseOrExpression
+    : bitwiseXorExpression
+    | bitwiseOrExpression BitwiseOr bitwiseXorExpression
+    ;
+
+bitwiseXorExpression
+    : concatenatingExpression
+    | bitwiseXorExpression BitwiseXor concatenatingExpression
+    ;
+
+// NOTE: the ampersand is both the concatenation operator
+// and the bitwise AND operator: the operation is determined
+// by the type of the operands during checking
+
 concatenatingExpression
+    : bitwiseShiftExpression
+    | concatenatingExpression Ampersand bitwiseShiftExpression
+    ;
+
+bitwiseShiftExpression
     : additiveExpression
-    | concatenatingExpression Ampersand additiveExpression
+    | bitwiseShiftExpression bitwiseShiftOp additiveExpression
     ;
 
 additiveExpression
@@ -484,13 +527,26 @@ Mod : '%' ;
 Auth : 'auth' ;
 Ampersand : '&';
 
+BitwiseOr : '|' ;
+BitwiseXor : '^' ;
+
+// NOTE: the shift operators are not tokens,
+// so nested type arguments (e.g. `T<U<V>>`) can still be closed
+
+bitwiseShiftOp
+    : Less {p.noWhitespace()}? Less
+    | Greater {p.noWhitespace()}? Greater
+    ;
+
 unaryOp
     : Minus
     | Negate
     | Move
+    | BitwiseNot
     ;
 
 Negate : '!' ;
+BitwiseNot : '~' ;
 Move : '<-' ;
 MoveForced : '<-!' ;
 
@@ -656,6 +712,11 @@ From : 'from' ;
 Create : 'create' ;
 Destroy : 'destroy' ;
 
+Enum : 'enum' ;
+Case : 'case' ;
+Switch : 'switch' ;
+Default : 'default' ;
+
 identifier
     : Identifier
     | From
@@ -732,8 +793,11 @@ fragment QuotedText
     | ~["\n\r\\]
     ;
 
+// NOTE: `\(` starts an interpolated expression, which extends up to the matching closing parenthesis.
+// Interpolated expressions are parsed separately, when the string literal is visited,
+// so they may not contain string literals, backslashes, or line breaks
 fragment EscapedCharacter
-    : '\\' [0\\tnr"']
+    : '\\' [0\\tnr"'(]
     // NOTE: allow arbitrary length in parser, but check length in semantic analysis
     | '\\u' '{' HexadecimalDigit+ '}'
     ;
@@ -670,3 +670,51 @@ func (s *BaseCadenceListener) EnterEos(ctx *EosContext) {}
 
 // ExitEos is called when production eos is exited.
 func (s *BaseCadenceListener) ExitEos(ctx *EosContext) {}
+
+// EnterEnumCase is called when production enumCase is entered.
+func (s *BaseCadenceListener) EnterEnumCase(ctx *EnumCaseContext) {}
+
+// ExitEnumCase is called when production enumCase is exited.
+func (s *BaseCadenceListener) ExitEnumCase(ctx *EnumCaseContext) {}
+
+// EnterSwitchStatement is called when production switchStatement is entered.
+func (s *BaseCadenceListener) EnterSwitchStatement(ctx *SwitchStatementContext) {}
+
+// ExitSwitchStatement is called when production switchStatement is exited.
+func (s *BaseCadenceListener) ExitSwitchStatement(ctx *SwitchStatementContext) {}
+
+// EnterSwitchCase is called when production switchCase is entered.
+func (s *BaseCadenceListener) EnterSwitchCase(ctx *SwitchCaseContext) {}
+
+// ExitSwitchCase is called when production switchCase is exited.
+func (s *BaseCadenceListener) ExitSwitchCase(ctx *SwitchCaseContext) {}
+
+// EnterBitwiseOrExpression is called when production bitwiseOrExpression is entered.
+func (s *BaseCadenceListener) EnterBitwiseOrExpression(ctx *BitwiseOrExpressionContext) {}
+
+// ExitBitwiseOrExpression is called when production bitwiseOrExpression is exited.
+func (s *BaseCadenceListener) ExitBitwiseOrExpression(ctx *BitwiseOrExpressionContext) {}
+
+// EnterBitwiseXorExpression is called when production bitwiseXorExpression is entered.
+func (s *BaseCadenceListener) EnterBitwiseXorExpression(ctx *BitwiseXorExpressionContext) {}
+
+// ExitBitwiseXorExpression is called when production bitwiseXorExpression is exited.
+func (s *BaseCadenceListener) ExitBitwiseXorExpression(ctx *BitwiseXorExpressionContext) {}
+
+// EnterBitwiseShiftExpression is called when production bitwiseShiftExpression is entered.
+func (s *BaseCadenceListener) EnterBitwiseShiftExpression(ctx *BitwiseShiftExpressionContext) {}
+
+// ExitBitwiseShiftExpression is called when production bitwiseShiftExpression is exited.
+func (s *BaseCadenceListener) ExitBitwiseShiftExpression(ctx *BitwiseShiftExpressionContext) {}
+
+// EnterBitwiseShiftOp is called when production bitwiseShiftOp is entered.
+func (s *BaseCadenceListener) EnterBitwiseShiftOp(ctx *BitwiseShiftOpContext) {}
+
+// ExitBitwiseShiftOp is called when production bitwiseShiftOp is exited.
+func (s *BaseCadenceListener) ExitBitwiseShiftOp(ctx *BitwiseShiftOpContext) {}
+
+// EnterInstantiationType is called when production instantiationType is entered.
+func (s *BaseCadenceListener) EnterInstantiationType(ctx *InstantiationTypeContext) {}
+
+// ExitInstantiationType is called when production instantiationType is exited.
+func (s *BaseCadenceListener) ExitInstantiationType(ctx *InstantiationTypeContext) {}
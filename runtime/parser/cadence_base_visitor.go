@@ -444,3 +444,35 @@ func (v *BaseCadenceVisitor) VisitIdentifier(ctx *IdentifierContext) interface{}
 func (v *BaseCadenceVisitor) VisitEos(ctx *EosContext) interface{} {
 	return v.VisitChildren(ctx)
 }
+
+func (v *BaseCadenceVisitor) VisitEnumCase(ctx *EnumCaseContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseCadenceVisitor) VisitSwitchStatement(ctx *SwitchStatementContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseCadenceVisitor) VisitSwitchCase(ctx *SwitchCaseContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseCadenceVisitor) VisitBitwiseOrExpression(ctx *BitwiseOrExpressionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseCadenceVisitor) VisitBitwiseXorExpression(ctx *BitwiseXorExpressionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseCadenceVisitor) VisitBitwiseShiftExpression(ctx *BitwiseShiftExpressionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseCadenceVisitor) VisitBitwiseShiftOp(ctx *BitwiseShiftOpContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseCadenceVisitor) VisitInstantiationType(ctx *InstantiationTypeContext) interface{} {
+	return v.VisitChildren(ctx)
+}
@@ -340,6 +340,30 @@ type CadenceListener interface {
 	// EnterEos is called when entering the eos production.
 	EnterEos(c *EosContext)
 
+	// EnterEnumCase is called when entering the enumCase production.
+	EnterEnumCase(c *EnumCaseContext)
+
+	// EnterSwitchStatement is called when entering the switchStatement production.
+	EnterSwitchStatement(c *SwitchStatementContext)
+
+	// EnterSwitchCase is called when entering the switchCase production.
+	EnterSwitchCase(c *SwitchCaseContext)
+
+	// EnterBitwiseOrExpression is called when entering the bitwiseOrExpression production.
+	EnterBitwiseOrExpression(c *BitwiseOrExpressionContext)
+
+	// EnterBitwiseXorExpression is called when entering the bitwiseXorExpression production.
+	EnterBitwiseXorExpression(c *BitwiseXorExpressionContext)
+
+	// EnterBitwiseShiftExpression is called when entering the bitwiseShiftExpression production.
+	EnterBitwiseShiftExpression(c *BitwiseShiftExpressionContext)
+
+	// EnterBitwiseShiftOp is called when entering the bitwiseShiftOp production.
+	EnterBitwiseShiftOp(c *BitwiseShiftOpContext)
+
+	// EnterInstantiationType is called when entering the instantiationType production.
+	EnterInstantiationType(c *InstantiationTypeContext)
+
 	// ExitProgram is called when exiting the program production.
 	ExitProgram(c *ProgramContext)
 
@@ -654,4 +678,28 @@ type CadenceListener interface {
 
 	// ExitEos is called when exiting the eos production.
 	ExitEos(c *EosContext)
+
+	// ExitEnumCase is called when exiting the enumCase production.
+	ExitEnumCase(c *EnumCaseContext)
+
+	// ExitSwitchStatement is called when exiting the switchStatement production.
+	ExitSwitchStatement(c *SwitchStatementContext)
+
+	// ExitSwitchCase is called when exiting the switchCase production.
+	ExitSwitchCase(c *SwitchCaseContext)
+
+	// ExitBitwiseOrExpression is called when exiting the bitwiseOrExpression production.
+	ExitBitwiseOrExpression(c *BitwiseOrExpressionContext)
+
+	// ExitBitwiseXorExpression is called when exiting the bitwiseXorExpression production.
+	ExitBitwiseXorExpression(c *BitwiseXorExpressionContext)
+
+	// ExitBitwiseShiftExpression is called when exiting the bitwiseShiftExpression production.
+	ExitBitwiseShiftExpression(c *BitwiseShiftExpressionContext)
+
+	// ExitBitwiseShiftOp is called when exiting the bitwiseShiftOp production.
+	ExitBitwiseShiftOp(c *BitwiseShiftOpContext)
+
+	// ExitInstantiationType is called when exiting the instantiationType production.
+	ExitInstantiationType(c *InstantiationTypeContext)
 }
@@ -37,7 +37,7 @@ var _ = reflect.Copy
 var _ = strconv.Itoa
 
 var parserATN = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 84, 918,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 91, 1027,
 	4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7, 9, 7,
 	4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12, 4, 13,
 	9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4, 18, 9,
@@ -135,82 +135,96 @@ var parserATN = []uint16{
 	10, 92, 3, 92, 3, 92, 3, 93, 3, 93, 3, 93, 3, 93, 7, 93, 897, 10, 93, 12,
 	93, 14, 93, 900, 11, 93, 5, 93, 902, 10, 93, 3, 93, 3, 93, 3, 94, 3, 94,
 	3, 94, 3, 94, 3, 95, 3, 95, 3, 96, 3, 96, 3, 96, 3, 96, 5, 96, 916, 10,
-	96, 3, 96, 2, 11, 114, 116, 118, 120, 124, 126, 128, 130, 136, 97, 2, 4,
+	96, 3, 96, 4, 97, 9, 97, 3, 97, 3, 97, 3, 97, 3, 97, 3, 20, 4, 98, 9, 98,
+	4, 99, 9, 99, 3, 98, 3, 98, 3, 98, 3, 98, 10, 98, 7, 98, 933, 12, 98, 11,
+	98, 14, 98, 936, 3, 98, 3, 98, 10, 99, 5, 99, 940, 3, 99, 3, 99, 3, 99,
+	3, 99, 10, 99, 7, 99, 946, 12, 99, 11, 99, 14, 99, 949, 3, 99, 3, 99, 3,
+	99, 3, 99, 3, 99, 3, 44, 4, 100, 9, 100, 4, 101, 9, 101, 4, 102, 9, 102,
+	4, 103, 9, 103, 3, 100, 3, 100, 3, 100, 3, 100, 3, 100, 3, 100, 3, 100,
+	10, 100, 7, 100, 972, 12, 100, 11, 100, 14, 100, 975, 3, 101, 3, 101, 3,
+	101, 3, 101, 3, 101, 3, 101, 3, 101, 10, 101, 7, 101, 984, 12, 101, 11,
+	101, 14, 101, 987, 3, 102, 3, 102, 3, 102, 3, 102, 3, 102, 3, 102, 3, 102,
+	10, 102, 7, 102, 996, 12, 102, 11, 102, 14, 102, 999, 10, 103, 5, 103,
+	1001, 3, 103, 3, 103, 3, 103, 3, 103, 3, 103, 3, 103, 3, 103, 3, 103, 4,
+	104, 9, 104, 3, 104, 3, 104, 3, 104, 3, 104, 3, 104, 3, 104, 10, 104, 7,
+	104, 1019, 12, 104, 11, 104, 14, 104, 1022, 3, 104, 3, 104, 3, 30, 2, 14,
+	114, 116, 118, 120, 124, 126, 128, 130, 136, 957, 959, 961, 105, 2, 4,
 	6, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 42,
 	44, 46, 48, 50, 52, 54, 56, 58, 60, 62, 64, 66, 68, 70, 72, 74, 76, 78,
 	80, 82, 84, 86, 88, 90, 92, 94, 96, 98, 100, 102, 104, 106, 108, 110, 112,
 	114, 116, 118, 120, 122, 124, 126, 128, 130, 132, 134, 136, 138, 140, 142,
 	144, 146, 148, 150, 152, 154, 156, 158, 160, 162, 164, 166, 168, 170, 172,
-	174, 176, 178, 180, 182, 184, 186, 188, 190, 2, 14, 4, 2, 42, 42, 53, 55,
-	3, 2, 59, 60, 3, 2, 40, 42, 4, 2, 12, 12, 29, 30, 3, 2, 15, 16, 3, 2, 17,
-	20, 3, 2, 21, 22, 3, 2, 23, 25, 4, 2, 22, 22, 28, 29, 3, 2, 33, 35, 3,
-	2, 66, 67, 8, 2, 26, 26, 40, 42, 45, 46, 52, 55, 65, 65, 70, 73, 2, 952,
-	2, 198, 3, 2, 2, 2, 4, 206, 3, 2, 2, 2, 6, 213, 3, 2, 2, 2, 8, 215, 3,
-	2, 2, 2, 10, 218, 3, 2, 2, 2, 12, 229, 3, 2, 2, 2, 14, 231, 3, 2, 2, 2,
-	16, 256, 3, 2, 2, 2, 18, 258, 3, 2, 2, 2, 20, 261, 3, 2, 2, 2, 22, 290,
-	3, 2, 2, 2, 24, 292, 3, 2, 2, 2, 26, 309, 3, 2, 2, 2, 28, 311, 3, 2, 2,
-	2, 30, 313, 3, 2, 2, 2, 32, 327, 3, 2, 2, 2, 34, 330, 3, 2, 2, 2, 36, 344,
-	3, 2, 2, 2, 38, 353, 3, 2, 2, 2, 40, 355, 3, 2, 2, 2, 42, 357, 3, 2, 2,
-	2, 44, 362, 3, 2, 2, 2, 46, 373, 3, 2, 2, 2, 48, 378, 3, 2, 2, 2, 50, 392,
-	3, 2, 2, 2, 52, 399, 3, 2, 2, 2, 54, 408, 3, 2, 2, 2, 56, 424, 3, 2, 2,
-	2, 58, 431, 3, 2, 2, 2, 60, 433, 3, 2, 2, 2, 62, 446, 3, 2, 2, 2, 64, 454,
-	3, 2, 2, 2, 66, 471, 3, 2, 2, 2, 68, 475, 3, 2, 2, 2, 70, 481, 3, 2, 2,
-	2, 72, 487, 3, 2, 2, 2, 74, 491, 3, 2, 2, 2, 76, 501, 3, 2, 2, 2, 78, 506,
-	3, 2, 2, 2, 80, 516, 3, 2, 2, 2, 82, 519, 3, 2, 2, 2, 84, 529, 3, 2, 2,
-	2, 86, 543, 3, 2, 2, 2, 88, 545, 3, 2, 2, 2, 90, 550, 3, 2, 2, 2, 92, 552,
-	3, 2, 2, 2, 94, 554, 3, 2, 2, 2, 96, 567, 3, 2, 2, 2, 98, 571, 3, 2, 2,
-	2, 100, 577, 3, 2, 2, 2, 102, 581, 3, 2, 2, 2, 104, 595, 3, 2, 2, 2, 106,
-	605, 3, 2, 2, 2, 108, 609, 3, 2, 2, 2, 110, 611, 3, 2, 2, 2, 112, 613,
-	3, 2, 2, 2, 114, 621, 3, 2, 2, 2, 116, 632, 3, 2, 2, 2, 118, 643, 3, 2,
-	2, 2, 120, 655, 3, 2, 2, 2, 122, 667, 3, 2, 2, 2, 124, 672, 3, 2, 2, 2,
-	126, 684, 3, 2, 2, 2, 128, 695, 3, 2, 2, 2, 130, 707, 3, 2, 2, 2, 132,
-	727, 3, 2, 2, 2, 134, 733, 3, 2, 2, 2, 136, 750, 3, 2, 2, 2, 138, 765,
-	3, 2, 2, 2, 140, 767, 3, 2, 2, 2, 142, 769, 3, 2, 2, 2, 144, 771, 3, 2,
-	2, 2, 146, 773, 3, 2, 2, 2, 148, 775, 3, 2, 2, 2, 150, 777, 3, 2, 2, 2,
-	152, 781, 3, 2, 2, 2, 154, 784, 3, 2, 2, 2, 156, 792, 3, 2, 2, 2, 158,
-	795, 3, 2, 2, 2, 160, 800, 3, 2, 2, 2, 162, 816, 3, 2, 2, 2, 164, 834,
-	3, 2, 2, 2, 166, 846, 3, 2, 2, 2, 168, 848, 3, 2, 2, 2, 170, 850, 3, 2,
-	2, 2, 172, 852, 3, 2, 2, 2, 174, 860, 3, 2, 2, 2, 176, 863, 3, 2, 2, 2,
-	178, 868, 3, 2, 2, 2, 180, 877, 3, 2, 2, 2, 182, 879, 3, 2, 2, 2, 184,
-	892, 3, 2, 2, 2, 186, 905, 3, 2, 2, 2, 188, 909, 3, 2, 2, 2, 190, 915,
-	3, 2, 2, 2, 192, 194, 5, 12, 7, 2, 193, 195, 7, 3, 2, 2, 194, 193, 3, 2,
-	2, 2, 194, 195, 3, 2, 2, 2, 195, 197, 3, 2, 2, 2, 196, 192, 3, 2, 2, 2,
-	197, 200, 3, 2, 2, 2, 198, 196, 3, 2, 2, 2, 198, 199, 3, 2, 2, 2, 199,
-	201, 3, 2, 2, 2, 200, 198, 3, 2, 2, 2, 201, 202, 7, 2, 2, 3, 202, 3, 3,
-	2, 2, 2, 203, 205, 5, 6, 4, 2, 204, 203, 3, 2, 2, 2, 205, 208, 3, 2, 2,
-	2, 206, 204, 3, 2, 2, 2, 206, 207, 3, 2, 2, 2, 207, 209, 3, 2, 2, 2, 208,
-	206, 3, 2, 2, 2, 209, 210, 7, 2, 2, 3, 210, 5, 3, 2, 2, 2, 211, 214, 5,
-	10, 6, 2, 212, 214, 5, 8, 5, 2, 213, 211, 3, 2, 2, 2, 213, 212, 3, 2, 2,
-	2, 214, 7, 3, 2, 2, 2, 215, 216, 5, 86, 44, 2, 216, 217, 5, 190, 96, 2,
-	217, 9, 3, 2, 2, 2, 218, 220, 5, 12, 7, 2, 219, 221, 7, 3, 2, 2, 220, 219,
-	3, 2, 2, 2, 220, 221, 3, 2, 2, 2, 221, 11, 3, 2, 2, 2, 222, 230, 5, 24,
-	13, 2, 223, 230, 5, 34, 18, 2, 224, 230, 5, 44, 23, 2, 225, 230, 5, 102,
-	52, 2, 226, 230, 5, 20, 11, 2, 227, 230, 5, 46, 24, 2, 228, 230, 5, 14,
-	8, 2, 229, 222, 3, 2, 2, 2, 229, 223, 3, 2, 2, 2, 229, 224, 3, 2, 2, 2,
-	229, 225, 3, 2, 2, 2, 229, 226, 3, 2, 2, 2, 229, 227, 3, 2, 2, 2, 229,
-	228, 3, 2, 2, 2, 230, 13, 3, 2, 2, 2, 231, 233, 7, 39, 2, 2, 232, 234,
-	5, 48, 25, 2, 233, 232, 3, 2, 2, 2, 233, 234, 3, 2, 2, 2, 234, 235, 3,
-	2, 2, 2, 235, 236, 7, 4, 2, 2, 236, 238, 5, 32, 17, 2, 237, 239, 5, 16,
-	9, 2, 238, 237, 3, 2, 2, 2, 238, 239, 3, 2, 2, 2, 239, 241, 3, 2, 2, 2,
-	240, 242, 5, 76, 39, 2, 241, 240, 3, 2, 2, 2, 241, 242, 3, 2, 2, 2, 242,
-	252, 3, 2, 2, 2, 243, 253, 5, 18, 10, 2, 244, 245, 5, 18, 10, 2, 245, 246,
-	5, 78, 40, 2, 246, 253, 3, 2, 2, 2, 247, 253, 5, 78, 40, 2, 248, 249, 5,
-	78, 40, 2, 249, 250, 5, 18, 10, 2, 250, 253, 3, 2, 2, 2, 251, 253, 3, 2,
-	2, 2, 252, 243, 3, 2, 2, 2, 252, 244, 3, 2, 2, 2, 252, 247, 3, 2, 2, 2,
-	252, 248, 3, 2, 2, 2, 252, 251, 3, 2, 2, 2, 253, 254, 3, 2, 2, 2, 254,
-	255, 7, 5, 2, 2, 255, 15, 3, 2, 2, 2, 256, 257, 5, 42, 22, 2, 257, 17,
-	3, 2, 2, 2, 258, 259, 5, 188, 95, 2, 259, 260, 5, 72, 37, 2, 260, 19, 3,
-	2, 2, 2, 261, 272, 7, 69, 2, 2, 262, 267, 5, 188, 95, 2, 263, 264, 7, 6,
-	2, 2, 264, 266, 5, 188, 95, 2, 265, 263, 3, 2, 2, 2, 266, 269, 3, 2, 2,
-	2, 267, 265, 3, 2, 2, 2, 267, 268, 3, 2, 2, 2, 268, 270, 3, 2, 2, 2, 269,
-	267, 3, 2, 2, 2, 270, 271, 7, 70, 2, 2, 271, 273, 3, 2, 2, 2, 272, 262,
-	3, 2, 2, 2, 272, 273, 3, 2, 2, 2, 273, 276, 3, 2, 2, 2, 274, 277, 5, 174,
-	88, 2, 275, 277, 7, 78, 2, 2, 276, 274, 3, 2, 2, 2, 276, 275, 3, 2, 2,
-	2, 277, 21, 3, 2, 2, 2, 278, 291, 3, 2, 2, 2, 279, 291, 7, 49, 2, 2, 280,
-	284, 7, 50, 2, 2, 281, 282, 7, 37, 2, 2, 282, 283, 7, 51, 2, 2, 283, 285,
-	7, 38, 2, 2, 284, 281, 3, 2, 2, 2, 284, 285, 3, 2, 2, 2, 285, 291, 3, 2,
-	2, 2, 286, 287, 7, 52, 2, 2, 287, 288, 7, 37, 2, 2, 288, 289, 9, 2, 2,
-	2, 289, 291, 7, 38, 2, 2, 290, 278, 3, 2, 2, 2, 290, 279, 3, 2, 2, 2, 290,
+	174, 176, 178, 180, 182, 184, 186, 188, 190, 918, 925, 927, 957, 959, 961,
+	963, 1011, 2, 14, 4, 2, 42, 42, 53, 55, 3, 2, 59, 60, 4, 2, 40, 42, 85,
+	85, 4, 2, 12, 12, 29, 30, 3, 2, 15, 16, 3, 2, 17, 20, 3, 2, 21, 22, 3,
+	2, 23, 25, 5, 2, 22, 22, 28, 29, 91, 91, 3, 2, 33, 35, 3, 2, 66, 67, 8,
+	2, 26, 26, 40, 42, 45, 46, 52, 55, 65, 65, 70, 73, 2, 1064, 2, 198, 3,
+	2, 2, 2, 4, 206, 3, 2, 2, 2, 6, 213, 3, 2, 2, 2, 8, 215, 3, 2, 2, 2, 10,
+	218, 3, 2, 2, 2, 12, 229, 3, 2, 2, 2, 14, 231, 3, 2, 2, 2, 16, 256, 3,
+	2, 2, 2, 18, 258, 3, 2, 2, 2, 20, 261, 3, 2, 2, 2, 22, 290, 3, 2, 2, 2,
+	24, 292, 3, 2, 2, 2, 26, 309, 3, 2, 2, 2, 28, 311, 3, 2, 2, 2, 30, 313,
+	3, 2, 2, 2, 32, 327, 3, 2, 2, 2, 34, 330, 3, 2, 2, 2, 36, 344, 3, 2, 2,
+	2, 38, 353, 3, 2, 2, 2, 40, 355, 3, 2, 2, 2, 42, 357, 3, 2, 2, 2, 44, 362,
+	3, 2, 2, 2, 46, 373, 3, 2, 2, 2, 48, 378, 3, 2, 2, 2, 50, 392, 3, 2, 2,
+	2, 52, 399, 3, 2, 2, 2, 54, 408, 3, 2, 2, 2, 56, 424, 3, 2, 2, 2, 58, 431,
+	3, 2, 2, 2, 60, 433, 3, 2, 2, 2, 62, 446, 3, 2, 2, 2, 64, 454, 3, 2, 2,
+	2, 66, 471, 3, 2, 2, 2, 68, 475, 3, 2, 2, 2, 70, 481, 3, 2, 2, 2, 72, 487,
+	3, 2, 2, 2, 74, 491, 3, 2, 2, 2, 76, 501, 3, 2, 2, 2, 78, 506, 3, 2, 2,
+	2, 80, 516, 3, 2, 2, 2, 82, 519, 3, 2, 2, 2, 84, 529, 3, 2, 2, 2, 86, 543,
+	3, 2, 2, 2, 88, 545, 3, 2, 2, 2, 90, 550, 3, 2, 2, 2, 92, 552, 3, 2, 2,
+	2, 94, 554, 3, 2, 2, 2, 96, 567, 3, 2, 2, 2, 98, 571, 3, 2, 2, 2, 100,
+	577, 3, 2, 2, 2, 102, 581, 3, 2, 2, 2, 104, 595, 3, 2, 2, 2, 106, 605,
+	3, 2, 2, 2, 108, 609, 3, 2, 2, 2, 110, 611, 3, 2, 2, 2, 112, 613, 3, 2,
+	2, 2, 114, 621, 3, 2, 2, 2, 116, 632, 3, 2, 2, 2, 118, 643, 3, 2, 2, 2,
+	120, 655, 3, 2, 2, 2, 122, 667, 3, 2, 2, 2, 124, 672, 3, 2, 2, 2, 126,
+	684, 3, 2, 2, 2, 128, 695, 3, 2, 2, 2, 130, 707, 3, 2, 2, 2, 132, 727,
+	3, 2, 2, 2, 134, 733, 3, 2, 2, 2, 136, 750, 3, 2, 2, 2, 138, 765, 3, 2,
+	2, 2, 140, 767, 3, 2, 2, 2, 142, 769, 3, 2, 2, 2, 144, 771, 3, 2, 2, 2,
+	146, 773, 3, 2, 2, 2, 148, 775, 3, 2, 2, 2, 150, 777, 3, 2, 2, 2, 152,
+	781, 3, 2, 2, 2, 154, 784, 3, 2, 2, 2, 156, 792, 3, 2, 2, 2, 158, 795,
+	3, 2, 2, 2, 160, 800, 3, 2, 2, 2, 162, 816, 3, 2, 2, 2, 164, 834, 3, 2,
+	2, 2, 166, 846, 3, 2, 2, 2, 168, 848, 3, 2, 2, 2, 170, 850, 3, 2, 2, 2,
+	172, 852, 3, 2, 2, 2, 174, 860, 3, 2, 2, 2, 176, 863, 3, 2, 2, 2, 178,
+	868, 3, 2, 2, 2, 180, 877, 3, 2, 2, 2, 182, 879, 3, 2, 2, 2, 184, 892,
+	3, 2, 2, 2, 186, 905, 3, 2, 2, 2, 188, 909, 3, 2, 2, 2, 190, 915, 3, 2,
+	2, 2, 192, 194, 5, 12, 7, 2, 193, 195, 7, 3, 2, 2, 194, 193, 3, 2, 2, 2,
+	194, 195, 3, 2, 2, 2, 195, 197, 3, 2, 2, 2, 196, 192, 3, 2, 2, 2, 197,
+	200, 3, 2, 2, 2, 198, 196, 3, 2, 2, 2, 198, 199, 3, 2, 2, 2, 199, 201,
+	3, 2, 2, 2, 200, 198, 3, 2, 2, 2, 201, 202, 7, 2, 2, 3, 202, 3, 3, 2, 2,
+	2, 203, 205, 5, 6, 4, 2, 204, 203, 3, 2, 2, 2, 205, 208, 3, 2, 2, 2, 206,
+	204, 3, 2, 2, 2, 206, 207, 3, 2, 2, 2, 207, 209, 3, 2, 2, 2, 208, 206,
+	3, 2, 2, 2, 209, 210, 7, 2, 2, 3, 210, 5, 3, 2, 2, 2, 211, 214, 5, 10,
+	6, 2, 212, 214, 5, 8, 5, 2, 213, 211, 3, 2, 2, 2, 213, 212, 3, 2, 2, 2,
+	214, 7, 3, 2, 2, 2, 215, 216, 5, 86, 44, 2, 216, 217, 5, 190, 96, 2, 217,
+	9, 3, 2, 2, 2, 218, 220, 5, 12, 7, 2, 219, 221, 7, 3, 2, 2, 220, 219, 3,
+	2, 2, 2, 220, 221, 3, 2, 2, 2, 221, 11, 3, 2, 2, 2, 222, 230, 5, 24, 13,
+	2, 223, 230, 5, 34, 18, 2, 224, 230, 5, 44, 23, 2, 225, 230, 5, 102, 52,
+	2, 226, 230, 5, 20, 11, 2, 227, 230, 5, 46, 24, 2, 228, 230, 5, 14, 8,
+	2, 229, 222, 3, 2, 2, 2, 229, 223, 3, 2, 2, 2, 229, 224, 3, 2, 2, 2, 229,
+	225, 3, 2, 2, 2, 229, 226, 3, 2, 2, 2, 229, 227, 3, 2, 2, 2, 229, 228,
+	3, 2, 2, 2, 230, 13, 3, 2, 2, 2, 231, 233, 7, 39, 2, 2, 232, 234, 5, 48,
+	25, 2, 233, 232, 3, 2, 2, 2, 233, 234, 3, 2, 2, 2, 234, 235, 3, 2, 2, 2,
+	235, 236, 7, 4, 2, 2, 236, 238, 5, 32, 17, 2, 237, 239, 5, 16, 9, 2, 238,
+	237, 3, 2, 2, 2, 238, 239, 3, 2, 2, 2, 239, 241, 3, 2, 2, 2, 240, 242,
+	5, 76, 39, 2, 241, 240, 3, 2, 2, 2, 241, 242, 3, 2, 2, 2, 242, 252, 3,
+	2, 2, 2, 243, 253, 5, 18, 10, 2, 244, 245, 5, 18, 10, 2, 245, 246, 5, 78,
+	40, 2, 246, 253, 3, 2, 2, 2, 247, 253, 5, 78, 40, 2, 248, 249, 5, 78, 40,
+	2, 249, 250, 5, 18, 10, 2, 250, 253, 3, 2, 2, 2, 251, 253, 3, 2, 2, 2,
+	252, 243, 3, 2, 2, 2, 252, 244, 3, 2, 2, 2, 252, 247, 3, 2, 2, 2, 252,
+	248, 3, 2, 2, 2, 252, 251, 3, 2, 2, 2, 253, 254, 3, 2, 2, 2, 254, 255,
+	7, 5, 2, 2, 255, 15, 3, 2, 2, 2, 256, 257, 5, 42, 22, 2, 257, 17, 3, 2,
+	2, 2, 258, 259, 5, 188, 95, 2, 259, 260, 5, 72, 37, 2, 260, 19, 3, 2, 2,
+	2, 261, 272, 7, 69, 2, 2, 262, 267, 5, 188, 95, 2, 263, 264, 7, 6, 2, 2,
+	264, 266, 5, 188, 95, 2, 265, 263, 3, 2, 2, 2, 266, 269, 3, 2, 2, 2, 267,
+	265, 3, 2, 2, 2, 267, 268, 3, 2, 2, 2, 268, 270, 3, 2, 2, 2, 269, 267,
+	3, 2, 2, 2, 270, 271, 7, 70, 2, 2, 271, 273, 3, 2, 2, 2, 272, 262, 3, 2,
+	2, 2, 272, 273, 3, 2, 2, 2, 273, 276, 3, 2, 2, 2, 274, 277, 5, 174, 88,
+	2, 275, 277, 7, 78, 2, 2, 276, 274, 3, 2, 2, 2, 276, 275, 3, 2, 2, 2, 277,
+	21, 3, 2, 2, 2, 278, 291, 3, 2, 2, 2, 279, 291, 7, 49, 2, 2, 280, 284,
+	7, 50, 2, 2, 281, 282, 7, 37, 2, 2, 282, 283, 7, 51, 2, 2, 283, 285, 7,
+	38, 2, 2, 284, 281, 3, 2, 2, 2, 284, 285, 3, 2, 2, 2, 285, 291, 3, 2, 2,
+	2, 286, 287, 7, 52, 2, 2, 287, 288, 7, 37, 2, 2, 288, 289, 9, 2, 2, 2,
+	289, 291, 7, 38, 2, 2, 290, 278, 3, 2, 2, 2, 290, 279, 3, 2, 2, 2, 290,
 	280, 3, 2, 2, 2, 290, 286, 3, 2, 2, 2, 291, 23, 3, 2, 2, 2, 292, 293, 5,
 	22, 12, 2, 293, 294, 5, 40, 21, 2, 294, 295, 5, 188, 95, 2, 295, 296, 5,
 	26, 14, 2, 296, 297, 7, 4, 2, 2, 297, 298, 5, 36, 19, 2, 298, 299, 7, 5,
@@ -264,189 +278,226 @@ var parserATN = []uint16{
 	426, 432, 5, 62, 32, 2, 427, 432, 5, 64, 33, 2, 428, 432, 5, 66, 34, 2,
 	429, 432, 5, 68, 35, 2, 430, 432, 5, 70, 36, 2, 431, 426, 3, 2, 2, 2, 431,
 	427, 3, 2, 2, 2, 431, 428, 3, 2, 2, 2, 431, 429, 3, 2, 2, 2, 431, 430,
-	3, 2, 2, 2, 432, 59, 3, 2, 2, 2, 433, 442, 7, 4, 2, 2, 434, 439, 5, 62,
-	32, 2, 435, 436, 7, 6, 2, 2, 436, 438, 5, 62, 32, 2, 437, 435, 3, 2, 2,
-	2, 438, 441, 3, 2, 2, 2, 439, 437, 3, 2, 2, 2, 439, 440, 3, 2, 2, 2, 440,
-	443, 3, 2, 2, 2, 441, 439, 3, 2, 2, 2, 442, 434, 3, 2, 2, 2, 442, 443,
-	3, 2, 2, 2, 443, 444, 3, 2, 2, 2, 444, 445, 7, 5, 2, 2, 445, 61, 3, 2,
-	2, 2, 446, 451, 5, 188, 95, 2, 447, 448, 7, 8, 2, 2, 448, 450, 5, 188,
-	95, 2, 449, 447, 3, 2, 2, 2, 450, 453, 3, 2, 2, 2, 451, 449, 3, 2, 2, 2,
-	451, 452, 3, 2, 2, 2, 452, 63, 3, 2, 2, 2, 453, 451, 3, 2, 2, 2, 454, 455,
-	7, 37, 2, 2, 455, 464, 7, 37, 2, 2, 456, 461, 5, 52, 27, 2, 457, 458, 7,
-	6, 2, 2, 458, 460, 5, 52, 27, 2, 459, 457, 3, 2, 2, 2, 460, 463, 3, 2,
-	2, 2, 461, 459, 3, 2, 2, 2, 461, 462, 3, 2, 2, 2, 462, 465, 3, 2, 2, 2,
-	463, 461, 3, 2, 2, 2, 464, 456, 3, 2, 2, 2, 464, 465, 3, 2, 2, 2, 465,
-	466, 3, 2, 2, 2, 466, 467, 7, 38, 2, 2, 467, 468, 7, 7, 2, 2, 468, 469,
-	5, 52, 27, 2, 469, 470, 7, 38, 2, 2, 470, 65, 3, 2, 2, 2, 471, 472, 7,
-	9, 2, 2, 472, 473, 5, 54, 28, 2, 473, 474, 7, 10, 2, 2, 474, 67, 3, 2,
-	2, 2, 475, 476, 7, 9, 2, 2, 476, 477, 5, 54, 28, 2, 477, 478, 7, 3, 2,
-	2, 478, 479, 5, 178, 90, 2, 479, 480, 7, 10, 2, 2, 480, 69, 3, 2, 2, 2,
-	481, 482, 7, 4, 2, 2, 482, 483, 5, 54, 28, 2, 483, 484, 7, 7, 2, 2, 484,
-	485, 5, 54, 28, 2, 485, 486, 7, 5, 2, 2, 486, 71, 3, 2, 2, 2, 487, 488,
-	7, 4, 2, 2, 488, 489, 5, 84, 43, 2, 489, 490, 7, 5, 2, 2, 490, 73, 3, 2,
-	2, 2, 491, 493, 7, 4, 2, 2, 492, 494, 5, 76, 39, 2, 493, 492, 3, 2, 2,
-	2, 493, 494, 3, 2, 2, 2, 494, 496, 3, 2, 2, 2, 495, 497, 5, 78, 40, 2,
-	496, 495, 3, 2, 2, 2, 496, 497, 3, 2, 2, 2, 497, 498, 3, 2, 2, 2, 498,
-	499, 5, 84, 43, 2, 499, 500, 7, 5, 2, 2, 500, 75, 3, 2, 2, 2, 501, 502,
-	7, 47, 2, 2, 502, 503, 7, 4, 2, 2, 503, 504, 5, 80, 41, 2, 504, 505, 7,
-	5, 2, 2, 505, 77, 3, 2, 2, 2, 506, 507, 7, 48, 2, 2, 507, 508, 7, 4, 2,
-	2, 508, 509, 5, 80, 41, 2, 509, 510, 7, 5, 2, 2, 510, 79, 3, 2, 2, 2, 511,
-	512, 5, 82, 42, 2, 512, 513, 5, 190, 96, 2, 513, 515, 3, 2, 2, 2, 514,
-	511, 3, 2, 2, 2, 515, 518, 3, 2, 2, 2, 516, 514, 3, 2, 2, 2, 516, 517,
-	3, 2, 2, 2, 517, 81, 3, 2, 2, 2, 518, 516, 3, 2, 2, 2, 519, 522, 5, 110,
-	56, 2, 520, 521, 7, 7, 2, 2, 521, 523, 5, 110, 56, 2, 522, 520, 3, 2, 2,
-	2, 522, 523, 3, 2, 2, 2, 523, 83, 3, 2, 2, 2, 524, 525, 5, 86, 44, 2, 525,
-	526, 5, 190, 96, 2, 526, 528, 3, 2, 2, 2, 527, 524, 3, 2, 2, 2, 528, 531,
-	3, 2, 2, 2, 529, 527, 3, 2, 2, 2, 529, 530, 3, 2, 2, 2, 530, 85, 3, 2,
-	2, 2, 531, 529, 3, 2, 2, 2, 532, 544, 5, 88, 45, 2, 533, 544, 5, 90, 46,
-	2, 534, 544, 5, 92, 47, 2, 535, 544, 5, 94, 48, 2, 536, 544, 5, 96, 49,
-	2, 537, 544, 5, 98, 50, 2, 538, 544, 5, 100, 51, 2, 539, 544, 5, 12, 7,
-	2, 540, 544, 5, 104, 53, 2, 541, 544, 5, 106, 54, 2, 542, 544, 5, 110,
-	56, 2, 543, 532, 3, 2, 2, 2, 543, 533, 3, 2, 2, 2, 543, 534, 3, 2, 2, 2,
-	543, 535, 3, 2, 2, 2, 543, 536, 3, 2, 2, 2, 543, 537, 3, 2, 2, 2, 543,
-	538, 3, 2, 2, 2, 543, 539, 3, 2, 2, 2, 543, 540, 3, 2, 2, 2, 543, 541,
-	3, 2, 2, 2, 543, 542, 3, 2, 2, 2, 544, 87, 3, 2, 2, 2, 545, 548, 7, 56,
-	2, 2, 546, 547, 6, 45, 5, 2, 547, 549, 5, 110, 56, 2, 548, 546, 3, 2, 2,
-	2, 548, 549, 3, 2, 2, 2, 549, 89, 3, 2, 2, 2, 550, 551, 7, 57, 2, 2, 551,
-	91, 3, 2, 2, 2, 552, 553, 7, 58, 2, 2, 553, 93, 3, 2, 2, 2, 554, 557, 7,
-	61, 2, 2, 555, 558, 5, 110, 56, 2, 556, 558, 5, 102, 52, 2, 557, 555, 3,
-	2, 2, 2, 557, 556, 3, 2, 2, 2, 558, 559, 3, 2, 2, 2, 559, 565, 5, 72, 37,
-	2, 560, 563, 7, 62, 2, 2, 561, 564, 5, 94, 48, 2, 562, 564, 5, 72, 37,
-	2, 563, 561, 3, 2, 2, 2, 563, 562, 3, 2, 2, 2, 564, 566, 3, 2, 2, 2, 565,
-	560, 3, 2, 2, 2, 565, 566, 3, 2, 2, 2, 566, 95, 3, 2, 2, 2, 567, 568, 7,
-	63, 2, 2, 568, 569, 5, 110, 56, 2, 569, 570, 5, 72, 37, 2, 570, 97, 3,
-	2, 2, 2, 571, 572, 7, 64, 2, 2, 572, 573, 5, 188, 95, 2, 573, 574, 7, 65,
-	2, 2, 574, 575, 5, 110, 56, 2, 575, 576, 5, 72, 37, 2, 576, 99, 3, 2, 2,
-	2, 577, 578, 7, 46, 2, 2, 578, 579, 5, 188, 95, 2, 579, 580, 5, 162, 82,
-	2, 580, 101, 3, 2, 2, 2, 581, 582, 5, 22, 12, 2, 582, 583, 5, 28, 15, 2,
-	583, 586, 5, 188, 95, 2, 584, 585, 7, 7, 2, 2, 585, 587, 5, 52, 27, 2,
-	586, 584, 3, 2, 2, 2, 586, 587, 3, 2, 2, 2, 587, 588, 3, 2, 2, 2, 588,
-	589, 5, 108, 55, 2, 589, 593, 5, 110, 56, 2, 590, 591, 5, 108, 55, 2, 591,
-	592, 5, 110, 56, 2, 592, 594, 3, 2, 2, 2, 593, 590, 3, 2, 2, 2, 593, 594,
-	3, 2, 2, 2, 594, 103, 3, 2, 2, 2, 595, 599, 5, 188, 95, 2, 596, 598, 5,
-	156, 79, 2, 597, 596, 3, 2, 2, 2, 598, 601, 3, 2, 2, 2, 599, 597, 3, 2,
-	2, 2, 599, 600, 3, 2, 2, 2, 600, 602, 3, 2, 2, 2, 601, 599, 3, 2, 2, 2,
-	602, 603, 5, 108, 55, 2, 603, 604, 5, 110, 56, 2, 604, 105, 3, 2, 2, 2,
-	605, 606, 5, 110, 56, 2, 606, 607, 7, 11, 2, 2, 607, 608, 5, 110, 56, 2,
-	608, 107, 3, 2, 2, 2, 609, 610, 9, 5, 2, 2, 610, 109, 3, 2, 2, 2, 611,
-	612, 5, 112, 57, 2, 612, 111, 3, 2, 2, 2, 613, 619, 5, 114, 58, 2, 614,
-	615, 7, 31, 2, 2, 615, 616, 5, 110, 56, 2, 616, 617, 7, 7, 2, 2, 617, 618,
-	5, 110, 56, 2, 618, 620, 3, 2, 2, 2, 619, 614, 3, 2, 2, 2, 619, 620, 3,
-	2, 2, 2, 620, 113, 3, 2, 2, 2, 621, 622, 8, 58, 1, 2, 622, 623, 5, 116,
-	59, 2, 623, 629, 3, 2, 2, 2, 624, 625, 12, 3, 2, 2, 625, 626, 7, 13, 2,
-	2, 626, 628, 5, 116, 59, 2, 627, 624, 3, 2, 2, 2, 628, 631, 3, 2, 2, 2,
-	629, 627, 3, 2, 2, 2, 629, 630, 3, 2, 2, 2, 630, 115, 3, 2, 2, 2, 631,
-	629, 3, 2, 2, 2, 632, 633, 8, 59, 1, 2, 633, 634, 5, 118, 60, 2, 634, 640,
-	3, 2, 2, 2, 635, 636, 12, 3, 2, 2, 636, 637, 7, 14, 2, 2, 637, 639, 5,
-	118, 60, 2, 638, 635, 3, 2, 2, 2, 639, 642, 3, 2, 2, 2, 640, 638, 3, 2,
-	2, 2, 640, 641, 3, 2, 2, 2, 641, 117, 3, 2, 2, 2, 642, 640, 3, 2, 2, 2,
-	643, 644, 8, 60, 1, 2, 644, 645, 5, 120, 61, 2, 645, 652, 3, 2, 2, 2, 646,
-	647, 12, 3, 2, 2, 647, 648, 5, 138, 70, 2, 648, 649, 5, 120, 61, 2, 649,
-	651, 3, 2, 2, 2, 650, 646, 3, 2, 2, 2, 651, 654, 3, 2, 2, 2, 652, 650,
-	3, 2, 2, 2, 652, 653, 3, 2, 2, 2, 653, 119, 3, 2, 2, 2, 654, 652, 3, 2,
-	2, 2, 655, 656, 8, 61, 1, 2, 656, 657, 5, 122, 62, 2, 657, 664, 3, 2, 2,
-	2, 658, 659, 12, 3, 2, 2, 659, 660, 5, 140, 71, 2, 660, 661, 5, 122, 62,
-	2, 661, 663, 3, 2, 2, 2, 662, 658, 3, 2, 2, 2, 663, 666, 3, 2, 2, 2, 664,
-	662, 3, 2, 2, 2, 664, 665, 3, 2, 2, 2, 665, 121, 3, 2, 2, 2, 666, 664,
-	3, 2, 2, 2, 667, 670, 5, 124, 63, 2, 668, 669, 7, 32, 2, 2, 669, 671, 5,
-	122, 62, 2, 670, 668, 3, 2, 2, 2, 670, 671, 3, 2, 2, 2, 671, 123, 3, 2,
-	2, 2, 672, 673, 8, 63, 1, 2, 673, 674, 5, 126, 64, 2, 674, 681, 3, 2, 2,
-	2, 675, 676, 12, 3, 2, 2, 676, 677, 5, 148, 75, 2, 677, 678, 5, 52, 27,
-	2, 678, 680, 3, 2, 2, 2, 679, 675, 3, 2, 2, 2, 680, 683, 3, 2, 2, 2, 681,
-	679, 3, 2, 2, 2, 681, 682, 3, 2, 2, 2, 682, 125, 3, 2, 2, 2, 683, 681,
-	3, 2, 2, 2, 684, 685, 8, 64, 1, 2, 685, 686, 5, 128, 65, 2, 686, 692, 3,
-	2, 2, 2, 687, 688, 12, 3, 2, 2, 688, 689, 7, 27, 2, 2, 689, 691, 5, 128,
-	65, 2, 690, 687, 3, 2, 2, 2, 691, 694, 3, 2, 2, 2, 692, 690, 3, 2, 2, 2,
-	692, 693, 3, 2, 2, 2, 693, 127, 3, 2, 2, 2, 694, 692, 3, 2, 2, 2, 695,
-	696, 8, 65, 1, 2, 696, 697, 5, 130, 66, 2, 697, 704, 3, 2, 2, 2, 698, 699,
-	12, 3, 2, 2, 699, 700, 5, 142, 72, 2, 700, 701, 5, 130, 66, 2, 701, 703,
-	3, 2, 2, 2, 702, 698, 3, 2, 2, 2, 703, 706, 3, 2, 2, 2, 704, 702, 3, 2,
-	2, 2, 704, 705, 3, 2, 2, 2, 705, 129, 3, 2, 2, 2, 706, 704, 3, 2, 2, 2,
-	707, 708, 8, 66, 1, 2, 708, 709, 5, 132, 67, 2, 709, 716, 3, 2, 2, 2, 710,
-	711, 12, 3, 2, 2, 711, 712, 5, 144, 73, 2, 712, 713, 5, 132, 67, 2, 713,
-	715, 3, 2, 2, 2, 714, 710, 3, 2, 2, 2, 715, 718, 3, 2, 2, 2, 716, 714,
-	3, 2, 2, 2, 716, 717, 3, 2, 2, 2, 717, 131, 3, 2, 2, 2, 718, 716, 3, 2,
-	2, 2, 719, 728, 5, 134, 68, 2, 720, 722, 5, 146, 74, 2, 721, 720, 3, 2,
-	2, 2, 722, 723, 3, 2, 2, 2, 723, 721, 3, 2, 2, 2, 723, 724, 3, 2, 2, 2,
-	724, 725, 3, 2, 2, 2, 725, 726, 5, 132, 67, 2, 726, 728, 3, 2, 2, 2, 727,
-	719, 3, 2, 2, 2, 727, 721, 3, 2, 2, 2, 728, 133, 3, 2, 2, 2, 729, 734,
-	5, 150, 76, 2, 730, 734, 5, 152, 77, 2, 731, 734, 5, 154, 78, 2, 732, 734,
-	5, 136, 69, 2, 733, 729, 3, 2, 2, 2, 733, 730, 3, 2, 2, 2, 733, 731, 3,
-	2, 2, 2, 733, 732, 3, 2, 2, 2, 734, 135, 3, 2, 2, 2, 735, 736, 8, 69, 1,
-	2, 736, 751, 5, 188, 95, 2, 737, 751, 5, 166, 84, 2, 738, 739, 7, 44, 2,
-	2, 739, 742, 5, 48, 25, 2, 740, 741, 7, 7, 2, 2, 741, 743, 5, 52, 27, 2,
-	742, 740, 3, 2, 2, 2, 742, 743, 3, 2, 2, 2, 743, 744, 3, 2, 2, 2, 744,
-	745, 5, 74, 38, 2, 745, 751, 3, 2, 2, 2, 746, 747, 7, 37, 2, 2, 747, 748,
-	5, 110, 56, 2, 748, 749, 7, 38, 2, 2, 749, 751, 3, 2, 2, 2, 750, 735, 3,
-	2, 2, 2, 750, 737, 3, 2, 2, 2, 750, 738, 3, 2, 2, 2, 750, 746, 3, 2, 2,
-	2, 751, 762, 3, 2, 2, 2, 752, 753, 12, 5, 2, 2, 753, 754, 6, 69, 15, 2,
-	754, 761, 5, 162, 82, 2, 755, 756, 12, 4, 2, 2, 756, 761, 5, 156, 79, 2,
-	757, 758, 12, 3, 2, 2, 758, 759, 6, 69, 18, 2, 759, 761, 7, 28, 2, 2, 760,
-	752, 3, 2, 2, 2, 760, 755, 3, 2, 2, 2, 760, 757, 3, 2, 2, 2, 761, 764,
-	3, 2, 2, 2, 762, 760, 3, 2, 2, 2, 762, 763, 3, 2, 2, 2, 763, 137, 3, 2,
-	2, 2, 764, 762, 3, 2, 2, 2, 765, 766, 9, 6, 2, 2, 766, 139, 3, 2, 2, 2,
-	767, 768, 9, 7, 2, 2, 768, 141, 3, 2, 2, 2, 769, 770, 9, 8, 2, 2, 770,
-	143, 3, 2, 2, 2, 771, 772, 9, 9, 2, 2, 772, 145, 3, 2, 2, 2, 773, 774,
-	9, 10, 2, 2, 774, 147, 3, 2, 2, 2, 775, 776, 9, 11, 2, 2, 776, 149, 3,
-	2, 2, 2, 777, 778, 7, 71, 2, 2, 778, 779, 5, 62, 32, 2, 779, 780, 5, 162,
-	82, 2, 780, 151, 3, 2, 2, 2, 781, 782, 7, 72, 2, 2, 782, 783, 5, 110, 56,
-	2, 783, 153, 3, 2, 2, 2, 784, 785, 7, 27, 2, 2, 785, 786, 5, 110, 56, 2,
-	786, 787, 7, 33, 2, 2, 787, 788, 5, 54, 28, 2, 788, 155, 3, 2, 2, 2, 789,
-	793, 5, 158, 80, 2, 790, 791, 6, 79, 19, 2, 791, 793, 5, 160, 81, 2, 792,
-	789, 3, 2, 2, 2, 792, 790, 3, 2, 2, 2, 793, 157, 3, 2, 2, 2, 794, 796,
-	7, 31, 2, 2, 795, 794, 3, 2, 2, 2, 795, 796, 3, 2, 2, 2, 796, 797, 3, 2,
-	2, 2, 797, 798, 7, 8, 2, 2, 798, 799, 5, 188, 95, 2, 799, 159, 3, 2, 2,
-	2, 800, 801, 7, 9, 2, 2, 801, 802, 5, 110, 56, 2, 802, 803, 7, 10, 2, 2,
-	803, 161, 3, 2, 2, 2, 804, 813, 7, 17, 2, 2, 805, 810, 5, 52, 27, 2, 806,
-	807, 7, 6, 2, 2, 807, 809, 5, 52, 27, 2, 808, 806, 3, 2, 2, 2, 809, 812,
-	3, 2, 2, 2, 810, 808, 3, 2, 2, 2, 810, 811, 3, 2, 2, 2, 811, 814, 3, 2,
-	2, 2, 812, 810, 3, 2, 2, 2, 813, 805, 3, 2, 2, 2, 813, 814, 3, 2, 2, 2,
-	814, 815, 3, 2, 2, 2, 815, 817, 7, 18, 2, 2, 816, 804, 3, 2, 2, 2, 816,
-	817, 3, 2, 2, 2, 817, 818, 3, 2, 2, 2, 818, 827, 7, 37, 2, 2, 819, 824,
-	5, 164, 83, 2, 820, 821, 7, 6, 2, 2, 821, 823, 5, 164, 83, 2, 822, 820,
-	3, 2, 2, 2, 823, 826, 3, 2, 2, 2, 824, 822, 3, 2, 2, 2, 824, 825, 3, 2,
-	2, 2, 825, 828, 3, 2, 2, 2, 826, 824, 3, 2, 2, 2, 827, 819, 3, 2, 2, 2,
-	827, 828, 3, 2, 2, 2, 828, 829, 3, 2, 2, 2, 829, 830, 7, 38, 2, 2, 830,
-	163, 3, 2, 2, 2, 831, 832, 5, 188, 95, 2, 832, 833, 7, 7, 2, 2, 833, 835,
-	3, 2, 2, 2, 834, 831, 3, 2, 2, 2, 834, 835, 3, 2, 2, 2, 835, 836, 3, 2,
-	2, 2, 836, 837, 5, 110, 56, 2, 837, 165, 3, 2, 2, 2, 838, 847, 5, 176,
-	89, 2, 839, 847, 5, 178, 90, 2, 840, 847, 5, 168, 85, 2, 841, 847, 5, 182,
-	92, 2, 842, 847, 5, 184, 93, 2, 843, 847, 5, 174, 88, 2, 844, 847, 5, 170,
-	86, 2, 845, 847, 5, 172, 87, 2, 846, 838, 3, 2, 2, 2, 846, 839, 3, 2, 2,
-	2, 846, 840, 3, 2, 2, 2, 846, 841, 3, 2, 2, 2, 846, 842, 3, 2, 2, 2, 846,
-	843, 3, 2, 2, 2, 846, 844, 3, 2, 2, 2, 846, 845, 3, 2, 2, 2, 847, 167,
-	3, 2, 2, 2, 848, 849, 9, 12, 2, 2, 849, 169, 3, 2, 2, 2, 850, 851, 7, 68,
-	2, 2, 851, 171, 3, 2, 2, 2, 852, 853, 7, 24, 2, 2, 853, 854, 6, 87, 20,
-	2, 854, 855, 5, 188, 95, 2, 855, 856, 6, 87, 21, 2, 856, 857, 7, 24, 2,
-	2, 857, 858, 6, 87, 22, 2, 858, 859, 5, 188, 95, 2, 859, 173, 3, 2, 2,
-	2, 860, 861, 7, 80, 2, 2, 861, 175, 3, 2, 2, 2, 862, 864, 7, 22, 2, 2,
-	863, 862, 3, 2, 2, 2, 863, 864, 3, 2, 2, 2, 864, 865, 3, 2, 2, 2, 865,
-	866, 7, 74, 2, 2, 866, 177, 3, 2, 2, 2, 867, 869, 7, 22, 2, 2, 868, 867,
-	3, 2, 2, 2, 868, 869, 3, 2, 2, 2, 869, 870, 3, 2, 2, 2, 870, 871, 5, 180,
-	91, 2, 871, 179, 3, 2, 2, 2, 872, 878, 7, 75, 2, 2, 873, 878, 7, 76, 2,
-	2, 874, 878, 7, 77, 2, 2, 875, 878, 7, 78, 2, 2, 876, 878, 7, 79, 2, 2,
-	877, 872, 3, 2, 2, 2, 877, 873, 3, 2, 2, 2, 877, 874, 3, 2, 2, 2, 877,
-	875, 3, 2, 2, 2, 877, 876, 3, 2, 2, 2, 878, 181, 3, 2, 2, 2, 879, 888,
-	7, 9, 2, 2, 880, 885, 5, 110, 56, 2, 881, 882, 7, 6, 2, 2, 882, 884, 5,
-	110, 56, 2, 883, 881, 3, 2, 2, 2, 884, 887, 3, 2, 2, 2, 885, 883, 3, 2,
-	2, 2, 885, 886, 3, 2, 2, 2, 886, 889, 3, 2, 2, 2, 887, 885, 3, 2, 2, 2,
-	888, 880, 3, 2, 2, 2, 888, 889, 3, 2, 2, 2, 889, 890, 3, 2, 2, 2, 890,
-	891, 7, 10, 2, 2, 891, 183, 3, 2, 2, 2, 892, 901, 7, 4, 2, 2, 893, 898,
-	5, 186, 94, 2, 894, 895, 7, 6, 2, 2, 895, 897, 5, 186, 94, 2, 896, 894,
-	3, 2, 2, 2, 897, 900, 3, 2, 2, 2, 898, 896, 3, 2, 2, 2, 898, 899, 3, 2,
-	2, 2, 899, 902, 3, 2, 2, 2, 900, 898, 3, 2, 2, 2, 901, 893, 3, 2, 2, 2,
-	901, 902, 3, 2, 2, 2, 902, 903, 3, 2, 2, 2, 903, 904, 7, 5, 2, 2, 904,
-	185, 3, 2, 2, 2, 905, 906, 5, 110, 56, 2, 906, 907, 7, 7, 2, 2, 907, 908,
-	5, 110, 56, 2, 908, 187, 3, 2, 2, 2, 909, 910, 9, 13, 2, 2, 910, 189, 3,
-	2, 2, 2, 911, 916, 7, 3, 2, 2, 912, 916, 7, 2, 2, 3, 913, 916, 6, 96, 23,
-	2, 914, 916, 6, 96, 24, 2, 915, 911, 3, 2, 2, 2, 915, 912, 3, 2, 2, 2,
-	915, 913, 3, 2, 2, 2, 915, 914, 3, 2, 2, 2, 916, 191, 3, 2, 2, 2, 90, 194,
-	198, 206, 213, 220, 229, 233, 238, 241, 252, 267, 272, 276, 284, 290, 306,
-	309, 315, 323, 327, 340, 344, 353, 360, 368, 371, 384, 387, 392, 399, 404,
-	408, 415, 422, 424, 431, 439, 442, 451, 461, 464, 493, 496, 516, 522, 529,
-	543, 548, 557, 563, 565, 586, 593, 599, 619, 629, 640, 652, 664, 670, 681,
-	692, 704, 716, 723, 727, 733, 742, 750, 760, 762, 792, 795, 810, 813, 816,
-	824, 827, 834, 846, 863, 868, 877, 885, 888, 898, 901, 915,
+	3, 2, 2, 2, 431, 1026, 3, 2, 2, 2, 432, 59, 3, 2, 2, 2, 433, 442, 7, 4,
+	2, 2, 434, 439, 5, 62, 32, 2, 435, 436, 7, 6, 2, 2, 436, 438, 5, 62, 32,
+	2, 437, 435, 3, 2, 2, 2, 438, 441, 3, 2, 2, 2, 439, 437, 3, 2, 2, 2, 439,
+	440, 3, 2, 2, 2, 440, 443, 3, 2, 2, 2, 441, 439, 3, 2, 2, 2, 442, 434,
+	3, 2, 2, 2, 442, 443, 3, 2, 2, 2, 443, 444, 3, 2, 2, 2, 444, 445, 7, 5,
+	2, 2, 445, 61, 3, 2, 2, 2, 446, 451, 5, 188, 95, 2, 447, 448, 7, 8, 2,
+	2, 448, 450, 5, 188, 95, 2, 449, 447, 3, 2, 2, 2, 450, 453, 3, 2, 2, 2,
+	451, 449, 3, 2, 2, 2, 451, 452, 3, 2, 2, 2, 452, 63, 3, 2, 2, 2, 453, 451,
+	3, 2, 2, 2, 454, 455, 7, 37, 2, 2, 455, 464, 7, 37, 2, 2, 456, 461, 5,
+	52, 27, 2, 457, 458, 7, 6, 2, 2, 458, 460, 5, 52, 27, 2, 459, 457, 3, 2,
+	2, 2, 460, 463, 3, 2, 2, 2, 461, 459, 3, 2, 2, 2, 461, 462, 3, 2, 2, 2,
+	462, 465, 3, 2, 2, 2, 463, 461, 3, 2, 2, 2, 464, 456, 3, 2, 2, 2, 464,
+	465, 3, 2, 2, 2, 465, 466, 3, 2, 2, 2, 466, 467, 7, 38, 2, 2, 467, 468,
+	7, 7, 2, 2, 468, 469, 5, 52, 27, 2, 469, 470, 7, 38, 2, 2, 470, 65, 3,
+	2, 2, 2, 471, 472, 7, 9, 2, 2, 472, 473, 5, 54, 28, 2, 473, 474, 7, 10,
+	2, 2, 474, 67, 3, 2, 2, 2, 475, 476, 7, 9, 2, 2, 476, 477, 5, 54, 28, 2,
+	477, 478, 7, 3, 2, 2, 478, 479, 5, 178, 90, 2, 479, 480, 7, 10, 2, 2, 480,
+	69, 3, 2, 2, 2, 481, 482, 7, 4, 2, 2, 482, 483, 5, 54, 28, 2, 483, 484,
+	7, 7, 2, 2, 484, 485, 5, 54, 28, 2, 485, 486, 7, 5, 2, 2, 486, 71, 3, 2,
+	2, 2, 487, 488, 7, 4, 2, 2, 488, 489, 5, 84, 43, 2, 489, 490, 7, 5, 2,
+	2, 490, 73, 3, 2, 2, 2, 491, 493, 7, 4, 2, 2, 492, 494, 5, 76, 39, 2, 493,
+	492, 3, 2, 2, 2, 493, 494, 3, 2, 2, 2, 494, 496, 3, 2, 2, 2, 495, 497,
+	5, 78, 40, 2, 496, 495, 3, 2, 2, 2, 496, 497, 3, 2, 2, 2, 497, 498, 3,
+	2, 2, 2, 498, 499, 5, 84, 43, 2, 499, 500, 7, 5, 2, 2, 500, 75, 3, 2, 2,
+	2, 501, 502, 7, 47, 2, 2, 502, 503, 7, 4, 2, 2, 503, 504, 5, 80, 41, 2,
+	504, 505, 7, 5, 2, 2, 505, 77, 3, 2, 2, 2, 506, 507, 7, 48, 2, 2, 507,
+	508, 7, 4, 2, 2, 508, 509, 5, 80, 41, 2, 509, 510, 7, 5, 2, 2, 510, 79,
+	3, 2, 2, 2, 511, 512, 5, 82, 42, 2, 512, 513, 5, 190, 96, 2, 513, 515,
+	3, 2, 2, 2, 514, 511, 3, 2, 2, 2, 515, 518, 3, 2, 2, 2, 516, 514, 3, 2,
+	2, 2, 516, 517, 3, 2, 2, 2, 517, 81, 3, 2, 2, 2, 518, 516, 3, 2, 2, 2,
+	519, 522, 5, 110, 56, 2, 520, 521, 7, 7, 2, 2, 521, 523, 5, 110, 56, 2,
+	522, 520, 3, 2, 2, 2, 522, 523, 3, 2, 2, 2, 523, 83, 3, 2, 2, 2, 524, 525,
+	5, 86, 44, 2, 525, 526, 5, 190, 96, 2, 526, 528, 3, 2, 2, 2, 527, 524,
+	3, 2, 2, 2, 528, 531, 3, 2, 2, 2, 529, 527, 3, 2, 2, 2, 529, 530, 3, 2,
+	2, 2, 530, 85, 3, 2, 2, 2, 531, 529, 3, 2, 2, 2, 532, 544, 5, 88, 45, 2,
+	533, 544, 5, 90, 46, 2, 534, 544, 5, 92, 47, 2, 535, 544, 5, 94, 48, 2,
+	536, 544, 5, 96, 49, 2, 537, 544, 5, 98, 50, 2, 538, 544, 5, 100, 51, 2,
+	539, 544, 5, 12, 7, 2, 540, 544, 5, 104, 53, 2, 541, 544, 5, 106, 54, 2,
+	542, 544, 5, 110, 56, 2, 543, 532, 3, 2, 2, 2, 543, 533, 3, 2, 2, 2, 543,
+	534, 3, 2, 2, 2, 543, 535, 3, 2, 2, 2, 543, 536, 3, 2, 2, 2, 543, 537,
+	3, 2, 2, 2, 543, 956, 3, 2, 2, 2, 543, 538, 3, 2, 2, 2, 543, 539, 3, 2,
+	2, 2, 543, 540, 3, 2, 2, 2, 543, 541, 3, 2, 2, 2, 543, 542, 3, 2, 2, 2,
+	544, 87, 3, 2, 2, 2, 545, 548, 7, 56, 2, 2, 546, 547, 6, 45, 5, 2, 547,
+	549, 5, 110, 56, 2, 548, 546, 3, 2, 2, 2, 548, 549, 3, 2, 2, 2, 549, 89,
+	3, 2, 2, 2, 550, 551, 7, 57, 2, 2, 551, 91, 3, 2, 2, 2, 552, 553, 7, 58,
+	2, 2, 553, 93, 3, 2, 2, 2, 554, 557, 7, 61, 2, 2, 555, 558, 5, 110, 56,
+	2, 556, 558, 5, 102, 52, 2, 557, 555, 3, 2, 2, 2, 557, 556, 3, 2, 2, 2,
+	558, 559, 3, 2, 2, 2, 559, 565, 5, 72, 37, 2, 560, 563, 7, 62, 2, 2, 561,
+	564, 5, 94, 48, 2, 562, 564, 5, 72, 37, 2, 563, 561, 3, 2, 2, 2, 563, 562,
+	3, 2, 2, 2, 564, 566, 3, 2, 2, 2, 565, 560, 3, 2, 2, 2, 565, 566, 3, 2,
+	2, 2, 566, 95, 3, 2, 2, 2, 567, 568, 7, 63, 2, 2, 568, 569, 5, 110, 56,
+	2, 569, 570, 5, 72, 37, 2, 570, 97, 3, 2, 2, 2, 571, 572, 7, 64, 2, 2,
+	572, 573, 5, 188, 95, 2, 573, 574, 7, 65, 2, 2, 574, 575, 5, 110, 56, 2,
+	575, 576, 5, 72, 37, 2, 576, 99, 3, 2, 2, 2, 577, 578, 7, 46, 2, 2, 578,
+	579, 5, 188, 95, 2, 579, 580, 5, 162, 82, 2, 580, 101, 3, 2, 2, 2, 581,
+	582, 5, 22, 12, 2, 582, 583, 5, 28, 15, 2, 583, 586, 5, 188, 95, 2, 584,
+	585, 7, 7, 2, 2, 585, 587, 5, 52, 27, 2, 586, 584, 3, 2, 2, 2, 586, 587,
+	3, 2, 2, 2, 587, 588, 3, 2, 2, 2, 588, 589, 5, 108, 55, 2, 589, 593, 5,
+	110, 56, 2, 590, 591, 5, 108, 55, 2, 591, 592, 5, 110, 56, 2, 592, 594,
+	3, 2, 2, 2, 593, 590, 3, 2, 2, 2, 593, 594, 3, 2, 2, 2, 594, 103, 3, 2,
+	2, 2, 595, 599, 5, 188, 95, 2, 596, 598, 5, 156, 79, 2, 597, 596, 3, 2,
+	2, 2, 598, 601, 3, 2, 2, 2, 599, 597, 3, 2, 2, 2, 599, 600, 3, 2, 2, 2,
+	600, 602, 3, 2, 2, 2, 601, 599, 3, 2, 2, 2, 602, 603, 5, 108, 55, 2, 603,
+	604, 5, 110, 56, 2, 604, 105, 3, 2, 2, 2, 605, 606, 5, 110, 56, 2, 606,
+	607, 7, 11, 2, 2, 607, 608, 5, 110, 56, 2, 608, 107, 3, 2, 2, 2, 609, 610,
+	9, 5, 2, 2, 610, 109, 3, 2, 2, 2, 611, 612, 5, 112, 57, 2, 612, 111, 3,
+	2, 2, 2, 613, 619, 5, 114, 58, 2, 614, 615, 7, 31, 2, 2, 615, 616, 5, 110,
+	56, 2, 616, 617, 7, 7, 2, 2, 617, 618, 5, 110, 56, 2, 618, 620, 3, 2, 2,
+	2, 619, 614, 3, 2, 2, 2, 619, 620, 3, 2, 2, 2, 620, 113, 3, 2, 2, 2, 621,
+	622, 8, 58, 1, 2, 622, 623, 5, 116, 59, 2, 623, 629, 3, 2, 2, 2, 624, 625,
+	12, 3, 2, 2, 625, 626, 7, 13, 2, 2, 626, 628, 5, 116, 59, 2, 627, 624,
+	3, 2, 2, 2, 628, 631, 3, 2, 2, 2, 629, 627, 3, 2, 2, 2, 629, 630, 3, 2,
+	2, 2, 630, 115, 3, 2, 2, 2, 631, 629, 3, 2, 2, 2, 632, 633, 8, 59, 1, 2,
+	633, 634, 5, 118, 60, 2, 634, 640, 3, 2, 2, 2, 635, 636, 12, 3, 2, 2, 636,
+	637, 7, 14, 2, 2, 637, 639, 5, 118, 60, 2, 638, 635, 3, 2, 2, 2, 639, 642,
+	3, 2, 2, 2, 640, 638, 3, 2, 2, 2, 640, 641, 3, 2, 2, 2, 641, 117, 3, 2,
+	2, 2, 642, 640, 3, 2, 2, 2, 643, 644, 8, 60, 1, 2, 644, 645, 5, 120, 61,
+	2, 645, 652, 3, 2, 2, 2, 646, 647, 12, 3, 2, 2, 647, 648, 5, 138, 70, 2,
+	648, 649, 5, 120, 61, 2, 649, 651, 3, 2, 2, 2, 650, 646, 3, 2, 2, 2, 651,
+	654, 3, 2, 2, 2, 652, 650, 3, 2, 2, 2, 652, 653, 3, 2, 2, 2, 653, 119,
+	3, 2, 2, 2, 654, 652, 3, 2, 2, 2, 655, 656, 8, 61, 1, 2, 656, 657, 5, 122,
+	62, 2, 657, 664, 3, 2, 2, 2, 658, 659, 12, 3, 2, 2, 659, 660, 5, 140, 71,
+	2, 660, 661, 5, 122, 62, 2, 661, 663, 3, 2, 2, 2, 662, 658, 3, 2, 2, 2,
+	663, 666, 3, 2, 2, 2, 664, 662, 3, 2, 2, 2, 664, 665, 3, 2, 2, 2, 665,
+	121, 3, 2, 2, 2, 666, 664, 3, 2, 2, 2, 667, 670, 5, 124, 63, 2, 668, 669,
+	7, 32, 2, 2, 669, 671, 5, 122, 62, 2, 670, 668, 3, 2, 2, 2, 670, 671, 3,
+	2, 2, 2, 671, 123, 3, 2, 2, 2, 672, 673, 8, 63, 1, 2, 673, 674, 5, 957,
+	100, 2, 674, 681, 3, 2, 2, 2, 675, 676, 12, 3, 2, 2, 676, 677, 5, 148,
+	75, 2, 677, 678, 5, 52, 27, 2, 678, 680, 3, 2, 2, 2, 679, 675, 3, 2, 2,
+	2, 680, 683, 3, 2, 2, 2, 681, 679, 3, 2, 2, 2, 681, 682, 3, 2, 2, 2, 682,
+	125, 3, 2, 2, 2, 683, 681, 3, 2, 2, 2, 684, 685, 8, 64, 1, 2, 685, 686,
+	5, 961, 102, 2, 686, 692, 3, 2, 2, 2, 687, 688, 12, 3, 2, 2, 688, 689,
+	7, 27, 2, 2, 689, 691, 5, 961, 102, 2, 690, 687, 3, 2, 2, 2, 691, 694,
+	3, 2, 2, 2, 692, 690, 3, 2, 2, 2, 692, 693, 3, 2, 2, 2, 693, 127, 3, 2,
+	2, 2, 694, 692, 3, 2, 2, 2, 695, 696, 8, 65, 1, 2, 696, 697, 5, 130, 66,
+	2, 697, 704, 3, 2, 2, 2, 698, 699, 12, 3, 2, 2, 699, 700, 5, 142, 72, 2,
+	700, 701, 5, 130, 66, 2, 701, 703, 3, 2, 2, 2, 702, 698, 3, 2, 2, 2, 703,
+	706, 3, 2, 2, 2, 704, 702, 3, 2, 2, 2, 704, 705, 3, 2, 2, 2, 705, 129,
+	3, 2, 2, 2, 706, 704, 3, 2, 2, 2, 707, 708, 8, 66, 1, 2, 708, 709, 5, 132,
+	67, 2, 709, 716, 3, 2, 2, 2, 710, 711, 12, 3, 2, 2, 711, 712, 5, 144, 73,
+	2, 712, 713, 5, 132, 67, 2, 713, 715, 3, 2, 2, 2, 714, 710, 3, 2, 2, 2,
+	715, 718, 3, 2, 2, 2, 716, 714, 3, 2, 2, 2, 716, 717, 3, 2, 2, 2, 717,
+	131, 3, 2, 2, 2, 718, 716, 3, 2, 2, 2, 719, 728, 5, 134, 68, 2, 720, 722,
+	5, 146, 74, 2, 721, 720, 3, 2, 2, 2, 722, 723, 3, 2, 2, 2, 723, 721, 3,
+	2, 2, 2, 723, 724, 3, 2, 2, 2, 724, 725, 3, 2, 2, 2, 725, 726, 5, 132,
+	67, 2, 726, 728, 3, 2, 2, 2, 727, 719, 3, 2, 2, 2, 727, 721, 3, 2, 2, 2,
+	728, 133, 3, 2, 2, 2, 729, 734, 5, 150, 76, 2, 730, 734, 5, 152, 77, 2,
+	731, 734, 5, 154, 78, 2, 732, 734, 5, 136, 69, 2, 733, 729, 3, 2, 2, 2,
+	733, 730, 3, 2, 2, 2, 733, 731, 3, 2, 2, 2, 733, 732, 3, 2, 2, 2, 734,
+	135, 3, 2, 2, 2, 735, 736, 8, 69, 1, 2, 736, 751, 5, 188, 95, 2, 737, 751,
+	5, 166, 84, 2, 738, 739, 7, 44, 2, 2, 739, 742, 5, 48, 25, 2, 740, 741,
+	7, 7, 2, 2, 741, 743, 5, 52, 27, 2, 742, 740, 3, 2, 2, 2, 742, 743, 3,
+	2, 2, 2, 743, 744, 3, 2, 2, 2, 744, 745, 5, 74, 38, 2, 745, 751, 3, 2,
+	2, 2, 746, 747, 7, 37, 2, 2, 747, 748, 5, 110, 56, 2, 748, 749, 7, 38,
+	2, 2, 749, 751, 3, 2, 2, 2, 750, 735, 3, 2, 2, 2, 750, 737, 3, 2, 2, 2,
+	750, 738, 3, 2, 2, 2, 750, 746, 3, 2, 2, 2, 751, 762, 3, 2, 2, 2, 752,
+	753, 12, 5, 2, 2, 753, 754, 6, 69, 15, 2, 754, 761, 5, 162, 82, 2, 755,
+	756, 12, 4, 2, 2, 756, 761, 5, 156, 79, 2, 757, 758, 12, 3, 2, 2, 758,
+	759, 6, 69, 18, 2, 759, 761, 7, 28, 2, 2, 760, 752, 3, 2, 2, 2, 760, 755,
+	3, 2, 2, 2, 760, 757, 3, 2, 2, 2, 761, 764, 3, 2, 2, 2, 762, 760, 3, 2,
+	2, 2, 762, 763, 3, 2, 2, 2, 763, 137, 3, 2, 2, 2, 764, 762, 3, 2, 2, 2,
+	765, 766, 9, 6, 2, 2, 766, 139, 3, 2, 2, 2, 767, 768, 9, 7, 2, 2, 768,
+	141, 3, 2, 2, 2, 769, 770, 9, 8, 2, 2, 770, 143, 3, 2, 2, 2, 771, 772,
+	9, 9, 2, 2, 772, 145, 3, 2, 2, 2, 773, 774, 9, 10, 2, 2, 774, 147, 3, 2,
+	2, 2, 775, 776, 9, 11, 2, 2, 776, 149, 3, 2, 2, 2, 777, 778, 7, 71, 2,
+	2, 778, 779, 5, 62, 32, 2, 779, 780, 5, 162, 82, 2, 780, 151, 3, 2, 2,
+	2, 781, 782, 7, 72, 2, 2, 782, 783, 5, 110, 56, 2, 783, 153, 3, 2, 2, 2,
+	784, 785, 7, 27, 2, 2, 785, 786, 5, 110, 56, 2, 786, 787, 7, 33, 2, 2,
+	787, 788, 5, 54, 28, 2, 788, 155, 3, 2, 2, 2, 789, 793, 5, 158, 80, 2,
+	790, 791, 6, 79, 19, 2, 791, 793, 5, 160, 81, 2, 792, 789, 3, 2, 2, 2,
+	792, 790, 3, 2, 2, 2, 793, 157, 3, 2, 2, 2, 794, 796, 7, 31, 2, 2, 795,
+	794, 3, 2, 2, 2, 795, 796, 3, 2, 2, 2, 796, 797, 3, 2, 2, 2, 797, 798,
+	7, 8, 2, 2, 798, 799, 5, 188, 95, 2, 799, 159, 3, 2, 2, 2, 800, 801, 7,
+	9, 2, 2, 801, 802, 5, 110, 56, 2, 802, 803, 7, 10, 2, 2, 803, 161, 3, 2,
+	2, 2, 804, 813, 7, 17, 2, 2, 805, 810, 5, 52, 27, 2, 806, 807, 7, 6, 2,
+	2, 807, 809, 5, 52, 27, 2, 808, 806, 3, 2, 2, 2, 809, 812, 3, 2, 2, 2,
+	810, 808, 3, 2, 2, 2, 810, 811, 3, 2, 2, 2, 811, 814, 3, 2, 2, 2, 812,
+	810, 3, 2, 2, 2, 813, 805, 3, 2, 2, 2, 813, 814, 3, 2, 2, 2, 814, 815,
+	3, 2, 2, 2, 815, 817, 7, 18, 2, 2, 816, 804, 3, 2, 2, 2, 816, 817, 3, 2,
+	2, 2, 817, 818, 3, 2, 2, 2, 818, 827, 7, 37, 2, 2, 819, 824, 5, 164, 83,
+	2, 820, 821, 7, 6, 2, 2, 821, 823, 5, 164, 83, 2, 822, 820, 3, 2, 2, 2,
+	823, 826, 3, 2, 2, 2, 824, 822, 3, 2, 2, 2, 824, 825, 3, 2, 2, 2, 825,
+	828, 3, 2, 2, 2, 826, 824, 3, 2, 2, 2, 827, 819, 3, 2, 2, 2, 827, 828,
+	3, 2, 2, 2, 828, 829, 3, 2, 2, 2, 829, 830, 7, 38, 2, 2, 830, 163, 3, 2,
+	2, 2, 831, 832, 5, 188, 95, 2, 832, 833, 7, 7, 2, 2, 833, 835, 3, 2, 2,
+	2, 834, 831, 3, 2, 2, 2, 834, 835, 3, 2, 2, 2, 835, 836, 3, 2, 2, 2, 836,
+	837, 5, 110, 56, 2, 837, 165, 3, 2, 2, 2, 838, 847, 5, 176, 89, 2, 839,
+	847, 5, 178, 90, 2, 840, 847, 5, 168, 85, 2, 841, 847, 5, 182, 92, 2, 842,
+	847, 5, 184, 93, 2, 843, 847, 5, 174, 88, 2, 844, 847, 5, 170, 86, 2, 845,
+	847, 5, 172, 87, 2, 846, 838, 3, 2, 2, 2, 846, 839, 3, 2, 2, 2, 846, 840,
+	3, 2, 2, 2, 846, 841, 3, 2, 2, 2, 846, 842, 3, 2, 2, 2, 846, 843, 3, 2,
+	2, 2, 846, 844, 3, 2, 2, 2, 846, 845, 3, 2, 2, 2, 847, 167, 3, 2, 2, 2,
+	848, 849, 9, 12, 2, 2, 849, 169, 3, 2, 2, 2, 850, 851, 7, 68, 2, 2, 851,
+	171, 3, 2, 2, 2, 852, 853, 7, 24, 2, 2, 853, 854, 6, 87, 20, 2, 854, 855,
+	5, 188, 95, 2, 855, 856, 6, 87, 21, 2, 856, 857, 7, 24, 2, 2, 857, 858,
+	6, 87, 22, 2, 858, 859, 5, 188, 95, 2, 859, 173, 3, 2, 2, 2, 860, 861,
+	7, 80, 2, 2, 861, 175, 3, 2, 2, 2, 862, 864, 7, 22, 2, 2, 863, 862, 3,
+	2, 2, 2, 863, 864, 3, 2, 2, 2, 864, 865, 3, 2, 2, 2, 865, 866, 7, 74, 2,
+	2, 866, 177, 3, 2, 2, 2, 867, 869, 7, 22, 2, 2, 868, 867, 3, 2, 2, 2, 868,
+	869, 3, 2, 2, 2, 869, 870, 3, 2, 2, 2, 870, 871, 5, 180, 91, 2, 871, 179,
+	3, 2, 2, 2, 872, 878, 7, 75, 2, 2, 873, 878, 7, 76, 2, 2, 874, 878, 7,
+	77, 2, 2, 875, 878, 7, 78, 2, 2, 876, 878, 7, 79, 2, 2, 877, 872, 3, 2,
+	2, 2, 877, 873, 3, 2, 2, 2, 877, 874, 3, 2, 2, 2, 877, 875, 3, 2, 2, 2,
+	877, 876, 3, 2, 2, 2, 878, 181, 3, 2, 2, 2, 879, 888, 7, 9, 2, 2, 880,
+	885, 5, 110, 56, 2, 881, 882, 7, 6, 2, 2, 882, 884, 5, 110, 56, 2, 883,
+	881, 3, 2, 2, 2, 884, 887, 3, 2, 2, 2, 885, 883, 3, 2, 2, 2, 885, 886,
+	3, 2, 2, 2, 886, 889, 3, 2, 2, 2, 887, 885, 3, 2, 2, 2, 888, 880, 3, 2,
+	2, 2, 888, 889, 3, 2, 2, 2, 889, 890, 3, 2, 2, 2, 890, 891, 7, 10, 2, 2,
+	891, 183, 3, 2, 2, 2, 892, 901, 7, 4, 2, 2, 893, 898, 5, 186, 94, 2, 894,
+	895, 7, 6, 2, 2, 895, 897, 5, 186, 94, 2, 896, 894, 3, 2, 2, 2, 897, 900,
+	3, 2, 2, 2, 898, 896, 3, 2, 2, 2, 898, 899, 3, 2, 2, 2, 899, 902, 3, 2,
+	2, 2, 900, 898, 3, 2, 2, 2, 901, 893, 3, 2, 2, 2, 901, 902, 3, 2, 2, 2,
+	902, 903, 3, 2, 2, 2, 903, 904, 7, 5, 2, 2, 904, 185, 3, 2, 2, 2, 905,
+	906, 5, 110, 56, 2, 906, 907, 7, 7, 2, 2, 907, 908, 5, 110, 56, 2, 908,
+	187, 3, 2, 2, 2, 909, 910, 9, 13, 2, 2, 910, 189, 3, 2, 2, 2, 911, 916,
+	7, 3, 2, 2, 912, 916, 7, 2, 2, 3, 913, 916, 6, 96, 23, 2, 914, 916, 6,
+	96, 24, 2, 915, 911, 3, 2, 2, 2, 915, 912, 3, 2, 2, 2, 915, 913, 3, 2,
+	2, 2, 915, 914, 3, 2, 2, 2, 916, 191, 3, 2, 2, 2, 918, 920, 3, 2, 2, 2,
+	920, 921, 5, 22, 12, 2, 921, 922, 7, 86, 2, 2, 922, 923, 5, 188, 95, 2,
+	923, 919, 3, 2, 2, 2, 924, 354, 5, 918, 97, 2, 353, 924, 3, 2, 2, 2, 925,
+	929, 3, 2, 2, 2, 929, 930, 7, 87, 2, 2, 930, 931, 5, 110, 56, 2, 931, 935,
+	7, 4, 2, 2, 934, 932, 3, 2, 2, 2, 932, 933, 5, 927, 99, 2, 933, 936, 3,
+	2, 2, 2, 935, 934, 3, 2, 2, 2, 935, 937, 3, 2, 2, 2, 937, 938, 3, 2, 2,
+	2, 936, 935, 3, 2, 2, 2, 938, 939, 7, 5, 2, 2, 939, 926, 3, 2, 2, 2, 927,
+	941, 3, 2, 2, 2, 941, 942, 3, 2, 2, 2, 941, 953, 3, 2, 2, 2, 942, 943,
+	7, 86, 2, 2, 943, 948, 5, 110, 56, 2, 947, 944, 3, 2, 2, 2, 944, 945, 7,
+	6, 2, 2, 945, 946, 5, 110, 56, 2, 946, 949, 3, 2, 2, 2, 948, 947, 3, 2,
+	2, 2, 948, 950, 3, 2, 2, 2, 950, 951, 3, 2, 2, 2, 949, 948, 3, 2, 2, 2,
+	951, 952, 7, 7, 2, 2, 952, 940, 5, 84, 43, 2, 953, 954, 7, 88, 2, 2, 954,
+	955, 7, 7, 2, 2, 955, 940, 5, 84, 43, 2, 940, 928, 3, 2, 2, 2, 956, 544,
+	5, 925, 98, 2, 957, 965, 3, 2, 2, 2, 965, 966, 8, 100, 1, 2, 966, 967,
+	5, 959, 101, 2, 967, 974, 3, 2, 2, 2, 968, 969, 12, 3, 2, 2, 969, 970,
+	7, 89, 2, 2, 970, 971, 5, 959, 101, 2, 971, 972, 3, 2, 2, 2, 973, 968,
+	3, 2, 2, 2, 972, 975, 3, 2, 2, 2, 974, 973, 3, 2, 2, 2, 974, 976, 3, 2,
+	2, 2, 976, 958, 3, 2, 2, 2, 975, 974, 3, 2, 2, 2, 959, 977, 3, 2, 2, 2,
+	977, 978, 8, 101, 1, 2, 978, 979, 5, 126, 64, 2, 979, 986, 3, 2, 2, 2,
+	980, 981, 12, 3, 2, 2, 981, 982, 7, 90, 2, 2, 982, 983, 5, 126, 64, 2,
+	983, 984, 3, 2, 2, 2, 985, 980, 3, 2, 2, 2, 984, 987, 3, 2, 2, 2, 986,
+	985, 3, 2, 2, 2, 986, 988, 3, 2, 2, 2, 988, 960, 3, 2, 2, 2, 987, 986,
+	3, 2, 2, 2, 961, 989, 3, 2, 2, 2, 989, 990, 8, 102, 1, 2, 990, 991, 5,
+	128, 65, 2, 991, 998, 3, 2, 2, 2, 992, 993, 12, 3, 2, 2, 993, 994, 5, 963,
+	103, 2, 994, 995, 5, 128, 65, 2, 995, 996, 3, 2, 2, 2, 997, 992, 3, 2,
+	2, 2, 996, 999, 3, 2, 2, 2, 998, 997, 3, 2, 2, 2, 998, 1000, 3, 2, 2, 2,
+	1000, 962, 3, 2, 2, 2, 999, 998, 3, 2, 2, 2, 963, 1002, 3, 2, 2, 2, 1002,
+	1003, 3, 2, 2, 2, 1003, 1004, 7, 17, 2, 2, 1004, 1005, 6, 103, 28, 2, 1005,
+	1006, 7, 17, 2, 2, 1006, 1001, 3, 2, 2, 2, 1002, 1007, 3, 2, 2, 2, 1007,
+	1008, 7, 18, 2, 2, 1008, 1009, 6, 103, 29, 2, 1009, 1010, 7, 18, 2, 2,
+	1010, 1001, 3, 2, 2, 2, 1001, 964, 3, 2, 2, 2, 1011, 1013, 3, 2, 2, 2,
+	1013, 1014, 5, 62, 32, 2, 1014, 1015, 6, 104, 30, 2, 1015, 1016, 7, 17,
+	2, 2, 1016, 1021, 5, 52, 27, 2, 1020, 1017, 3, 2, 2, 2, 1017, 1018, 7,
+	6, 2, 2, 1018, 1019, 5, 52, 27, 2, 1019, 1022, 3, 2, 2, 2, 1021, 1020,
+	3, 2, 2, 2, 1021, 1023, 3, 2, 2, 2, 1023, 1024, 3, 2, 2, 2, 1022, 1021,
+	3, 2, 2, 2, 1024, 1025, 7, 18, 2, 2, 1025, 1012, 3, 2, 2, 2, 1026, 432,
+	5, 1011, 104, 2, 98, 194, 198, 206, 213, 220, 229, 233, 238, 241, 252,
+	267, 272, 276, 284, 290, 306, 309, 315, 323, 327, 340, 344, 353, 360, 368,
+	371, 384, 387, 392, 399, 404, 408, 415, 422, 424, 431, 439, 442, 451, 461,
+	464, 493, 496, 516, 522, 529, 543, 548, 557, 563, 565, 586, 593, 599, 619,
+	629, 640, 652, 664, 670, 681, 692, 704, 716, 723, 727, 733, 742, 750, 760,
+	762, 792, 795, 810, 813, 816, 824, 827, 834, 846, 863, 868, 877, 885, 888,
+	898, 901, 915, 935, 941, 948, 974, 986, 998, 1002, 1021,
 }
 var deserializer = antlr.NewATNDeserializer(nil)
 var deserializedATN = deserializer.DeserializeFromUInt16(parserATN)
@@ -460,7 +511,8 @@ var literalNames = []string{
 	"'pre'", "'post'", "'priv'", "'pub'", "'set'", "'access'", "'all'", "'self'",
 	"'account'", "'return'", "'break'", "'continue'", "'let'", "'var'", "'if'",
 	"'else'", "'while'", "'for'", "'in'", "'true'", "'false'", "'nil'", "'import'",
-	"'from'", "'create'", "'destroy'",
+	"'from'", "'create'", "'destroy'", "", "", "", "", "", "", "", "", "", "",
+	"", "", "'enum'", "'case'", "'switch'", "'default'", "'|'", "'^'", "'~'",
 }
 var symbolicNames = []string{
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "Equal", "Unequal",
@@ -474,7 +526,8 @@ var symbolicNames = []string{
 	"From", "Create", "Destroy", "Identifier", "PositiveFixedPointLiteral",
 	"DecimalLiteral", "BinaryLiteral", "OctalLiteral", "HexadecimalLiteral",
 	"InvalidNumberLiteral", "StringLiteral", "WS", "Terminator", "BlockComment",
-	"LineComment",
+	"LineComment", "Enum", "Case", "Switch", "Default", "BitwiseOr", "BitwiseXor",
+	"BitwiseNot",
 }
 
 var ruleNames = []string{
@@ -498,7 +551,9 @@ var ruleNames = []string{
 	"expressionAccess", "memberAccess", "bracketExpression", "invocation",
 	"argument", "literal", "booleanLiteral", "nilLiteral", "pathLiteral", "stringLiteral",
 	"fixedPointLiteral", "integerLiteral", "positiveIntegerLiteral", "arrayLiteral",
-	"dictionaryLiteral", "dictionaryEntry", "identifier", "eos",
+	"dictionaryLiteral", "dictionaryEntry", "identifier", "eos", "enumCase",
+	"switchStatement", "switchCase", "bitwiseOrExpression", "bitwiseXorExpression",
+	"bitwiseShiftExpression", "bitwiseShiftOp", "instantiationType",
 }
 var decisionToDFA = make([]*antlr.DFA, len(deserializedATN.DecisionToState))
 
@@ -658,6 +713,13 @@ const (
 	CadenceParserTerminator                = 80
 	CadenceParserBlockComment              = 81
 	CadenceParserLineComment               = 82
+	CadenceParserEnum                      = 83
+	CadenceParserCase                      = 84
+	CadenceParserSwitch                    = 85
+	CadenceParserDefault                   = 86
+	CadenceParserBitwiseOr                 = 87
+	CadenceParserBitwiseXor                = 88
+	CadenceParserBitwiseNot                = 89
 )
 
 // CadenceParser rules.
@@ -757,6 +819,14 @@ const (
 	CadenceParserRULE_dictionaryEntry              = 92
 	CadenceParserRULE_identifier                   = 93
 	CadenceParserRULE_eos                          = 94
+	CadenceParserRULE_enumCase                     = 95
+	CadenceParserRULE_switchStatement              = 96
+	CadenceParserRULE_switchCase                   = 97
+	CadenceParserRULE_bitwiseOrExpression          = 98
+	CadenceParserRULE_bitwiseXorExpression         = 99
+	CadenceParserRULE_bitwiseShiftExpression       = 100
+	CadenceParserRULE_bitwiseShiftOp               = 101
+	CadenceParserRULE_instantiationType            = 102
 )
 
 // IProgramContext is an interface to support dynamic dispatch.
@@ -880,7 +950,7 @@ func (p *CadenceParser) Program() (localctx IProgramContext) {
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	for ((_la-37)&-(0x1f+1)) == 0 && ((1<<uint((_la-37)))&((1<<(CadenceParserTransaction-37))|(1<<(CadenceParserStruct-37))|(1<<(CadenceParserResource-37))|(1<<(CadenceParserContract-37))|(1<<(CadenceParserFun-37))|(1<<(CadenceParserEvent-37))|(1<<(CadenceParserPriv-37))|(1<<(CadenceParserPub-37))|(1<<(CadenceParserAccess-37))|(1<<(CadenceParserLet-37))|(1<<(CadenceParserVar-37))|(1<<(CadenceParserImport-37)))) != 0 {
+	for (((_la-37)&-(0x1f+1)) == 0 && ((1<<uint((_la-37)))&((1<<(CadenceParserTransaction-37))|(1<<(CadenceParserStruct-37))|(1<<(CadenceParserResource-37))|(1<<(CadenceParserContract-37))|(1<<(CadenceParserFun-37))|(1<<(CadenceParserEvent-37))|(1<<(CadenceParserPriv-37))|(1<<(CadenceParserPub-37))|(1<<(CadenceParserAccess-37))|(1<<(CadenceParserLet-37))|(1<<(CadenceParserVar-37))|(1<<(CadenceParserImport-37)))) != 0) || _la == CadenceParserEnum {
 		{
 			p.SetState(190)
 			p.Declaration()
@@ -1030,7 +1100,7 @@ func (p *CadenceParser) ReplInput() (localctx IReplInputContext) {
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	for (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CadenceParserT__1)|(1<<CadenceParserT__6)|(1<<CadenceParserMinus)|(1<<CadenceParserDiv)|(1<<CadenceParserAuth)|(1<<CadenceParserAmpersand)|(1<<CadenceParserNegate)|(1<<CadenceParserMove))) != 0) || (((_la-35)&-(0x1f+1)) == 0 && ((1<<uint((_la-35)))&((1<<(CadenceParserOpenParen-35))|(1<<(CadenceParserTransaction-35))|(1<<(CadenceParserStruct-35))|(1<<(CadenceParserResource-35))|(1<<(CadenceParserContract-35))|(1<<(CadenceParserFun-35))|(1<<(CadenceParserEvent-35))|(1<<(CadenceParserEmit-35))|(1<<(CadenceParserPriv-35))|(1<<(CadenceParserPub-35))|(1<<(CadenceParserAccess-35))|(1<<(CadenceParserAll-35))|(1<<(CadenceParserSelf-35))|(1<<(CadenceParserAccount-35))|(1<<(CadenceParserReturn-35))|(1<<(CadenceParserBreak-35))|(1<<(CadenceParserContinue-35))|(1<<(CadenceParserLet-35))|(1<<(CadenceParserVar-35))|(1<<(CadenceParserIf-35))|(1<<(CadenceParserWhile-35))|(1<<(CadenceParserFor-35))|(1<<(CadenceParserIn-35))|(1<<(CadenceParserTrue-35))|(1<<(CadenceParserFalse-35))|(1<<(CadenceParserNil-35)))) != 0) || (((_la-67)&-(0x1f+1)) == 0 && ((1<<uint((_la-67)))&((1<<(CadenceParserImport-67))|(1<<(CadenceParserFrom-67))|(1<<(CadenceParserCreate-67))|(1<<(CadenceParserDestroy-67))|(1<<(CadenceParserIdentifier-67))|(1<<(CadenceParserPositiveFixedPointLiteral-67))|(1<<(CadenceParserDecimalLiteral-67))|(1<<(CadenceParserBinaryLiteral-67))|(1<<(CadenceParserOctalLiteral-67))|(1<<(CadenceParserHexadecimalLiteral-67))|(1<<(CadenceParserInvalidNumberLiteral-67))|(1<<(CadenceParserStringLiteral-67)))) != 0) {
+	for (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CadenceParserT__1)|(1<<CadenceParserT__6)|(1<<CadenceParserMinus)|(1<<CadenceParserDiv)|(1<<CadenceParserAuth)|(1<<CadenceParserAmpersand)|(1<<CadenceParserNegate)|(1<<CadenceParserMove))) != 0) || (((_la-35)&-(0x1f+1)) == 0 && ((1<<uint((_la-35)))&((1<<(CadenceParserOpenParen-35))|(1<<(CadenceParserTransaction-35))|(1<<(CadenceParserStruct-35))|(1<<(CadenceParserResource-35))|(1<<(CadenceParserContract-35))|(1<<(CadenceParserFun-35))|(1<<(CadenceParserEvent-35))|(1<<(CadenceParserEmit-35))|(1<<(CadenceParserPriv-35))|(1<<(CadenceParserPub-35))|(1<<(CadenceParserAccess-35))|(1<<(CadenceParserAll-35))|(1<<(CadenceParserSelf-35))|(1<<(CadenceParserAccount-35))|(1<<(CadenceParserReturn-35))|(1<<(CadenceParserBreak-35))|(1<<(CadenceParserContinue-35))|(1<<(CadenceParserLet-35))|(1<<(CadenceParserVar-35))|(1<<(CadenceParserIf-35))|(1<<(CadenceParserWhile-35))|(1<<(CadenceParserFor-35))|(1<<(CadenceParserIn-35))|(1<<(CadenceParserTrue-35))|(1<<(CadenceParserFalse-35))|(1<<(CadenceParserNil-35)))) != 0) || (((_la-67)&-(0x1f+1)) == 0 && ((1<<uint((_la-67)))&((1<<(CadenceParserImport-67))|(1<<(CadenceParserFrom-67))|(1<<(CadenceParserCreate-67))|(1<<(CadenceParserDestroy-67))|(1<<(CadenceParserIdentifier-67))|(1<<(CadenceParserPositiveFixedPointLiteral-67))|(1<<(CadenceParserDecimalLiteral-67))|(1<<(CadenceParserBinaryLiteral-67))|(1<<(CadenceParserOctalLiteral-67))|(1<<(CadenceParserHexadecimalLiteral-67))|(1<<(CadenceParserInvalidNumberLiteral-67))|(1<<(CadenceParserStringLiteral-67))|(1<<(CadenceParserEnum-67))|(1<<(CadenceParserSwitch-67))|(1<<(CadenceParserBitwiseNot-67)))) != 0) {
 		{
 			p.SetState(201)
 			p.ReplElement()
@@ -3522,7 +3592,7 @@ func (p *CadenceParser) MembersAndNestedDeclarations() (localctx IMembersAndNest
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	for (((_la-24)&-(0x1f+1)) == 0 && ((1<<uint((_la-24)))&((1<<(CadenceParserAuth-24))|(1<<(CadenceParserStruct-24))|(1<<(CadenceParserResource-24))|(1<<(CadenceParserContract-24))|(1<<(CadenceParserFun-24))|(1<<(CadenceParserEvent-24))|(1<<(CadenceParserEmit-24))|(1<<(CadenceParserPriv-24))|(1<<(CadenceParserPub-24))|(1<<(CadenceParserAccess-24))|(1<<(CadenceParserAll-24))|(1<<(CadenceParserSelf-24))|(1<<(CadenceParserAccount-24)))) != 0) || (((_la-57)&-(0x1f+1)) == 0 && ((1<<uint((_la-57)))&((1<<(CadenceParserLet-57))|(1<<(CadenceParserVar-57))|(1<<(CadenceParserIn-57))|(1<<(CadenceParserFrom-57))|(1<<(CadenceParserCreate-57))|(1<<(CadenceParserDestroy-57))|(1<<(CadenceParserIdentifier-57)))) != 0) {
+	for (((_la-24)&-(0x1f+1)) == 0 && ((1<<uint((_la-24)))&((1<<(CadenceParserAuth-24))|(1<<(CadenceParserStruct-24))|(1<<(CadenceParserResource-24))|(1<<(CadenceParserContract-24))|(1<<(CadenceParserFun-24))|(1<<(CadenceParserEvent-24))|(1<<(CadenceParserEmit-24))|(1<<(CadenceParserPriv-24))|(1<<(CadenceParserPub-24))|(1<<(CadenceParserAccess-24))|(1<<(CadenceParserAll-24))|(1<<(CadenceParserSelf-24))|(1<<(CadenceParserAccount-24)))) != 0) || (((_la-57)&-(0x1f+1)) == 0 && ((1<<uint((_la-57)))&((1<<(CadenceParserLet-57))|(1<<(CadenceParserVar-57))|(1<<(CadenceParserIn-57))|(1<<(CadenceParserFrom-57))|(1<<(CadenceParserCreate-57))|(1<<(CadenceParserDestroy-57))|(1<<(CadenceParserIdentifier-57))|(1<<(CadenceParserEnum-57))|(1<<(CadenceParserCase-57)))) != 0) {
 		{
 			p.SetState(336)
 			p.MemberOrNestedDeclaration()
@@ -3645,6 +3715,16 @@ func (s *MemberOrNestedDeclarationContext) EventDeclaration() IEventDeclarationC
 	return t.(IEventDeclarationContext)
 }
 
+func (s *MemberOrNestedDeclarationContext) EnumCase() IEnumCaseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IEnumCaseContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IEnumCaseContext)
+}
+
 func (s *MemberOrNestedDeclarationContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -3740,6 +3820,13 @@ func (p *CadenceParser) MemberOrNestedDeclaration() (localctx IMemberOrNestedDec
 			p.EventDeclaration()
 		}
 
+	case 7:
+		p.EnterOuterAlt(localctx, 7)
+		{
+			p.SetState(922)
+			p.EnumCase()
+		}
+
 	}
 
 	return localctx
@@ -3795,6 +3882,10 @@ func (s *CompositeKindContext) Contract() antlr.TerminalNode {
 	return s.GetToken(CadenceParserContract, 0)
 }
 
+func (s *CompositeKindContext) Enum() antlr.TerminalNode {
+	return s.GetToken(CadenceParserEnum, 0)
+}
+
 func (s *CompositeKindContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -3851,7 +3942,7 @@ func (p *CadenceParser) CompositeKind() (localctx ICompositeKindContext) {
 		p.SetState(353)
 		_la = p.GetTokenStream().LA(1)
 
-		if !(((_la-38)&-(0x1f+1)) == 0 && ((1<<uint((_la-38)))&((1<<(CadenceParserStruct-38))|(1<<(CadenceParserResource-38))|(1<<(CadenceParserContract-38)))) != 0) {
+		if !((((_la-38)&-(0x1f+1)) == 0 && ((1<<uint((_la-38)))&((1<<(CadenceParserStruct-38))|(1<<(CadenceParserResource-38))|(1<<(CadenceParserContract-38)))) != 0) || _la == CadenceParserEnum) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -5242,6 +5333,16 @@ func (s *BaseTypeContext) DictionaryType() IDictionaryTypeContext {
 	return t.(IDictionaryTypeContext)
 }
 
+func (s *BaseTypeContext) InstantiationType() IInstantiationTypeContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IInstantiationTypeContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IInstantiationTypeContext)
+}
+
 func (s *BaseTypeContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -5330,6 +5431,13 @@ func (p *CadenceParser) BaseType() (localctx IBaseTypeContext) {
 			p.DictionaryType()
 		}
 
+	case 6:
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(1024)
+			p.InstantiationType()
+		}
+
 	}
 
 	return localctx
@@ -6946,7 +7054,7 @@ func (p *CadenceParser) Conditions() (localctx IConditionsContext) {
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	for (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CadenceParserT__1)|(1<<CadenceParserT__6)|(1<<CadenceParserMinus)|(1<<CadenceParserDiv)|(1<<CadenceParserAuth)|(1<<CadenceParserAmpersand)|(1<<CadenceParserNegate)|(1<<CadenceParserMove))) != 0) || (((_la-35)&-(0x1f+1)) == 0 && ((1<<uint((_la-35)))&((1<<(CadenceParserOpenParen-35))|(1<<(CadenceParserStruct-35))|(1<<(CadenceParserResource-35))|(1<<(CadenceParserContract-35))|(1<<(CadenceParserFun-35))|(1<<(CadenceParserEvent-35))|(1<<(CadenceParserEmit-35))|(1<<(CadenceParserAccess-35))|(1<<(CadenceParserAll-35))|(1<<(CadenceParserSelf-35))|(1<<(CadenceParserAccount-35))|(1<<(CadenceParserIn-35))|(1<<(CadenceParserTrue-35))|(1<<(CadenceParserFalse-35))|(1<<(CadenceParserNil-35)))) != 0) || (((_la-68)&-(0x1f+1)) == 0 && ((1<<uint((_la-68)))&((1<<(CadenceParserFrom-68))|(1<<(CadenceParserCreate-68))|(1<<(CadenceParserDestroy-68))|(1<<(CadenceParserIdentifier-68))|(1<<(CadenceParserPositiveFixedPointLiteral-68))|(1<<(CadenceParserDecimalLiteral-68))|(1<<(CadenceParserBinaryLiteral-68))|(1<<(CadenceParserOctalLiteral-68))|(1<<(CadenceParserHexadecimalLiteral-68))|(1<<(CadenceParserInvalidNumberLiteral-68))|(1<<(CadenceParserStringLiteral-68)))) != 0) {
+	for (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CadenceParserT__1)|(1<<CadenceParserT__6)|(1<<CadenceParserMinus)|(1<<CadenceParserDiv)|(1<<CadenceParserAuth)|(1<<CadenceParserAmpersand)|(1<<CadenceParserNegate)|(1<<CadenceParserMove))) != 0) || (((_la-35)&-(0x1f+1)) == 0 && ((1<<uint((_la-35)))&((1<<(CadenceParserOpenParen-35))|(1<<(CadenceParserStruct-35))|(1<<(CadenceParserResource-35))|(1<<(CadenceParserContract-35))|(1<<(CadenceParserFun-35))|(1<<(CadenceParserEvent-35))|(1<<(CadenceParserEmit-35))|(1<<(CadenceParserAccess-35))|(1<<(CadenceParserAll-35))|(1<<(CadenceParserSelf-35))|(1<<(CadenceParserAccount-35))|(1<<(CadenceParserIn-35))|(1<<(CadenceParserTrue-35))|(1<<(CadenceParserFalse-35))|(1<<(CadenceParserNil-35)))) != 0) || (((_la-68)&-(0x1f+1)) == 0 && ((1<<uint((_la-68)))&((1<<(CadenceParserFrom-68))|(1<<(CadenceParserCreate-68))|(1<<(CadenceParserDestroy-68))|(1<<(CadenceParserIdentifier-68))|(1<<(CadenceParserPositiveFixedPointLiteral-68))|(1<<(CadenceParserDecimalLiteral-68))|(1<<(CadenceParserBinaryLiteral-68))|(1<<(CadenceParserOctalLiteral-68))|(1<<(CadenceParserHexadecimalLiteral-68))|(1<<(CadenceParserInvalidNumberLiteral-68))|(1<<(CadenceParserStringLiteral-68))|(1<<(CadenceParserBitwiseNot-68)))) != 0) {
 		{
 			p.SetState(509)
 			p.Condition()
@@ -7266,7 +7374,7 @@ func (p *CadenceParser) Statements() (localctx IStatementsContext) {
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	for (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CadenceParserT__1)|(1<<CadenceParserT__6)|(1<<CadenceParserMinus)|(1<<CadenceParserDiv)|(1<<CadenceParserAuth)|(1<<CadenceParserAmpersand)|(1<<CadenceParserNegate)|(1<<CadenceParserMove))) != 0) || (((_la-35)&-(0x1f+1)) == 0 && ((1<<uint((_la-35)))&((1<<(CadenceParserOpenParen-35))|(1<<(CadenceParserTransaction-35))|(1<<(CadenceParserStruct-35))|(1<<(CadenceParserResource-35))|(1<<(CadenceParserContract-35))|(1<<(CadenceParserFun-35))|(1<<(CadenceParserEvent-35))|(1<<(CadenceParserEmit-35))|(1<<(CadenceParserPriv-35))|(1<<(CadenceParserPub-35))|(1<<(CadenceParserAccess-35))|(1<<(CadenceParserAll-35))|(1<<(CadenceParserSelf-35))|(1<<(CadenceParserAccount-35))|(1<<(CadenceParserReturn-35))|(1<<(CadenceParserBreak-35))|(1<<(CadenceParserContinue-35))|(1<<(CadenceParserLet-35))|(1<<(CadenceParserVar-35))|(1<<(CadenceParserIf-35))|(1<<(CadenceParserWhile-35))|(1<<(CadenceParserFor-35))|(1<<(CadenceParserIn-35))|(1<<(CadenceParserTrue-35))|(1<<(CadenceParserFalse-35))|(1<<(CadenceParserNil-35)))) != 0) || (((_la-67)&-(0x1f+1)) == 0 && ((1<<uint((_la-67)))&((1<<(CadenceParserImport-67))|(1<<(CadenceParserFrom-67))|(1<<(CadenceParserCreate-67))|(1<<(CadenceParserDestroy-67))|(1<<(CadenceParserIdentifier-67))|(1<<(CadenceParserPositiveFixedPointLiteral-67))|(1<<(CadenceParserDecimalLiteral-67))|(1<<(CadenceParserBinaryLiteral-67))|(1<<(CadenceParserOctalLiteral-67))|(1<<(CadenceParserHexadecimalLiteral-67))|(1<<(CadenceParserInvalidNumberLiteral-67))|(1<<(CadenceParserStringLiteral-67)))) != 0) {
+	for (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CadenceParserT__1)|(1<<CadenceParserT__6)|(1<<CadenceParserMinus)|(1<<CadenceParserDiv)|(1<<CadenceParserAuth)|(1<<CadenceParserAmpersand)|(1<<CadenceParserNegate)|(1<<CadenceParserMove))) != 0) || (((_la-35)&-(0x1f+1)) == 0 && ((1<<uint((_la-35)))&((1<<(CadenceParserOpenParen-35))|(1<<(CadenceParserTransaction-35))|(1<<(CadenceParserStruct-35))|(1<<(CadenceParserResource-35))|(1<<(CadenceParserContract-35))|(1<<(CadenceParserFun-35))|(1<<(CadenceParserEvent-35))|(1<<(CadenceParserEmit-35))|(1<<(CadenceParserPriv-35))|(1<<(CadenceParserPub-35))|(1<<(CadenceParserAccess-35))|(1<<(CadenceParserAll-35))|(1<<(CadenceParserSelf-35))|(1<<(CadenceParserAccount-35))|(1<<(CadenceParserReturn-35))|(1<<(CadenceParserBreak-35))|(1<<(CadenceParserContinue-35))|(1<<(CadenceParserLet-35))|(1<<(CadenceParserVar-35))|(1<<(CadenceParserIf-35))|(1<<(CadenceParserWhile-35))|(1<<(CadenceParserFor-35))|(1<<(CadenceParserIn-35))|(1<<(CadenceParserTrue-35))|(1<<(CadenceParserFalse-35))|(1<<(CadenceParserNil-35)))) != 0) || (((_la-67)&-(0x1f+1)) == 0 && ((1<<uint((_la-67)))&((1<<(CadenceParserImport-67))|(1<<(CadenceParserFrom-67))|(1<<(CadenceParserCreate-67))|(1<<(CadenceParserDestroy-67))|(1<<(CadenceParserIdentifier-67))|(1<<(CadenceParserPositiveFixedPointLiteral-67))|(1<<(CadenceParserDecimalLiteral-67))|(1<<(CadenceParserBinaryLiteral-67))|(1<<(CadenceParserOctalLiteral-67))|(1<<(CadenceParserHexadecimalLiteral-67))|(1<<(CadenceParserInvalidNumberLiteral-67))|(1<<(CadenceParserStringLiteral-67))|(1<<(CadenceParserEnum-67))|(1<<(CadenceParserSwitch-67))|(1<<(CadenceParserBitwiseNot-67)))) != 0) {
 		{
 			p.SetState(522)
 			p.Statement()
@@ -7382,6 +7490,16 @@ func (s *StatementContext) ForStatement() IForStatementContext {
 	return t.(IForStatementContext)
 }
 
+func (s *StatementContext) SwitchStatement() ISwitchStatementContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISwitchStatementContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISwitchStatementContext)
+}
+
 func (s *StatementContext) EmitStatement() IEmitStatementContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IEmitStatementContext)(nil)).Elem(), 0)
 
@@ -7530,33 +7648,40 @@ func (p *CadenceParser) Statement() (localctx IStatementContext) {
 	case 7:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(536)
-			p.EmitStatement()
+			p.SetState(954)
+			p.SwitchStatement()
 		}
 
 	case 8:
 		p.EnterOuterAlt(localctx, 8)
 		{
-			p.SetState(537)
-			p.Declaration()
+			p.SetState(536)
+			p.EmitStatement()
 		}
 
 	case 9:
 		p.EnterOuterAlt(localctx, 9)
 		{
-			p.SetState(538)
-			p.Assignment()
+			p.SetState(537)
+			p.Declaration()
 		}
 
 	case 10:
 		p.EnterOuterAlt(localctx, 10)
 		{
-			p.SetState(539)
-			p.Swap()
+			p.SetState(538)
+			p.Assignment()
 		}
 
 	case 11:
 		p.EnterOuterAlt(localctx, 11)
+		{
+			p.SetState(539)
+			p.Swap()
+		}
+
+	case 12:
+		p.EnterOuterAlt(localctx, 12)
 		{
 			p.SetState(540)
 			p.Expression()
@@ -10427,14 +10552,14 @@ func NewCastingExpressionContext(parser antlr.Parser, parent antlr.ParserRuleCon
 
 func (s *CastingExpressionContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *CastingExpressionContext) ConcatenatingExpression() IConcatenatingExpressionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IConcatenatingExpressionContext)(nil)).Elem(), 0)
+func (s *CastingExpressionContext) BitwiseOrExpression() IBitwiseOrExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IBitwiseOrExpressionContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IConcatenatingExpressionContext)
+	return t.(IBitwiseOrExpressionContext)
 }
 
 func (s *CastingExpressionContext) CastingExpression() ICastingExpressionContext {
@@ -10531,7 +10656,7 @@ func (p *CadenceParser) castingExpression(_p int) (localctx ICastingExpressionCo
 	p.EnterOuterAlt(localctx, 1)
 	{
 		p.SetState(671)
-		p.concatenatingExpression(0)
+		p.bitwiseOrExpression(0)
 	}
 
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
@@ -10608,14 +10733,14 @@ func NewConcatenatingExpressionContext(parser antlr.Parser, parent antlr.ParserR
 
 func (s *ConcatenatingExpressionContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ConcatenatingExpressionContext) AdditiveExpression() IAdditiveExpressionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IAdditiveExpressionContext)(nil)).Elem(), 0)
+func (s *ConcatenatingExpressionContext) BitwiseShiftExpression() IBitwiseShiftExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IBitwiseShiftExpressionContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IAdditiveExpressionContext)
+	return t.(IBitwiseShiftExpressionContext)
 }
 
 func (s *ConcatenatingExpressionContext) ConcatenatingExpression() IConcatenatingExpressionContext {
@@ -10696,7 +10821,7 @@ func (p *CadenceParser) concatenatingExpression(_p int) (localctx IConcatenating
 	p.EnterOuterAlt(localctx, 1)
 	{
 		p.SetState(683)
-		p.additiveExpression(0)
+		p.bitwiseShiftExpression(0)
 	}
 
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
@@ -10723,7 +10848,7 @@ func (p *CadenceParser) concatenatingExpression(_p int) (localctx IConcatenating
 			}
 			{
 				p.SetState(687)
-				p.additiveExpression(0)
+				p.bitwiseShiftExpression(0)
 			}
 
 		}
@@ -12575,6 +12700,10 @@ func (s *UnaryOpContext) Move() antlr.TerminalNode {
 	return s.GetToken(CadenceParserMove, 0)
 }
 
+func (s *UnaryOpContext) BitwiseNot() antlr.TerminalNode {
+	return s.GetToken(CadenceParserBitwiseNot, 0)
+}
+
 func (s *UnaryOpContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -12631,7 +12760,7 @@ func (p *CadenceParser) UnaryOp() (localctx IUnaryOpContext) {
 		p.SetState(771)
 		_la = p.GetTokenStream().LA(1)
 
-		if !(((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CadenceParserMinus)|(1<<CadenceParserNegate)|(1<<CadenceParserMove))) != 0) {
+		if !((((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CadenceParserMinus)|(1<<CadenceParserNegate)|(1<<CadenceParserMove))) != 0) || _la == CadenceParserBitwiseNot) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -13721,7 +13850,7 @@ func (p *CadenceParser) Invocation() (localctx IInvocationContext) {
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CadenceParserT__1)|(1<<CadenceParserT__6)|(1<<CadenceParserMinus)|(1<<CadenceParserDiv)|(1<<CadenceParserAuth)|(1<<CadenceParserAmpersand)|(1<<CadenceParserNegate)|(1<<CadenceParserMove))) != 0) || (((_la-35)&-(0x1f+1)) == 0 && ((1<<uint((_la-35)))&((1<<(CadenceParserOpenParen-35))|(1<<(CadenceParserStruct-35))|(1<<(CadenceParserResource-35))|(1<<(CadenceParserContract-35))|(1<<(CadenceParserFun-35))|(1<<(CadenceParserEvent-35))|(1<<(CadenceParserEmit-35))|(1<<(CadenceParserAccess-35))|(1<<(CadenceParserAll-35))|(1<<(CadenceParserSelf-35))|(1<<(CadenceParserAccount-35))|(1<<(CadenceParserIn-35))|(1<<(CadenceParserTrue-35))|(1<<(CadenceParserFalse-35))|(1<<(CadenceParserNil-35)))) != 0) || (((_la-68)&-(0x1f+1)) == 0 && ((1<<uint((_la-68)))&((1<<(CadenceParserFrom-68))|(1<<(CadenceParserCreate-68))|(1<<(CadenceParserDestroy-68))|(1<<(CadenceParserIdentifier-68))|(1<<(CadenceParserPositiveFixedPointLiteral-68))|(1<<(CadenceParserDecimalLiteral-68))|(1<<(CadenceParserBinaryLiteral-68))|(1<<(CadenceParserOctalLiteral-68))|(1<<(CadenceParserHexadecimalLiteral-68))|(1<<(CadenceParserInvalidNumberLiteral-68))|(1<<(CadenceParserStringLiteral-68)))) != 0) {
+	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CadenceParserT__1)|(1<<CadenceParserT__6)|(1<<CadenceParserMinus)|(1<<CadenceParserDiv)|(1<<CadenceParserAuth)|(1<<CadenceParserAmpersand)|(1<<CadenceParserNegate)|(1<<CadenceParserMove))) != 0) || (((_la-35)&-(0x1f+1)) == 0 && ((1<<uint((_la-35)))&((1<<(CadenceParserOpenParen-35))|(1<<(CadenceParserStruct-35))|(1<<(CadenceParserResource-35))|(1<<(CadenceParserContract-35))|(1<<(CadenceParserFun-35))|(1<<(CadenceParserEvent-35))|(1<<(CadenceParserEmit-35))|(1<<(CadenceParserAccess-35))|(1<<(CadenceParserAll-35))|(1<<(CadenceParserSelf-35))|(1<<(CadenceParserAccount-35))|(1<<(CadenceParserIn-35))|(1<<(CadenceParserTrue-35))|(1<<(CadenceParserFalse-35))|(1<<(CadenceParserNil-35)))) != 0) || (((_la-68)&-(0x1f+1)) == 0 && ((1<<uint((_la-68)))&((1<<(CadenceParserFrom-68))|(1<<(CadenceParserCreate-68))|(1<<(CadenceParserDestroy-68))|(1<<(CadenceParserIdentifier-68))|(1<<(CadenceParserPositiveFixedPointLiteral-68))|(1<<(CadenceParserDecimalLiteral-68))|(1<<(CadenceParserBinaryLiteral-68))|(1<<(CadenceParserOctalLiteral-68))|(1<<(CadenceParserHexadecimalLiteral-68))|(1<<(CadenceParserInvalidNumberLiteral-68))|(1<<(CadenceParserStringLiteral-68))|(1<<(CadenceParserBitwiseNot-68)))) != 0) {
 		{
 			p.SetState(817)
 			p.Argument()
@@ -15317,7 +15446,7 @@ func (p *CadenceParser) ArrayLiteral() (localctx IArrayLiteralContext) {
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CadenceParserT__1)|(1<<CadenceParserT__6)|(1<<CadenceParserMinus)|(1<<CadenceParserDiv)|(1<<CadenceParserAuth)|(1<<CadenceParserAmpersand)|(1<<CadenceParserNegate)|(1<<CadenceParserMove))) != 0) || (((_la-35)&-(0x1f+1)) == 0 && ((1<<uint((_la-35)))&((1<<(CadenceParserOpenParen-35))|(1<<(CadenceParserStruct-35))|(1<<(CadenceParserResource-35))|(1<<(CadenceParserContract-35))|(1<<(CadenceParserFun-35))|(1<<(CadenceParserEvent-35))|(1<<(CadenceParserEmit-35))|(1<<(CadenceParserAccess-35))|(1<<(CadenceParserAll-35))|(1<<(CadenceParserSelf-35))|(1<<(CadenceParserAccount-35))|(1<<(CadenceParserIn-35))|(1<<(CadenceParserTrue-35))|(1<<(CadenceParserFalse-35))|(1<<(CadenceParserNil-35)))) != 0) || (((_la-68)&-(0x1f+1)) == 0 && ((1<<uint((_la-68)))&((1<<(CadenceParserFrom-68))|(1<<(CadenceParserCreate-68))|(1<<(CadenceParserDestroy-68))|(1<<(CadenceParserIdentifier-68))|(1<<(CadenceParserPositiveFixedPointLiteral-68))|(1<<(CadenceParserDecimalLiteral-68))|(1<<(CadenceParserBinaryLiteral-68))|(1<<(CadenceParserOctalLiteral-68))|(1<<(CadenceParserHexadecimalLiteral-68))|(1<<(CadenceParserInvalidNumberLiteral-68))|(1<<(CadenceParserStringLiteral-68)))) != 0) {
+	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CadenceParserT__1)|(1<<CadenceParserT__6)|(1<<CadenceParserMinus)|(1<<CadenceParserDiv)|(1<<CadenceParserAuth)|(1<<CadenceParserAmpersand)|(1<<CadenceParserNegate)|(1<<CadenceParserMove))) != 0) || (((_la-35)&-(0x1f+1)) == 0 && ((1<<uint((_la-35)))&((1<<(CadenceParserOpenParen-35))|(1<<(CadenceParserStruct-35))|(1<<(CadenceParserResource-35))|(1<<(CadenceParserContract-35))|(1<<(CadenceParserFun-35))|(1<<(CadenceParserEvent-35))|(1<<(CadenceParserEmit-35))|(1<<(CadenceParserAccess-35))|(1<<(CadenceParserAll-35))|(1<<(CadenceParserSelf-35))|(1<<(CadenceParserAccount-35))|(1<<(CadenceParserIn-35))|(1<<(CadenceParserTrue-35))|(1<<(CadenceParserFalse-35))|(1<<(CadenceParserNil-35)))) != 0) || (((_la-68)&-(0x1f+1)) == 0 && ((1<<uint((_la-68)))&((1<<(CadenceParserFrom-68))|(1<<(CadenceParserCreate-68))|(1<<(CadenceParserDestroy-68))|(1<<(CadenceParserIdentifier-68))|(1<<(CadenceParserPositiveFixedPointLiteral-68))|(1<<(CadenceParserDecimalLiteral-68))|(1<<(CadenceParserBinaryLiteral-68))|(1<<(CadenceParserOctalLiteral-68))|(1<<(CadenceParserHexadecimalLiteral-68))|(1<<(CadenceParserInvalidNumberLiteral-68))|(1<<(CadenceParserStringLiteral-68))|(1<<(CadenceParserBitwiseNot-68)))) != 0) {
 		{
 			p.SetState(878)
 			p.Expression()
@@ -15471,7 +15600,7 @@ func (p *CadenceParser) DictionaryLiteral() (localctx IDictionaryLiteralContext)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CadenceParserT__1)|(1<<CadenceParserT__6)|(1<<CadenceParserMinus)|(1<<CadenceParserDiv)|(1<<CadenceParserAuth)|(1<<CadenceParserAmpersand)|(1<<CadenceParserNegate)|(1<<CadenceParserMove))) != 0) || (((_la-35)&-(0x1f+1)) == 0 && ((1<<uint((_la-35)))&((1<<(CadenceParserOpenParen-35))|(1<<(CadenceParserStruct-35))|(1<<(CadenceParserResource-35))|(1<<(CadenceParserContract-35))|(1<<(CadenceParserFun-35))|(1<<(CadenceParserEvent-35))|(1<<(CadenceParserEmit-35))|(1<<(CadenceParserAccess-35))|(1<<(CadenceParserAll-35))|(1<<(CadenceParserSelf-35))|(1<<(CadenceParserAccount-35))|(1<<(CadenceParserIn-35))|(1<<(CadenceParserTrue-35))|(1<<(CadenceParserFalse-35))|(1<<(CadenceParserNil-35)))) != 0) || (((_la-68)&-(0x1f+1)) == 0 && ((1<<uint((_la-68)))&((1<<(CadenceParserFrom-68))|(1<<(CadenceParserCreate-68))|(1<<(CadenceParserDestroy-68))|(1<<(CadenceParserIdentifier-68))|(1<<(CadenceParserPositiveFixedPointLiteral-68))|(1<<(CadenceParserDecimalLiteral-68))|(1<<(CadenceParserBinaryLiteral-68))|(1<<(CadenceParserOctalLiteral-68))|(1<<(CadenceParserHexadecimalLiteral-68))|(1<<(CadenceParserInvalidNumberLiteral-68))|(1<<(CadenceParserStringLiteral-68)))) != 0) {
+	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CadenceParserT__1)|(1<<CadenceParserT__6)|(1<<CadenceParserMinus)|(1<<CadenceParserDiv)|(1<<CadenceParserAuth)|(1<<CadenceParserAmpersand)|(1<<CadenceParserNegate)|(1<<CadenceParserMove))) != 0) || (((_la-35)&-(0x1f+1)) == 0 && ((1<<uint((_la-35)))&((1<<(CadenceParserOpenParen-35))|(1<<(CadenceParserStruct-35))|(1<<(CadenceParserResource-35))|(1<<(CadenceParserContract-35))|(1<<(CadenceParserFun-35))|(1<<(CadenceParserEvent-35))|(1<<(CadenceParserEmit-35))|(1<<(CadenceParserAccess-35))|(1<<(CadenceParserAll-35))|(1<<(CadenceParserSelf-35))|(1<<(CadenceParserAccount-35))|(1<<(CadenceParserIn-35))|(1<<(CadenceParserTrue-35))|(1<<(CadenceParserFalse-35))|(1<<(CadenceParserNil-35)))) != 0) || (((_la-68)&-(0x1f+1)) == 0 && ((1<<uint((_la-68)))&((1<<(CadenceParserFrom-68))|(1<<(CadenceParserCreate-68))|(1<<(CadenceParserDestroy-68))|(1<<(CadenceParserIdentifier-68))|(1<<(CadenceParserPositiveFixedPointLiteral-68))|(1<<(CadenceParserDecimalLiteral-68))|(1<<(CadenceParserBinaryLiteral-68))|(1<<(CadenceParserOctalLiteral-68))|(1<<(CadenceParserHexadecimalLiteral-68))|(1<<(CadenceParserInvalidNumberLiteral-68))|(1<<(CadenceParserStringLiteral-68))|(1<<(CadenceParserBitwiseNot-68)))) != 0) {
 		{
 			p.SetState(891)
 			p.DictionaryEntry()
@@ -15955,208 +16084,1548 @@ func (p *CadenceParser) Eos() (localctx IEosContext) {
 	return localctx
 }
 
-func (p *CadenceParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex int) bool {
-	switch ruleIndex {
-	case 26:
-		var t *FullTypeContext = nil
-		if localctx != nil {
-			t = localctx.(*FullTypeContext)
-		}
-		return p.FullType_Sempred(t, predIndex)
-
-	case 27:
-		var t *InnerTypeContext = nil
-		if localctx != nil {
-			t = localctx.(*InnerTypeContext)
-		}
-		return p.InnerType_Sempred(t, predIndex)
-
-	case 43:
-		var t *ReturnStatementContext = nil
-		if localctx != nil {
-			t = localctx.(*ReturnStatementContext)
-		}
-		return p.ReturnStatement_Sempred(t, predIndex)
-
-	case 56:
-		var t *OrExpressionContext = nil
-		if localctx != nil {
-			t = localctx.(*OrExpressionContext)
-		}
-		return p.OrExpression_Sempred(t, predIndex)
-
-	case 57:
-		var t *AndExpressionContext = nil
-		if localctx != nil {
-			t = localctx.(*AndExpressionContext)
-		}
-		return p.AndExpression_Sempred(t, predIndex)
-
-	case 58:
-		var t *EqualityExpressionContext = nil
-		if localctx != nil {
-			t = localctx.(*EqualityExpressionContext)
-		}
-		return p.EqualityExpression_Sempred(t, predIndex)
-
-	case 59:
-		var t *RelationalExpressionContext = nil
-		if localctx != nil {
-			t = localctx.(*RelationalExpressionContext)
-		}
-		return p.RelationalExpression_Sempred(t, predIndex)
+// IEnumCaseContext is an interface to support dynamic dispatch.
+type IEnumCaseContext interface {
+	antlr.ParserRuleContext
 
-	case 61:
-		var t *CastingExpressionContext = nil
-		if localctx != nil {
-			t = localctx.(*CastingExpressionContext)
-		}
-		return p.CastingExpression_Sempred(t, predIndex)
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
 
-	case 62:
-		var t *ConcatenatingExpressionContext = nil
-		if localctx != nil {
-			t = localctx.(*ConcatenatingExpressionContext)
-		}
-		return p.ConcatenatingExpression_Sempred(t, predIndex)
+	// IsEnumCaseContext differentiates from other interfaces.
+	IsEnumCaseContext()
+}
 
-	case 63:
-		var t *AdditiveExpressionContext = nil
-		if localctx != nil {
-			t = localctx.(*AdditiveExpressionContext)
-		}
-		return p.AdditiveExpression_Sempred(t, predIndex)
+type EnumCaseContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
 
-	case 64:
-		var t *MultiplicativeExpressionContext = nil
-		if localctx != nil {
-			t = localctx.(*MultiplicativeExpressionContext)
-		}
-		return p.MultiplicativeExpression_Sempred(t, predIndex)
+func NewEmptyEnumCaseContext() *EnumCaseContext {
+	var p = new(EnumCaseContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = CadenceParserRULE_enumCase
+	return p
+}
 
-	case 67:
-		var t *PostfixExpressionContext = nil
-		if localctx != nil {
-			t = localctx.(*PostfixExpressionContext)
-		}
-		return p.PostfixExpression_Sempred(t, predIndex)
+func (*EnumCaseContext) IsEnumCaseContext() {}
 
-	case 77:
-		var t *ExpressionAccessContext = nil
-		if localctx != nil {
-			t = localctx.(*ExpressionAccessContext)
-		}
-		return p.ExpressionAccess_Sempred(t, predIndex)
+func NewEnumCaseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *EnumCaseContext {
+	var p = new(EnumCaseContext)
 
-	case 85:
-		var t *PathLiteralContext = nil
-		if localctx != nil {
-			t = localctx.(*PathLiteralContext)
-		}
-		return p.PathLiteral_Sempred(t, predIndex)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
-	case 94:
-		var t *EosContext = nil
-		if localctx != nil {
-			t = localctx.(*EosContext)
-		}
-		return p.Eos_Sempred(t, predIndex)
+	p.parser = parser
+	p.RuleIndex = CadenceParserRULE_enumCase
 
-	default:
-		panic("No predicate with index: " + fmt.Sprint(ruleIndex))
-	}
+	return p
 }
 
-func (p *CadenceParser) FullType_Sempred(localctx antlr.RuleContext, predIndex int) bool {
-	switch predIndex {
-	case 0:
-		return p.noWhitespace()
+func (s *EnumCaseContext) GetParser() antlr.Parser { return s.parser }
 
-	case 1:
-		return p.noWhitespace()
+func (s *EnumCaseContext) Access() IAccessContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IAccessContext)(nil)).Elem(), 0)
 
-	default:
-		panic("No predicate with index: " + fmt.Sprint(predIndex))
+	if t == nil {
+		return nil
 	}
-}
-
-func (p *CadenceParser) InnerType_Sempred(localctx antlr.RuleContext, predIndex int) bool {
-	switch predIndex {
-	case 2:
-		return p.noWhitespace()
 
-	default:
-		panic("No predicate with index: " + fmt.Sprint(predIndex))
-	}
+	return t.(IAccessContext)
 }
 
-func (p *CadenceParser) ReturnStatement_Sempred(localctx antlr.RuleContext, predIndex int) bool {
-	switch predIndex {
-	case 3:
-		return !p.lineTerminatorAhead()
-
-	default:
-		panic("No predicate with index: " + fmt.Sprint(predIndex))
-	}
+func (s *EnumCaseContext) Case() antlr.TerminalNode {
+	return s.GetToken(CadenceParserCase, 0)
 }
 
-func (p *CadenceParser) OrExpression_Sempred(localctx antlr.RuleContext, predIndex int) bool {
-	switch predIndex {
-	case 4:
-		return p.Precpred(p.GetParserRuleContext(), 1)
+func (s *EnumCaseContext) Identifier() IIdentifierContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIdentifierContext)(nil)).Elem(), 0)
 
-	default:
-		panic("No predicate with index: " + fmt.Sprint(predIndex))
+	if t == nil {
+		return nil
 	}
-}
 
-func (p *CadenceParser) AndExpression_Sempred(localctx antlr.RuleContext, predIndex int) bool {
-	switch predIndex {
-	case 5:
-		return p.Precpred(p.GetParserRuleContext(), 1)
+	return t.(IIdentifierContext)
+}
 
-	default:
-		panic("No predicate with index: " + fmt.Sprint(predIndex))
-	}
+func (s *EnumCaseContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
-func (p *CadenceParser) EqualityExpression_Sempred(localctx antlr.RuleContext, predIndex int) bool {
-	switch predIndex {
-	case 6:
-		return p.Precpred(p.GetParserRuleContext(), 1)
+func (s *EnumCaseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
 
-	default:
-		panic("No predicate with index: " + fmt.Sprint(predIndex))
+func (s *EnumCaseContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CadenceListener); ok {
+		listenerT.EnterEnumCase(s)
 	}
 }
 
-func (p *CadenceParser) RelationalExpression_Sempred(localctx antlr.RuleContext, predIndex int) bool {
-	switch predIndex {
-	case 7:
-		return p.Precpred(p.GetParserRuleContext(), 1)
-
-	default:
-		panic("No predicate with index: " + fmt.Sprint(predIndex))
+func (s *EnumCaseContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CadenceListener); ok {
+		listenerT.ExitEnumCase(s)
 	}
 }
 
-func (p *CadenceParser) CastingExpression_Sempred(localctx antlr.RuleContext, predIndex int) bool {
-	switch predIndex {
-	case 8:
-		return p.Precpred(p.GetParserRuleContext(), 1)
+func (s *EnumCaseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case CadenceVisitor:
+		return t.VisitEnumCase(s)
 
 	default:
-		panic("No predicate with index: " + fmt.Sprint(predIndex))
+		return t.VisitChildren(s)
 	}
 }
 
-func (p *CadenceParser) ConcatenatingExpression_Sempred(localctx antlr.RuleContext, predIndex int) bool {
-	switch predIndex {
-	case 9:
-		return p.Precpred(p.GetParserRuleContext(), 1)
+func (p *CadenceParser) EnumCase() (localctx IEnumCaseContext) {
+	localctx = NewEnumCaseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 916, CadenceParserRULE_enumCase)
 
-	default:
-		panic("No predicate with index: " + fmt.Sprint(predIndex))
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(918)
+		p.Access()
+	}
+	{
+		p.SetState(919)
+		p.Match(CadenceParserCase)
+	}
+	{
+		p.SetState(920)
+		p.Identifier()
+	}
+
+	return localctx
+}
+
+// ISwitchStatementContext is an interface to support dynamic dispatch.
+type ISwitchStatementContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsSwitchStatementContext differentiates from other interfaces.
+	IsSwitchStatementContext()
+}
+
+type SwitchStatementContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySwitchStatementContext() *SwitchStatementContext {
+	var p = new(SwitchStatementContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = CadenceParserRULE_switchStatement
+	return p
+}
+
+func (*SwitchStatementContext) IsSwitchStatementContext() {}
+
+func NewSwitchStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SwitchStatementContext {
+	var p = new(SwitchStatementContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = CadenceParserRULE_switchStatement
+
+	return p
+}
+
+func (s *SwitchStatementContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SwitchStatementContext) Switch() antlr.TerminalNode {
+	return s.GetToken(CadenceParserSwitch, 0)
+}
+
+func (s *SwitchStatementContext) Expression() IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *SwitchStatementContext) AllSwitchCase() []ISwitchCaseContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ISwitchCaseContext)(nil)).Elem())
+	var tst = make([]ISwitchCaseContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(ISwitchCaseContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *SwitchStatementContext) SwitchCase(i int) ISwitchCaseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISwitchCaseContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISwitchCaseContext)
+}
+
+func (s *SwitchStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SwitchStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SwitchStatementContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CadenceListener); ok {
+		listenerT.EnterSwitchStatement(s)
+	}
+}
+
+func (s *SwitchStatementContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CadenceListener); ok {
+		listenerT.ExitSwitchStatement(s)
+	}
+}
+
+func (s *SwitchStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case CadenceVisitor:
+		return t.VisitSwitchStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *CadenceParser) SwitchStatement() (localctx ISwitchStatementContext) {
+	localctx = NewSwitchStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 923, CadenceParserRULE_switchStatement)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(927)
+		p.Match(CadenceParserSwitch)
+	}
+	{
+		p.SetState(928)
+		p.Expression()
+	}
+	{
+		p.SetState(929)
+		p.Match(CadenceParserT__1)
+	}
+	p.SetState(933)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == CadenceParserCase || _la == CadenceParserDefault {
+		{
+			p.SetState(930)
+			p.SwitchCase()
+		}
+
+		p.SetState(934)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(936)
+		p.Match(CadenceParserT__2)
+	}
+
+	return localctx
+}
+
+// ISwitchCaseContext is an interface to support dynamic dispatch.
+type ISwitchCaseContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsSwitchCaseContext differentiates from other interfaces.
+	IsSwitchCaseContext()
+}
+
+type SwitchCaseContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySwitchCaseContext() *SwitchCaseContext {
+	var p = new(SwitchCaseContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = CadenceParserRULE_switchCase
+	return p
+}
+
+func (*SwitchCaseContext) IsSwitchCaseContext() {}
+
+func NewSwitchCaseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SwitchCaseContext {
+	var p = new(SwitchCaseContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = CadenceParserRULE_switchCase
+
+	return p
+}
+
+func (s *SwitchCaseContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SwitchCaseContext) Case() antlr.TerminalNode {
+	return s.GetToken(CadenceParserCase, 0)
+}
+
+func (s *SwitchCaseContext) AllExpression() []IExpressionContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
+	var tst = make([]IExpressionContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExpressionContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *SwitchCaseContext) Expression(i int) IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *SwitchCaseContext) Statements() IStatementsContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStatementsContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStatementsContext)
+}
+
+func (s *SwitchCaseContext) Default() antlr.TerminalNode {
+	return s.GetToken(CadenceParserDefault, 0)
+}
+
+func (s *SwitchCaseContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SwitchCaseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SwitchCaseContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CadenceListener); ok {
+		listenerT.EnterSwitchCase(s)
+	}
+}
+
+func (s *SwitchCaseContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CadenceListener); ok {
+		listenerT.ExitSwitchCase(s)
+	}
+}
+
+func (s *SwitchCaseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case CadenceVisitor:
+		return t.VisitSwitchCase(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *CadenceParser) SwitchCase() (localctx ISwitchCaseContext) {
+	localctx = NewSwitchCaseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 925, CadenceParserRULE_switchCase)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(939)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case CadenceParserCase:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(940)
+			p.Match(CadenceParserCase)
+		}
+		{
+			p.SetState(941)
+			p.Expression()
+		}
+		p.SetState(946)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == CadenceParserT__3 {
+			{
+				p.SetState(942)
+				p.Match(CadenceParserT__3)
+			}
+			{
+				p.SetState(943)
+				p.Expression()
+			}
+
+			p.SetState(947)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(949)
+			p.Match(CadenceParserT__4)
+		}
+		{
+			p.SetState(950)
+			p.Statements()
+		}
+
+	case CadenceParserDefault:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(951)
+			p.Match(CadenceParserDefault)
+		}
+		{
+			p.SetState(952)
+			p.Match(CadenceParserT__4)
+		}
+		{
+			p.SetState(953)
+			p.Statements()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// IBitwiseOrExpressionContext is an interface to support dynamic dispatch.
+type IBitwiseOrExpressionContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsBitwiseOrExpressionContext differentiates from other interfaces.
+	IsBitwiseOrExpressionContext()
+}
+
+type BitwiseOrExpressionContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyBitwiseOrExpressionContext() *BitwiseOrExpressionContext {
+	var p = new(BitwiseOrExpressionContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = CadenceParserRULE_bitwiseOrExpression
+	return p
+}
+
+func (*BitwiseOrExpressionContext) IsBitwiseOrExpressionContext() {}
+
+func NewBitwiseOrExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *BitwiseOrExpressionContext {
+	var p = new(BitwiseOrExpressionContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = CadenceParserRULE_bitwiseOrExpression
+
+	return p
+}
+
+func (s *BitwiseOrExpressionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *BitwiseOrExpressionContext) BitwiseXorExpression() IBitwiseXorExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IBitwiseXorExpressionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBitwiseXorExpressionContext)
+}
+
+func (s *BitwiseOrExpressionContext) BitwiseOrExpression() IBitwiseOrExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IBitwiseOrExpressionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBitwiseOrExpressionContext)
+}
+
+func (s *BitwiseOrExpressionContext) BitwiseOr() antlr.TerminalNode {
+	return s.GetToken(CadenceParserBitwiseOr, 0)
+}
+
+func (s *BitwiseOrExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *BitwiseOrExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *BitwiseOrExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CadenceListener); ok {
+		listenerT.EnterBitwiseOrExpression(s)
+	}
+}
+
+func (s *BitwiseOrExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CadenceListener); ok {
+		listenerT.ExitBitwiseOrExpression(s)
+	}
+}
+
+func (s *BitwiseOrExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case CadenceVisitor:
+		return t.VisitBitwiseOrExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *CadenceParser) BitwiseOrExpression() (localctx IBitwiseOrExpressionContext) {
+	return p.bitwiseOrExpression(0)
+}
+
+func (p *CadenceParser) bitwiseOrExpression(_p int) (localctx IBitwiseOrExpressionContext) {
+	var _parentctx antlr.ParserRuleContext = p.GetParserRuleContext()
+	_parentState := p.GetState()
+	localctx = NewBitwiseOrExpressionContext(p, p.GetParserRuleContext(), _parentState)
+	var _prevctx IBitwiseOrExpressionContext = localctx
+	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
+	_startState := 955
+	p.EnterRecursionRule(localctx, 955, CadenceParserRULE_bitwiseOrExpression, _p)
+
+	defer func() {
+		p.UnrollRecursionContexts(_parentctx)
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(964)
+		p.bitwiseXorExpression(0)
+	}
+
+	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
+	p.SetState(972)
+	p.GetErrorHandler().Sync(p)
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 91, p.GetParserRuleContext())
+
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			if p.GetParseListeners() != nil {
+				p.TriggerExitRuleEvent()
+			}
+			_prevctx = localctx
+			localctx = NewBitwiseOrExpressionContext(p, _parentctx, _parentState)
+			p.PushNewRecursionContext(localctx, _startState, CadenceParserRULE_bitwiseOrExpression)
+			p.SetState(966)
+
+			if !(p.Precpred(p.GetParserRuleContext(), 1)) {
+				panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 1)", ""))
+			}
+			{
+				p.SetState(967)
+				p.Match(CadenceParserBitwiseOr)
+			}
+			{
+				p.SetState(968)
+				p.bitwiseXorExpression(0)
+			}
+
+		}
+		p.SetState(973)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 91, p.GetParserRuleContext())
+	}
+
+	return localctx
+}
+
+// IBitwiseXorExpressionContext is an interface to support dynamic dispatch.
+type IBitwiseXorExpressionContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsBitwiseXorExpressionContext differentiates from other interfaces.
+	IsBitwiseXorExpressionContext()
+}
+
+type BitwiseXorExpressionContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyBitwiseXorExpressionContext() *BitwiseXorExpressionContext {
+	var p = new(BitwiseXorExpressionContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = CadenceParserRULE_bitwiseXorExpression
+	return p
+}
+
+func (*BitwiseXorExpressionContext) IsBitwiseXorExpressionContext() {}
+
+func NewBitwiseXorExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *BitwiseXorExpressionContext {
+	var p = new(BitwiseXorExpressionContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = CadenceParserRULE_bitwiseXorExpression
+
+	return p
+}
+
+func (s *BitwiseXorExpressionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *BitwiseXorExpressionContext) ConcatenatingExpression() IConcatenatingExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IConcatenatingExpressionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IConcatenatingExpressionContext)
+}
+
+func (s *BitwiseXorExpressionContext) BitwiseXorExpression() IBitwiseXorExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IBitwiseXorExpressionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBitwiseXorExpressionContext)
+}
+
+func (s *BitwiseXorExpressionContext) BitwiseXor() antlr.TerminalNode {
+	return s.GetToken(CadenceParserBitwiseXor, 0)
+}
+
+func (s *BitwiseXorExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *BitwiseXorExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *BitwiseXorExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CadenceListener); ok {
+		listenerT.EnterBitwiseXorExpression(s)
+	}
+}
+
+func (s *BitwiseXorExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CadenceListener); ok {
+		listenerT.ExitBitwiseXorExpression(s)
+	}
+}
+
+func (s *BitwiseXorExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case CadenceVisitor:
+		return t.VisitBitwiseXorExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *CadenceParser) BitwiseXorExpression() (localctx IBitwiseXorExpressionContext) {
+	return p.bitwiseXorExpression(0)
+}
+
+func (p *CadenceParser) bitwiseXorExpression(_p int) (localctx IBitwiseXorExpressionContext) {
+	var _parentctx antlr.ParserRuleContext = p.GetParserRuleContext()
+	_parentState := p.GetState()
+	localctx = NewBitwiseXorExpressionContext(p, p.GetParserRuleContext(), _parentState)
+	var _prevctx IBitwiseXorExpressionContext = localctx
+	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
+	_startState := 957
+	p.EnterRecursionRule(localctx, 957, CadenceParserRULE_bitwiseXorExpression, _p)
+
+	defer func() {
+		p.UnrollRecursionContexts(_parentctx)
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(976)
+		p.concatenatingExpression(0)
+	}
+
+	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
+	p.SetState(984)
+	p.GetErrorHandler().Sync(p)
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 92, p.GetParserRuleContext())
+
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			if p.GetParseListeners() != nil {
+				p.TriggerExitRuleEvent()
+			}
+			_prevctx = localctx
+			localctx = NewBitwiseXorExpressionContext(p, _parentctx, _parentState)
+			p.PushNewRecursionContext(localctx, _startState, CadenceParserRULE_bitwiseXorExpression)
+			p.SetState(978)
+
+			if !(p.Precpred(p.GetParserRuleContext(), 1)) {
+				panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 1)", ""))
+			}
+			{
+				p.SetState(979)
+				p.Match(CadenceParserBitwiseXor)
+			}
+			{
+				p.SetState(980)
+				p.concatenatingExpression(0)
+			}
+
+		}
+		p.SetState(985)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 92, p.GetParserRuleContext())
+	}
+
+	return localctx
+}
+
+// IBitwiseShiftExpressionContext is an interface to support dynamic dispatch.
+type IBitwiseShiftExpressionContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsBitwiseShiftExpressionContext differentiates from other interfaces.
+	IsBitwiseShiftExpressionContext()
+}
+
+type BitwiseShiftExpressionContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyBitwiseShiftExpressionContext() *BitwiseShiftExpressionContext {
+	var p = new(BitwiseShiftExpressionContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = CadenceParserRULE_bitwiseShiftExpression
+	return p
+}
+
+func (*BitwiseShiftExpressionContext) IsBitwiseShiftExpressionContext() {}
+
+func NewBitwiseShiftExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *BitwiseShiftExpressionContext {
+	var p = new(BitwiseShiftExpressionContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = CadenceParserRULE_bitwiseShiftExpression
+
+	return p
+}
+
+func (s *BitwiseShiftExpressionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *BitwiseShiftExpressionContext) AdditiveExpression() IAdditiveExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IAdditiveExpressionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAdditiveExpressionContext)
+}
+
+func (s *BitwiseShiftExpressionContext) BitwiseShiftExpression() IBitwiseShiftExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IBitwiseShiftExpressionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBitwiseShiftExpressionContext)
+}
+
+func (s *BitwiseShiftExpressionContext) BitwiseShiftOp() IBitwiseShiftOpContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IBitwiseShiftOpContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBitwiseShiftOpContext)
+}
+
+func (s *BitwiseShiftExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *BitwiseShiftExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *BitwiseShiftExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CadenceListener); ok {
+		listenerT.EnterBitwiseShiftExpression(s)
+	}
+}
+
+func (s *BitwiseShiftExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CadenceListener); ok {
+		listenerT.ExitBitwiseShiftExpression(s)
+	}
+}
+
+func (s *BitwiseShiftExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case CadenceVisitor:
+		return t.VisitBitwiseShiftExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *CadenceParser) BitwiseShiftExpression() (localctx IBitwiseShiftExpressionContext) {
+	return p.bitwiseShiftExpression(0)
+}
+
+func (p *CadenceParser) bitwiseShiftExpression(_p int) (localctx IBitwiseShiftExpressionContext) {
+	var _parentctx antlr.ParserRuleContext = p.GetParserRuleContext()
+	_parentState := p.GetState()
+	localctx = NewBitwiseShiftExpressionContext(p, p.GetParserRuleContext(), _parentState)
+	var _prevctx IBitwiseShiftExpressionContext = localctx
+	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
+	_startState := 959
+	p.EnterRecursionRule(localctx, 959, CadenceParserRULE_bitwiseShiftExpression, _p)
+
+	defer func() {
+		p.UnrollRecursionContexts(_parentctx)
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(988)
+		p.additiveExpression(0)
+	}
+
+	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
+	p.SetState(996)
+	p.GetErrorHandler().Sync(p)
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 93, p.GetParserRuleContext())
+
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			if p.GetParseListeners() != nil {
+				p.TriggerExitRuleEvent()
+			}
+			_prevctx = localctx
+			localctx = NewBitwiseShiftExpressionContext(p, _parentctx, _parentState)
+			p.PushNewRecursionContext(localctx, _startState, CadenceParserRULE_bitwiseShiftExpression)
+			p.SetState(990)
+
+			if !(p.Precpred(p.GetParserRuleContext(), 1)) {
+				panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 1)", ""))
+			}
+			{
+				p.SetState(991)
+				p.BitwiseShiftOp()
+			}
+			{
+				p.SetState(992)
+				p.additiveExpression(0)
+			}
+
+		}
+		p.SetState(997)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 93, p.GetParserRuleContext())
+	}
+
+	return localctx
+}
+
+// IBitwiseShiftOpContext is an interface to support dynamic dispatch.
+type IBitwiseShiftOpContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsBitwiseShiftOpContext differentiates from other interfaces.
+	IsBitwiseShiftOpContext()
+}
+
+type BitwiseShiftOpContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyBitwiseShiftOpContext() *BitwiseShiftOpContext {
+	var p = new(BitwiseShiftOpContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = CadenceParserRULE_bitwiseShiftOp
+	return p
+}
+
+func (*BitwiseShiftOpContext) IsBitwiseShiftOpContext() {}
+
+func NewBitwiseShiftOpContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *BitwiseShiftOpContext {
+	var p = new(BitwiseShiftOpContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = CadenceParserRULE_bitwiseShiftOp
+
+	return p
+}
+
+func (s *BitwiseShiftOpContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *BitwiseShiftOpContext) AllLess() []antlr.TerminalNode {
+	return s.GetTokens(CadenceParserLess)
+}
+
+func (s *BitwiseShiftOpContext) Less(i int) antlr.TerminalNode {
+	return s.GetToken(CadenceParserLess, i)
+}
+
+func (s *BitwiseShiftOpContext) AllGreater() []antlr.TerminalNode {
+	return s.GetTokens(CadenceParserGreater)
+}
+
+func (s *BitwiseShiftOpContext) Greater(i int) antlr.TerminalNode {
+	return s.GetToken(CadenceParserGreater, i)
+}
+
+func (s *BitwiseShiftOpContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *BitwiseShiftOpContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *BitwiseShiftOpContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CadenceListener); ok {
+		listenerT.EnterBitwiseShiftOp(s)
+	}
+}
+
+func (s *BitwiseShiftOpContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CadenceListener); ok {
+		listenerT.ExitBitwiseShiftOp(s)
+	}
+}
+
+func (s *BitwiseShiftOpContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case CadenceVisitor:
+		return t.VisitBitwiseShiftOp(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *CadenceParser) BitwiseShiftOp() (localctx IBitwiseShiftOpContext) {
+	localctx = NewBitwiseShiftOpContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 961, CadenceParserRULE_bitwiseShiftOp)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(1000)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case CadenceParserLess:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(1001)
+			p.Match(CadenceParserLess)
+		}
+		p.SetState(1002)
+
+		if !(p.noWhitespace()) {
+			panic(antlr.NewFailedPredicateException(p, "p.noWhitespace()", ""))
+		}
+		{
+			p.SetState(1003)
+			p.Match(CadenceParserLess)
+		}
+
+	case CadenceParserGreater:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(1005)
+			p.Match(CadenceParserGreater)
+		}
+		p.SetState(1006)
+
+		if !(p.noWhitespace()) {
+			panic(antlr.NewFailedPredicateException(p, "p.noWhitespace()", ""))
+		}
+		{
+			p.SetState(1007)
+			p.Match(CadenceParserGreater)
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// IInstantiationTypeContext is an interface to support dynamic dispatch.
+type IInstantiationTypeContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsInstantiationTypeContext differentiates from other interfaces.
+	IsInstantiationTypeContext()
+}
+
+type InstantiationTypeContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyInstantiationTypeContext() *InstantiationTypeContext {
+	var p = new(InstantiationTypeContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = CadenceParserRULE_instantiationType
+	return p
+}
+
+func (*InstantiationTypeContext) IsInstantiationTypeContext() {}
+
+func NewInstantiationTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *InstantiationTypeContext {
+	var p = new(InstantiationTypeContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = CadenceParserRULE_instantiationType
+
+	return p
+}
+
+func (s *InstantiationTypeContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *InstantiationTypeContext) NominalType() INominalTypeContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*INominalTypeContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INominalTypeContext)
+}
+
+func (s *InstantiationTypeContext) Less() antlr.TerminalNode {
+	return s.GetToken(CadenceParserLess, 0)
+}
+
+func (s *InstantiationTypeContext) AllTypeAnnotation() []ITypeAnnotationContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ITypeAnnotationContext)(nil)).Elem())
+	var tst = make([]ITypeAnnotationContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(ITypeAnnotationContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *InstantiationTypeContext) TypeAnnotation(i int) ITypeAnnotationContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypeAnnotationContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeAnnotationContext)
+}
+
+func (s *InstantiationTypeContext) Greater() antlr.TerminalNode {
+	return s.GetToken(CadenceParserGreater, 0)
+}
+
+func (s *InstantiationTypeContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *InstantiationTypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *InstantiationTypeContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CadenceListener); ok {
+		listenerT.EnterInstantiationType(s)
+	}
+}
+
+func (s *InstantiationTypeContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CadenceListener); ok {
+		listenerT.ExitInstantiationType(s)
+	}
+}
+
+func (s *InstantiationTypeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case CadenceVisitor:
+		return t.VisitInstantiationType(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *CadenceParser) InstantiationType() (localctx IInstantiationTypeContext) {
+	localctx = NewInstantiationTypeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 1009, CadenceParserRULE_instantiationType)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(1011)
+		p.NominalType()
+	}
+	p.SetState(1012)
+
+	if !(p.noWhitespace()) {
+		panic(antlr.NewFailedPredicateException(p, "p.noWhitespace()", ""))
+	}
+	{
+		p.SetState(1013)
+		p.Match(CadenceParserLess)
+	}
+	{
+		p.SetState(1014)
+		p.TypeAnnotation()
+	}
+	p.SetState(1019)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == CadenceParserT__3 {
+		{
+			p.SetState(1015)
+			p.Match(CadenceParserT__3)
+		}
+		{
+			p.SetState(1016)
+			p.TypeAnnotation()
+		}
+
+		p.SetState(1020)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(1022)
+		p.Match(CadenceParserGreater)
+	}
+
+	return localctx
+}
+
+func (p *CadenceParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex int) bool {
+	switch ruleIndex {
+	case 26:
+		var t *FullTypeContext = nil
+		if localctx != nil {
+			t = localctx.(*FullTypeContext)
+		}
+		return p.FullType_Sempred(t, predIndex)
+
+	case 27:
+		var t *InnerTypeContext = nil
+		if localctx != nil {
+			t = localctx.(*InnerTypeContext)
+		}
+		return p.InnerType_Sempred(t, predIndex)
+
+	case 43:
+		var t *ReturnStatementContext = nil
+		if localctx != nil {
+			t = localctx.(*ReturnStatementContext)
+		}
+		return p.ReturnStatement_Sempred(t, predIndex)
+
+	case 56:
+		var t *OrExpressionContext = nil
+		if localctx != nil {
+			t = localctx.(*OrExpressionContext)
+		}
+		return p.OrExpression_Sempred(t, predIndex)
+
+	case 57:
+		var t *AndExpressionContext = nil
+		if localctx != nil {
+			t = localctx.(*AndExpressionContext)
+		}
+		return p.AndExpression_Sempred(t, predIndex)
+
+	case 58:
+		var t *EqualityExpressionContext = nil
+		if localctx != nil {
+			t = localctx.(*EqualityExpressionContext)
+		}
+		return p.EqualityExpression_Sempred(t, predIndex)
+
+	case 59:
+		var t *RelationalExpressionContext = nil
+		if localctx != nil {
+			t = localctx.(*RelationalExpressionContext)
+		}
+		return p.RelationalExpression_Sempred(t, predIndex)
+
+	case 61:
+		var t *CastingExpressionContext = nil
+		if localctx != nil {
+			t = localctx.(*CastingExpressionContext)
+		}
+		return p.CastingExpression_Sempred(t, predIndex)
+
+	case 62:
+		var t *ConcatenatingExpressionContext = nil
+		if localctx != nil {
+			t = localctx.(*ConcatenatingExpressionContext)
+		}
+		return p.ConcatenatingExpression_Sempred(t, predIndex)
+
+	case 63:
+		var t *AdditiveExpressionContext = nil
+		if localctx != nil {
+			t = localctx.(*AdditiveExpressionContext)
+		}
+		return p.AdditiveExpression_Sempred(t, predIndex)
+
+	case 64:
+		var t *MultiplicativeExpressionContext = nil
+		if localctx != nil {
+			t = localctx.(*MultiplicativeExpressionContext)
+		}
+		return p.MultiplicativeExpression_Sempred(t, predIndex)
+
+	case 67:
+		var t *PostfixExpressionContext = nil
+		if localctx != nil {
+			t = localctx.(*PostfixExpressionContext)
+		}
+		return p.PostfixExpression_Sempred(t, predIndex)
+
+	case 77:
+		var t *ExpressionAccessContext = nil
+		if localctx != nil {
+			t = localctx.(*ExpressionAccessContext)
+		}
+		return p.ExpressionAccess_Sempred(t, predIndex)
+
+	case 85:
+		var t *PathLiteralContext = nil
+		if localctx != nil {
+			t = localctx.(*PathLiteralContext)
+		}
+		return p.PathLiteral_Sempred(t, predIndex)
+
+	case 94:
+		var t *EosContext = nil
+		if localctx != nil {
+			t = localctx.(*EosContext)
+		}
+		return p.Eos_Sempred(t, predIndex)
+
+	case 98:
+		var t *BitwiseOrExpressionContext = nil
+		if localctx != nil {
+			t = localctx.(*BitwiseOrExpressionContext)
+		}
+		return p.BitwiseOrExpression_Sempred(t, predIndex)
+
+	case 99:
+		var t *BitwiseXorExpressionContext = nil
+		if localctx != nil {
+			t = localctx.(*BitwiseXorExpressionContext)
+		}
+		return p.BitwiseXorExpression_Sempred(t, predIndex)
+
+	case 100:
+		var t *BitwiseShiftExpressionContext = nil
+		if localctx != nil {
+			t = localctx.(*BitwiseShiftExpressionContext)
+		}
+		return p.BitwiseShiftExpression_Sempred(t, predIndex)
+
+	case 101:
+		var t *BitwiseShiftOpContext = nil
+		if localctx != nil {
+			t = localctx.(*BitwiseShiftOpContext)
+		}
+		return p.BitwiseShiftOp_Sempred(t, predIndex)
+
+	case 102:
+		var t *InstantiationTypeContext = nil
+		if localctx != nil {
+			t = localctx.(*InstantiationTypeContext)
+		}
+		return p.InstantiationType_Sempred(t, predIndex)
+
+	default:
+		panic("No predicate with index: " + fmt.Sprint(ruleIndex))
+	}
+}
+
+func (p *CadenceParser) FullType_Sempred(localctx antlr.RuleContext, predIndex int) bool {
+	switch predIndex {
+	case 0:
+		return p.noWhitespace()
+
+	case 1:
+		return p.noWhitespace()
+
+	default:
+		panic("No predicate with index: " + fmt.Sprint(predIndex))
+	}
+}
+
+func (p *CadenceParser) InnerType_Sempred(localctx antlr.RuleContext, predIndex int) bool {
+	switch predIndex {
+	case 2:
+		return p.noWhitespace()
+
+	default:
+		panic("No predicate with index: " + fmt.Sprint(predIndex))
+	}
+}
+
+func (p *CadenceParser) ReturnStatement_Sempred(localctx antlr.RuleContext, predIndex int) bool {
+	switch predIndex {
+	case 3:
+		return !p.lineTerminatorAhead()
+
+	default:
+		panic("No predicate with index: " + fmt.Sprint(predIndex))
+	}
+}
+
+func (p *CadenceParser) OrExpression_Sempred(localctx antlr.RuleContext, predIndex int) bool {
+	switch predIndex {
+	case 4:
+		return p.Precpred(p.GetParserRuleContext(), 1)
+
+	default:
+		panic("No predicate with index: " + fmt.Sprint(predIndex))
+	}
+}
+
+func (p *CadenceParser) AndExpression_Sempred(localctx antlr.RuleContext, predIndex int) bool {
+	switch predIndex {
+	case 5:
+		return p.Precpred(p.GetParserRuleContext(), 1)
+
+	default:
+		panic("No predicate with index: " + fmt.Sprint(predIndex))
+	}
+}
+
+func (p *CadenceParser) EqualityExpression_Sempred(localctx antlr.RuleContext, predIndex int) bool {
+	switch predIndex {
+	case 6:
+		return p.Precpred(p.GetParserRuleContext(), 1)
+
+	default:
+		panic("No predicate with index: " + fmt.Sprint(predIndex))
+	}
+}
+
+func (p *CadenceParser) RelationalExpression_Sempred(localctx antlr.RuleContext, predIndex int) bool {
+	switch predIndex {
+	case 7:
+		return p.Precpred(p.GetParserRuleContext(), 1)
+
+	default:
+		panic("No predicate with index: " + fmt.Sprint(predIndex))
+	}
+}
+
+func (p *CadenceParser) CastingExpression_Sempred(localctx antlr.RuleContext, predIndex int) bool {
+	switch predIndex {
+	case 8:
+		return p.Precpred(p.GetParserRuleContext(), 1)
+
+	default:
+		panic("No predicate with index: " + fmt.Sprint(predIndex))
+	}
+}
+
+func (p *CadenceParser) ConcatenatingExpression_Sempred(localctx antlr.RuleContext, predIndex int) bool {
+	switch predIndex {
+	case 9:
+		return p.Precpred(p.GetParserRuleContext(), 1)
+
+	default:
+		panic("No predicate with index: " + fmt.Sprint(predIndex))
 	}
 }
 
@@ -16240,3 +17709,56 @@ func (p *CadenceParser) Eos_Sempred(localctx antlr.RuleContext, predIndex int) b
 		panic("No predicate with index: " + fmt.Sprint(predIndex))
 	}
 }
+
+func (p *CadenceParser) BitwiseOrExpression_Sempred(localctx antlr.RuleContext, predIndex int) bool {
+	switch predIndex {
+	case 23:
+		return p.Precpred(p.GetParserRuleContext(), 1)
+
+	default:
+		panic("No predicate with index: " + fmt.Sprint(predIndex))
+	}
+}
+
+func (p *CadenceParser) BitwiseXorExpression_Sempred(localctx antlr.RuleContext, predIndex int) bool {
+	switch predIndex {
+	case 24:
+		return p.Precpred(p.GetParserRuleContext(), 1)
+
+	default:
+		panic("No predicate with index: " + fmt.Sprint(predIndex))
+	}
+}
+
+func (p *CadenceParser) BitwiseShiftExpression_Sempred(localctx antlr.RuleContext, predIndex int) bool {
+	switch predIndex {
+	case 25:
+		return p.Precpred(p.GetParserRuleContext(), 1)
+
+	default:
+		panic("No predicate with index: " + fmt.Sprint(predIndex))
+	}
+}
+
+func (p *CadenceParser) BitwiseShiftOp_Sempred(localctx antlr.RuleContext, predIndex int) bool {
+	switch predIndex {
+	case 26:
+		return p.noWhitespace()
+
+	case 27:
+		return p.noWhitespace()
+
+	default:
+		panic("No predicate with index: " + fmt.Sprint(predIndex))
+	}
+}
+
+func (p *CadenceParser) InstantiationType_Sempred(localctx antlr.RuleContext, predIndex int) bool {
+	switch predIndex {
+	case 28:
+		return p.noWhitespace()
+
+	default:
+		panic("No predicate with index: " + fmt.Sprint(predIndex))
+	}
+}
@@ -343,4 +343,28 @@ type CadenceVisitor interface {
 
 	// Visit a parse tree produced by CadenceParser#eos.
 	VisitEos(ctx *EosContext) interface{}
+
+	// Visit a parse tree produced by CadenceParser#enumCase.
+	VisitEnumCase(ctx *EnumCaseContext) interface{}
+
+	// Visit a parse tree produced by CadenceParser#switchStatement.
+	VisitSwitchStatement(ctx *SwitchStatementContext) interface{}
+
+	// Visit a parse tree produced by CadenceParser#switchCase.
+	VisitSwitchCase(ctx *SwitchCaseContext) interface{}
+
+	// Visit a parse tree produced by CadenceParser#bitwiseOrExpression.
+	VisitBitwiseOrExpression(ctx *BitwiseOrExpressionContext) interface{}
+
+	// Visit a parse tree produced by CadenceParser#bitwiseXorExpression.
+	VisitBitwiseXorExpression(ctx *BitwiseXorExpressionContext) interface{}
+
+	// Visit a parse tree produced by CadenceParser#bitwiseShiftExpression.
+	VisitBitwiseShiftExpression(ctx *BitwiseShiftExpressionContext) interface{}
+
+	// Visit a parse tree produced by CadenceParser#bitwiseShiftOp.
+	VisitBitwiseShiftOp(ctx *BitwiseShiftOpContext) interface{}
+
+	// Visit a parse tree produced by CadenceParser#instantiationType.
+	VisitInstantiationType(ctx *InstantiationTypeContext) interface{}
 }
@@ -141,3 +141,39 @@ func (e *InvalidIntegerLiteralError) SecondaryError() string {
 
 	panic(errors.NewUnreachableError())
 }
+
+// UnterminatedStringInterpolationError
+
+type UnterminatedStringInterpolationError struct {
+	Pos ast.Position
+}
+
+func (*UnterminatedStringInterpolationError) isParseError() {}
+
+func (e *UnterminatedStringInterpolationError) StartPosition() ast.Position {
+	return e.Pos
+}
+
+func (e *UnterminatedStringInterpolationError) EndPosition() ast.Position {
+	return e.Pos
+}
+
+func (e *UnterminatedStringInterpolationError) Error() string {
+	return "unterminated string interpolation"
+}
+
+func (e *UnterminatedStringInterpolationError) SecondaryError() string {
+	return "interpolated expressions must end with a closing parenthesis"
+}
+
+// InvalidImportLocationError
+
+type InvalidImportLocationError struct {
+	ast.Range
+}
+
+func (*InvalidImportLocationError) isParseError() {}
+
+func (e *InvalidImportLocationError) Error() string {
+	return "import location must not contain interpolated expressions"
+}
@@ -144,6 +144,49 @@ func ParseReplInput(code string) (replInput []interface{}, inputIsComplete bool,
 	return elements, inputIsComplete, err
 }
 
+// parseInterpolatedExpression parses the interpolated expression of a string template,
+// which starts at the given position in the given input and has the given length (in characters).
+//
+func parseInterpolatedExpression(
+	input antlr.CharStream,
+	startPosition ast.Position,
+	length int,
+) (
+	expression ast.Expression,
+	errors []error,
+) {
+	// NOTE: keep the input preceding the expression,
+	// so the offsets of the parsed expression are the offsets in the whole input,
+	// but end the input after the expression
+
+	endOffset := startPosition.Offset + length
+	expressionInput := antlr.NewInputStream(input.GetText(0, endOffset-1))
+
+	result, _, errors := parseInput(
+		expressionInput,
+		startPosition,
+		func(parser *CadenceParser) antlr.ParserRuleContext {
+			expression := parser.Expression()
+
+			// the interpolated expression must be the whole input
+
+			if parser.GetTokenStream().LA(1) != antlr.TokenEOF {
+				parser.NotifyErrorListeners(
+					"unexpected token in interpolated expression",
+					parser.GetCurrentToken(),
+					nil,
+				)
+			}
+
+			return expression
+		},
+	)
+
+	expression, _ = result.(ast.Expression)
+
+	return expression, errors
+}
+
 func parse(
 	code string,
 	parse func(*CadenceParser) antlr.ParserRuleContext,
@@ -152,11 +195,34 @@ func parse(
 	inputIsComplete bool,
 	errors []error,
 ) {
-	input := antlr.NewInputStream(code)
+	return parseInput(
+		antlr.NewInputStream(code),
+		ast.Position{Offset: 0, Line: 1, Column: 0},
+		parse,
+	)
+}
 
+// parseInput parses the given input, starting at the given position.
+//
+func parseInput(
+	input antlr.CharStream,
+	startPosition ast.Position,
+	parse func(*CadenceParser) antlr.ParserRuleContext,
+) (
+	result ast.Repr,
+	inputIsComplete bool,
+	errors []error,
+) {
 	listener := new(errorListener)
 
+	input.Seek(startPosition.Offset)
+
 	lexer := NewCadenceLexer(input)
+
+	lexerSimulator := lexer.Interpreter.(*antlr.LexerATNSimulator)
+	lexerSimulator.Line = startPosition.Line
+	lexerSimulator.CharPositionInLine = startPosition.Column
+
 	// remove the lexer's default console error listener
 	lexer.RemoveErrorListeners()
 	lexer.AddErrorListener(listener)
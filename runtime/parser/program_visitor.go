@@ -22,6 +22,7 @@ import (
 	"encoding/hex"
 	"math/big"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/antlr/antlr4/runtime/Go/antlr"
 
@@ -176,10 +177,21 @@ func (v *ProgramVisitor) VisitImportDeclaration(ctx *ImportDeclarationContext) i
 	// string literal?
 	stringLiteralContext := ctx.StringLiteral()
 	if stringLiteralContext != nil {
-		stringExpression := stringLiteralContext.Accept(v).(*ast.StringExpression)
-		location = ast.StringLocation(stringExpression.Value)
-		locationPos = stringExpression.StartPos
-		endPos = stringExpression.EndPos
+		switch expression := stringLiteralContext.Accept(v).(type) {
+		case *ast.StringExpression:
+			location = ast.StringLocation(expression.Value)
+			locationPos = expression.StartPos
+			endPos = expression.EndPos
+
+		case *ast.StringTemplateExpression:
+			v.report(
+				&InvalidImportLocationError{
+					Range: expression.Range,
+				},
+			)
+			locationPos = expression.StartPos
+			endPos = expression.EndPos
+		}
 	} else {
 		// hexadecimal literal (address)
 
@@ -399,6 +411,7 @@ func (v *ProgramVisitor) VisitMembersAndNestedDeclarations(ctx *MembersAndNested
 	var functions []*ast.FunctionDeclaration
 	var compositeDeclarations []*ast.CompositeDeclaration
 	var interfaceDeclarations []*ast.InterfaceDeclaration
+	var enumCases []*ast.EnumCaseDeclaration
 
 	for _, memberOrNestedDeclarationContext := range ctx.AllMemberOrNestedDeclaration() {
 		memberOrNestedDeclaration := memberOrNestedDeclarationContext.Accept(v)
@@ -418,6 +431,9 @@ func (v *ProgramVisitor) VisitMembersAndNestedDeclarations(ctx *MembersAndNested
 
 		case *ast.InterfaceDeclaration:
 			interfaceDeclarations = append(interfaceDeclarations, memberOrNestedDeclaration)
+
+		case *ast.EnumCaseDeclaration:
+			enumCases = append(enumCases, memberOrNestedDeclaration)
 		}
 	}
 
@@ -425,6 +441,7 @@ func (v *ProgramVisitor) VisitMembersAndNestedDeclarations(ctx *MembersAndNested
 		Fields:           fields,
 		SpecialFunctions: specialFunctions,
 		Functions:        functions,
+		EnumCases:        enumCases,
 	}
 
 	return membersAndNestedDeclarations{
@@ -519,6 +536,19 @@ func (v *ProgramVisitor) VisitSpecialFunctionDeclaration(ctx *SpecialFunctionDec
 	}
 }
 
+func (v *ProgramVisitor) VisitEnumCase(ctx *EnumCaseContext) interface{} {
+	access := ctx.Access().Accept(v).(ast.Access)
+	identifier := ctx.Identifier().Accept(v).(ast.Identifier)
+
+	startPosition := PositionFromToken(ctx.GetStart())
+
+	return &ast.EnumCaseDeclaration{
+		Access:     access,
+		Identifier: identifier,
+		StartPos:   startPosition,
+	}
+}
+
 func (v *ProgramVisitor) VisitInterfaceDeclaration(ctx *InterfaceDeclarationContext) interface{} {
 	access := ctx.Access().Accept(v).(ast.Access)
 	kind := ctx.CompositeKind().Accept(v).(common.CompositeKind)
@@ -551,6 +581,9 @@ func (v *ProgramVisitor) VisitCompositeKind(ctx *CompositeKindContext) interface
 	case ctx.Contract() != nil:
 		return common.CompositeKindContract
 
+	case ctx.Enum() != nil:
+		return common.CompositeKindEnum
+
 	default:
 		panic(errors.NewUnreachableError())
 	}
@@ -724,6 +757,27 @@ func (v *ProgramVisitor) VisitDictionaryType(ctx *DictionaryTypeContext) interfa
 	}
 }
 
+func (v *ProgramVisitor) VisitInstantiationType(ctx *InstantiationTypeContext) interface{} {
+	nominalType := ctx.NominalType().Accept(v).(*ast.NominalType)
+
+	var typeArguments []*ast.TypeAnnotation
+	for _, typeAnnotationContext := range ctx.AllTypeAnnotation() {
+		typeArgument := typeAnnotationContext.Accept(v).(*ast.TypeAnnotation)
+		typeArguments = append(typeArguments, typeArgument)
+	}
+
+	startPosition, endPosition := PositionRangeFromContext(ctx)
+
+	return &ast.InstantiationType{
+		Type:          nominalType,
+		TypeArguments: typeArguments,
+		Range: ast.Range{
+			StartPos: startPosition,
+			EndPos:   endPosition,
+		},
+	}
+}
+
 func (v *ProgramVisitor) VisitTypeAnnotation(ctx *TypeAnnotationContext) interface{} {
 	isResource := ctx.ResourceAnnotation() != nil
 	fullType := ctx.FullType().Accept(v).(ast.Type)
@@ -1352,7 +1406,7 @@ func (v *ProgramVisitor) VisitNilCoalescingExpression(ctx *NilCoalescingExpressi
 func (v *ProgramVisitor) VisitCastingExpression(ctx *CastingExpressionContext) interface{} {
 	typeAnnotationContext := ctx.TypeAnnotation()
 	if typeAnnotationContext == nil {
-		return ctx.ConcatenatingExpression().Accept(v)
+		return ctx.BitwiseOrExpression().Accept(v)
 	}
 
 	expression := ctx.CastingExpression().Accept(v).(ast.Expression)
@@ -1366,8 +1420,50 @@ func (v *ProgramVisitor) VisitCastingExpression(ctx *CastingExpressionContext) i
 	}
 }
 
+func (v *ProgramVisitor) VisitBitwiseOrExpression(ctx *BitwiseOrExpressionContext) interface{} {
+	right := ctx.BitwiseXorExpression().Accept(v)
+	if right == nil {
+		return nil
+	}
+	rightExpression := right.(ast.Expression)
+
+	leftContext := ctx.BitwiseOrExpression()
+	if leftContext == nil {
+		return rightExpression
+	}
+
+	leftExpression := leftContext.Accept(v).(ast.Expression)
+
+	return &ast.BinaryExpression{
+		Operation: ast.OperationBitwiseOr,
+		Left:      leftExpression,
+		Right:     rightExpression,
+	}
+}
+
+func (v *ProgramVisitor) VisitBitwiseXorExpression(ctx *BitwiseXorExpressionContext) interface{} {
+	right := ctx.ConcatenatingExpression().Accept(v)
+	if right == nil {
+		return nil
+	}
+	rightExpression := right.(ast.Expression)
+
+	leftContext := ctx.BitwiseXorExpression()
+	if leftContext == nil {
+		return rightExpression
+	}
+
+	leftExpression := leftContext.Accept(v).(ast.Expression)
+
+	return &ast.BinaryExpression{
+		Operation: ast.OperationBitwiseXor,
+		Left:      leftExpression,
+		Right:     rightExpression,
+	}
+}
+
 func (v *ProgramVisitor) VisitConcatenatingExpression(ctx *ConcatenatingExpressionContext) interface{} {
-	right := ctx.AdditiveExpression().Accept(v)
+	right := ctx.BitwiseShiftExpression().Accept(v)
 	if right == nil {
 		return nil
 	}
@@ -1387,6 +1483,28 @@ func (v *ProgramVisitor) VisitConcatenatingExpression(ctx *ConcatenatingExpressi
 	}
 }
 
+func (v *ProgramVisitor) VisitBitwiseShiftExpression(ctx *BitwiseShiftExpressionContext) interface{} {
+	right := ctx.AdditiveExpression().Accept(v)
+	if right == nil {
+		return nil
+	}
+	rightExpression := right.(ast.Expression)
+
+	leftContext := ctx.BitwiseShiftExpression()
+	if leftContext == nil {
+		return rightExpression
+	}
+
+	leftExpression := leftContext.Accept(v).(ast.Expression)
+	operation := ctx.BitwiseShiftOp().Accept(v).(ast.Operation)
+
+	return &ast.BinaryExpression{
+		Operation: operation,
+		Left:      leftExpression,
+		Right:     rightExpression,
+	}
+}
+
 func (v *ProgramVisitor) VisitAdditiveExpression(ctx *AdditiveExpressionContext) interface{} {
 	right := ctx.MultiplicativeExpression().Accept(v)
 	if right == nil {
@@ -1478,6 +1596,9 @@ func (v *ProgramVisitor) VisitUnaryOp(ctx *UnaryOpContext) interface{} {
 	case ctx.Move() != nil:
 		return ast.OperationMove
 
+	case ctx.BitwiseNot() != nil:
+		return ast.OperationBitwiseNot
+
 	default:
 		panic(errors.NewUnreachableError())
 	}
@@ -1805,24 +1926,140 @@ func (v *ProgramVisitor) VisitNilLiteral(ctx *NilLiteralContext) interface{} {
 }
 
 func (v *ProgramVisitor) VisitStringLiteral(ctx *StringLiteralContext) interface{} {
-	startPosition := PositionFromToken(ctx.GetStart())
-	endPosition := ast.EndPosition(startPosition, ctx.StringLiteral().GetSymbol().GetStop())
+	token := ctx.StringLiteral().GetSymbol()
+
+	startPosition := PositionFromToken(token)
+	endPosition := ast.EndPosition(startPosition, token.GetStop())
+
+	stringLiteral := token.GetText()
 
-	stringLiteral := ctx.StringLiteral().GetText()
+	// slice off leading and trailing quotes,
+	// parse escape characters and interpolated expressions
 
-	// slice off leading and trailing quotes
-	// and parse escape characters
-	parsedString := parseStringLiteral(
-		stringLiteral[1 : len(stringLiteral)-1],
+	values, expressions := v.parseStringTemplate(
+		stringLiteral[1:len(stringLiteral)-1],
+		startPosition.Shifted(1),
+		token.GetInputStream(),
 	)
 
-	return &ast.StringExpression{
-		Value: parsedString,
-		Range: ast.Range{
-			StartPos: startPosition,
-			EndPos:   endPosition,
-		},
+	literalRange := ast.Range{
+		StartPos: startPosition,
+		EndPos:   endPosition,
+	}
+
+	if len(expressions) == 0 {
+		return &ast.StringExpression{
+			Value: values[0],
+			Range: literalRange,
+		}
+	}
+
+	return &ast.StringTemplateExpression{
+		Values:      values,
+		Expressions: expressions,
+		Range:       literalRange,
+	}
+}
+
+// parseStringTemplate splits the contents of a string literal into the literal parts,
+// with escape characters parsed, and the interpolated expressions, i.e. the parts
+// enclosed in `\(` and `)`. There is always one more value than there are expressions.
+//
+// The position is the position of the start of the contents in the given input.
+//
+func (v *ProgramVisitor) parseStringTemplate(
+	s string,
+	startPosition ast.Position,
+	input antlr.CharStream,
+) (
+	values []string,
+	expressions []ast.Expression,
+) {
+	// NOTE: positions are in characters, not bytes
+
+	var value strings.Builder
+
+	partStart := 0
+	characterOffset := 0
+
+	i := 0
+	for i < len(s) {
+		if s[i] != '\\' {
+			_, size := utf8.DecodeRuneInString(s[i:])
+			i += size
+			characterOffset++
+			continue
+		}
+
+		// escape sequences only consist of ASCII characters.
+		// skip all but the start of interpolated expressions
+
+		if s[i+1] != '(' {
+			i += 2
+			characterOffset += 2
+			continue
+		}
+
+		value.WriteString(parseStringLiteral(s[partStart:i]))
+
+		interpolationPosition := startPosition.Shifted(characterOffset)
+
+		// find the closing parenthesis matching the opening parenthesis
+
+		expressionStart := i + 2
+		expressionEnd := expressionStart
+		depth := 1
+
+		for expressionEnd < len(s) {
+			switch s[expressionEnd] {
+			case '(':
+				depth++
+			case ')':
+				depth--
+			}
+			if depth == 0 {
+				break
+			}
+			expressionEnd++
+		}
+
+		if depth > 0 {
+			v.report(
+				&UnterminatedStringInterpolationError{
+					Pos: interpolationPosition,
+				},
+			)
+
+			// treat the rest of the contents as a literal part
+
+			partStart = len(s)
+			break
+		}
+
+		expressionLength := utf8.RuneCountInString(s[expressionStart:expressionEnd])
+
+		expression, errs := parseInterpolatedExpression(
+			input,
+			interpolationPosition.Shifted(2),
+			expressionLength,
+		)
+		v.report(errs...)
+
+		if expression != nil {
+			values = append(values, value.String())
+			expressions = append(expressions, expression)
+			value.Reset()
+		}
+
+		i = expressionEnd + 1
+		characterOffset += 2 + expressionLength + 1
+		partStart = i
 	}
+
+	value.WriteString(parseStringLiteral(s[partStart:]))
+	values = append(values, value.String())
+
+	return values, expressions
 }
 
 func parseStringLiteral(s string) string {
@@ -1903,6 +2140,63 @@ func (v *ProgramVisitor) VisitPathLiteral(ctx *PathLiteralContext) interface{} {
 	}
 }
 
+func (v *ProgramVisitor) VisitSwitchStatement(ctx *SwitchStatementContext) interface{} {
+	expression := ctx.Expression().Accept(v).(ast.Expression)
+
+	var cases []*ast.SwitchCase
+	for _, switchCase := range ctx.AllSwitchCase() {
+		cases = append(
+			cases,
+			switchCase.Accept(v).(*ast.SwitchCase),
+		)
+	}
+
+	startPosition, endPosition := PositionRangeFromContext(ctx)
+
+	return &ast.SwitchStatement{
+		Expression: expression,
+		Cases:      cases,
+		Range: ast.Range{
+			StartPos: startPosition,
+			EndPos:   endPosition,
+		},
+	}
+}
+
+func (v *ProgramVisitor) VisitSwitchCase(ctx *SwitchCaseContext) interface{} {
+	var expressions []ast.Expression
+	if ctx.Default() == nil {
+		expressions = []ast.Expression{}
+		for _, expression := range ctx.AllExpression() {
+			expressions = append(
+				expressions,
+				expression.Accept(v).(ast.Expression),
+			)
+		}
+	}
+
+	statements := ctx.Statements().Accept(v).([]ast.Statement)
+
+	startPosition, endPosition := PositionRangeFromContext(ctx)
+
+	// NOTE: the stop token might be the last token of the last statement,
+	// so use the statement's end position, which includes the whole token
+
+	statementCount := len(statements)
+	if statementCount > 0 {
+		endPosition = statements[statementCount-1].EndPosition()
+	}
+
+	return &ast.SwitchCase{
+		Expressions: expressions,
+		Statements:  statements,
+		Range: ast.Range{
+			StartPos: startPosition,
+			EndPos:   endPosition,
+		},
+	}
+}
+
 func (v *ProgramVisitor) VisitArrayLiteral(ctx *ArrayLiteralContext) interface{} {
 	var expressions []ast.Expression
 	for _, expression := range ctx.AllExpression() {
@@ -2086,6 +2380,19 @@ func (v *ProgramVisitor) VisitAdditiveOp(ctx *AdditiveOpContext) interface{} {
 	}
 }
 
+func (v *ProgramVisitor) VisitBitwiseShiftOp(ctx *BitwiseShiftOpContext) interface{} {
+	switch {
+	case ctx.Less(0) != nil:
+		return ast.OperationBitwiseLeftShift
+
+	case ctx.Greater(0) != nil:
+		return ast.OperationBitwiseRightShift
+
+	default:
+		panic(errors.NewUnreachableError())
+	}
+}
+
 func (v *ProgramVisitor) VisitMultiplicativeOp(ctx *MultiplicativeOpContext) interface{} {
 	switch {
 	case ctx.Mul() != nil:
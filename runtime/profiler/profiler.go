@@ -0,0 +1,322 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package profiler implements a profiler for Cadence programs.
+//
+// The profiler attributes the computation and the wall time of the executed statements
+// to the functions and source lines of the programs, including imported programs,
+// and produces profiles in the pprof format.
+//
+package profiler
+
+import (
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/pprof/profile"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+// position is a source line in a function of a program.
+//
+type position struct {
+	location ast.LocationID
+	function string
+	line     int
+}
+
+// measurement is the computation and wall time attributed to a position.
+//
+type measurement struct {
+	computation uint64
+	time        time.Duration
+}
+
+// Profiler records the computation and wall time of programs executed by interpreters.
+//
+// The wall time between the start of a statement and the start of the next statement,
+// or the end of the execution, is attributed to the statement.
+//
+// A profiler should only be used for one execution at a time,
+// as the statements of concurrent executions would be interleaved.
+//
+type Profiler struct {
+	mutex        sync.Mutex
+	now          func() time.Time
+	measurements map[position]*measurement
+	functions    map[ast.LocationID]*ast.FunctionIndex
+	// current is the position of the currently executing statement, if any
+	current        *position
+	currentStarted time.Time
+	started        time.Time
+}
+
+// Option is a function that configures a profiler.
+//
+type Option func(*Profiler)
+
+// WithClock returns a profiler option which sets the given function
+// as the source of the current time.
+//
+func WithClock(now func() time.Time) Option {
+	return func(profiler *Profiler) {
+		profiler.now = now
+	}
+}
+
+func NewProfiler(options ...Option) *Profiler {
+	profiler := &Profiler{
+		now:          time.Now,
+		measurements: map[position]*measurement{},
+		functions:    map[ast.LocationID]*ast.FunctionIndex{},
+	}
+
+	for _, option := range options {
+		option(profiler)
+	}
+
+	profiler.started = profiler.now()
+
+	return profiler
+}
+
+// InterpreterOptions returns the interpreter options which report
+// the statements, loop iterations, and function invocations to the profiler.
+// Each statement, loop iteration, and function invocation uses one unit of computation.
+//
+// NOTE: the options replace any other statement, loop iteration, and function invocation handler.
+//
+func (p *Profiler) InterpreterOptions() []interpreter.Option {
+	return []interpreter.Option{
+		interpreter.WithOnStatementHandler(
+			func(statement *interpreter.Statement) {
+				p.ReportStatement(statement.Interpreter, statement.Line)
+				p.ReportComputation(statement.Interpreter, statement.Line, 1)
+			},
+		),
+		interpreter.WithOnLoopIterationHandler(
+			func(inter *interpreter.Interpreter, line int) {
+				p.ReportComputation(inter, line, 1)
+			},
+		),
+		interpreter.WithOnFunctionInvocationHandler(
+			func(inter *interpreter.Interpreter, line int) {
+				p.ReportComputation(inter, line, 1)
+			},
+		),
+	}
+}
+
+// ReportStatement reports that the statement at the given line
+// of the program of the given interpreter is about to be executed.
+//
+// The wall time since the start of the previous statement is attributed to the previous statement.
+//
+func (p *Profiler) ReportStatement(inter *interpreter.Interpreter, line int) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	now := p.now()
+	p.stopCurrent(now)
+
+	position := p.position(inter, line)
+	p.current = &position
+	p.currentStarted = now
+}
+
+// ReportComputation attributes the given computation to the given line
+// of the program of the given interpreter.
+//
+func (p *Profiler) ReportComputation(inter *interpreter.Interpreter, line int, computation uint64) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.measurement(p.position(inter, line)).computation += computation
+}
+
+// ReportCurrentComputation attributes the given computation to the currently executing statement.
+// If no statement is executing, the computation is ignored.
+//
+func (p *Profiler) ReportCurrentComputation(computation uint64) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.current == nil {
+		return
+	}
+
+	p.measurement(*p.current).computation += computation
+}
+
+// Stop reports the end of an execution.
+//
+// The wall time since the start of the last statement is attributed to it.
+//
+func (p *Profiler) Stop() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.stopCurrent(p.now())
+}
+
+func (p *Profiler) stopCurrent(now time.Time) {
+	if p.current == nil {
+		return
+	}
+
+	p.measurement(*p.current).time += now.Sub(p.currentStarted)
+	p.current = nil
+}
+
+func (p *Profiler) position(inter *interpreter.Interpreter, line int) position {
+	location := inter.Checker.Location
+	locationID := location.ID()
+
+	functions, ok := p.functions[locationID]
+	if !ok {
+		functions = ast.NewFunctionIndex(inter.Checker.Program)
+		p.functions[locationID] = functions
+	}
+
+	// Statements outside of functions, e.g. the initializers of global variables,
+	// are attributed to the top-level
+
+	function := functions.Find(line)
+	if function == "" {
+		function = topLevelFunctionName
+	}
+
+	return position{
+		location: locationID,
+		function: function,
+		line:     line,
+	}
+}
+
+const topLevelFunctionName = "<top-level>"
+
+func (p *Profiler) measurement(position position) *measurement {
+	result, ok := p.measurements[position]
+	if !ok {
+		result = &measurement{}
+		p.measurements[position] = result
+	}
+	return result
+}
+
+// Profile returns the recorded measurements as a pprof profile.
+//
+// Each source line is a sample with two values:
+// the computation, and the wall time in nanoseconds.
+// The filenames of the functions are the IDs of the locations of the programs.
+//
+func (p *Profiler) Profile() *profile.Profile {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	result := &profile.Profile{
+		SampleType: []*profile.ValueType{
+			{Type: "computation", Unit: "count"},
+			{Type: "time", Unit: "nanoseconds"},
+		},
+		DefaultSampleType: "computation",
+		TimeNanos:         p.started.UnixNano(),
+		DurationNanos:     int64(p.now().Sub(p.started)),
+	}
+
+	// Sort the positions, so the profile is deterministic
+
+	positions := make([]position, 0, len(p.measurements))
+	for position := range p.measurements {
+		positions = append(positions, position)
+	}
+
+	sort.Slice(positions, func(i, j int) bool {
+		a := positions[i]
+		b := positions[j]
+		if a.location != b.location {
+			return a.location < b.location
+		}
+		if a.function != b.function {
+			return a.function < b.function
+		}
+		return a.line < b.line
+	})
+
+	type functionKey struct {
+		location ast.LocationID
+		name     string
+	}
+
+	functions := map[functionKey]*profile.Function{}
+
+	for _, position := range positions {
+		key := functionKey{
+			location: position.location,
+			name:     position.function,
+		}
+
+		function, ok := functions[key]
+		if !ok {
+			function = &profile.Function{
+				ID:         uint64(len(result.Function) + 1),
+				Name:       position.function,
+				SystemName: position.function,
+				Filename:   string(position.location),
+			}
+			functions[key] = function
+			result.Function = append(result.Function, function)
+		}
+
+		location := &profile.Location{
+			ID: uint64(len(result.Location) + 1),
+			Line: []profile.Line{
+				{
+					Function: function,
+					Line:     int64(position.line),
+				},
+			},
+		}
+		result.Location = append(result.Location, location)
+
+		measurement := p.measurements[position]
+
+		result.Sample = append(
+			result.Sample,
+			&profile.Sample{
+				Location: []*profile.Location{location},
+				Value: []int64{
+					int64(measurement.computation),
+					int64(measurement.time),
+				},
+			},
+		)
+	}
+
+	return result
+}
+
+// WriteProfile writes the recorded measurements as a gzip-compressed pprof profile.
+//
+func (p *Profiler) WriteProfile(w io.Writer) error {
+	return p.Profile().Write(w)
+}
@@ -0,0 +1,175 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package profiler
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/pprof/profile"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/tests/utils"
+)
+
+// newTestClock returns a clock which advances by one second each time it is read.
+//
+func newTestClock() func() time.Time {
+	now := time.Unix(0, 0)
+	return func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+}
+
+func profileProgram(t *testing.T, code string, profiler *Profiler) {
+
+	checker, err := utils.ParseAndCheckWithOptions(t,
+		code,
+		utils.ParseAndCheckOptions{
+			ImportResolver: func(location ast.Location) (*ast.Program, error) {
+				program, err := utils.ParseAndCheck(t,
+					`
+                      pub fun double(_ x: Int): Int {
+                          return x * 2
+                      }
+                    `,
+				)
+				if err != nil {
+					return nil, err
+				}
+				return program.Program, nil
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	inter, err := interpreter.NewInterpreter(checker, profiler.InterpreterOptions()...)
+	require.NoError(t, err)
+
+	err = inter.Interpret()
+	require.NoError(t, err)
+
+	_, err = inter.Invoke("main")
+	require.NoError(t, err)
+
+	profiler.Stop()
+}
+
+// samples returns the values of the samples of the given profile,
+// keyed by the filename, function name, and line.
+//
+func samples(p *profile.Profile) map[string][]int64 {
+	result := map[string][]int64{}
+	for _, sample := range p.Sample {
+		line := sample.Location[0].Line[0]
+		key := fmt.Sprintf("%s:%s:%d", line.Function.Filename, line.Function.Name, line.Line)
+		result[key] = sample.Value
+	}
+	return result
+}
+
+func TestProfiler(t *testing.T) {
+
+	t.Parallel()
+
+	const code = `
+      import "imported"
+
+      pub struct S {
+          pub fun triple(_ x: Int): Int {
+              return x * 3
+          }
+      }
+
+      pub fun main() {
+          var i = 0
+          while i < 2 {
+              double(i)
+              i = i + 1
+          }
+          S().triple(1)
+      }
+    `
+
+	profiler := NewProfiler(WithClock(newTestClock()))
+
+	profileProgram(t, code, profiler)
+
+	p := profiler.Profile()
+	require.NoError(t, p.CheckValid())
+
+	assert.Equal(t,
+		map[string][]int64{
+			// statement
+			"imported:double:3": {2, 2 * int64(time.Second)},
+			// statement
+			"test:main:11": {1, int64(time.Second)},
+			// statement and loop iterations
+			"test:main:12": {1 + 2, int64(time.Second)},
+			// statement and invocation
+			"test:main:13": {2 * 2, 2 * int64(time.Second)},
+			// statement
+			"test:main:14": {2, 2 * int64(time.Second)},
+			// statement and invocations of the constructor and the function
+			"test:main:16": {3, int64(time.Second)},
+			// statement
+			"test:S.triple:6": {1, int64(time.Second)},
+		},
+		samples(p),
+	)
+}
+
+func TestProfilerWriteProfile(t *testing.T) {
+
+	t.Parallel()
+
+	const code = `
+      pub fun main() {
+          let x = 1
+      }
+    `
+
+	profiler := NewProfiler(WithClock(newTestClock()))
+
+	profileProgram(t, code, profiler)
+
+	var buffer bytes.Buffer
+	err := profiler.WriteProfile(&buffer)
+	require.NoError(t, err)
+
+	p, err := profile.Parse(&buffer)
+	require.NoError(t, err)
+
+	require.Len(t, p.SampleType, 2)
+	assert.Equal(t, "computation", p.SampleType[0].Type)
+	assert.Equal(t, "time", p.SampleType[1].Type)
+
+	assert.Equal(t,
+		map[string][]int64{
+			"test:main:3": {1, int64(time.Second)},
+		},
+		samples(p),
+	)
+}
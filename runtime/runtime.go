@@ -23,7 +23,7 @@ import (
 	"encoding/binary"
 	"encoding/gob"
 	"fmt"
-	"math"
+	"math/big"
 	"time"
 
 	"golang.org/x/crypto/sha3"
@@ -34,6 +34,7 @@ import (
 	runtimeErrors "github.com/onflow/cadence/runtime/errors"
 	"github.com/onflow/cadence/runtime/interpreter"
 	"github.com/onflow/cadence/runtime/parser"
+	"github.com/onflow/cadence/runtime/profiler"
 	"github.com/onflow/cadence/runtime/sema"
 	"github.com/onflow/cadence/runtime/stdlib"
 	"github.com/onflow/cadence/runtime/trampoline"
@@ -43,9 +44,11 @@ import (
 type Runtime interface {
 	// ExecuteScript executes the given script.
 	//
+	// The arguments are decoded against the parameter types of the script's `main` function.
+	//
 	// This function returns an error if the program has errors (e.g syntax errors, type errors),
 	// or if the execution fails.
-	ExecuteScript(script []byte, runtimeInterface Interface, location Location) (cadence.Value, error)
+	ExecuteScript(script []byte, arguments [][]byte, runtimeInterface Interface, location Location) (cadence.Value, error)
 
 	// ExecuteTransaction executes the given transaction.
 	//
@@ -82,7 +85,7 @@ func validTopLevelDeclarations(location ast.Location) []common.DeclarationKind {
 	switch location.(type) {
 	case TransactionLocation:
 		return validTopLevelDeclarationsInTransaction
-	case AddressLocation:
+	case AddressLocation, AddressContractLocation:
 		return validTopLevelDeclarationsInAccountCode
 	}
 
@@ -91,21 +94,98 @@ func validTopLevelDeclarations(location ast.Location) []common.DeclarationKind {
 
 const contractKey = "contract"
 
+// contractStorageKey returns the storage key of the contract value
+// of the contract deployed under the given name.
+//
+// \x1F = Information Separator One
+//
+func contractStorageKey(name string) string {
+	return fmt.Sprintf("%s\x1F%s", contractKey, name)
+}
+
 // interpreterRuntime is a interpreter-based version of the Flow runtime.
-type interpreterRuntime struct{}
+type interpreterRuntime struct {
+	checkedPrograms *checkedProgramCache
+	profiler        *profiler.Profiler
+	debugger        *interpreter.Debugger
+}
+
+// Option is a function that configures a runtime.
+//
+type Option func(*interpreterRuntime)
+
+// WithProfiler returns a runtime option which reports the computation
+// and the statements of all executions to the given profiler.
+//
+func WithProfiler(profiler *profiler.Profiler) Option {
+	return func(runtime *interpreterRuntime) {
+		runtime.profiler = profiler
+	}
+}
+
+// WithDebugger returns a runtime option which lets the given debugger
+// pause and inspect all executions.
+//
+func WithDebugger(debugger *interpreter.Debugger) Option {
+	return func(runtime *interpreterRuntime) {
+		runtime.debugger = debugger
+	}
+}
 
 // NewInterpreterRuntime returns a interpreter-based version of the Flow runtime.
-func NewInterpreterRuntime() Runtime {
-	return &interpreterRuntime{}
+func NewInterpreterRuntime(options ...Option) Runtime {
+	runtime := &interpreterRuntime{
+		checkedPrograms: newCheckedProgramCache(),
+	}
+
+	for _, option := range options {
+		option(runtime)
+	}
+
+	return runtime
 }
 
 func (r *interpreterRuntime) ExecuteScript(
 	script []byte,
+	arguments [][]byte,
+	runtimeInterface Interface,
+	location Location,
+) (cadence.Value, error) {
+
+	computationMeter := newComputationMeter(runtimeInterface, r.profiler)
+	memoryMeter := newMemoryMeter(runtimeInterface)
+
+	value, err := r.executeScript(
+		script,
+		arguments,
+		runtimeInterface,
+		location,
+		computationMeter,
+		memoryMeter,
+	)
+
+	// Report the computation and memory used, even if the execution failed
+
+	runtimeInterface.SetComputationUsed(computationMeter.used)
+	runtimeInterface.SetMemoryUsed(memoryMeter.used)
+
+	if r.profiler != nil {
+		r.profiler.Stop()
+	}
+
+	return value, err
+}
+
+func (r *interpreterRuntime) executeScript(
+	script []byte,
+	arguments [][]byte,
 	runtimeInterface Interface,
 	location Location,
+	computationMeter *computationMeter,
+	memoryMeter *memoryMeter,
 ) (cadence.Value, error) {
 
-	runtimeStorage := newInterpreterRuntimeStorage(runtimeInterface)
+	runtimeStorage := newInterpreterRuntimeStorage(runtimeInterface, computationMeter, memoryMeter)
 
 	functions := r.standardLibraryFunctions(runtimeInterface, runtimeStorage)
 
@@ -114,12 +194,36 @@ func (r *interpreterRuntime) ExecuteScript(
 		return nil, newError(err)
 	}
 
-	_, ok := checker.GlobalValues["main"]
+	mainVariable, ok := checker.GlobalValues["main"]
 	if !ok {
 		// TODO: error because no main?
 		return nil, nil
 	}
 
+	// the entry point must be a function declaration,
+	// not e.g. a constant or a variable containing a function
+
+	mainFunctionType, ok := mainVariable.Type.(*sema.FunctionType)
+	if !ok || mainVariable.DeclarationKind != common.DeclarationKindFunction {
+		return nil, newError(InvalidEntryPointTypeError{
+			DeclarationKind: mainVariable.DeclarationKind,
+			Type:            mainVariable.Type,
+		})
+	}
+
+	mainParameters := mainFunctionType.Parameters
+
+	// check parameter count
+
+	argumentCount := len(arguments)
+	mainParameterCount := len(mainParameters)
+	if argumentCount != mainParameterCount {
+		return nil, newError(InvalidScriptParameterCountError{
+			Expected: mainParameterCount,
+			Actual:   argumentCount,
+		})
+	}
+
 	value, err := r.interpret(
 		runtimeInterface,
 		runtimeStorage,
@@ -127,7 +231,22 @@ func (r *interpreterRuntime) ExecuteScript(
 		functions,
 		nil,
 		func(inter *interpreter.Interpreter) (interpreter.Value, error) {
-			return inter.Invoke("main")
+			argumentValues := make([]interpreter.Value, argumentCount)
+
+			// decode arguments against parameter types
+			for i, parameter := range mainParameters {
+				value, err := r.importArgument(inter, runtimeInterface, arguments[i], parameter)
+				if err != nil {
+					return nil, &InvalidScriptArgumentError{
+						Index: i,
+						Err:   err,
+					}
+				}
+
+				argumentValues[i] = value
+			}
+
+			return inter.Invoke("main", argumentValues...)
 		},
 	)
 	if err != nil {
@@ -139,7 +258,10 @@ func (r *interpreterRuntime) ExecuteScript(
 	// Even though this function is `ExecuteScript`, that doesn't imply the changes
 	// to storage will be actually persisted
 
-	runtimeStorage.writeCached()
+	err = runtimeStorage.writeCached()
+	if err != nil {
+		return nil, newError(err)
+	}
 
 	return exportValue(value), nil
 }
@@ -186,6 +308,8 @@ func (r *interpreterRuntime) newAuthAccountValue(
 		r.newSetCodeFunction(addressValue, runtimeInterface, runtimeStorage),
 		r.newAddPublicKeyFunction(addressValue, runtimeInterface),
 		r.newRemovePublicKeyFunction(addressValue, runtimeInterface),
+		r.newAuthAccountContractsValue(addressValue, runtimeInterface, runtimeStorage),
+		r.newAuthAccountKeysValue(addressValue, runtimeInterface),
 	)
 }
 
@@ -195,7 +319,40 @@ func (r *interpreterRuntime) ExecuteTransaction(
 	runtimeInterface Interface,
 	location Location,
 ) error {
-	runtimeStorage := newInterpreterRuntimeStorage(runtimeInterface)
+
+	computationMeter := newComputationMeter(runtimeInterface, r.profiler)
+	memoryMeter := newMemoryMeter(runtimeInterface)
+
+	err := r.executeTransaction(
+		script,
+		arguments,
+		runtimeInterface,
+		location,
+		computationMeter,
+		memoryMeter,
+	)
+
+	// Report the computation and memory used, even if the execution failed
+
+	runtimeInterface.SetComputationUsed(computationMeter.used)
+	runtimeInterface.SetMemoryUsed(memoryMeter.used)
+
+	if r.profiler != nil {
+		r.profiler.Stop()
+	}
+
+	return err
+}
+
+func (r *interpreterRuntime) executeTransaction(
+	script []byte,
+	arguments [][]byte,
+	runtimeInterface Interface,
+	location Location,
+	computationMeter *computationMeter,
+	memoryMeter *memoryMeter,
+) error {
+	runtimeStorage := newInterpreterRuntimeStorage(runtimeInterface, computationMeter, memoryMeter)
 
 	functions := r.standardLibraryFunctions(runtimeInterface, runtimeStorage)
 
@@ -257,13 +414,7 @@ func (r *interpreterRuntime) ExecuteTransaction(
 
 			// decode arguments against parameter types
 			for i, parameter := range transactionType.Parameters {
-				parameterType := parameter.TypeAnnotation.Type
-				argument := arguments[i]
-
-				value, err := runtimeInterface.DecodeArgument(
-					argument,
-					exportType(parameterType),
-				)
+				value, err := r.importArgument(inter, runtimeInterface, arguments[i], parameter)
 				if err != nil {
 					return nil, &InvalidTransactionArgumentError{
 						Index: i,
@@ -271,20 +422,7 @@ func (r *interpreterRuntime) ExecuteTransaction(
 					}
 				}
 
-				arg := importValue(value)
-
-				// check that decoded value is a subtype of static parameter type
-				if !interpreter.IsSubType(arg.DynamicType(inter), parameterType) {
-					return nil, &InvalidTransactionArgumentError{
-						Index: i,
-						Err: &InvalidTypeAssignmentError{
-							Value: arg,
-							Type:  parameterType,
-						},
-					}
-				}
-
-				argumentValues[i] = arg
+				argumentValues[i] = value
 			}
 
 			allArguments := append(argumentValues, authorizerValues...)
@@ -298,13 +436,157 @@ func (r *interpreterRuntime) ExecuteTransaction(
 	}
 
 	// Write back all stored values, which were actually just cached, back into storage
-	runtimeStorage.writeCached()
+	err = runtimeStorage.writeCached()
+	if err != nil {
+		return newError(err)
+	}
+
+	return nil
+}
+
+// importArgument decodes the given encoded argument against the type of the given parameter,
+// and checks that the decoded value is a subtype of the parameter type.
+//
+func (r *interpreterRuntime) importArgument(
+	inter *interpreter.Interpreter,
+	runtimeInterface Interface,
+	argument []byte,
+	parameter *sema.Parameter,
+) (interpreter.Value, error) {
+
+	parameterType := parameter.TypeAnnotation.Type
+
+	value, err := runtimeInterface.DecodeArgument(
+		argument,
+		exportType(parameterType),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	arg, err := importValue(value, inter)
+	if err != nil {
+		return nil, err
+	}
+
+	// check that decoded value is a subtype of static parameter type
+	if !interpreter.IsSubType(arg.DynamicType(inter), parameterType) {
+		return nil, &InvalidTypeAssignmentError{
+			Value: arg,
+			Type:  parameterType,
+		}
+	}
+
+	err = validateEnumValues(inter, arg)
+	if err != nil {
+		return nil, err
+	}
+
+	return arg, nil
+}
+
+// validateEnumValues checks that all enum values in the given imported value
+// are declared cases of their enum type.
+//
+// The dynamic type of an imported enum value only depends on its type ID,
+// so the raw value must be checked separately.
+//
+func validateEnumValues(inter *interpreter.Interpreter, value interpreter.Value) error {
+	switch value := value.(type) {
+	case *interpreter.SomeValue:
+		return validateEnumValues(inter, value.Value)
+
+	case *interpreter.ArrayValue:
+		for _, element := range value.Values {
+			err := validateEnumValues(inter, element)
+			if err != nil {
+				return err
+			}
+		}
+
+	case *interpreter.DictionaryValue:
+		for _, key := range value.Keys.Values {
+			err := validateEnumValues(inter, key)
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, entry := range value.Entries {
+			err := validateEnumValues(inter, entry)
+			if err != nil {
+				return err
+			}
+		}
+
+	case *interpreter.CompositeValue:
+		if value.Kind == common.CompositeKindEnum {
+			return validateEnumValue(inter, value)
+		}
+
+		for _, field := range value.Fields {
+			err := validateEnumValues(inter, field)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateEnumValue(inter *interpreter.Interpreter, value *interpreter.CompositeValue) error {
+	dynamicType := value.DynamicType(inter).(interpreter.CompositeDynamicType)
+	enumType, ok := dynamicType.StaticType.(*sema.CompositeType)
+	if !ok || enumType.Kind != common.CompositeKindEnum {
+		return &InvalidTypeAssignmentError{
+			Value: value,
+			Type:  dynamicType.StaticType,
+		}
+	}
+
+	rawValue := value.Fields[sema.EnumRawValueFieldName]
+
+	if len(value.Fields) != 1 ||
+		rawValue == nil ||
+		!interpreter.IsSubType(rawValue.DynamicType(inter), enumType.EnumRawType) ||
+		!isEnumCaseIndex(rawValue, len(enumType.EnumCases)) {
+
+		return &InvalidEnumRawValueError{
+			Type:     enumType,
+			RawValue: rawValue,
+		}
+	}
 
 	return nil
 }
 
+// isEnumCaseIndex returns true if the given raw value is the index of a case,
+// i.e. the raw value is non-negative and less than the number of cases.
+//
+func isEnumCaseIndex(rawValue interpreter.Value, caseCount int) bool {
+	switch rawValue := rawValue.(type) {
+	case interpreter.BigNumberValue:
+		index := rawValue.ToBigInt()
+		return index.Sign() >= 0 &&
+			index.Cmp(big.NewInt(int64(caseCount))) < 0
+
+	case interpreter.NumberValue:
+		// NOTE: large unsigned values wrap around to negative values
+		index := rawValue.ToInt()
+		return index >= 0 && index < caseCount
+
+	default:
+		return false
+	}
+}
+
 func (r *interpreterRuntime) ParseAndCheckProgram(script []byte, runtimeInterface Interface, location Location) error {
-	runtimeStorage := newInterpreterRuntimeStorage(runtimeInterface)
+	runtimeStorage := newInterpreterRuntimeStorage(
+		runtimeInterface,
+		newComputationMeter(runtimeInterface, r.profiler),
+		newMemoryMeter(runtimeInterface),
+	)
 	functions := r.standardLibraryFunctions(runtimeInterface, runtimeStorage)
 
 	_, err := r.parseAndCheckProgram(script, runtimeInterface, location, functions, nil)
@@ -329,19 +611,41 @@ func (r *interpreterRuntime) parseAndCheckProgram(
 	}
 
 	if program == nil {
-		program, err = r.parse(code)
+		program, err = r.parse(code, runtimeInterface)
 		if err != nil {
 			return nil, err
 		}
 	}
 
+	return r.checkProgram(program, runtimeInterface, location, functions, options)
+}
+
+func (r *interpreterRuntime) checkProgram(
+	program *ast.Program,
+	runtimeInterface Interface,
+	location Location,
+	functions stdlib.StandardLibraryFunctions,
+	options []sema.Option,
+) (*sema.Checker, error) {
+
 	importResolver := r.importResolver(runtimeInterface)
-	err = program.ResolveImports(importResolver)
+	err := program.ResolveImports(importResolver)
+	if err != nil {
+		return nil, err
+	}
+
+	// Use the cached checkers of the imported programs, if possible,
+	// so that only the imported programs which were not checked before are checked
+
+	allCheckers, codeHashes, err := r.cachedImportCheckers(program, location, runtimeInterface)
 	if err != nil {
 		return nil, err
 	}
 
 	valueDeclarations := functions.ToValueDeclarations()
+	for name, declaration := range r.standardLibraryValues(runtimeInterface).ToValueDeclarations() {
+		valueDeclarations[name] = declaration
+	}
 
 	checker, err := sema.NewChecker(
 		program,
@@ -351,6 +655,7 @@ func (r *interpreterRuntime) parseAndCheckProgram(
 				sema.WithPredeclaredValues(valueDeclarations),
 				sema.WithPredeclaredTypes(typeDeclarations),
 				sema.WithValidTopLevelDeclarationsHandler(validTopLevelDeclarations),
+				sema.WithAllCheckers(allCheckers),
 			},
 			options...,
 		)...,
@@ -363,6 +668,8 @@ func (r *interpreterRuntime) parseAndCheckProgram(
 		return nil, err
 	}
 
+	r.cacheImportCheckers(allCheckers, location, codeHashes)
+
 	// After the program has passed semantic analysis, cache the program AST.
 	err = runtimeInterface.CacheProgram(location, program)
 	if err != nil {
@@ -372,6 +679,123 @@ func (r *interpreterRuntime) parseAndCheckProgram(
 	return checker, nil
 }
 
+// cachedImportCheckers returns the checkers of the programs directly or indirectly imported
+// by the given program which can be reused from the checked program cache,
+// and the code hashes of all imported programs.
+//
+// The imports of the program must already be resolved.
+//
+func (r *interpreterRuntime) cachedImportCheckers(
+	program *ast.Program,
+	location Location,
+	runtimeInterface Interface,
+) (
+	checkers map[ast.LocationID]*sema.Checker,
+	codeHashes map[ast.LocationID]codeHash,
+	err error,
+) {
+	checkers = map[ast.LocationID]*sema.Checker{}
+	codeHashes = map[ast.LocationID]codeHash{}
+
+	// Visit the imported programs depth-first,
+	// as a program can only be reused if all of its imports are reused
+
+	var visit func(program *ast.Program) error
+	visit = func(program *ast.Program) error {
+		for _, importLocation := range program.ImportLocations() {
+			importLocationID := importLocation.ID()
+
+			if _, ok := codeHashes[importLocationID]; ok {
+				continue
+			}
+
+			importedProgram := program.ImportedPrograms()[importLocationID]
+			if importedProgram == nil {
+				continue
+			}
+
+			err := visit(importedProgram)
+			if err != nil {
+				return err
+			}
+
+			code, err := r.getCode(importLocation, runtimeInterface)
+			if err != nil {
+				return err
+			}
+
+			hash := newCodeHash(code)
+			codeHashes[importLocationID] = hash
+
+			if importLocationID == location.ID() {
+				continue
+			}
+
+			imports := importedCheckers(importedProgram, checkers)
+			checker := r.checkedPrograms.get(importLocationID, hash, imports)
+			if checker != nil {
+				checkers[importLocationID] = checker
+			}
+		}
+
+		return nil
+	}
+
+	err = visit(program)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return checkers, codeHashes, nil
+}
+
+// cacheImportCheckers caches the checkers of the imported programs which were newly checked.
+//
+func (r *interpreterRuntime) cacheImportCheckers(
+	allCheckers map[ast.LocationID]*sema.Checker,
+	location Location,
+	codeHashes map[ast.LocationID]codeHash,
+) {
+	for locationID, checker := range allCheckers {
+		if locationID == location.ID() {
+			continue
+		}
+
+		hash, ok := codeHashes[locationID]
+		if !ok {
+			continue
+		}
+
+		imports := importedCheckers(checker.Program, allCheckers)
+		if r.checkedPrograms.get(locationID, hash, imports) == checker {
+			continue
+		}
+
+		r.checkedPrograms.set(
+			locationID,
+			&checkedProgram{
+				codeHash: hash,
+				checker:  checker,
+				imports:  imports,
+			},
+		)
+	}
+}
+
+// importedCheckers returns the checkers of the programs directly imported by the given program.
+//
+func importedCheckers(
+	program *ast.Program,
+	checkers map[ast.LocationID]*sema.Checker,
+) map[ast.LocationID]*sema.Checker {
+	imports := map[ast.LocationID]*sema.Checker{}
+	for _, importLocation := range program.ImportLocations() {
+		importLocationID := importLocation.ID()
+		imports[importLocationID] = checkers[importLocationID]
+	}
+	return imports
+}
+
 func (r *interpreterRuntime) newInterpreter(
 	checker *sema.Checker,
 	functions stdlib.StandardLibraryFunctions,
@@ -380,8 +804,13 @@ func (r *interpreterRuntime) newInterpreter(
 	options []interpreter.Option,
 ) (*interpreter.Interpreter, error) {
 
+	predefinedValues := functions.ToValues()
+	for name, value := range r.standardLibraryValues(runtimeInterface).ToValues() {
+		predefinedValues[name] = value
+	}
+
 	defaultOptions := []interpreter.Option{
-		interpreter.WithPredefinedValues(functions.ToValues()),
+		interpreter.WithPredefinedValues(predefinedValues),
 		interpreter.WithOnEventEmittedHandler(
 			func(
 				inter *interpreter.Interpreter,
@@ -422,9 +851,19 @@ func (r *interpreterRuntime) newInterpreter(
 	)
 
 	defaultOptions = append(defaultOptions,
-		r.meteringInterpreterOptions(runtimeInterface)...,
+		runtimeStorage.computationMeter.interpreterOptions()...,
 	)
 
+	defaultOptions = append(defaultOptions,
+		runtimeStorage.memoryMeter.interpreterOptions()...,
+	)
+
+	if r.debugger != nil {
+		defaultOptions = append(defaultOptions,
+			interpreter.WithDebugger(r.debugger),
+		)
+	}
+
 	return interpreter.NewInterpreter(
 		checker,
 		append(defaultOptions, options...)...,
@@ -467,6 +906,8 @@ func (r *interpreterRuntime) injectedCompositeFieldsHandler(
 			switch location := location.(type) {
 			case AddressLocation:
 				address = location
+			case AddressContractLocation:
+				address = location.AddressLocation
 			default:
 				panic(runtimeErrors.NewUnreachableError())
 			}
@@ -499,47 +940,19 @@ func (r *interpreterRuntime) storageInterpreterOptions(runtimeStorage *interpret
 				runtimeStorage.writeValue(string(address[:]), key, value)
 			},
 		),
-	}
-}
-
-func (r *interpreterRuntime) meteringInterpreterOptions(runtimeInterface Interface) []interpreter.Option {
-	limit := runtimeInterface.GetComputationLimit()
-	if limit <= 0 {
-		return nil
-	}
-
-	if limit == math.MaxUint64 {
-		limit--
-	}
-
-	var used uint64
-
-	checkLimit := func() {
-		used++
-
-		if used <= limit {
-			return
-		}
-
-		panic(ComputationLimitExceededError{
-			Limit: limit,
-		})
-	}
-
-	return []interpreter.Option{
-		interpreter.WithOnStatementHandler(
-			func(_ *interpreter.Statement) {
-				checkLimit()
+		interpreter.WithStorageEnumerationHandler(
+			func(_ *interpreter.Interpreter, address common.Address) []string {
+				return runtimeStorage.valueKeys(string(address[:]))
 			},
 		),
-		interpreter.WithOnLoopIterationHandler(
-			func(_ *interpreter.Interpreter, _ int) {
-				checkLimit()
+		interpreter.WithStorageUsedHandler(
+			func(_ *interpreter.Interpreter, address common.Address) uint64 {
+				return runtimeStorage.storageUsed(string(address[:]))
 			},
 		),
-		interpreter.WithOnFunctionInvocationHandler(
-			func(_ *interpreter.Interpreter, _ int) {
-				checkLimit()
+		interpreter.WithStorageCapacityHandler(
+			func(_ *interpreter.Interpreter, address common.Address) uint64 {
+				return runtimeStorage.storageCapacity(string(address[:]))
 			},
 		),
 	}
@@ -561,6 +974,13 @@ func (r *interpreterRuntime) standardLibraryFunctions(
 	)
 }
 
+func (r *interpreterRuntime) standardLibraryValues(runtimeInterface Interface) stdlib.StandardLibraryValues {
+	return stdlib.FlowBuiltInValues(stdlib.CryptoImpls{
+		Hash:            r.newHashFunction(runtimeInterface),
+		VerifySignature: r.newVerifySignatureFunction(runtimeInterface),
+	})
+}
+
 func (r *interpreterRuntime) importResolver(runtimeInterface Interface) ImportResolver {
 	return func(location Location) (program *ast.Program, e error) {
 
@@ -572,12 +992,12 @@ func (r *interpreterRuntime) importResolver(runtimeInterface Interface) ImportRe
 			return program, nil
 		}
 
-		script, err := runtimeInterface.ResolveImport(location)
+		script, err := r.getCode(location, runtimeInterface)
 		if err != nil {
 			return nil, err
 		}
 
-		program, err = r.parse(script)
+		program, err = r.parse(script, runtimeInterface)
 		if err != nil {
 			return nil, err
 		}
@@ -591,21 +1011,128 @@ func (r *interpreterRuntime) importResolver(runtimeInterface Interface) ImportRe
 	}
 }
 
-func (r *interpreterRuntime) parse(script []byte) (program *ast.Program, err error) {
+// getCode returns the code of the program at the given location.
+//
+func (r *interpreterRuntime) getCode(location Location, runtimeInterface Interface) ([]byte, error) {
+	switch location := location.(type) {
+	case AddressContractLocation:
+		return runtimeInterface.GetAccountContractCode(location.ToAddress(), location.Name)
+	default:
+		return runtimeInterface.ResolveImport(location)
+	}
+}
+
+func (r *interpreterRuntime) parse(script []byte, runtimeInterface Interface) (program *ast.Program, err error) {
 	program, _, err = parser.ParseProgram(string(script))
-	return
+	if err != nil {
+		return nil, err
+	}
+
+	return r.resolveContractImports(program, runtimeInterface)
 }
 
-// emitEvent converts an event value to native Go types and emits it to the runtime interface.
-func (r *interpreterRuntime) emitEvent(
-	inter *interpreter.Interpreter,
+// resolveContractImports rewrites the imports of contracts
+// which are deployed to accounts under a name.
+//
+// An import declaration like `import A, B from 0x1` imports from the account code of 0x1.
+// If contracts are deployed to the account under the imported names,
+// the declaration is split, and each of these contracts is imported from its own location.
+//
+func (r *interpreterRuntime) resolveContractImports(
+	program *ast.Program,
 	runtimeInterface Interface,
-	event *interpreter.CompositeValue,
-	eventType *sema.CompositeType,
-) {
-	fields := make([]exportableValue, len(eventType.ConstructorParameters))
+) (*ast.Program, error) {
 
-	for i, parameter := range eventType.ConstructorParameters {
+	declarations := make([]ast.Declaration, 0, len(program.Declarations))
+	rewritten := false
+
+	for _, declaration := range program.Declarations {
+
+		importDeclaration, ok := declaration.(*ast.ImportDeclaration)
+		if !ok {
+			declarations = append(declarations, declaration)
+			continue
+		}
+
+		addressLocation, ok := importDeclaration.Location.(AddressLocation)
+		if !ok || len(importDeclaration.Identifiers) == 0 {
+			declarations = append(declarations, declaration)
+			continue
+		}
+
+		var contractDeclarations []ast.Declaration
+		var remainingIdentifiers []ast.Identifier
+
+		for _, identifier := range importDeclaration.Identifiers {
+
+			code, err := runtimeInterface.GetAccountContractCode(
+				addressLocation.ToAddress(),
+				identifier.Identifier,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			if len(code) == 0 {
+				remainingIdentifiers = append(remainingIdentifiers, identifier)
+				continue
+			}
+
+			contractDeclarations = append(
+				contractDeclarations,
+				&ast.ImportDeclaration{
+					Identifiers: []ast.Identifier{identifier},
+					Location: AddressContractLocation{
+						AddressLocation: addressLocation,
+						Name:            identifier.Identifier,
+					},
+					LocationPos: importDeclaration.LocationPos,
+					Range:       importDeclaration.Range,
+				},
+			)
+		}
+
+		if len(contractDeclarations) == 0 {
+			declarations = append(declarations, declaration)
+			continue
+		}
+
+		rewritten = true
+
+		declarations = append(declarations, contractDeclarations...)
+
+		if len(remainingIdentifiers) > 0 {
+			declarations = append(
+				declarations,
+				&ast.ImportDeclaration{
+					Identifiers: remainingIdentifiers,
+					Location:    addressLocation,
+					LocationPos: importDeclaration.LocationPos,
+					Range:       importDeclaration.Range,
+				},
+			)
+		}
+	}
+
+	if !rewritten {
+		return program, nil
+	}
+
+	return &ast.Program{
+		Declarations: declarations,
+	}, nil
+}
+
+// emitEvent converts an event value to native Go types and emits it to the runtime interface.
+func (r *interpreterRuntime) emitEvent(
+	inter *interpreter.Interpreter,
+	runtimeInterface Interface,
+	event *interpreter.CompositeValue,
+	eventType *sema.CompositeType,
+) {
+	fields := make([]exportableValue, len(eventType.ConstructorParameters))
+
+	for i, parameter := range eventType.ConstructorParameters {
 		fields[i] = newExportableValue(event.Fields[parameter.Identifier], inter)
 	}
 
@@ -811,6 +1338,497 @@ func (r *interpreterRuntime) newSetCodeFunction(
 	)
 }
 
+func (r *interpreterRuntime) newAuthAccountKeysValue(
+	addressValue interpreter.AddressValue,
+	runtimeInterface Interface,
+) interpreter.AuthAccountKeysValue {
+	return interpreter.NewAuthAccountKeysValue(
+		addressValue,
+		r.newAuthAccountKeysAddFunction(addressValue, runtimeInterface),
+		r.newAccountKeysGetFunction(addressValue, runtimeInterface),
+		r.newAuthAccountKeysRevokeFunction(addressValue, runtimeInterface),
+	)
+}
+
+func (r *interpreterRuntime) newPublicAccountKeysValue(
+	addressValue interpreter.AddressValue,
+	runtimeInterface Interface,
+) interpreter.PublicAccountKeysValue {
+	return interpreter.NewPublicAccountKeysValue(
+		addressValue,
+		r.newAccountKeysGetFunction(addressValue, runtimeInterface),
+	)
+}
+
+func newAccountKeyValue(accountKey *AccountKey) interpreter.AccountKeyValue {
+	return interpreter.NewAccountKeyValue(
+		interpreter.NewIntValueFromInt64(int64(accountKey.KeyIndex)),
+		interpreter.ByteSliceToByteArrayValue(accountKey.PublicKey),
+		stdlib.NewSignatureAlgorithmCaseValue(accountKey.SignatureAlgorithm),
+		stdlib.NewHashAlgorithmCaseValue(accountKey.HashAlgorithm),
+		interpreter.UFix64Value(accountKey.Weight),
+		interpreter.BoolValue(accountKey.IsRevoked),
+	)
+}
+
+// newAuthAccountKeysAddFunction returns the function `AuthAccount.keys.add`,
+// which adds a key with the given algorithms and weight to the account.
+//
+func (r *interpreterRuntime) newAuthAccountKeysAddFunction(
+	addressValue interpreter.AddressValue,
+	runtimeInterface Interface,
+) interpreter.HostFunctionValue {
+	return interpreter.NewHostFunctionValue(
+		func(invocation interpreter.Invocation) trampoline.Trampoline {
+			publicKey, err := interpreter.ByteArrayValueToByteSlice(invocation.Arguments[0])
+			if err != nil {
+				panic(&InvalidHostFunctionArgumentError{
+					FunctionName:  "keys.add",
+					ParameterName: "publicKey",
+					Err:           err,
+				})
+			}
+
+			signatureAlgorithm := stdlib.SignatureAlgorithmFromValue(invocation.Arguments[1])
+			hashAlgorithm := stdlib.HashAlgorithmFromValue(invocation.Arguments[2])
+			weight := uint64(invocation.Arguments[3].(interpreter.UFix64Value))
+
+			accountKey, err := runtimeInterface.AddStructuredAccountKey(
+				addressValue.ToAddress(),
+				publicKey,
+				signatureAlgorithm,
+				hashAlgorithm,
+				weight,
+			)
+			if err != nil {
+				panic(err)
+			}
+
+			accountKeyValue := newAccountKeyValue(accountKey)
+
+			r.emitAccountEvent(
+				stdlib.AccountStructuredKeyAddedEventType,
+				runtimeInterface,
+				[]exportableValue{
+					newExportableValue(addressValue, nil),
+					newExportableValue(accountKeyValue.KeyIndex, nil),
+					newExportableValue(accountKeyValue.PublicKey, nil),
+					newExportableValue(accountKeyValue.SignatureAlgorithm, nil),
+					newExportableValue(accountKeyValue.HashAlgorithm, nil),
+					newExportableValue(accountKeyValue.Weight, nil),
+				},
+			)
+
+			return trampoline.Done{Result: accountKeyValue}
+		},
+	)
+}
+
+// accountKeyIndex returns the given key index as an int.
+// If the index is negative or too large, i.e. no key can exist at the index, false is returned.
+//
+func accountKeyIndex(value interpreter.Value) (int, bool) {
+	index := value.(interpreter.IntValue).BigInt
+	if index.Sign() < 0 || !index.IsInt64() {
+		return 0, false
+	}
+
+	keyIndex := int(index.Int64())
+	if int64(keyIndex) != index.Int64() {
+		return 0, false
+	}
+
+	return keyIndex, true
+}
+
+// newAccountKeysGetFunction returns the function `AuthAccount.keys.get` / `PublicAccount.keys.get`,
+// which returns the key at the given index, if any.
+//
+// Invalid indices are rejected before the runtime interface is called.
+//
+func (r *interpreterRuntime) newAccountKeysGetFunction(
+	addressValue interpreter.AddressValue,
+	runtimeInterface Interface,
+) interpreter.HostFunctionValue {
+	return interpreter.NewHostFunctionValue(
+		func(invocation interpreter.Invocation) trampoline.Trampoline {
+			keyIndex, ok := accountKeyIndex(invocation.Arguments[0])
+			if !ok {
+				return trampoline.Done{Result: interpreter.NilValue{}}
+			}
+
+			accountKey, err := runtimeInterface.GetAccountKey(addressValue.ToAddress(), keyIndex)
+			if err != nil {
+				panic(err)
+			}
+
+			var result interpreter.Value
+			if accountKey == nil {
+				result = interpreter.NilValue{}
+			} else {
+				result = interpreter.NewSomeValueOwningNonCopying(
+					newAccountKeyValue(accountKey),
+				)
+			}
+
+			return trampoline.Done{Result: result}
+		},
+	)
+}
+
+// newAuthAccountKeysRevokeFunction returns the function `AuthAccount.keys.revoke`,
+// which marks the key at the given index as revoked.
+// Revoked keys are kept, so key indices are stable.
+//
+// Invalid indices are rejected before the runtime interface is called.
+//
+func (r *interpreterRuntime) newAuthAccountKeysRevokeFunction(
+	addressValue interpreter.AddressValue,
+	runtimeInterface Interface,
+) interpreter.HostFunctionValue {
+	return interpreter.NewHostFunctionValue(
+		func(invocation interpreter.Invocation) trampoline.Trampoline {
+			keyIndex, ok := accountKeyIndex(invocation.Arguments[0])
+			if !ok {
+				return trampoline.Done{Result: interpreter.NilValue{}}
+			}
+
+			accountKey, err := runtimeInterface.RevokeAccountKey(addressValue.ToAddress(), keyIndex)
+			if err != nil {
+				panic(err)
+			}
+
+			if accountKey == nil {
+				return trampoline.Done{Result: interpreter.NilValue{}}
+			}
+
+			accountKeyValue := newAccountKeyValue(accountKey)
+
+			r.emitAccountEvent(
+				stdlib.AccountKeyRevokedEventType,
+				runtimeInterface,
+				[]exportableValue{
+					newExportableValue(addressValue, nil),
+					newExportableValue(accountKeyValue.KeyIndex, nil),
+					newExportableValue(accountKeyValue.PublicKey, nil),
+				},
+			)
+
+			result := interpreter.NewSomeValueOwningNonCopying(accountKeyValue)
+			return trampoline.Done{Result: result}
+		},
+	)
+}
+
+func (r *interpreterRuntime) newAuthAccountContractsValue(
+	addressValue interpreter.AddressValue,
+	runtimeInterface Interface,
+	runtimeStorage *interpreterRuntimeStorage,
+) interpreter.AuthAccountContractsValue {
+	return interpreter.NewAuthAccountContractsValue(
+		addressValue,
+		r.newAuthAccountContractsChangeFunction(addressValue, runtimeInterface, runtimeStorage, false),
+		r.newAuthAccountContractsChangeFunction(addressValue, runtimeInterface, runtimeStorage, true),
+		r.newAuthAccountContractsGetFunction(addressValue, runtimeInterface),
+		r.newAuthAccountContractsRemoveFunction(addressValue, runtimeInterface, runtimeStorage),
+	)
+}
+
+// newAuthAccountContractsChangeFunction returns the function `AuthAccount.contracts.add`,
+// or `AuthAccount.contracts.update` if isUpdate is true.
+//
+// Adding a contract instantiates it and stores the contract value.
+// Updating a contract only replaces its code, the existing contract value is kept.
+//
+func (r *interpreterRuntime) newAuthAccountContractsChangeFunction(
+	addressValue interpreter.AddressValue,
+	runtimeInterface Interface,
+	runtimeStorage *interpreterRuntimeStorage,
+	isUpdate bool,
+) interpreter.HostFunctionValue {
+	return interpreter.NewHostFunctionValue(
+		func(invocation interpreter.Invocation) trampoline.Trampoline {
+			const requiredArgumentCount = 2
+
+			name := invocation.Arguments[0].(*interpreter.StringValue).Str
+
+			code, err := interpreter.ByteArrayValueToByteSlice(invocation.Arguments[1])
+			if err != nil {
+				functionName := "contracts.add"
+				if isUpdate {
+					functionName = "contracts.update"
+				}
+
+				panic(&InvalidHostFunctionArgumentError{
+					FunctionName:  functionName,
+					ParameterName: "code",
+					Err:           err,
+				})
+			}
+
+			address := addressValue.ToAddress()
+
+			existingCode, err := runtimeInterface.GetAccountContractCode(address, name)
+			if err != nil {
+				panic(err)
+			}
+
+			if isUpdate {
+				if len(existingCode) == 0 {
+					panic(ContractNotFoundError{
+						Address: address,
+						Name:    name,
+					})
+				}
+			} else if len(existingCode) > 0 {
+				panic(ContractAlreadyExistsError{
+					Address: address,
+					Name:    name,
+				})
+			}
+
+			location := AddressContractLocation{
+				AddressLocation: addressValue[:],
+				Name:            name,
+			}
+
+			// NOTE: always parse the given code, a cached program for the location
+			// would be the program of the existing contract
+
+			program, err := r.parse(code, runtimeInterface)
+			if err != nil {
+				panic(err)
+			}
+
+			functions := r.standardLibraryFunctions(runtimeInterface, runtimeStorage)
+
+			checker, err := r.checkProgram(
+				program,
+				runtimeInterface,
+				location,
+				functions,
+				nil,
+			)
+			if err != nil {
+				panic(err)
+			}
+
+			contractType, err := deployedContractType(checker, name)
+			if err != nil {
+				panic(err)
+			}
+
+			// The contract value is kept when the contract is updated,
+			// so the new code must be compatible with the stored values
+
+			if isUpdate {
+				err = r.validateContractUpdate(location, existingCode, program)
+				if err != nil {
+					panic(err)
+				}
+			}
+
+			// If a contract is added, instantiate it and store it.
+			// Contract interfaces have no value
+
+			if compositeType, ok := contractType.(*sema.CompositeType); ok && !isUpdate {
+
+				contract, err := r.instantiateContract(
+					compositeType,
+					invocation.Arguments[requiredArgumentCount:],
+					invocation.ArgumentTypes[requiredArgumentCount:],
+					runtimeInterface,
+					runtimeStorage,
+					checker,
+					functions,
+					invocation.LocationRange.Range,
+				)
+				if err != nil {
+					panic(err)
+				}
+
+				contractValue := interpreter.NewSomeValueOwningNonCopying(contract)
+				contractValue.SetOwner(&address)
+
+				r.writeContract(runtimeStorage, addressValue, contractStorageKey(name), contractValue)
+			}
+
+			// NOTE: only update the contract code if contract instantiation succeeded
+
+			err = runtimeInterface.UpdateAccountContractCode(address, name, code)
+			if err != nil {
+				panic(err)
+			}
+
+			// The cached programs for the location and the programs importing it are outdated
+
+			r.checkedPrograms.invalidate(location.ID())
+
+			eventType := stdlib.AccountContractAddedEventType
+			if isUpdate {
+				eventType = stdlib.AccountContractUpdatedEventType
+			}
+
+			r.emitAccountEvent(
+				eventType,
+				runtimeInterface,
+				[]exportableValue{
+					newExportableValue(addressValue, nil),
+					newExportableValue(CodeToHashValue(code), nil),
+					newExportableValue(interpreter.NewStringValue(name), nil),
+				},
+			)
+
+			result := interpreter.NewDeployedContractValue(addressValue, name, code)
+			return trampoline.Done{Result: result}
+		},
+	)
+}
+
+func (r *interpreterRuntime) newAuthAccountContractsGetFunction(
+	addressValue interpreter.AddressValue,
+	runtimeInterface Interface,
+) interpreter.HostFunctionValue {
+	return interpreter.NewHostFunctionValue(
+		func(invocation interpreter.Invocation) trampoline.Trampoline {
+			name := invocation.Arguments[0].(*interpreter.StringValue).Str
+
+			code, err := runtimeInterface.GetAccountContractCode(addressValue.ToAddress(), name)
+			if err != nil {
+				panic(err)
+			}
+
+			var result interpreter.Value
+			if len(code) == 0 {
+				result = interpreter.NilValue{}
+			} else {
+				result = interpreter.NewSomeValueOwningNonCopying(
+					interpreter.NewDeployedContractValue(addressValue, name, code),
+				)
+			}
+
+			return trampoline.Done{Result: result}
+		},
+	)
+}
+
+// newAuthAccountContractsRemoveFunction returns the function `AuthAccount.contracts.remove`,
+// which removes the code and the stored contract value of a contract.
+//
+func (r *interpreterRuntime) newAuthAccountContractsRemoveFunction(
+	addressValue interpreter.AddressValue,
+	runtimeInterface Interface,
+	runtimeStorage *interpreterRuntimeStorage,
+) interpreter.HostFunctionValue {
+	return interpreter.NewHostFunctionValue(
+		func(invocation interpreter.Invocation) trampoline.Trampoline {
+			name := invocation.Arguments[0].(*interpreter.StringValue).Str
+
+			address := addressValue.ToAddress()
+
+			code, err := runtimeInterface.GetAccountContractCode(address, name)
+			if err != nil {
+				panic(err)
+			}
+
+			if len(code) == 0 {
+				return trampoline.Done{Result: interpreter.NilValue{}}
+			}
+
+			err = runtimeInterface.RemoveAccountContractCode(address, name)
+			if err != nil {
+				panic(err)
+			}
+
+			r.checkedPrograms.invalidate(
+				AddressContractLocation{
+					AddressLocation: addressValue[:],
+					Name:            name,
+				}.ID(),
+			)
+
+			r.writeContract(runtimeStorage, addressValue, contractStorageKey(name), interpreter.NilValue{})
+
+			r.emitAccountEvent(
+				stdlib.AccountContractRemovedEventType,
+				runtimeInterface,
+				[]exportableValue{
+					newExportableValue(addressValue, nil),
+					newExportableValue(CodeToHashValue(code), nil),
+					newExportableValue(interpreter.NewStringValue(name), nil),
+				},
+			)
+
+			result := interpreter.NewSomeValueOwningNonCopying(
+				interpreter.NewDeployedContractValue(addressValue, name, code),
+			)
+			return trampoline.Done{Result: result}
+		},
+	)
+}
+
+// validateContractUpdate validates that the new program is compatible with the old code.
+//
+// Old code which cannot be parsed anymore, e.g. because it uses identifiers
+// which became keywords, is rejected, as it can not be determined
+// if the new program is compatible with the stored values.
+//
+func (r *interpreterRuntime) validateContractUpdate(
+	location Location,
+	oldCode []byte,
+	newProgram *ast.Program,
+	options ...ContractUpdateValidatorOption,
+) error {
+	oldProgram, _, err := parser.ParseProgram(string(oldCode))
+	if err != nil {
+		return &InvalidOldContractCodeError{
+			Location: location,
+			Err:      err,
+		}
+	}
+
+	return NewContractUpdateValidator(oldProgram, newProgram, options...).
+		Validate(location)
+}
+
+// deployedContractType returns the type of the contract or contract interface declared in the checked program.
+//
+// Contracts deployed under a name must declare exactly one contract or contract interface,
+// which must have the same name.
+//
+func deployedContractType(checker *sema.Checker, name string) (sema.Type, error) {
+
+	var declaredNames []string
+	var declaredType sema.Type
+
+	for _, declaration := range checker.Program.CompositeDeclarations() {
+		if declaration.CompositeKind != common.CompositeKindContract {
+			continue
+		}
+
+		declaredNames = append(declaredNames, declaration.Identifier.Identifier)
+		declaredType = checker.Elaboration.CompositeDeclarationTypes[declaration]
+	}
+
+	for _, declaration := range checker.Program.InterfaceDeclarations() {
+		if declaration.CompositeKind != common.CompositeKindContract {
+			continue
+		}
+
+		declaredNames = append(declaredNames, declaration.Identifier.Identifier)
+		declaredType = checker.Elaboration.InterfaceDeclarationTypes[declaration]
+	}
+
+	if len(declaredNames) != 1 || declaredNames[0] != name {
+		return nil, InvalidContractDeploymentError{
+			Name:     name,
+			Declared: declaredNames,
+		}
+	}
+
+	return declaredType, nil
+}
+
 func (r *interpreterRuntime) updateAccountCode(
 	runtimeInterface Interface,
 	runtimeStorage *interpreterRuntimeStorage,
@@ -846,6 +1864,26 @@ func (r *interpreterRuntime) updateAccountCode(
 		panic(fmt.Sprintf("code declares more than one contract"))
 	}
 
+	// If the account already has code, the new code must be compatible with it.
+	// The contract is initialized again, so only the fields of the contract itself may change
+
+	oldCode, err := runtimeInterface.GetAccountCode(addressValue.ToAddress())
+	if err != nil {
+		panic(err)
+	}
+
+	if len(oldCode) > 0 {
+		err = r.validateContractUpdate(
+			location,
+			oldCode,
+			checker.Program,
+			WithContractsReinitialized(true),
+		)
+		if err != nil {
+			panic(err)
+		}
+	}
+
 	// If the code declares a contract, instantiate it and store it
 
 	var contractValue interpreter.OptionalValue = interpreter.NilValue{}
@@ -882,7 +1920,11 @@ func (r *interpreterRuntime) updateAccountCode(
 		panic(err)
 	}
 
-	r.writeContract(runtimeStorage, addressValue, contractValue)
+	// The cached programs for the location and the programs importing it are outdated
+
+	r.checkedPrograms.invalidate(location.ID())
+
+	r.writeContract(runtimeStorage, addressValue, contractKey, contractValue)
 
 	return contractTypes
 }
@@ -890,11 +1932,12 @@ func (r *interpreterRuntime) updateAccountCode(
 func (r *interpreterRuntime) writeContract(
 	runtimeStorage *interpreterRuntimeStorage,
 	addressValue interpreter.AddressValue,
+	key string,
 	contractValue interpreter.OptionalValue,
 ) {
 	runtimeStorage.writeValue(
 		string(addressValue[:]),
-		contractKey,
+		key,
 		contractValue,
 	)
 }
@@ -903,10 +1946,24 @@ func (r *interpreterRuntime) loadContract(
 	compositeType *sema.CompositeType,
 	runtimeStorage *interpreterRuntimeStorage,
 ) *interpreter.CompositeValue {
-	address := compositeType.Location.(AddressLocation).ToAddress()
+
+	var address common.Address
+	var key string
+
+	switch location := compositeType.Location.(type) {
+	case AddressLocation:
+		address = location.ToAddress()
+		key = contractKey
+	case AddressContractLocation:
+		address = location.ToAddress()
+		key = contractStorageKey(location.Name)
+	default:
+		panic(runtimeErrors.NewUnreachableError())
+	}
+
 	storedValue := runtimeStorage.readValue(
 		string(address[:]),
-		contractKey,
+		key,
 	)
 	switch typedValue := storedValue.(type) {
 	case *interpreter.SomeValue:
@@ -1022,10 +2079,13 @@ func (r *interpreterRuntime) instantiateContract(
 	return contract, err
 }
 
-func (r *interpreterRuntime) newGetAccountFunction(_ Interface) interpreter.HostFunction {
+func (r *interpreterRuntime) newGetAccountFunction(runtimeInterface Interface) interpreter.HostFunction {
 	return func(invocation interpreter.Invocation) trampoline.Trampoline {
 		accountAddress := invocation.Arguments[0].(interpreter.AddressValue)
-		publicAccount := interpreter.NewPublicAccountValue(accountAddress)
+		publicAccount := interpreter.NewPublicAccountValue(
+			accountAddress,
+			r.newPublicAccountKeysValue(accountAddress, runtimeInterface),
+		)
 		return trampoline.Done{Result: publicAccount}
 	}
 }
@@ -1084,6 +2144,88 @@ func (r *interpreterRuntime) newGetBlockFunction(runtimeInterface Interface) int
 	}
 }
 
+func (r *interpreterRuntime) newHashFunction(runtimeInterface Interface) interpreter.HostFunction {
+	return func(invocation interpreter.Invocation) trampoline.Trampoline {
+		data, err := interpreter.ByteArrayValueToByteSlice(invocation.Arguments[0])
+		if err != nil {
+			panic(&InvalidHostFunctionArgumentError{
+				FunctionName:  "Crypto.hash",
+				ParameterName: "data",
+				Err:           err,
+			})
+		}
+
+		hashAlgorithm := stdlib.HashAlgorithmFromValue(invocation.Arguments[1])
+
+		invocation.Interpreter.ReportComputation(
+			common.ComputationKindCryptoOperation,
+			len(data),
+		)
+
+		digest, err := runtimeInterface.Hash(data, hashAlgorithm)
+		if err != nil {
+			panic(err)
+		}
+
+		return trampoline.Done{Result: interpreter.ByteSliceToByteArrayValue(digest)}
+	}
+}
+
+func (r *interpreterRuntime) newVerifySignatureFunction(runtimeInterface Interface) interpreter.HostFunction {
+	return func(invocation interpreter.Invocation) trampoline.Trampoline {
+		signature, err := interpreter.ByteArrayValueToByteSlice(invocation.Arguments[0])
+		if err != nil {
+			panic(&InvalidHostFunctionArgumentError{
+				FunctionName:  "Crypto.verifySignature",
+				ParameterName: "signature",
+				Err:           err,
+			})
+		}
+
+		tag := invocation.Arguments[1].(*interpreter.StringValue).Str
+
+		signedData, err := interpreter.ByteArrayValueToByteSlice(invocation.Arguments[2])
+		if err != nil {
+			panic(&InvalidHostFunctionArgumentError{
+				FunctionName:  "Crypto.verifySignature",
+				ParameterName: "signedData",
+				Err:           err,
+			})
+		}
+
+		publicKey, err := interpreter.ByteArrayValueToByteSlice(invocation.Arguments[3])
+		if err != nil {
+			panic(&InvalidHostFunctionArgumentError{
+				FunctionName:  "Crypto.verifySignature",
+				ParameterName: "publicKey",
+				Err:           err,
+			})
+		}
+
+		signatureAlgorithm := stdlib.SignatureAlgorithmFromValue(invocation.Arguments[4])
+		hashAlgorithm := stdlib.HashAlgorithmFromValue(invocation.Arguments[5])
+
+		invocation.Interpreter.ReportComputation(
+			common.ComputationKindCryptoOperation,
+			len(signature)+len(tag)+len(signedData)+len(publicKey),
+		)
+
+		valid, err := runtimeInterface.VerifySignature(
+			signature,
+			tag,
+			signedData,
+			publicKey,
+			signatureAlgorithm,
+			hashAlgorithm,
+		)
+		if err != nil {
+			panic(err)
+		}
+
+		return trampoline.Done{Result: interpreter.BoolValue(valid)}
+	}
+}
+
 func compositeTypesToIDValues(types []*sema.CompositeType) *interpreter.ArrayValue {
 	typeIDValues := make([]interpreter.Value, len(types))
 
@@ -1131,7 +2273,9 @@ func NewBlockValue(height uint64, id [stdlib.BlockIDSize]byte, timestamp time.Ti
 func (BlockValue) IsValue() {}
 
 func (BlockValue) DynamicType(*interpreter.Interpreter) interpreter.DynamicType {
-	return nil
+	return interpreter.CompositeDynamicType{
+		StaticType: &stdlib.BlockType{},
+	}
 }
 
 func (v BlockValue) Copy() interpreter.Value {
@@ -19,9 +19,10 @@
 package runtime
 
 import (
-	"bytes"
-	"encoding/gob"
+	"encoding/binary"
+	"sort"
 
+	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/errors"
 	"github.com/onflow/cadence/runtime/interpreter"
 )
@@ -31,15 +32,40 @@ type storageKey struct {
 	key               string
 }
 
+// storageUsedKey is the key under which the number of bytes used
+// by the values stored in an account is stored.
+//
+// NOTE: the key must not clash with the keys of stored values.
+// It is reserved, as it starts with `$`, which no storage key starts with:
+// Keys of stored values start with a path domain or `contract`,
+// and `$` is not valid in an identifier
+//
+const storageUsedKey = "$storage_used"
+
 type interpreterRuntimeStorage struct {
 	runtimeInterface Interface
 	cache            map[storageKey]interpreter.Value
+	// storedSizes are the sizes of the encoded values in storage,
+	// before they are written back in `writeCached`
+	storedSizes map[storageKey]int
+	// computationMeter meters the computation of the execution the storage is used in,
+	// including the storage reads and writes
+	computationMeter *computationMeter
+	// memoryMeter meters the memory allocated by the execution the storage is used in
+	memoryMeter *memoryMeter
 }
 
-func newInterpreterRuntimeStorage(runtimeInterface Interface) *interpreterRuntimeStorage {
+func newInterpreterRuntimeStorage(
+	runtimeInterface Interface,
+	computationMeter *computationMeter,
+	memoryMeter *memoryMeter,
+) *interpreterRuntimeStorage {
 	return &interpreterRuntimeStorage{
 		runtimeInterface: runtimeInterface,
 		cache:            map[storageKey]interpreter.Value{},
+		storedSizes:      map[storageKey]int{},
+		computationMeter: computationMeter,
+		memoryMeter:      memoryMeter,
 	}
 }
 
@@ -76,6 +102,7 @@ func (s *interpreterRuntimeStorage) valueExists(
 
 	if !exists {
 		s.cache[storageKey] = nil
+		s.storedSizes[storageKey] = 0
 	}
 
 	return exists
@@ -118,18 +145,27 @@ func (s *interpreterRuntimeStorage) readValue(
 		panic(err)
 	}
 
-	var storedValue interpreter.Value
+	s.storedSizes[storageKey] = len(storedData)
+
+	s.computationMeter.mustMeter(
+		common.ComputationKindStorageRead,
+		uint(len(storedData)),
+	)
+
 	if len(storedData) == 0 {
 		s.cache[storageKey] = nil
 		return interpreter.NilValue{}
 	}
 
-	decoder := gob.NewDecoder(bytes.NewReader(storedData))
-	err = decoder.Decode(&storedValue)
+	owner := common.BytesToAddress([]byte(storageIdentifier))
+
+	storedValue, err := interpreter.DecodeValue(storedData, &owner)
 	if err != nil {
 		panic(err)
 	}
 
+	s.memoryMeter.mustMeterValue(storedValue)
+
 	s.cache[storageKey] = storedValue
 	return interpreter.NewSomeValueOwningNonCopying(storedValue)
 }
@@ -164,23 +200,256 @@ func (s *interpreterRuntimeStorage) writeValue(
 	}
 }
 
-// writeCached serializes/saves all values in the cache in storage (through the runtime interface).
+// valueKeys is the StorageEnumerationHandlerFunc for the interpreter.
+//
+// It returns the keys of all values stored for the given storage identifier, in sorted order.
+//
+// The keys stored in storage (through the runtime interface) are combined with the cache,
+// so values which were written, but not yet saved in storage, are included,
+// and values which were removed, but not yet removed from storage, are excluded.
+//
+func (s *interpreterRuntimeStorage) valueKeys(storageIdentifier string) []string {
+
+	// TODO: fix controller
+	storedKeys, err := s.runtimeInterface.GetValueKeys([]byte(storageIdentifier), []byte{})
+	if err != nil {
+		panic(err)
+	}
+
+	keySet := make(map[string]struct{}, len(storedKeys))
+
+	for _, key := range storedKeys {
+		keySet[string(key)] = struct{}{}
+	}
+
+	for storageKey, value := range s.cache {
+		if storageKey.storageIdentifier != storageIdentifier {
+			continue
+		}
+
+		if value == nil {
+			delete(keySet, storageKey.key)
+		} else {
+			keySet[storageKey.key] = struct{}{}
+		}
+	}
+
+	keys := make([]string, 0, len(keySet))
+	for key := range keySet {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// storedSize returns the size of the encoded value stored under the given key in storage.
+//
+func (s *interpreterRuntimeStorage) storedSize(storageKey storageKey) int {
+	if size, ok := s.storedSizes[storageKey]; ok {
+		return size
+	}
+
+	// TODO: fix controller
+	storedData, err := s.runtimeInterface.GetValue(
+		[]byte(storageKey.storageIdentifier),
+		[]byte{},
+		[]byte(storageKey.key),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	size := len(storedData)
+	s.storedSizes[storageKey] = size
+	return size
+}
+
+// storedStorageUsed returns the number of bytes used by the values stored in an account,
+// as recorded in storage, i.e. without the values in the cache.
+//
+func (s *interpreterRuntimeStorage) storedStorageUsed(storageIdentifier string) uint64 {
+
+	// TODO: fix controller
+	storedData, err := s.runtimeInterface.GetValue(
+		[]byte(storageIdentifier),
+		[]byte{},
+		[]byte(storageUsedKey),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	if len(storedData) == 0 {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(storedData)
+}
+
+// encodeCachedValue encodes the given cached value.
+// Values which were removed are encoded as empty data.
 //
-func (s *interpreterRuntimeStorage) writeCached() {
+func encodeCachedValue(value interpreter.Value) []byte {
+	if value == nil {
+		return nil
+	}
+
+	data, err := interpreter.EncodeValue(value)
+	if err != nil {
+		panic(err)
+	}
+
+	return data
+}
+
+// encodeCached encodes all values in the cache.
+// Values which were removed are encoded as empty data.
+//
+func (s *interpreterRuntimeStorage) encodeCached() map[storageKey][]byte {
+	encoded := make(map[storageKey][]byte, len(s.cache))
 
 	for storageKey, value := range s.cache {
+		encoded[storageKey] = encodeCachedValue(value)
+	}
+
+	return encoded
+}
+
+// storageUsedDeltas returns the change in the number of bytes used
+// by the values stored in each account, if the given encoded values were written to storage.
+//
+func (s *interpreterRuntimeStorage) storageUsedDeltas(encoded map[storageKey][]byte) map[string]int64 {
+	deltas := map[string]int64{}
 
-		var newData []byte
-		if value != nil {
-			var newStoredData bytes.Buffer
-			encoder := gob.NewEncoder(&newStoredData)
-			err := encoder.Encode(&value)
-			if err != nil {
-				panic(err)
+	for storageKey, newData := range encoded {
+		delta := int64(len(newData)) - int64(s.storedSize(storageKey))
+		deltas[storageKey.storageIdentifier] += delta
+	}
+
+	return deltas
+}
+
+// applyStorageUsedDelta returns the number of bytes used after applying the given change.
+//
+// Values stored before storage usage was tracked are not accounted for,
+// so the result is never less than zero.
+//
+func applyStorageUsedDelta(storageUsed uint64, delta int64) uint64 {
+	if delta < 0 && uint64(-delta) > storageUsed {
+		return 0
+	}
+	return uint64(int64(storageUsed) + delta)
+}
+
+// storageUsed is the StorageUsedHandlerFunc for the interpreter.
+//
+// It returns the number of bytes used by the values stored in an account,
+// including the values in the cache, which are not yet written back to storage.
+//
+func (s *interpreterRuntimeStorage) storageUsed(storageIdentifier string) uint64 {
+
+	// Only encode the cached values of the requested account.
+	// The encoded bytes are metered, as the cache may grow arbitrarily large
+
+	var delta int64
+	for storageKey, value := range s.cache {
+		if storageKey.storageIdentifier != storageIdentifier {
+			continue
+		}
+
+		newData := encodeCachedValue(value)
+
+		s.computationMeter.mustMeter(
+			common.ComputationKindValueEncoding,
+			uint(len(newData)),
+		)
+
+		delta += int64(len(newData)) - int64(s.storedSize(storageKey))
+	}
+
+	return applyStorageUsedDelta(s.storedStorageUsed(storageIdentifier), delta)
+}
+
+// storageCapacity is the StorageCapacityHandlerFunc for the interpreter.
+//
+// It returns the storage capacity of an account, as provided by the runtime interface.
+//
+func (s *interpreterRuntimeStorage) storageCapacity(storageIdentifier string) uint64 {
+	address := common.BytesToAddress([]byte(storageIdentifier))
+
+	capacity, err := s.runtimeInterface.GetStorageCapacity(address)
+	if err != nil {
+		panic(err)
+	}
+
+	return capacity
+}
+
+// writeCached serializes/saves all values in the cache in storage (through the runtime interface).
+//
+// The number of bytes used by the values stored in each account is updated.
+// If the storage used by an account grows beyond its capacity,
+// nothing is written and a StorageCapacityExceededError is returned.
+//
+// The written bytes are metered. If the computation limit is exceeded,
+// nothing is written and a ComputationLimitExceededError is returned.
+//
+func (s *interpreterRuntimeStorage) writeCached() error {
+
+	encoded := s.encodeCached()
+
+	var writtenBytes uint
+	for _, newData := range encoded {
+		writtenBytes += uint(len(newData))
+	}
+
+	err := s.computationMeter.meter(common.ComputationKindStorageWrite, writtenBytes)
+	if err != nil {
+		return err
+	}
+
+	deltas := s.storageUsedDeltas(encoded)
+
+	// Sort the accounts, so the capacity checks are performed in a deterministic order
+
+	storageIdentifiers := make([]string, 0, len(deltas))
+	for storageIdentifier, delta := range deltas {
+		if delta == 0 {
+			continue
+		}
+		storageIdentifiers = append(storageIdentifiers, storageIdentifier)
+	}
+
+	sort.Strings(storageIdentifiers)
+
+	newStorageUsed := make(map[string]uint64, len(storageIdentifiers))
+
+	for _, storageIdentifier := range storageIdentifiers {
+		delta := deltas[storageIdentifier]
+
+		storageUsed := applyStorageUsedDelta(s.storedStorageUsed(storageIdentifier), delta)
+
+		// Only check the capacity if the storage used grows,
+		// so accounts beyond their capacity can still free up storage
+
+		if delta > 0 {
+			capacity := s.storageCapacity(storageIdentifier)
+			if storageUsed > capacity {
+				return StorageCapacityExceededError{
+					Address:         common.BytesToAddress([]byte(storageIdentifier)),
+					StorageUsed:     storageUsed,
+					StorageCapacity: capacity,
+				}
 			}
-			newData = newStoredData.Bytes()
 		}
 
+		newStorageUsed[storageIdentifier] = storageUsed
+	}
+
+	for storageKey, newData := range encoded {
+
 		// TODO: fix controller
 		err := s.runtimeInterface.SetValue(
 			[]byte(storageKey.storageIdentifier),
@@ -192,4 +461,23 @@ func (s *interpreterRuntimeStorage) writeCached() {
 			panic(err)
 		}
 	}
+
+	for storageIdentifier, storageUsed := range newStorageUsed {
+
+		var newData [8]byte
+		binary.BigEndian.PutUint64(newData[:], storageUsed)
+
+		// TODO: fix controller
+		err := s.runtimeInterface.SetValue(
+			[]byte(storageIdentifier),
+			[]byte{},
+			[]byte(storageUsedKey),
+			newData[:],
+		)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	return nil
 }
@@ -19,8 +19,15 @@
 package runtime
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"math"
+	"math/big"
 	"strings"
 	"testing"
 
@@ -32,16 +39,19 @@ import (
 	jsoncdc "github.com/onflow/cadence/encoding/json"
 	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/crypto"
 	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/profiler"
 	"github.com/onflow/cadence/runtime/sema"
 	"github.com/onflow/cadence/runtime/stdlib"
 	"github.com/onflow/cadence/runtime/tests/utils"
 )
 
 type testRuntimeInterfaceStorage struct {
-	valueExists func(controller, owner, key []byte) (exists bool, err error)
-	getValue    func(controller, owner, key []byte) (value []byte, err error)
-	setValue    func(controller, owner, key, value []byte) (err error)
+	valueExists  func(controller, owner, key []byte) (exists bool, err error)
+	getValue     func(controller, owner, key []byte) (value []byte, err error)
+	setValue     func(controller, owner, key, value []byte) (err error)
+	getValueKeys func(owner, controller []byte) (keys [][]byte, err error)
 }
 
 func newTestStorage() testRuntimeInterfaceStorage {
@@ -64,27 +74,64 @@ func newTestStorage() testRuntimeInterfaceStorage {
 			storedValues[storageKey(string(controller), string(owner), string(key))] = value
 			return nil
 		},
+		getValueKeys: func(owner, controller []byte) (keys [][]byte, err error) {
+			prefix := storageKey(string(owner), string(controller), "")
+			for storedKey, value := range storedValues {
+				if len(value) == 0 || !strings.HasPrefix(storedKey, prefix) {
+					continue
+				}
+				keys = append(keys, []byte(storedKey[len(prefix):]))
+			}
+			return keys, nil
+		},
 	}
 
 	return storage
 }
 
 type testRuntimeInterface struct {
-	resolveImport      func(Location) ([]byte, error)
-	getCachedProgram   func(Location) (*ast.Program, error)
-	cacheProgram       func(Location, *ast.Program) error
-	storage            testRuntimeInterfaceStorage
-	createAccount      func(publicKeys [][]byte) (address Address, err error)
-	addAccountKey      func(address Address, publicKey []byte) error
-	removeAccountKey   func(address Address, index int) (publicKey []byte, err error)
-	checkCode          func(address Address, code []byte) (err error)
-	updateAccountCode  func(address Address, code []byte, checkPermission bool) (err error)
-	getSigningAccounts func() []Address
-	log                func(string)
-	emitEvent          func(cadence.Event)
-	generateUUID       func() uint64
-	computationLimit   uint64
-	decodeArgument     func(b []byte, t cadence.Type) (cadence.Value, error)
+	resolveImport           func(Location) ([]byte, error)
+	getCachedProgram        func(Location) (*ast.Program, error)
+	cacheProgram            func(Location, *ast.Program) error
+	storage                 testRuntimeInterfaceStorage
+	createAccount           func(publicKeys [][]byte) (address Address, err error)
+	addAccountKey           func(address Address, publicKey []byte) error
+	addStructuredAccountKey func(
+		address Address,
+		publicKey []byte,
+		signatureAlgorithm SignatureAlgorithm,
+		hashAlgorithm HashAlgorithm,
+		weight uint64,
+	) (*AccountKey, error)
+	getAccountKey             func(address Address, keyIndex int) (*AccountKey, error)
+	revokeAccountKey          func(address Address, keyIndex int) (*AccountKey, error)
+	removeAccountKey          func(address Address, index int) (publicKey []byte, err error)
+	checkCode                 func(address Address, code []byte) (err error)
+	getAccountCode            func(address Address) (code []byte, err error)
+	updateAccountCode         func(address Address, code []byte, checkPermission bool) (err error)
+	getAccountContractCode    func(address Address, name string) (code []byte, err error)
+	updateAccountContractCode func(address Address, name string, code []byte) (err error)
+	removeAccountContractCode func(address Address, name string) (err error)
+	getSigningAccounts        func() []Address
+	getStorageCapacity        func(address Address) (capacity uint64, err error)
+	log                       func(string)
+	emitEvent                 func(cadence.Event)
+	generateUUID              func() uint64
+	computationLimit          uint64
+	computationWeights        ComputationWeights
+	setComputationUsed        func(used uint64)
+	memoryLimit               uint64
+	setMemoryUsed             func(used uint64)
+	decodeArgument            func(b []byte, t cadence.Type) (cadence.Value, error)
+	hash                      func(data []byte, hashAlgorithm HashAlgorithm) ([]byte, error)
+	verifySignature           func(
+		signature []byte,
+		tag string,
+		signedData []byte,
+		publicKey []byte,
+		signatureAlgorithm SignatureAlgorithm,
+		hashAlgorithm HashAlgorithm,
+	) (bool, error)
 }
 
 func (i *testRuntimeInterface) ResolveImport(location Location) ([]byte, error) {
@@ -117,6 +164,17 @@ func (i *testRuntimeInterface) SetValue(controller, owner, key, value []byte) (e
 	return i.storage.setValue(controller, owner, key, value)
 }
 
+func (i *testRuntimeInterface) GetValueKeys(owner, controller []byte) (keys [][]byte, err error) {
+	return i.storage.getValueKeys(owner, controller)
+}
+
+func (i *testRuntimeInterface) GetStorageCapacity(address Address) (capacity uint64, err error) {
+	if i.getStorageCapacity == nil {
+		return math.MaxUint64, nil
+	}
+	return i.getStorageCapacity(address)
+}
+
 func (i *testRuntimeInterface) CreateAccount(publicKeys [][]byte) (address Address, err error) {
 	return i.createAccount(publicKeys)
 }
@@ -125,6 +183,24 @@ func (i *testRuntimeInterface) AddAccountKey(address Address, publicKey []byte)
 	return i.addAccountKey(address, publicKey)
 }
 
+func (i *testRuntimeInterface) AddStructuredAccountKey(
+	address Address,
+	publicKey []byte,
+	signatureAlgorithm SignatureAlgorithm,
+	hashAlgorithm HashAlgorithm,
+	weight uint64,
+) (*AccountKey, error) {
+	return i.addStructuredAccountKey(address, publicKey, signatureAlgorithm, hashAlgorithm, weight)
+}
+
+func (i *testRuntimeInterface) GetAccountKey(address Address, keyIndex int) (*AccountKey, error) {
+	return i.getAccountKey(address, keyIndex)
+}
+
+func (i *testRuntimeInterface) RevokeAccountKey(address Address, keyIndex int) (*AccountKey, error) {
+	return i.revokeAccountKey(address, keyIndex)
+}
+
 func (i *testRuntimeInterface) RemoveAccountKey(address Address, index int) (publicKey []byte, err error) {
 	return i.removeAccountKey(address, index)
 }
@@ -133,10 +209,32 @@ func (i *testRuntimeInterface) CheckCode(address Address, code []byte) (err erro
 	return i.checkCode(address, code)
 }
 
+func (i *testRuntimeInterface) GetAccountCode(address Address) (code []byte, err error) {
+	if i.getAccountCode == nil {
+		return nil, nil
+	}
+	return i.getAccountCode(address)
+}
+
 func (i *testRuntimeInterface) UpdateAccountCode(address Address, code []byte, checkPermission bool) (err error) {
 	return i.updateAccountCode(address, code, checkPermission)
 }
 
+func (i *testRuntimeInterface) GetAccountContractCode(address Address, name string) (code []byte, err error) {
+	if i.getAccountContractCode == nil {
+		return nil, nil
+	}
+	return i.getAccountContractCode(address, name)
+}
+
+func (i *testRuntimeInterface) UpdateAccountContractCode(address Address, name string, code []byte) (err error) {
+	return i.updateAccountContractCode(address, name, code)
+}
+
+func (i *testRuntimeInterface) RemoveAccountContractCode(address Address, name string) (err error) {
+	return i.removeAccountContractCode(address, name)
+}
+
 func (i *testRuntimeInterface) GetSigningAccounts() []Address {
 	if i.getSigningAccounts == nil {
 		return nil
@@ -163,10 +261,67 @@ func (i *testRuntimeInterface) GetComputationLimit() uint64 {
 	return i.computationLimit
 }
 
+func (i *testRuntimeInterface) GetComputationWeights() ComputationWeights {
+	return i.computationWeights
+}
+
+func (i *testRuntimeInterface) SetComputationUsed(used uint64) {
+	if i.setComputationUsed == nil {
+		return
+	}
+	i.setComputationUsed(used)
+}
+
+func (i *testRuntimeInterface) GetMemoryLimit() uint64 {
+	return i.memoryLimit
+}
+
+func (i *testRuntimeInterface) SetMemoryUsed(used uint64) {
+	if i.setMemoryUsed == nil {
+		return
+	}
+	i.setMemoryUsed(used)
+}
+
 func (i *testRuntimeInterface) DecodeArgument(b []byte, t cadence.Type) (cadence.Value, error) {
 	return i.decodeArgument(b, t)
 }
 
+func (i *testRuntimeInterface) Hash(data []byte, hashAlgorithm HashAlgorithm) ([]byte, error) {
+	if i.hash == nil {
+		return crypto.Hash(data, hashAlgorithm)
+	}
+	return i.hash(data, hashAlgorithm)
+}
+
+func (i *testRuntimeInterface) VerifySignature(
+	signature []byte,
+	tag string,
+	signedData []byte,
+	publicKey []byte,
+	signatureAlgorithm SignatureAlgorithm,
+	hashAlgorithm HashAlgorithm,
+) (bool, error) {
+	if i.verifySignature == nil {
+		return crypto.VerifySignature(
+			signature,
+			tag,
+			signedData,
+			publicKey,
+			signatureAlgorithm,
+			hashAlgorithm,
+		)
+	}
+	return i.verifySignature(
+		signature,
+		tag,
+		signedData,
+		publicKey,
+		signatureAlgorithm,
+		hashAlgorithm,
+	)
+}
+
 func TestRuntimeImport(t *testing.T) {
 
 	runtime := NewInterpreterRuntime()
@@ -200,7 +355,7 @@ func TestRuntimeImport(t *testing.T) {
 		},
 	}
 
-	value, err := runtime.ExecuteScript(script, runtimeInterface, utils.TestLocation)
+	value, err := runtime.ExecuteScript(script, nil, runtimeInterface, utils.TestLocation)
 	require.NoError(t, err)
 
 	assert.Equal(t, cadence.NewInt(42), value)
@@ -311,6 +466,163 @@ func TestRuntimeProgramCache(t *testing.T) {
 	})
 }
 
+func TestRuntimeCheckedProgramCache(t *testing.T) {
+
+	t.Parallel()
+
+	address := common.BytesToAddress([]byte{0x1})
+
+	contractA := []byte(`
+      pub contract A {
+          pub fun hello(): String {
+              return "Hello from A"
+          }
+      }
+    `)
+
+	updatedContractA := []byte(`
+      pub contract A {
+          pub fun hello(): String {
+              return "Hello from updated A"
+          }
+      }
+    `)
+
+	contractB := []byte(`
+      import A from 0x1
+
+      pub contract B {
+          pub fun hello(): String {
+              return A.hello().concat(" via B")
+          }
+      }
+    `)
+
+	script := []byte(`
+      import B from 0x1
+
+      pub fun main(): String {
+          return B.hello()
+      }
+    `)
+
+	newTransaction := func(function string, name string, code []byte) []byte {
+		return []byte(fmt.Sprintf(
+			`
+              transaction {
+                  prepare(signer: AuthAccount) {
+                      signer.contracts.%s(name: "%s", code: "%s".decodeHex())
+                  }
+              }
+            `,
+			function,
+			name,
+			hex.EncodeToString(code),
+		))
+	}
+
+	locationA := AddressContractLocation{
+		AddressLocation: address[:],
+		Name:            "A",
+	}.ID()
+
+	locationB := AddressContractLocation{
+		AddressLocation: address[:],
+		Name:            "B",
+	}.ID()
+
+	contracts := map[string][]byte{}
+
+	runtime := NewInterpreterRuntime()
+	checkedPrograms := runtime.(*interpreterRuntime).checkedPrograms
+
+	runtimeInterface := &testRuntimeInterface{
+		storage: newTestStorage(),
+		getSigningAccounts: func() []Address {
+			return []Address{address}
+		},
+		getAccountContractCode: func(_ Address, name string) ([]byte, error) {
+			return contracts[name], nil
+		},
+		updateAccountContractCode: func(_ Address, name string, code []byte) error {
+			contracts[name] = code
+			return nil
+		},
+		emitEvent: func(event cadence.Event) {},
+	}
+
+	err := runtime.ExecuteTransaction(
+		newTransaction("add", "A", contractA),
+		nil,
+		runtimeInterface,
+		utils.TestLocation,
+	)
+	require.NoError(t, err)
+
+	err = runtime.ExecuteTransaction(
+		newTransaction("add", "B", contractB),
+		nil,
+		runtimeInterface,
+		utils.TestLocation,
+	)
+	require.NoError(t, err)
+
+	executeScript := func(expected string) {
+		value, err := runtime.ExecuteScript(script, nil, runtimeInterface, utils.TestLocation)
+		require.NoError(t, err)
+
+		assert.Equal(t, cadence.NewString(expected), value)
+	}
+
+	executeScript("Hello from A via B")
+
+	require.Contains(t, checkedPrograms.programs, locationA)
+	require.Contains(t, checkedPrograms.programs, locationB)
+
+	checkerA := checkedPrograms.programs[locationA].checker
+	checkerB := checkedPrograms.programs[locationB].checker
+
+	// Imported programs are only checked once
+
+	executeScript("Hello from A via B")
+
+	assert.Same(t, checkerA, checkedPrograms.programs[locationA].checker)
+	assert.Same(t, checkerB, checkedPrograms.programs[locationB].checker)
+
+	// Updating A invalidates A and B, which imports A
+
+	err = runtime.ExecuteTransaction(
+		newTransaction("update", "A", updatedContractA),
+		nil,
+		runtimeInterface,
+		utils.TestLocation,
+	)
+	require.NoError(t, err)
+
+	assert.NotContains(t, checkedPrograms.programs, locationA)
+	assert.NotContains(t, checkedPrograms.programs, locationB)
+
+	executeScript("Hello from updated A via B")
+
+	require.Contains(t, checkedPrograms.programs, locationA)
+	require.Contains(t, checkedPrograms.programs, locationB)
+
+	assert.NotSame(t, checkerA, checkedPrograms.programs[locationA].checker)
+	assert.NotSame(t, checkerB, checkedPrograms.programs[locationB].checker)
+
+	// Code which changed without an update is not reused
+
+	checkerA = checkedPrograms.programs[locationA].checker
+	checkerB = checkedPrograms.programs[locationB].checker
+
+	contracts["A"] = contractA
+
+	executeScript("Hello from A via B")
+
+	assert.NotSame(t, checkerA, checkedPrograms.programs[locationA].checker)
+	assert.NotSame(t, checkerB, checkedPrograms.programs[locationB].checker)
+}
+
 func TestRuntimeInvalidTransactionArgumentAccount(t *testing.T) {
 	runtime := NewInterpreterRuntime()
 
@@ -658,35 +970,378 @@ func TestRuntimeTransactionWithArguments(t *testing.T) {
 	}
 }
 
-func TestRuntimeProgramWithNoTransaction(t *testing.T) {
-	runtime := NewInterpreterRuntime()
-
-	script := []byte(`
-      pub fun main() {}
-    `)
-
-	runtimeInterface := &testRuntimeInterface{}
-
-	err := runtime.ExecuteTransaction(script, nil, runtimeInterface, utils.TestLocation)
-
-	require.IsType(t, Error{}, err)
-	err = err.(Error).Unwrap()
-	assert.IsType(t, InvalidTransactionCountError{}, err)
-}
-
-func TestRuntimeProgramWithMultipleTransaction(t *testing.T) {
-	runtime := NewInterpreterRuntime()
+func TestRuntimeScriptArguments(t *testing.T) {
+	var tests = []struct {
+		label        string
+		script       string
+		args         [][]byte
+		expectedLogs []string
+		check        func(t *testing.T, err error)
+	}{
+		{
+			label: "No arguments",
+			script: `
+			  pub fun main() {
+				log("t")
+			  }
+			`,
+			args:         nil,
+			expectedLogs: []string{`"t"`},
+		},
+		{
+			label: "Single argument",
+			script: `
+			  pub fun main(x: Int) {
+				log(x)
+			  }
+			`,
+			args: [][]byte{
+				jsoncdc.MustEncode(cadence.NewInt(42)),
+			},
+			expectedLogs: []string{"42"},
+		},
+		{
+			label: "Multiple arguments",
+			script: `
+			  pub fun main(x: Int, y: String) {
+				log(x)
+				log(y)
+			  }
+			`,
+			args: [][]byte{
+				jsoncdc.MustEncode(cadence.NewInt(42)),
+				jsoncdc.MustEncode(cadence.NewString("foo")),
+			},
+			expectedLogs: []string{"42", `"foo"`},
+		},
+		{
+			label: "Missing argument",
+			script: `
+			  pub fun main(x: Int) {}
+			`,
+			args: nil,
+			check: func(t *testing.T, err error) {
+				assert.Error(t, err)
+				assert.IsType(t, InvalidScriptParameterCountError{}, errors.Unwrap(err))
+			},
+		},
+		{
+			label: "Invalid bytes",
+			script: `
+			  pub fun main(x: Int) {}
+			`,
+			args: [][]byte{
+				{1, 2, 3, 4}, // not valid JSON-CDC
+			},
+			check: func(t *testing.T, err error) {
+				assert.Error(t, err)
+				assert.IsType(t, &InvalidScriptArgumentError{}, errors.Unwrap(err))
+			},
+		},
+		{
+			label: "Type mismatch",
+			script: `
+			  pub fun main(x: Int) {
+				log(x)
+			  }
+			`,
+			args: [][]byte{
+				jsoncdc.MustEncode(cadence.NewString("foo")),
+			},
+			check: func(t *testing.T, err error) {
+				assert.Error(t, err)
+				assert.IsType(t, &InvalidScriptArgumentError{}, errors.Unwrap(err))
+				assert.IsType(t, &InvalidTypeAssignmentError{}, errors.Unwrap(errors.Unwrap(err)))
+			},
+		},
+		{
+			label: "Address",
+			script: `
+			  pub fun main(x: Address) {
+				log(getAccount(x).address)
+			  }
+			`,
+			args: [][]byte{
+				jsoncdc.MustEncode(cadence.BytesToAddress([]byte{42})),
+			},
+			expectedLogs: []string{"0x2a"},
+		},
+		{
+			label: "Enum",
+			script: `
+			  pub enum E: UInt8 {
+				pub case a
+				pub case b
+			  }
 
-	script := []byte(`
-      transaction {
-        execute {}
-      }
-      transaction {
-        execute {}
-      }
-    `)
+			  pub fun main(e: E) {
+				log(e.rawValue)
+			  }
+			`,
+			args: [][]byte{
+				jsoncdc.MustEncode(newTestEnumValue(1)),
+			},
+			expectedLogs: []string{"1"},
+		},
+		{
+			label: "Enum with undeclared raw value",
+			script: `
+			  pub enum E: UInt8 {
+				pub case a
+				pub case b
+			  }
 
-	runtimeInterface := &testRuntimeInterface{}
+			  pub fun main(e: E): Int {
+				switch e {
+				case E.a:
+				  return 1
+				case E.b:
+				  return 2
+				}
+			  }
+			`,
+			args: [][]byte{
+				jsoncdc.MustEncode(newTestEnumValue(200)),
+			},
+			check: func(t *testing.T, err error) {
+				assert.Error(t, err)
+				assert.IsType(t, &InvalidScriptArgumentError{}, errors.Unwrap(err))
+				assert.IsType(t, &InvalidEnumRawValueError{}, errors.Unwrap(errors.Unwrap(err)))
+			},
+		},
+		{
+			label: "Enum with undeclared raw value in array",
+			script: `
+			  pub enum E: UInt8 {
+				pub case a
+				pub case b
+			  }
+
+			  pub fun main(es: [E]) {}
+			`,
+			args: [][]byte{
+				jsoncdc.MustEncode(cadence.NewArray([]cadence.Value{
+					newTestEnumValue(0),
+					newTestEnumValue(2),
+				})),
+			},
+			check: func(t *testing.T, err error) {
+				assert.Error(t, err)
+				assert.IsType(t, &InvalidScriptArgumentError{}, errors.Unwrap(err))
+				assert.IsType(t, &InvalidEnumRawValueError{}, errors.Unwrap(errors.Unwrap(err)))
+			},
+		},
+		{
+			label: "Constant main",
+			script: `
+			  pub let main = 1
+			`,
+			args: nil,
+			check: func(t *testing.T, err error) {
+				assert.Error(t, err)
+				assert.IsType(t, InvalidEntryPointTypeError{}, errors.Unwrap(err))
+			},
+		},
+		{
+			label: "Function-typed constant main",
+			script: `
+			  pub let main = fun () {}
+			`,
+			args: nil,
+			check: func(t *testing.T, err error) {
+				assert.Error(t, err)
+				assert.IsType(t, InvalidEntryPointTypeError{}, errors.Unwrap(err))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.label, func(t *testing.T) {
+			rt := NewInterpreterRuntime()
+
+			var loggedMessages []string
+
+			runtimeInterface := &testRuntimeInterface{
+				storage: newTestStorage(),
+				decodeArgument: func(b []byte, t cadence.Type) (cadence.Value, error) {
+					return jsoncdc.Decode(b)
+				},
+				log: func(message string) {
+					loggedMessages = append(loggedMessages, message)
+				},
+			}
+
+			_, err := rt.ExecuteScript(
+				[]byte(tt.script),
+				tt.args,
+				runtimeInterface,
+				utils.TestLocation,
+			)
+
+			if tt.check != nil {
+				tt.check(t, err)
+			} else {
+				if !assert.NoError(t, err) {
+					for err := err; err != nil; err = errors.Unwrap(err) {
+						t.Log(err)
+					}
+				}
+				assert.ElementsMatch(t, tt.expectedLogs, loggedMessages)
+			}
+		})
+	}
+}
+
+func TestRuntimeScriptTypeArgument(t *testing.T) {
+
+	script := []byte(`
+      pub resource interface I {}
+
+      pub resource R: I {}
+
+      pub struct S {}
+
+      pub fun main(type: Type): Type {
+          return type
+      }
+    `)
+
+	rt := NewInterpreterRuntime()
+
+	runtimeInterface := &testRuntimeInterface{
+		storage: newTestStorage(),
+		resolveImport: func(location Location) ([]byte, error) {
+			return nil, fmt.Errorf("unknown import location: %s", location)
+		},
+		decodeArgument: func(b []byte, t cadence.Type) (cadence.Value, error) {
+			return jsoncdc.Decode(b)
+		},
+	}
+
+	t.Run("JSON", func(t *testing.T) {
+
+		value, err := rt.ExecuteScript(
+			script,
+			[][]byte{
+				[]byte(`{"type":"Type","value":{"staticType":"Int"}}`),
+			},
+			runtimeInterface,
+			utils.TestLocation,
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, cadence.NewTypeValue("Int"), value)
+	})
+
+	for _, typeID := range []string{
+		"Int",
+		"String?",
+		"[UInt8]",
+		"[Int;3]",
+		"{String:[Int?]}",
+		"Type",
+		"Block",
+		"flow.HashAlgorithm",
+		"AuthAccount.Keys",
+		"test.S",
+		"test.I",
+		"&test.S",
+		"auth &test.R",
+		"test.R{test.I}",
+		"Capability",
+		"Capability<&test.R{test.I}>",
+	} {
+		t.Run(typeID, func(t *testing.T) {
+
+			value, err := rt.ExecuteScript(
+				script,
+				[][]byte{
+					jsoncdc.MustEncode(cadence.NewTypeValue(typeID)),
+				},
+				runtimeInterface,
+				utils.TestLocation,
+			)
+			require.NoError(t, err)
+
+			assert.Equal(t, cadence.NewTypeValue(typeID), value)
+		})
+	}
+
+	for _, typeID := range []string{
+		"",
+		"Foo",
+		"test.Foo",
+		"unknown.Foo",
+		"[Int",
+		"{String}",
+		"Int??!",
+		"test.S{test.S}",
+	} {
+		t.Run(fmt.Sprintf("invalid: %s", typeID), func(t *testing.T) {
+
+			_, err := rt.ExecuteScript(
+				script,
+				[][]byte{
+					jsoncdc.MustEncode(cadence.NewTypeValue(typeID)),
+				},
+				runtimeInterface,
+				utils.TestLocation,
+			)
+			require.Error(t, err)
+
+			require.IsType(t, &InvalidScriptArgumentError{}, errors.Unwrap(err))
+			assert.IsType(t, &InvalidTypeIDError{}, errors.Unwrap(errors.Unwrap(err)))
+		})
+	}
+}
+
+// newTestEnumValue returns a value of the enum `E` declared in the test location
+// with the given raw value, which is not necessarily the raw value of a declared case.
+//
+func newTestEnumValue(rawValue uint8) cadence.Enum {
+	return cadence.NewEnum([]cadence.Value{cadence.NewUInt8(rawValue)}).
+		WithType(cadence.EnumType{
+			TypeID:     fmt.Sprintf("%s.E", utils.TestLocation),
+			Identifier: "E",
+			RawType:    cadence.UInt8Type{},
+			Fields: []cadence.Field{
+				{
+					Identifier: "rawValue",
+					Type:       cadence.UInt8Type{},
+				},
+			},
+		})
+}
+
+func TestRuntimeProgramWithNoTransaction(t *testing.T) {
+	runtime := NewInterpreterRuntime()
+
+	script := []byte(`
+      pub fun main() {}
+    `)
+
+	runtimeInterface := &testRuntimeInterface{}
+
+	err := runtime.ExecuteTransaction(script, nil, runtimeInterface, utils.TestLocation)
+
+	require.IsType(t, Error{}, err)
+	err = err.(Error).Unwrap()
+	assert.IsType(t, InvalidTransactionCountError{}, err)
+}
+
+func TestRuntimeProgramWithMultipleTransaction(t *testing.T) {
+	runtime := NewInterpreterRuntime()
+
+	script := []byte(`
+      transaction {
+        execute {}
+      }
+      transaction {
+        execute {}
+      }
+    `)
+
+	runtimeInterface := &testRuntimeInterface{}
 
 	err := runtime.ExecuteTransaction(script, nil, runtimeInterface, utils.TestLocation)
 
@@ -1369,7 +2024,7 @@ func TestRuntimeSyntaxError(t *testing.T) {
 		},
 	}
 
-	_, err := runtime.ExecuteScript(script, runtimeInterface, utils.TestLocation)
+	_, err := runtime.ExecuteScript(script, nil, runtimeInterface, utils.TestLocation)
 	assert.Error(t, err)
 }
 
@@ -1730,7 +2385,10 @@ func TestRuntimeTransactionWithContractDeployment(t *testing.T) {
 
 		codeHashValue := event.Fields[codeHashParameterIndex]
 
-		actualCodeHash, err := interpreter.ByteArrayValueToByteSlice(importValue(codeHashValue))
+		codeHash, err := importValue(codeHashValue, nil)
+		require.NoError(t, err)
+
+		actualCodeHash, err := interpreter.ByteArrayValueToByteSlice(codeHash)
 		require.NoError(t, err)
 
 		require.Equal(t, expectedCodeHash[:], actualCodeHash)
@@ -2005,113 +2663,398 @@ func TestRuntimeContractAccount(t *testing.T) {
 	assert.NotNil(t, accountCode)
 
 	t.Run("", func(t *testing.T) {
-		value, err := runtime.ExecuteScript(script1, runtimeInterface, utils.TestLocation)
+		value, err := runtime.ExecuteScript(script1, nil, runtimeInterface, utils.TestLocation)
 		require.NoError(t, err)
 
 		assert.Equal(t, addressValue, value)
 	})
 
 	t.Run("", func(t *testing.T) {
-		value, err := runtime.ExecuteScript(script2, runtimeInterface, utils.TestLocation)
+		value, err := runtime.ExecuteScript(script2, nil, runtimeInterface, utils.TestLocation)
 		require.NoError(t, err)
 
 		assert.Equal(t, addressValue, value)
 	})
 }
 
-func TestRuntimeContractNestedResource(t *testing.T) {
-	runtime := NewInterpreterRuntime()
+func TestRuntimeAccountContracts(t *testing.T) {
 
-	addressValue := Address{
-		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1,
-	}
+	address := common.BytesToAddress([]byte{0x1})
 
-	contract := []byte(`
-        pub contract Test {
-            pub resource R {
-                // test that the hello function is linked back into the nested resource
-                // after being loaded from storage
-                pub fun hello(): String {
-                    return "Hello World!"
-                }
-            }
+	contractA := []byte(`
+      pub contract A {
+          pub let x: Int
 
-            init() {
-                // store nested resource in account on deployment
-                self.account.save(<-create R(), to: /storage/r)
-            }
-        }
+          init(x: Int) {
+              self.x = x
+          }
+
+          pub fun hello(): String {
+              return "Hello from A"
+          }
+      }
     `)
 
-	tx := []byte(`
-		import Test from 0x01
+	updatedContractA := []byte(`
+      pub contract A {
+          pub let x: Int
 
-		transaction {
+          init(x: Int) {
+              self.x = x
+          }
 
-			prepare(acct: AuthAccount) {
-				log(acct.borrow<&Test.R>(from: /storage/r)?.hello())
-			}
-		}
-	`)
+          pub fun hello(): String {
+              return "Hello from updated A"
+          }
+      }
+    `)
 
-	deploy := []byte(fmt.Sprintf(
-		`
-        transaction {
+	contractB := []byte(`
+      import A from 0x1
 
-            prepare(signer: AuthAccount) {
-                signer.setCode(%s)
-            }
-        }
-        `,
-		ArrayValueFromBytes(contract).String(),
-	))
+      pub contract B {
+          pub fun hello(): String {
+              return A.hello().concat(" via B")
+          }
+      }
+    `)
 
-	var accountCode []byte
-	var loggedMessage string
+	script := []byte(`
+      import A, B from 0x1
 
-	runtimeInterface := &testRuntimeInterface{
-		resolveImport: func(_ Location) (bytes []byte, err error) {
-			return accountCode, nil
-		},
-		storage: newTestStorage(),
-		getSigningAccounts: func() []Address {
-			return []Address{addressValue}
-		},
-		updateAccountCode: func(address Address, code []byte, checkPermission bool) (err error) {
-			accountCode = code
-			return nil
-		},
-		emitEvent: func(event cadence.Event) {},
-		log: func(message string) {
-			loggedMessage = message
-		},
+      pub fun main(): [String] {
+          log(A.x)
+          return [A.hello(), B.hello()]
+      }
+    `)
+
+	newTransaction := func(body string) []byte {
+		return []byte(fmt.Sprintf(
+			`
+              transaction {
+                  prepare(signer: AuthAccount) {
+                      %s
+                  }
+              }
+            `,
+			body,
+		))
 	}
 
-	err := runtime.ExecuteTransaction(deploy, nil, runtimeInterface, utils.TestLocation)
-	require.NoError(t, err)
+	addContract := func(name string, code []byte, arguments string) []byte {
+		return newTransaction(fmt.Sprintf(
+			`
+              let contract = signer.contracts.add(name: "%s", code: "%s".decodeHex()%s)
+              log(contract.name)
+            `,
+			name,
+			hex.EncodeToString(code),
+			arguments,
+		))
+	}
 
-	assert.NotNil(t, accountCode)
+	type testEnvironment struct {
+		runtime          Runtime
+		runtimeInterface *testRuntimeInterface
+		contracts        map[string][]byte
+		events           []cadence.Event
+		loggedMessages   []string
+	}
 
-	err = runtime.ExecuteTransaction(tx, nil, runtimeInterface, utils.TestLocation)
-	require.NoError(t, err)
+	newEnvironment := func() *testEnvironment {
+		env := &testEnvironment{
+			runtime:   NewInterpreterRuntime(),
+			contracts: map[string][]byte{},
+		}
 
-	assert.Equal(t, `"Hello World!"`, loggedMessage)
-}
+		contractKey := func(address Address, name string) string {
+			return fmt.Sprintf("%s.%s", address, name)
+		}
 
-const fungibleTokenContract = `
-pub contract FungibleToken {
+		env.runtimeInterface = &testRuntimeInterface{
+			resolveImport: func(location Location) ([]byte, error) {
+				return nil, fmt.Errorf("unknown import location: %s", location)
+			},
+			storage: newTestStorage(),
+			getSigningAccounts: func() []Address {
+				return []Address{address}
+			},
+			getAccountContractCode: func(address Address, name string) ([]byte, error) {
+				return env.contracts[contractKey(address, name)], nil
+			},
+			updateAccountContractCode: func(address Address, name string, code []byte) error {
+				env.contracts[contractKey(address, name)] = code
+				return nil
+			},
+			removeAccountContractCode: func(address Address, name string) error {
+				delete(env.contracts, contractKey(address, name))
+				return nil
+			},
+			emitEvent: func(event cadence.Event) {
+				env.events = append(env.events, event)
+			},
+			log: func(message string) {
+				env.loggedMessages = append(env.loggedMessages, message)
+			},
+		}
 
-    pub resource interface Provider {
-        pub fun withdraw(amount: Int): @Vault {
-            pre {
-                amount > 0:
-                    "Withdrawal amount must be positive"
-            }
-            post {
-                result.balance == amount:
-                    "Incorrect amount returned"
-            }
-        }
+		return env
+	}
+
+	t.Run("add, import, update, and remove", func(t *testing.T) {
+
+		env := newEnvironment()
+
+		err := env.runtime.ExecuteTransaction(
+			addContract("A", contractA, ", x: 42"),
+			nil,
+			env.runtimeInterface,
+			utils.TestLocation,
+		)
+		require.NoError(t, err)
+
+		err = env.runtime.ExecuteTransaction(
+			addContract("B", contractB, ""),
+			nil,
+			env.runtimeInterface,
+			utils.TestLocation,
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{`"A"`, `"B"`}, env.loggedMessages)
+		assert.Len(t, env.contracts, 2)
+
+		require.Len(t, env.events, 2)
+		assert.Equal(t, exportType(stdlib.AccountContractAddedEventType), env.events[0].Type())
+		assert.Equal(t, cadence.NewString("B"), env.events[1].Fields[2])
+
+		value, err := env.runtime.ExecuteScript(script, nil, env.runtimeInterface, utils.TestLocation)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			cadence.NewArray([]cadence.Value{
+				cadence.NewString("Hello from A"),
+				cadence.NewString("Hello from A via B"),
+			}),
+			value,
+		)
+
+		// Update A: the code is replaced, the contract value is kept
+
+		err = env.runtime.ExecuteTransaction(
+			newTransaction(fmt.Sprintf(
+				`signer.contracts.update(name: "A", code: "%s".decodeHex())`,
+				hex.EncodeToString(updatedContractA),
+			)),
+			nil,
+			env.runtimeInterface,
+			utils.TestLocation,
+		)
+		require.NoError(t, err)
+
+		require.Len(t, env.events, 3)
+		assert.Equal(t, exportType(stdlib.AccountContractUpdatedEventType), env.events[2].Type())
+
+		// NOTE: the field of the contract value is still the initial one
+
+		value, err = env.runtime.ExecuteScript(script, nil, env.runtimeInterface, utils.TestLocation)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			cadence.NewArray([]cadence.Value{
+				cadence.NewString("Hello from updated A"),
+				cadence.NewString("Hello from updated A via B"),
+			}),
+			value,
+		)
+
+		assert.Equal(t, []string{`"A"`, `"B"`, "42", "42"}, env.loggedMessages)
+
+		// Get and remove B
+
+		err = env.runtime.ExecuteTransaction(
+			newTransaction(`
+              log(signer.contracts.get(name: "B")?.name)
+              log(signer.contracts.remove(name: "B")?.name)
+              log(signer.contracts.get(name: "B")?.name)
+              log(signer.contracts.remove(name: "B")?.name)
+            `),
+			nil,
+			env.runtimeInterface,
+			utils.TestLocation,
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{`"B"`, `"B"`, "nil", "nil"}, env.loggedMessages[4:])
+
+		assert.NotContains(t, env.contracts, fmt.Sprintf("%s.B", address))
+
+		require.Len(t, env.events, 4)
+		assert.Equal(t, exportType(stdlib.AccountContractRemovedEventType), env.events[3].Type())
+
+		_, err = env.runtime.ExecuteScript(script, nil, env.runtimeInterface, utils.TestLocation)
+		require.Error(t, err)
+	})
+
+	t.Run("add existing", func(t *testing.T) {
+
+		env := newEnvironment()
+
+		tx := addContract("A", contractA, ", x: 1")
+
+		err := env.runtime.ExecuteTransaction(tx, nil, env.runtimeInterface, utils.TestLocation)
+		require.NoError(t, err)
+
+		err = env.runtime.ExecuteTransaction(tx, nil, env.runtimeInterface, utils.TestLocation)
+		require.Error(t, err)
+
+		require.IsType(t, Error{}, err)
+		err = err.(Error).Err
+
+		require.IsType(t, ContractAlreadyExistsError{}, err)
+	})
+
+	t.Run("update missing", func(t *testing.T) {
+
+		env := newEnvironment()
+
+		err := env.runtime.ExecuteTransaction(
+			newTransaction(fmt.Sprintf(
+				`signer.contracts.update(name: "A", code: "%s".decodeHex())`,
+				hex.EncodeToString(contractA),
+			)),
+			nil,
+			env.runtimeInterface,
+			utils.TestLocation,
+		)
+		require.Error(t, err)
+
+		require.IsType(t, Error{}, err)
+		err = err.(Error).Err
+
+		require.IsType(t, ContractNotFoundError{}, err)
+	})
+
+	t.Run("name mismatch", func(t *testing.T) {
+
+		env := newEnvironment()
+
+		err := env.runtime.ExecuteTransaction(
+			addContract("C", contractA, ", x: 1"),
+			nil,
+			env.runtimeInterface,
+			utils.TestLocation,
+		)
+		require.Error(t, err)
+
+		require.IsType(t, Error{}, err)
+		err = err.(Error).Err
+
+		assert.Equal(t,
+			InvalidContractDeploymentError{
+				Name:     "C",
+				Declared: []string{"A"},
+			},
+			err,
+		)
+
+		assert.Empty(t, env.contracts)
+	})
+}
+
+func TestRuntimeContractNestedResource(t *testing.T) {
+	runtime := NewInterpreterRuntime()
+
+	addressValue := Address{
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1,
+	}
+
+	contract := []byte(`
+        pub contract Test {
+            pub resource R {
+                // test that the hello function is linked back into the nested resource
+                // after being loaded from storage
+                pub fun hello(): String {
+                    return "Hello World!"
+                }
+            }
+
+            init() {
+                // store nested resource in account on deployment
+                self.account.save(<-create R(), to: /storage/r)
+            }
+        }
+    `)
+
+	tx := []byte(`
+		import Test from 0x01
+
+		transaction {
+
+			prepare(acct: AuthAccount) {
+				log(acct.borrow<&Test.R>(from: /storage/r)?.hello())
+			}
+		}
+	`)
+
+	deploy := []byte(fmt.Sprintf(
+		`
+        transaction {
+
+            prepare(signer: AuthAccount) {
+                signer.setCode(%s)
+            }
+        }
+        `,
+		ArrayValueFromBytes(contract).String(),
+	))
+
+	var accountCode []byte
+	var loggedMessage string
+
+	runtimeInterface := &testRuntimeInterface{
+		resolveImport: func(_ Location) (bytes []byte, err error) {
+			return accountCode, nil
+		},
+		storage: newTestStorage(),
+		getSigningAccounts: func() []Address {
+			return []Address{addressValue}
+		},
+		updateAccountCode: func(address Address, code []byte, checkPermission bool) (err error) {
+			accountCode = code
+			return nil
+		},
+		emitEvent: func(event cadence.Event) {},
+		log: func(message string) {
+			loggedMessage = message
+		},
+	}
+
+	err := runtime.ExecuteTransaction(deploy, nil, runtimeInterface, utils.TestLocation)
+	require.NoError(t, err)
+
+	assert.NotNil(t, accountCode)
+
+	err = runtime.ExecuteTransaction(tx, nil, runtimeInterface, utils.TestLocation)
+	require.NoError(t, err)
+
+	assert.Equal(t, `"Hello World!"`, loggedMessage)
+}
+
+const fungibleTokenContract = `
+pub contract FungibleToken {
+
+    pub resource interface Provider {
+        pub fun withdraw(amount: Int): @Vault {
+            pre {
+                amount > 0:
+                    "Withdrawal amount must be positive"
+            }
+            post {
+                result.balance == amount:
+                    "Incorrect amount returned"
+            }
+        }
     }
 
     pub resource interface Receiver {
@@ -2611,659 +3554,2296 @@ func TestRuntimeBlock(t *testing.T) {
 	)
 }
 
-func TestRuntimeTransactionTopLevelDeclarations(t *testing.T) {
+func TestRuntimeGetTypeOfAllValues(t *testing.T) {
+
+	runtime := NewInterpreterRuntime()
+
+	script := []byte(`
+      pub struct S {}
+
+      pub resource R {}
+
+      pub enum E: UInt8 {
+          pub case a
+      }
+
+      pub fun f() {}
+
+      transaction {
+        prepare(signer: AuthAccount) {
+          let s = S()
+          let r <- create R()
+          let optionalInt: Int? = 1
+          let optionalString: String? = nil
+          let void: AnyStruct = f()
+          let ufix: UFix64 = 1.5
+
+          let values: [AnyStruct] = [
+              void,
+              true,
+              "a",
+              1,
+              UInt8(1),
+              Word64(1),
+              -1.5,
+              ufix,
+              signer.address,
+              /storage/s,
+              [1, 2],
+              {"a": 1},
+              optionalInt,
+              optionalString,
+              s,
+              &s as &S,
+              E.a,
+              Type<Int>(),
+              f,
+              log,
+              getCurrentBlock(),
+              Crypto,
+              signer,
+              signer.contracts,
+              signer.keys,
+              getAccount(0x1),
+              getAccount(0x1).keys,
+              signer.getCapability(/public/s)!,
+              signer.keys.get(keyIndex: 0)!
+          ]
+
+          for value in values {
+              log(value.getType().identifier)
+          }
+
+          log(r.getType().identifier)
+          destroy r
+        }
+      }
+    `)
+
+	var loggedMessages []string
+
+	runtimeInterface := &testRuntimeInterface{
+		storage: newTestStorage(),
+		getSigningAccounts: func() []Address {
+			return []Address{{42}}
+		},
+		getAccountKey: func(address Address, keyIndex int) (*AccountKey, error) {
+			return &AccountKey{
+				KeyIndex:           keyIndex,
+				PublicKey:          []byte{1, 2, 3},
+				SignatureAlgorithm: crypto.SignatureAlgorithmECDSA_P256,
+				HashAlgorithm:      crypto.HashAlgorithmSHA3_256,
+			}, nil
+		},
+		log: func(message string) {
+			loggedMessages = append(loggedMessages, message)
+		},
+	}
+
+	err := runtime.ExecuteTransaction(script, nil, runtimeInterface, utils.TestLocation)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		[]string{
+			`"Void"`,
+			`"Bool"`,
+			`"String"`,
+			`"Int"`,
+			`"UInt8"`,
+			`"Word64"`,
+			`"Fix64"`,
+			`"UFix64"`,
+			`"Address"`,
+			`"Path"`,
+			`"[Int]"`,
+			`"{String:Int}"`,
+			`"Int?"`,
+			`"Never?"`,
+			`"test.S"`,
+			`"&test.S"`,
+			`"test.E"`,
+			`"Type"`,
+			`"(():Void)"`,
+			// NOTE: the function type of host functions is not available
+			`"AnyStruct"`,
+			`"Block"`,
+			`"Crypto"`,
+			`"AuthAccount"`,
+			`"AuthAccount.Contracts"`,
+			`"AuthAccount.Keys"`,
+			`"PublicAccount"`,
+			`"PublicAccount.Keys"`,
+			`"Capability"`,
+			`"AccountKey"`,
+			`"test.R"`,
+		},
+		loggedMessages,
+	)
+}
+
+func TestRuntimeCrypto(t *testing.T) {
+
+	t.Run("hash", func(t *testing.T) {
 
-	t.Run("transaction with function", func(t *testing.T) {
 		runtime := NewInterpreterRuntime()
 
 		script := []byte(`
-          pub fun test() {}
-
-          transaction {}
+          pub fun main(): [UInt8] {
+              let data: [UInt8] = [UInt8(1), UInt8(2), UInt8(3)]
+              return Crypto.hash(data, algorithm: HashAlgorithm.SHA3_256)
+          }
         `)
 
+		called := false
+
 		runtimeInterface := &testRuntimeInterface{
-			getSigningAccounts: func() []Address {
-				return nil
+			hash: func(data []byte, hashAlgorithm HashAlgorithm) ([]byte, error) {
+				called = true
+				assert.Equal(t, []byte{1, 2, 3}, data)
+				assert.Equal(t, crypto.HashAlgorithmSHA3_256, hashAlgorithm)
+				return []byte{4, 5, 6}, nil
 			},
 		}
 
-		err := runtime.ExecuteTransaction(script, nil, runtimeInterface, TransactionLocation{})
+		value, err := runtime.ExecuteScript(script, nil, runtimeInterface, utils.TestLocation)
 		require.NoError(t, err)
+
+		assert.True(t, called)
+		assert.Equal(t,
+			cadence.NewArray([]cadence.Value{
+				cadence.NewUInt8(4),
+				cadence.NewUInt8(5),
+				cadence.NewUInt8(6),
+			}),
+			value,
+		)
 	})
 
-	t.Run("transaction with resource", func(t *testing.T) {
+	t.Run("hash, default implementation", func(t *testing.T) {
+
 		runtime := NewInterpreterRuntime()
 
 		script := []byte(`
-          pub resource R {}
-
-          transaction {}
+          pub fun main(): [UInt8] {
+              let data: [UInt8] = [UInt8(0x61), UInt8(0x62), UInt8(0x63)]
+              return Crypto.hash(data, algorithm: HashAlgorithm.SHA2_256)
+          }
         `)
 
-		runtimeInterface := &testRuntimeInterface{
-			getSigningAccounts: func() []Address {
-				return nil
-			},
-		}
-
-		err := runtime.ExecuteTransaction(script, nil, runtimeInterface, TransactionLocation{})
-		require.Error(t, err)
+		value, err := runtime.ExecuteScript(script, nil, &testRuntimeInterface{}, utils.TestLocation)
+		require.NoError(t, err)
 
-		require.IsType(t, Error{}, err)
-		err = err.(Error).Unwrap()
+		expected, err := crypto.Hash([]byte("abc"), crypto.HashAlgorithmSHA2_256)
+		require.NoError(t, err)
 
-		errs := utils.ExpectCheckerErrors(t, err, 1)
+		expectedValues := make([]cadence.Value, len(expected))
+		for i, b := range expected {
+			expectedValues[i] = cadence.NewUInt8(b)
+		}
 
-		assert.IsType(t, &sema.InvalidTopLevelDeclarationError{}, errs[0])
+		assert.Equal(t, cadence.NewArray(expectedValues), value)
 	})
-}
 
-func TestRuntimeStoreIntegerTypes(t *testing.T) {
+	t.Run("verifySignature", func(t *testing.T) {
 
-	runtime := NewInterpreterRuntime()
+		runtime := NewInterpreterRuntime()
 
-	addressValue := interpreter.AddressValue{
-		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xCA, 0xDE,
-	}
+		script := []byte(`
+          pub fun main(): Bool {
+              let signature: [UInt8] = [UInt8(1), UInt8(2)]
+              let signedData: [UInt8] = [UInt8(3), UInt8(4)]
+              let publicKey: [UInt8] = [UInt8(5), UInt8(6)]
+
+              return Crypto.verifySignature(
+                  signature: signature,
+                  tag: "FLOW-V0.0-user",
+                  signedData: signedData,
+                  publicKey: publicKey,
+                  signatureAlgorithm: SignatureAlgorithm.ECDSA_Secp256k1,
+                  hashAlgorithm: HashAlgorithm.SHA2_384
+              )
+          }
+        `)
 
-	for _, integerType := range sema.AllIntegerTypes {
+		called := false
 
-		typeName := integerType.String()
+		runtimeInterface := &testRuntimeInterface{
+			verifySignature: func(
+				signature []byte,
+				tag string,
+				signedData []byte,
+				publicKey []byte,
+				signatureAlgorithm SignatureAlgorithm,
+				hashAlgorithm HashAlgorithm,
+			) (bool, error) {
+				called = true
+				assert.Equal(t, []byte{1, 2}, signature)
+				assert.Equal(t, "FLOW-V0.0-user", tag)
+				assert.Equal(t, []byte{3, 4}, signedData)
+				assert.Equal(t, []byte{5, 6}, publicKey)
+				assert.Equal(t, crypto.SignatureAlgorithmECDSA_Secp256k1, signatureAlgorithm)
+				assert.Equal(t, crypto.HashAlgorithmSHA2_384, hashAlgorithm)
+				return true, nil
+			},
+		}
 
-		t.Run(typeName, func(t *testing.T) {
+		value, err := runtime.ExecuteScript(script, nil, runtimeInterface, utils.TestLocation)
+		require.NoError(t, err)
 
-			contract := []byte(
-				fmt.Sprintf(
-					`
-                      pub contract Test {
+		assert.True(t, called)
+		assert.Equal(t, cadence.NewBool(true), value)
+	})
 
-                          pub let n: %s
+	t.Run("computation", func(t *testing.T) {
 
-                          init() {
-                              self.n = 42
-                          }
-                      }
-                    `,
-					typeName,
-				),
-			)
+		runtime := NewInterpreterRuntime()
 
-			deploy := []byte(
-				fmt.Sprintf(
-					`
-                      transaction {
+		var used uint64
 
-                          prepare(signer: AuthAccount) {
-                              signer.setCode(%s)
-                          }
-                      }
-                    `,
-					ArrayValueFromBytes(contract).String(),
-				),
-			)
+		runtimeInterface := &testRuntimeInterface{
+			hash: func(data []byte, hashAlgorithm HashAlgorithm) ([]byte, error) {
+				return nil, nil
+			},
+			verifySignature: func(
+				signature []byte,
+				tag string,
+				signedData []byte,
+				publicKey []byte,
+				signatureAlgorithm SignatureAlgorithm,
+				hashAlgorithm HashAlgorithm,
+			) (bool, error) {
+				return true, nil
+			},
+			computationWeights: ComputationWeights{
+				common.ComputationKindCryptoOperation: 1,
+			},
+			setComputationUsed: func(computationUsed uint64) {
+				used = computationUsed
+			},
+		}
 
-			var accountCode []byte
-			var events []cadence.Event
+		script := []byte(`
+          pub fun main(): Bool {
+              let data: [UInt8] = []
+              var i = 0
+              while i < 1000 {
+                  data.append(UInt8(i % 256))
+                  i = i + 1
+              }
 
-			runtimeInterface := &testRuntimeInterface{
-				resolveImport: func(_ Location) (bytes []byte, err error) {
-					return accountCode, nil
-				},
-				storage: newTestStorage(),
-				getSigningAccounts: func() []Address {
-					return []Address{addressValue.ToAddress()}
-				},
-				updateAccountCode: func(address Address, code []byte, checkPermission bool) (err error) {
-					accountCode = code
-					return nil
-				},
-				emitEvent: func(event cadence.Event) {
-					events = append(events, event)
-				},
-			}
+              Crypto.hash(data, algorithm: HashAlgorithm.SHA3_256)
 
-			err := runtime.ExecuteTransaction(deploy, nil, runtimeInterface, utils.TestLocation)
-			require.NoError(t, err)
+              return Crypto.verifySignature(
+                  signature: [UInt8(1), UInt8(2)],
+                  tag: "tag",
+                  signedData: data,
+                  publicKey: [UInt8(3), UInt8(4)],
+                  signatureAlgorithm: SignatureAlgorithm.ECDSA_P256,
+                  hashAlgorithm: HashAlgorithm.SHA3_256
+              )
+          }
+        `)
 
-			assert.NotNil(t, accountCode)
-		})
-	}
-}
+		_, err := runtime.ExecuteScript(script, nil, runtimeInterface, utils.TestLocation)
+		require.NoError(t, err)
 
-func TestInterpretResourceOwnerFieldUseComposite(t *testing.T) {
+		// 1000 bytes hashed, 2 + 3 + 1000 + 2 bytes verified
+		assert.Equal(t, uint64(1000+1007), used)
 
-	runtime := NewInterpreterRuntime()
+		const limit = 1500
 
-	address := Address{
-		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1,
-	}
+		runtimeInterface.computationLimit = limit
 
-	contract := []byte(`
-      pub contract Test {
+		_, err = runtime.ExecuteScript(script, nil, runtimeInterface, utils.TestLocation)
+		require.Error(t, err)
 
-          pub resource R {
+		require.IsType(t, Error{}, err)
+		err = err.(Error).Unwrap()
 
-              pub fun logOwnerAddress() {
-                log(self.owner?.address)
-              }
-          }
+		assert.Equal(t,
+			ComputationLimitExceededError{
+				Limit: limit,
+			},
+			err,
+		)
+	})
 
-          pub fun createR(): @R {
-              return <-create R()
-          }
-      }
-    `)
+	t.Run("verifySignature, default implementation", func(t *testing.T) {
 
-	deploy := []byte(
-		fmt.Sprintf(
-			`
-              transaction {
+		runtime := NewInterpreterRuntime()
 
-                  prepare(signer: AuthAccount) {
-                      signer.setCode(%s)
-                  }
+		privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+
+		const tag = "FLOW-V0.0-user"
+		signedData := []byte{1, 2, 3}
+
+		message := make([]byte, crypto.DomainTagLength)
+		copy(message, tag)
+		message = append(message, signedData...)
+
+		digest, err := crypto.Hash(message, crypto.HashAlgorithmSHA3_256)
+		require.NoError(t, err)
+
+		r, s, err := ecdsa.Sign(rand.Reader, privateKey, digest)
+		require.NoError(t, err)
+
+		byteArrayLiteral := func(ints ...*big.Int) string {
+			elements := make([]string, 0, 64)
+			for _, i := range ints {
+				bytes := make([]byte, 32)
+				i.FillBytes(bytes)
+				for _, b := range bytes {
+					elements = append(elements, fmt.Sprintf("UInt8(%d)", b))
+				}
+			}
+			return fmt.Sprintf("[%s]", strings.Join(elements, ", "))
+		}
+
+		script := []byte(fmt.Sprintf(
+			`
+              pub fun main(): [Bool] {
+                  let signature: [UInt8] = %s
+                  let publicKey: [UInt8] = %s
+                  let signedData: [UInt8] = [UInt8(1), UInt8(2), UInt8(3)]
+                  let otherData: [UInt8] = [UInt8(1), UInt8(2)]
+
+                  return [
+                      Crypto.verifySignature(
+                          signature: signature,
+                          tag: "FLOW-V0.0-user",
+                          signedData: signedData,
+                          publicKey: publicKey,
+                          signatureAlgorithm: SignatureAlgorithm.ECDSA_P256,
+                          hashAlgorithm: HashAlgorithm.SHA3_256
+                      ),
+                      Crypto.verifySignature(
+                          signature: signature,
+                          tag: "FLOW-V0.0-user",
+                          signedData: otherData,
+                          publicKey: publicKey,
+                          signatureAlgorithm: SignatureAlgorithm.ECDSA_P256,
+                          hashAlgorithm: HashAlgorithm.SHA3_256
+                      )
+                  ]
               }
             `,
-			ArrayValueFromBytes(contract).String(),
-		),
-	)
+			byteArrayLiteral(r, s),
+			byteArrayLiteral(privateKey.X, privateKey.Y),
+		))
 
-	tx := []byte(`
-      import Test from 0x1
+		value, err := runtime.ExecuteScript(script, nil, &testRuntimeInterface{}, utils.TestLocation)
+		require.NoError(t, err)
 
-      transaction {
+		assert.Equal(t,
+			cadence.NewArray([]cadence.Value{
+				cadence.NewBool(true),
+				cadence.NewBool(false),
+			}),
+			value,
+		)
+	})
 
-          prepare(signer: AuthAccount) {
+	t.Run("verifySignature, error", func(t *testing.T) {
 
-              let r <- Test.createR()
-              log(r.owner?.address)
-              r.logOwnerAddress()
+		runtime := NewInterpreterRuntime()
 
-              signer.save(<-r, to: /storage/r)
-              signer.link<&Test.R>(/public/r, target: /storage/r)
+		script := []byte(`
+          pub fun main(): Bool {
+              let empty: [UInt8] = []
+
+              return Crypto.verifySignature(
+                  signature: empty,
+                  tag: "this tag is definitely longer than 32 bytes",
+                  signedData: empty,
+                  publicKey: empty,
+                  signatureAlgorithm: SignatureAlgorithm.ECDSA_P256,
+                  hashAlgorithm: HashAlgorithm.SHA3_256
+              )
+          }
+        `)
 
-              let ref1 = signer.borrow<&Test.R>(from: /storage/r)!
-              log(ref1.owner?.address)
-              ref1.logOwnerAddress()
+		_, err := runtime.ExecuteScript(script, nil, &testRuntimeInterface{}, utils.TestLocation)
+		require.Error(t, err)
 
-              let publicAccount = getAccount(0x01)
-              let ref2 = publicAccount.getCapability(/public/r)!.borrow<&Test.R>()!
-              log(ref2.owner?.address)
-              ref2.logOwnerAddress()
+		require.IsType(t, Error{}, err)
+		assert.IsType(t, crypto.InvalidDomainTagError{}, err.(Error).Err)
+	})
+
+	t.Run("algorithm enums", func(t *testing.T) {
+
+		runtime := NewInterpreterRuntime()
+
+		script := []byte(`
+          pub fun main(): [UInt8] {
+              let algorithm: HashAlgorithm = HashAlgorithm(rawValue: 2)!
+              assert(algorithm == HashAlgorithm.SHA3_256)
+              assert(HashAlgorithm(rawValue: 4) == nil)
+              assert(SignatureAlgorithm(rawValue: 1) == SignatureAlgorithm.ECDSA_Secp256k1)
+
+              return [
+                  HashAlgorithm.SHA2_256.rawValue,
+                  HashAlgorithm.SHA3_384.rawValue,
+                  SignatureAlgorithm.ECDSA_P256.rawValue
+              ]
           }
-      }
-    `)
+        `)
 
-	tx2 := []byte(`
-      import Test from 0x1
+		value, err := runtime.ExecuteScript(script, nil, &testRuntimeInterface{}, utils.TestLocation)
+		require.NoError(t, err)
 
-      transaction {
+		assert.Equal(t,
+			cadence.NewArray([]cadence.Value{
+				cadence.NewUInt8(0),
+				cadence.NewUInt8(3),
+				cadence.NewUInt8(0),
+			}),
+			value,
+		)
+	})
 
-          prepare(signer: AuthAccount) {
-              let ref1 = signer.borrow<&Test.R>(from: /storage/r)!
-              log(ref1.owner?.address)
-              ref1.logOwnerAddress()
+	t.Run("type", func(t *testing.T) {
 
-              let publicAccount = getAccount(0x01)
-              let ref2 = publicAccount.getCapability(/public/r)!.borrow<&Test.R>()!
-              log(ref2.owner?.address)
-              ref2.logOwnerAddress()
+		runtime := NewInterpreterRuntime()
+
+		script := []byte(`
+          pub fun main(): String {
+              assert(Crypto.isInstance(Type<AnyStruct>()))
+              assert(!Crypto.isInstance(Type<@AnyResource>()))
+
+              return Crypto.getType().identifier
           }
-      }
-    `)
+        `)
 
-	accountCodes := map[string][]byte{}
-	var events []cadence.Event
+		value, err := runtime.ExecuteScript(script, nil, &testRuntimeInterface{}, utils.TestLocation)
+		require.NoError(t, err)
+
+		assert.Equal(t, cadence.NewString("Crypto"), value)
+	})
+
+	t.Run("invalid algorithm", func(t *testing.T) {
+
+		runtime := NewInterpreterRuntime()
+
+		script := []byte(`
+          pub fun main(): [UInt8] {
+              let data: [UInt8] = []
+              return Crypto.hash(data, algorithm: SignatureAlgorithm.ECDSA_P256)
+          }
+        `)
+
+		_, err := runtime.ExecuteScript(script, nil, &testRuntimeInterface{}, utils.TestLocation)
+		require.Error(t, err)
+
+		errs := utils.ExpectCheckerErrors(t, err.(Error).Unwrap(), 1)
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+}
+
+func TestRuntimeStorageEnumeration(t *testing.T) {
+
+	runtime := NewInterpreterRuntime()
 
 	var loggedMessages []string
 
 	runtimeInterface := &testRuntimeInterface{
-		resolveImport: func(location Location) (bytes []byte, err error) {
-			key := string(location.(AddressLocation).ID())
-			return accountCodes[key], nil
-		},
 		storage: newTestStorage(),
 		getSigningAccounts: func() []Address {
-			return []Address{address}
-		},
-		updateAccountCode: func(address Address, code []byte, checkPermission bool) (err error) {
-			key := string(AddressLocation(address[:]).ID())
-			accountCodes[key] = code
-			return nil
-		},
-		emitEvent: func(event cadence.Event) {
-			events = append(events, event)
+			return []Address{{42}}
 		},
 		log: func(message string) {
 			loggedMessages = append(loggedMessages, message)
 		},
 	}
 
-	err := runtime.ExecuteTransaction(deploy, nil, runtimeInterface, utils.TestLocation)
-	require.NoError(t, err)
+	setup := []byte(`
+      transaction {
+        prepare(signer: AuthAccount) {
+          signer.save(1, to: /storage/a)
+          signer.save("b", to: /storage/b)
+          signer.link<&Int>(/public/a, target: /storage/a)
+          signer.link<&String>(/private/b, target: /storage/b)
+        }
+      }
+    `)
 
-	err = runtime.ExecuteTransaction(tx, nil, runtimeInterface, utils.TestLocation)
+	err := runtime.ExecuteTransaction(setup, nil, runtimeInterface, utils.TestLocation)
 	require.NoError(t, err)
 
-	assert.Equal(t,
-		[]string{
-			"nil", "nil",
-			"0x1", "0x1",
-			"0x1", "0x1",
-		},
-		loggedMessages,
-	)
+	// The paths of values which were saved and loaded in the same transaction,
+	// but not yet written back to storage, are respected
 
-	loggedMessages = nil
-	err = runtime.ExecuteTransaction(tx2, nil, runtimeInterface, utils.TestLocation)
+	enumerate := []byte(`
+      transaction {
+        prepare(signer: AuthAccount) {
+          signer.save(true, to: /storage/c)
+          signer.load<Int>(from: /storage/a)
+          signer.unlink(/public/a)
+
+          log(signer.storagePaths)
+          log(signer.publicPaths)
+          log(signer.privatePaths)
+
+          signer.forEachStored(fun (path: Path, type: Type): Bool {
+              log(path)
+              log(type == Type<Bool>())
+              return true
+          })
+        }
+      }
+    `)
+
+	err = runtime.ExecuteTransaction(enumerate, nil, runtimeInterface, utils.TestLocation)
 	require.NoError(t, err)
 
 	assert.Equal(t,
 		[]string{
-			"0x1", "0x1",
-			"0x1", "0x1",
+			"[/storage/b, /storage/c]",
+			"[]",
+			"[/private/b]",
+			"/storage/b",
+			"false",
+			"/storage/c",
+			"true",
 		},
 		loggedMessages,
 	)
 }
 
-func TestInterpretResourceOwnerFieldUseArray(t *testing.T) {
+func TestRuntimeStorageUsed(t *testing.T) {
 
 	runtime := NewInterpreterRuntime()
 
-	address := Address{
-		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1,
-	}
+	address := common.BytesToAddress([]byte{42})
 
-	contract := []byte(`
-      pub contract Test {
+	var capacity uint64 = math.MaxUint64
+	var loggedMessages []string
 
-          pub resource R {
+	runtimeInterface := &testRuntimeInterface{
+		storage: newTestStorage(),
+		getSigningAccounts: func() []Address {
+			return []Address{address}
+		},
+		getStorageCapacity: func(owner Address) (uint64, error) {
+			assert.Equal(t, address, owner)
+			return capacity, nil
+		},
+		log: func(message string) {
+			loggedMessages = append(loggedMessages, message)
+		},
+	}
 
-              pub fun logOwnerAddress() {
-                log(self.owner?.address)
-              }
+	getStorageUsed := func() cadence.Value {
+		script := []byte(`
+          pub fun main(): UInt64 {
+              return getAccount(0x2a).storageUsed
           }
+        `)
 
-          pub fun createR(): @R {
-              return <-create R()
+		value, err := runtime.ExecuteScript(script, nil, runtimeInterface, utils.TestLocation)
+		require.NoError(t, err)
+
+		return value
+	}
+
+	data, err := interpreter.EncodeValue(interpreter.NewStringValue("Hello, world!"))
+	require.NoError(t, err)
+
+	storageUsed := uint64(len(data))
+
+	t.Run("save", func(t *testing.T) {
+
+		loggedMessages = nil
+
+		// The storage used includes values which are not written back to storage yet
+
+		script := []byte(`
+          transaction {
+            prepare(signer: AuthAccount) {
+              log(signer.storageUsed)
+              signer.save("Hello, world!", to: /storage/message)
+              log(signer.storageUsed)
+            }
           }
-      }
-    `)
+        `)
 
-	deploy := []byte(
-		fmt.Sprintf(
-			`
-              transaction {
+		err := runtime.ExecuteTransaction(script, nil, runtimeInterface, utils.TestLocation)
+		require.NoError(t, err)
 
-                  prepare(signer: AuthAccount) {
-                      signer.setCode(%s)
-                  }
-              }
-            `,
-			ArrayValueFromBytes(contract).String(),
-		),
-	)
+		assert.Equal(t,
+			[]string{"0", fmt.Sprint(storageUsed)},
+			loggedMessages,
+		)
 
-	tx := []byte(`
-      import Test from 0x1
+		assert.Equal(t, cadence.NewUInt64(storageUsed), getStorageUsed())
+	})
 
-      transaction {
+	t.Run("capacity", func(t *testing.T) {
 
-          prepare(signer: AuthAccount) {
+		capacity = 1000
 
-              let rs <- [
-                  <-Test.createR(),
-                  <-Test.createR()
-              ]
-              log(rs[0].owner?.address)
-              log(rs[1].owner?.address)
-              rs[0].logOwnerAddress()
-              rs[1].logOwnerAddress()
+		script := []byte(`
+          pub fun main(): UInt64 {
+              return getAccount(0x2a).storageCapacity
+          }
+        `)
 
-              signer.save(<-rs, to: /storage/rs)
-              signer.link<&[Test.R]>(/public/rs, target: /storage/rs)
+		value, err := runtime.ExecuteScript(script, nil, runtimeInterface, utils.TestLocation)
+		require.NoError(t, err)
 
-              let ref1 = signer.borrow<&[Test.R]>(from: /storage/rs)!
-              log(ref1[0].owner?.address)
-              log(ref1[1].owner?.address)
-              ref1[0].logOwnerAddress()
-              ref1[1].logOwnerAddress()
+		assert.Equal(t, cadence.NewUInt64(1000), value)
+	})
 
-              let publicAccount = getAccount(0x01)
-              let ref2 = publicAccount.getCapability(/public/rs)!.borrow<&[Test.R]>()!
-              log(ref2[0].owner?.address)
-              log(ref2[1].owner?.address)
-              ref2[0].logOwnerAddress()
-              ref2[1].logOwnerAddress()
+	t.Run("capacity exceeded", func(t *testing.T) {
+
+		capacity = storageUsed
+
+		script := []byte(`
+          transaction {
+            prepare(signer: AuthAccount) {
+              signer.save(1, to: /storage/one)
+            }
           }
-      }
-    `)
+        `)
 
-	tx2 := []byte(`
-      import Test from 0x1
+		err := runtime.ExecuteTransaction(script, nil, runtimeInterface, utils.TestLocation)
+		require.Error(t, err)
 
-      transaction {
+		require.IsType(t, Error{}, err)
+		err = err.(Error).Unwrap()
 
-          prepare(signer: AuthAccount) {
-              let ref1 = signer.borrow<&[Test.R]>(from: /storage/rs)!
-              log(ref1[0].owner?.address)
-              log(ref1[1].owner?.address)
-              ref1[0].logOwnerAddress()
-              ref1[1].logOwnerAddress()
+		require.IsType(t, StorageCapacityExceededError{}, err)
+		capacityErr := err.(StorageCapacityExceededError)
+		assert.Equal(t, address, capacityErr.Address)
+		assert.Equal(t, storageUsed, capacityErr.StorageCapacity)
+		assert.Greater(t, capacityErr.StorageUsed, storageUsed)
 
-              let publicAccount = getAccount(0x01)
-              let ref2 = publicAccount.getCapability(/public/rs)!.borrow<&[Test.R]>()!
-              log(ref2[0].owner?.address)
-              log(ref2[1].owner?.address)
-              ref2[0].logOwnerAddress()
-              ref2[1].logOwnerAddress()
+		// Nothing was written
+
+		assert.Equal(t, cadence.NewUInt64(storageUsed), getStorageUsed())
+	})
+
+	t.Run("free up storage beyond capacity", func(t *testing.T) {
+
+		capacity = 0
+
+		script := []byte(`
+          transaction {
+            prepare(signer: AuthAccount) {
+              signer.load<String>(from: /storage/message)
+            }
           }
-      }
-    `)
+        `)
 
-	accountCodes := map[string][]byte{}
-	var events []cadence.Event
+		err := runtime.ExecuteTransaction(script, nil, runtimeInterface, utils.TestLocation)
+		require.NoError(t, err)
 
-	var loggedMessages []string
+		assert.Equal(t, cadence.NewUInt64(0), getStorageUsed())
+	})
+}
+
+func TestRuntimeAccountKeys(t *testing.T) {
+
+	runtime := NewInterpreterRuntime()
+
+	var keys []*AccountKey
+	var events []cadence.Event
 
 	runtimeInterface := &testRuntimeInterface{
-		resolveImport: func(location Location) (bytes []byte, err error) {
-			key := string(location.(AddressLocation).ID())
-			return accountCodes[key], nil
-		},
 		storage: newTestStorage(),
 		getSigningAccounts: func() []Address {
-			return []Address{address}
+			return []Address{{42}}
 		},
-		updateAccountCode: func(address Address, code []byte, checkPermission bool) (err error) {
-			key := string(AddressLocation(address[:]).ID())
-			accountCodes[key] = code
-			return nil
+		addStructuredAccountKey: func(
+			address Address,
+			publicKey []byte,
+			signatureAlgorithm SignatureAlgorithm,
+			hashAlgorithm HashAlgorithm,
+			weight uint64,
+		) (*AccountKey, error) {
+			key := &AccountKey{
+				KeyIndex:           len(keys),
+				PublicKey:          publicKey,
+				SignatureAlgorithm: signatureAlgorithm,
+				HashAlgorithm:      hashAlgorithm,
+				Weight:             weight,
+			}
+			keys = append(keys, key)
+			return key, nil
+		},
+		getAccountKey: func(address Address, keyIndex int) (*AccountKey, error) {
+			assert.GreaterOrEqual(t, keyIndex, 0)
+			if keyIndex >= len(keys) {
+				return nil, nil
+			}
+			return keys[keyIndex], nil
+		},
+		revokeAccountKey: func(address Address, keyIndex int) (*AccountKey, error) {
+			assert.GreaterOrEqual(t, keyIndex, 0)
+			if keyIndex >= len(keys) {
+				return nil, nil
+			}
+			keys[keyIndex].IsRevoked = true
+			return keys[keyIndex], nil
 		},
 		emitEvent: func(event cadence.Event) {
 			events = append(events, event)
 		},
-		log: func(message string) {
-			loggedMessages = append(loggedMessages, message)
-		},
 	}
 
-	err := runtime.ExecuteTransaction(deploy, nil, runtimeInterface, utils.TestLocation)
-	require.NoError(t, err)
+	t.Run("add", func(t *testing.T) {
 
-	err = runtime.ExecuteTransaction(tx, nil, runtimeInterface, utils.TestLocation)
-	require.NoError(t, err)
+		script := []byte(`
+          transaction {
+            prepare(signer: AuthAccount) {
+              let publicKey: [UInt8] = [UInt8(1), UInt8(2), UInt8(3)]
+              let key = signer.keys.add(
+                  publicKey: publicKey,
+                  signatureAlgorithm: SignatureAlgorithm.ECDSA_Secp256k1,
+                  hashAlgorithm: HashAlgorithm.SHA3_256,
+                  weight: 1000.5
+              )
+              assert(key.keyIndex == 0)
+              let weight: UFix64 = 1000.5
+              assert(key.weight == weight)
+              assert(key.hashAlgorithm == HashAlgorithm.SHA3_256)
+              assert(!key.isRevoked)
+            }
+          }
+        `)
 
-	assert.Equal(t,
-		[]string{
-			"nil", "nil",
-			"nil", "nil",
-			"0x1", "0x1",
-			"0x1", "0x1",
-			"0x1", "0x1",
-			"0x1", "0x1",
-		},
-		loggedMessages,
-	)
+		err := runtime.ExecuteTransaction(script, nil, runtimeInterface, utils.TestLocation)
+		require.NoError(t, err)
 
-	loggedMessages = nil
-	err = runtime.ExecuteTransaction(tx2, nil, runtimeInterface, utils.TestLocation)
-	require.NoError(t, err)
+		require.Len(t, keys, 1)
+		assert.Equal(t,
+			&AccountKey{
+				KeyIndex:           0,
+				PublicKey:          []byte{1, 2, 3},
+				SignatureAlgorithm: crypto.SignatureAlgorithmECDSA_Secp256k1,
+				HashAlgorithm:      crypto.HashAlgorithmSHA3_256,
+				Weight:             1000_50000000,
+			},
+			keys[0],
+		)
 
-	assert.Equal(t,
-		[]string{
-			"0x1", "0x1",
-			"0x1", "0x1",
-			"0x1", "0x1",
-			"0x1", "0x1",
-		},
-		loggedMessages,
-	)
-}
+		require.Len(t, events, 1)
+		assert.Equal(t, "flow.AccountStructuredKeyAdded", events[0].EventType.ID())
+		assert.Equal(t, cadence.NewInt(0), events[0].Fields[1])
+		assert.Equal(t, cadence.NewUFix64(1000_50000000), events[0].Fields[5])
+	})
 
-func TestInterpretResourceOwnerFieldUseDictionary(t *testing.T) {
+	t.Run("get", func(t *testing.T) {
 
-	runtime := NewInterpreterRuntime()
+		script := []byte(`
+          pub fun main(): [UInt8] {
+              let keys = getAccount(0x2a).keys
+              assert(keys.get(keyIndex: 1) == nil)
+              assert(keys.get(keyIndex: -1) == nil)
+              assert(keys.get(keyIndex: 0x1_0000_0000_0000_0000) == nil)
+              let key = keys.get(keyIndex: 0)!
+              assert(key.signatureAlgorithm == SignatureAlgorithm.ECDSA_Secp256k1)
+              return key.publicKey
+          }
+        `)
 
-	address := Address{
-		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1,
-	}
+		value, err := runtime.ExecuteScript(script, nil, runtimeInterface, utils.TestLocation)
+		require.NoError(t, err)
 
-	contract := []byte(`
-      pub contract Test {
+		assert.Equal(t,
+			cadence.NewArray([]cadence.Value{
+				cadence.NewUInt8(1),
+				cadence.NewUInt8(2),
+				cadence.NewUInt8(3),
+			}),
+			value,
+		)
+	})
 
-          pub resource R {
+	t.Run("revoke", func(t *testing.T) {
 
-              pub fun logOwnerAddress() {
-                log(self.owner?.address)
-              }
-          }
+		events = nil
 
-          pub fun createR(): @R {
-              return <-create R()
+		script := []byte(`
+          transaction {
+            prepare(signer: AuthAccount) {
+              assert(signer.keys.revoke(keyIndex: 1) == nil)
+              assert(signer.keys.revoke(keyIndex: -1) == nil)
+              assert(signer.keys.revoke(keyIndex: 0x1_0000_0000_0000_0000) == nil)
+              let key = signer.keys.revoke(keyIndex: 0)!
+              assert(key.isRevoked)
+              assert(signer.keys.get(keyIndex: 0)!.isRevoked)
+            }
           }
-      }
-    `)
+        `)
 
-	deploy := []byte(
-		fmt.Sprintf(
-			`
-              transaction {
+		err := runtime.ExecuteTransaction(script, nil, runtimeInterface, utils.TestLocation)
+		require.NoError(t, err)
 
-                  prepare(signer: AuthAccount) {
-                      signer.setCode(%s)
-                  }
-              }
-            `,
-			ArrayValueFromBytes(contract).String(),
-		),
-	)
+		assert.True(t, keys[0].IsRevoked)
 
-	tx := []byte(`
-      import Test from 0x1
+		require.Len(t, events, 1)
+		assert.Equal(t, "flow.AccountKeyRevoked", events[0].EventType.ID())
+	})
 
-      transaction {
+	t.Run("events", func(t *testing.T) {
 
-          prepare(signer: AuthAccount) {
+		runtimeInterface.addAccountKey = func(address Address, publicKey []byte) error {
+			return nil
+		}
 
-              let rs <- {
-                  "a": <-Test.createR(),
-                  "b": <-Test.createR()
-              }
-              log(rs["a"]?.owner?.address)
-              log(rs["b"]?.owner?.address)
-              rs["a"]?.logOwnerAddress()
-              rs["b"]?.logOwnerAddress()
+		for _, testCase := range []struct {
+			name              string
+			code              string
+			expectedEventType string
+			expectedFields    int
+		}{
+			{
+				name:              "keys.add",
+				code:              `signer.keys.add(publicKey: [UInt8(1), UInt8(2)], signatureAlgorithm: SignatureAlgorithm.ECDSA_P256, hashAlgorithm: HashAlgorithm.SHA3_256, weight: 1.0)`,
+				expectedEventType: "flow.AccountStructuredKeyAdded",
+				// address, key index, public key, signature algorithm, hash algorithm, weight
+				expectedFields: 6,
+			},
+			{
+				// deprecated
+				name:              "addPublicKey",
+				code:              `signer.addPublicKey([1, 2])`,
+				expectedEventType: "flow.AccountKeyAdded",
+				// address, public key
+				expectedFields: 2,
+			},
+		} {
 
-              signer.save(<-rs, to: /storage/rs)
-              signer.link<&{String: Test.R}>(/public/rs, target: /storage/rs)
+			t.Run(testCase.name, func(t *testing.T) {
 
-              let ref1 = signer.borrow<&{String: Test.R}>(from: /storage/rs)!
-              log(ref1["a"]?.owner?.address)
-              log(ref1["b"]?.owner?.address)
-              ref1["a"]?.logOwnerAddress()
-              ref1["b"]?.logOwnerAddress()
+				events = nil
 
-              let publicAccount = getAccount(0x01)
-              let ref2 = publicAccount.getCapability(/public/rs)!.borrow<&{String: Test.R}>()!
-              log(ref2["a"]?.owner?.address)
-              log(ref2["b"]?.owner?.address)
-              ref2["a"]?.logOwnerAddress()
-              ref2["b"]?.logOwnerAddress()
-          }
-      }
-    `)
+				script := []byte(fmt.Sprintf(
+					`
+                      transaction {
+                        prepare(signer: AuthAccount) {
+                          %s
+                        }
+                      }
+                    `,
+					testCase.code,
+				))
 
-	tx2 := []byte(`
-      import Test from 0x1
+				err := runtime.ExecuteTransaction(script, nil, runtimeInterface, utils.TestLocation)
+				require.NoError(t, err)
+
+				require.Len(t, events, 1)
+				assert.Equal(t, testCase.expectedEventType, events[0].EventType.ID())
+				assert.Len(t, events[0].Fields, testCase.expectedFields)
+			})
+		}
+	})
+}
+
+func TestRuntimeTransactionTopLevelDeclarations(t *testing.T) {
+
+	t.Run("transaction with function", func(t *testing.T) {
+		runtime := NewInterpreterRuntime()
+
+		script := []byte(`
+          pub fun test() {}
+
+          transaction {}
+        `)
+
+		runtimeInterface := &testRuntimeInterface{
+			getSigningAccounts: func() []Address {
+				return nil
+			},
+		}
+
+		err := runtime.ExecuteTransaction(script, nil, runtimeInterface, TransactionLocation{})
+		require.NoError(t, err)
+	})
+
+	t.Run("transaction with resource", func(t *testing.T) {
+		runtime := NewInterpreterRuntime()
+
+		script := []byte(`
+          pub resource R {}
+
+          transaction {}
+        `)
+
+		runtimeInterface := &testRuntimeInterface{
+			getSigningAccounts: func() []Address {
+				return nil
+			},
+		}
+
+		err := runtime.ExecuteTransaction(script, nil, runtimeInterface, TransactionLocation{})
+		require.Error(t, err)
+
+		require.IsType(t, Error{}, err)
+		err = err.(Error).Unwrap()
+
+		errs := utils.ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidTopLevelDeclarationError{}, errs[0])
+	})
+}
+
+func TestRuntimeStoreIntegerTypes(t *testing.T) {
+
+	runtime := NewInterpreterRuntime()
+
+	addressValue := interpreter.AddressValue{
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xCA, 0xDE,
+	}
+
+	for _, integerType := range sema.AllIntegerTypes {
+
+		typeName := integerType.String()
+
+		t.Run(typeName, func(t *testing.T) {
+
+			contract := []byte(
+				fmt.Sprintf(
+					`
+                      pub contract Test {
+
+                          pub let n: %s
+
+                          init() {
+                              self.n = 42
+                          }
+                      }
+                    `,
+					typeName,
+				),
+			)
+
+			deploy := []byte(
+				fmt.Sprintf(
+					`
+                      transaction {
+
+                          prepare(signer: AuthAccount) {
+                              signer.setCode(%s)
+                          }
+                      }
+                    `,
+					ArrayValueFromBytes(contract).String(),
+				),
+			)
+
+			var accountCode []byte
+			var events []cadence.Event
+
+			runtimeInterface := &testRuntimeInterface{
+				resolveImport: func(_ Location) (bytes []byte, err error) {
+					return accountCode, nil
+				},
+				storage: newTestStorage(),
+				getSigningAccounts: func() []Address {
+					return []Address{addressValue.ToAddress()}
+				},
+				updateAccountCode: func(address Address, code []byte, checkPermission bool) (err error) {
+					accountCode = code
+					return nil
+				},
+				emitEvent: func(event cadence.Event) {
+					events = append(events, event)
+				},
+			}
+
+			err := runtime.ExecuteTransaction(deploy, nil, runtimeInterface, utils.TestLocation)
+			require.NoError(t, err)
+
+			assert.NotNil(t, accountCode)
+		})
+	}
+}
+
+func TestInterpretResourceOwnerFieldUseComposite(t *testing.T) {
+
+	runtime := NewInterpreterRuntime()
+
+	address := Address{
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1,
+	}
+
+	contract := []byte(`
+      pub contract Test {
+
+          pub resource R {
+
+              pub fun logOwnerAddress() {
+                log(self.owner?.address)
+              }
+          }
+
+          pub fun createR(): @R {
+              return <-create R()
+          }
+      }
+    `)
+
+	deploy := []byte(
+		fmt.Sprintf(
+			`
+              transaction {
+
+                  prepare(signer: AuthAccount) {
+                      signer.setCode(%s)
+                  }
+              }
+            `,
+			ArrayValueFromBytes(contract).String(),
+		),
+	)
+
+	tx := []byte(`
+      import Test from 0x1
+
+      transaction {
+
+          prepare(signer: AuthAccount) {
+
+              let r <- Test.createR()
+              log(r.owner?.address)
+              r.logOwnerAddress()
+
+              signer.save(<-r, to: /storage/r)
+              signer.link<&Test.R>(/public/r, target: /storage/r)
+
+              let ref1 = signer.borrow<&Test.R>(from: /storage/r)!
+              log(ref1.owner?.address)
+              ref1.logOwnerAddress()
+
+              let publicAccount = getAccount(0x01)
+              let ref2 = publicAccount.getCapability(/public/r)!.borrow<&Test.R>()!
+              log(ref2.owner?.address)
+              ref2.logOwnerAddress()
+          }
+      }
+    `)
+
+	tx2 := []byte(`
+      import Test from 0x1
+
+      transaction {
+
+          prepare(signer: AuthAccount) {
+              let ref1 = signer.borrow<&Test.R>(from: /storage/r)!
+              log(ref1.owner?.address)
+              ref1.logOwnerAddress()
+
+              let publicAccount = getAccount(0x01)
+              let ref2 = publicAccount.getCapability(/public/r)!.borrow<&Test.R>()!
+              log(ref2.owner?.address)
+              ref2.logOwnerAddress()
+          }
+      }
+    `)
+
+	accountCodes := map[string][]byte{}
+	var events []cadence.Event
+
+	var loggedMessages []string
+
+	runtimeInterface := &testRuntimeInterface{
+		resolveImport: func(location Location) (bytes []byte, err error) {
+			key := string(location.(AddressLocation).ID())
+			return accountCodes[key], nil
+		},
+		storage: newTestStorage(),
+		getSigningAccounts: func() []Address {
+			return []Address{address}
+		},
+		updateAccountCode: func(address Address, code []byte, checkPermission bool) (err error) {
+			key := string(AddressLocation(address[:]).ID())
+			accountCodes[key] = code
+			return nil
+		},
+		emitEvent: func(event cadence.Event) {
+			events = append(events, event)
+		},
+		log: func(message string) {
+			loggedMessages = append(loggedMessages, message)
+		},
+	}
+
+	err := runtime.ExecuteTransaction(deploy, nil, runtimeInterface, utils.TestLocation)
+	require.NoError(t, err)
+
+	err = runtime.ExecuteTransaction(tx, nil, runtimeInterface, utils.TestLocation)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		[]string{
+			"nil", "nil",
+			"0x1", "0x1",
+			"0x1", "0x1",
+		},
+		loggedMessages,
+	)
+
+	loggedMessages = nil
+	err = runtime.ExecuteTransaction(tx2, nil, runtimeInterface, utils.TestLocation)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		[]string{
+			"0x1", "0x1",
+			"0x1", "0x1",
+		},
+		loggedMessages,
+	)
+}
+
+func TestInterpretResourceOwnerFieldUseArray(t *testing.T) {
+
+	runtime := NewInterpreterRuntime()
+
+	address := Address{
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1,
+	}
+
+	contract := []byte(`
+      pub contract Test {
+
+          pub resource R {
+
+              pub fun logOwnerAddress() {
+                log(self.owner?.address)
+              }
+          }
+
+          pub fun createR(): @R {
+              return <-create R()
+          }
+      }
+    `)
+
+	deploy := []byte(
+		fmt.Sprintf(
+			`
+              transaction {
+
+                  prepare(signer: AuthAccount) {
+                      signer.setCode(%s)
+                  }
+              }
+            `,
+			ArrayValueFromBytes(contract).String(),
+		),
+	)
+
+	tx := []byte(`
+      import Test from 0x1
+
+      transaction {
+
+          prepare(signer: AuthAccount) {
+
+              let rs <- [
+                  <-Test.createR(),
+                  <-Test.createR()
+              ]
+              log(rs[0].owner?.address)
+              log(rs[1].owner?.address)
+              rs[0].logOwnerAddress()
+              rs[1].logOwnerAddress()
+
+              signer.save(<-rs, to: /storage/rs)
+              signer.link<&[Test.R]>(/public/rs, target: /storage/rs)
+
+              let ref1 = signer.borrow<&[Test.R]>(from: /storage/rs)!
+              log(ref1[0].owner?.address)
+              log(ref1[1].owner?.address)
+              ref1[0].logOwnerAddress()
+              ref1[1].logOwnerAddress()
+
+              let publicAccount = getAccount(0x01)
+              let ref2 = publicAccount.getCapability(/public/rs)!.borrow<&[Test.R]>()!
+              log(ref2[0].owner?.address)
+              log(ref2[1].owner?.address)
+              ref2[0].logOwnerAddress()
+              ref2[1].logOwnerAddress()
+          }
+      }
+    `)
+
+	tx2 := []byte(`
+      import Test from 0x1
+
+      transaction {
+
+          prepare(signer: AuthAccount) {
+              let ref1 = signer.borrow<&[Test.R]>(from: /storage/rs)!
+              log(ref1[0].owner?.address)
+              log(ref1[1].owner?.address)
+              ref1[0].logOwnerAddress()
+              ref1[1].logOwnerAddress()
+
+              let publicAccount = getAccount(0x01)
+              let ref2 = publicAccount.getCapability(/public/rs)!.borrow<&[Test.R]>()!
+              log(ref2[0].owner?.address)
+              log(ref2[1].owner?.address)
+              ref2[0].logOwnerAddress()
+              ref2[1].logOwnerAddress()
+          }
+      }
+    `)
+
+	accountCodes := map[string][]byte{}
+	var events []cadence.Event
+
+	var loggedMessages []string
+
+	runtimeInterface := &testRuntimeInterface{
+		resolveImport: func(location Location) (bytes []byte, err error) {
+			key := string(location.(AddressLocation).ID())
+			return accountCodes[key], nil
+		},
+		storage: newTestStorage(),
+		getSigningAccounts: func() []Address {
+			return []Address{address}
+		},
+		updateAccountCode: func(address Address, code []byte, checkPermission bool) (err error) {
+			key := string(AddressLocation(address[:]).ID())
+			accountCodes[key] = code
+			return nil
+		},
+		emitEvent: func(event cadence.Event) {
+			events = append(events, event)
+		},
+		log: func(message string) {
+			loggedMessages = append(loggedMessages, message)
+		},
+	}
+
+	err := runtime.ExecuteTransaction(deploy, nil, runtimeInterface, utils.TestLocation)
+	require.NoError(t, err)
+
+	err = runtime.ExecuteTransaction(tx, nil, runtimeInterface, utils.TestLocation)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		[]string{
+			"nil", "nil",
+			"nil", "nil",
+			"0x1", "0x1",
+			"0x1", "0x1",
+			"0x1", "0x1",
+			"0x1", "0x1",
+		},
+		loggedMessages,
+	)
+
+	loggedMessages = nil
+	err = runtime.ExecuteTransaction(tx2, nil, runtimeInterface, utils.TestLocation)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		[]string{
+			"0x1", "0x1",
+			"0x1", "0x1",
+			"0x1", "0x1",
+			"0x1", "0x1",
+		},
+		loggedMessages,
+	)
+}
+
+func TestInterpretResourceOwnerFieldUseDictionary(t *testing.T) {
+
+	runtime := NewInterpreterRuntime()
+
+	address := Address{
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1,
+	}
+
+	contract := []byte(`
+      pub contract Test {
+
+          pub resource R {
+
+              pub fun logOwnerAddress() {
+                log(self.owner?.address)
+              }
+          }
+
+          pub fun createR(): @R {
+              return <-create R()
+          }
+      }
+    `)
+
+	deploy := []byte(
+		fmt.Sprintf(
+			`
+              transaction {
+
+                  prepare(signer: AuthAccount) {
+                      signer.setCode(%s)
+                  }
+              }
+            `,
+			ArrayValueFromBytes(contract).String(),
+		),
+	)
+
+	tx := []byte(`
+      import Test from 0x1
+
+      transaction {
+
+          prepare(signer: AuthAccount) {
+
+              let rs <- {
+                  "a": <-Test.createR(),
+                  "b": <-Test.createR()
+              }
+              log(rs["a"]?.owner?.address)
+              log(rs["b"]?.owner?.address)
+              rs["a"]?.logOwnerAddress()
+              rs["b"]?.logOwnerAddress()
+
+              signer.save(<-rs, to: /storage/rs)
+              signer.link<&{String: Test.R}>(/public/rs, target: /storage/rs)
+
+              let ref1 = signer.borrow<&{String: Test.R}>(from: /storage/rs)!
+              log(ref1["a"]?.owner?.address)
+              log(ref1["b"]?.owner?.address)
+              ref1["a"]?.logOwnerAddress()
+              ref1["b"]?.logOwnerAddress()
+
+              let publicAccount = getAccount(0x01)
+              let ref2 = publicAccount.getCapability(/public/rs)!.borrow<&{String: Test.R}>()!
+              log(ref2["a"]?.owner?.address)
+              log(ref2["b"]?.owner?.address)
+              ref2["a"]?.logOwnerAddress()
+              ref2["b"]?.logOwnerAddress()
+          }
+      }
+    `)
+
+	tx2 := []byte(`
+      import Test from 0x1
+
+      transaction {
+
+          prepare(signer: AuthAccount) {
+              let ref1 = signer.borrow<&{String: Test.R}>(from: /storage/rs)!
+              log(ref1["a"]?.owner?.address)
+              log(ref1["b"]?.owner?.address)
+              ref1["a"]?.logOwnerAddress()
+              ref1["b"]?.logOwnerAddress()
+
+              let publicAccount = getAccount(0x01)
+              let ref2 = publicAccount.getCapability(/public/rs)!.borrow<&{String: Test.R}>()!
+              log(ref2["a"]?.owner?.address)
+              log(ref2["b"]?.owner?.address)
+              ref2["a"]?.logOwnerAddress()
+              ref2["b"]?.logOwnerAddress()
+          }
+      }
+    `)
+
+	accountCodes := map[string][]byte{}
+	var events []cadence.Event
+
+	var loggedMessages []string
+
+	runtimeInterface := &testRuntimeInterface{
+		resolveImport: func(location Location) (bytes []byte, err error) {
+			key := string(location.(AddressLocation).ID())
+			return accountCodes[key], nil
+		},
+		storage: newTestStorage(),
+		getSigningAccounts: func() []Address {
+			return []Address{address}
+		},
+		updateAccountCode: func(address Address, code []byte, checkPermission bool) (err error) {
+			key := string(AddressLocation(address[:]).ID())
+			accountCodes[key] = code
+			return nil
+		},
+		emitEvent: func(event cadence.Event) {
+			events = append(events, event)
+		},
+		log: func(message string) {
+			loggedMessages = append(loggedMessages, message)
+		},
+	}
+
+	err := runtime.ExecuteTransaction(deploy, nil, runtimeInterface, utils.TestLocation)
+	require.NoError(t, err)
+
+	err = runtime.ExecuteTransaction(tx, nil, runtimeInterface, utils.TestLocation)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		[]string{
+			"nil", "nil",
+			"nil", "nil",
+			"0x1", "0x1",
+			"0x1", "0x1",
+			"0x1", "0x1",
+			"0x1", "0x1",
+		},
+		loggedMessages,
+	)
+
+	loggedMessages = nil
+	err = runtime.ExecuteTransaction(tx2, nil, runtimeInterface, utils.TestLocation)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		[]string{
+			"0x1", "0x1",
+			"0x1", "0x1",
+			"0x1", "0x1",
+			"0x1", "0x1",
+		},
+		loggedMessages,
+	)
+}
+
+func TestRuntimeComputationLimit(t *testing.T) {
+
+	const computationLimit = 5
+
+	type test struct {
+		name string
+		code string
+		ok   bool
+	}
+
+	tests := []test{
+		{
+			name: "Infinite while loop",
+			code: `
+              while true {}
+            `,
+			ok: false,
+		},
+		{
+			name: "Limited while loop",
+			code: `
+              var i = 0
+              while i < 5 {
+                  i = i + 1
+              }
+            `,
+			ok: false,
+		},
+		{
+			name: "Too many for-in loop iterations",
+			code: `
+              for i in [1, 2, 3, 4, 5, 6, 7, 8, 9, 10] {}
+            `,
+			ok: false,
+		},
+		{
+			name: "Some for-in loop iterations",
+			code: `
+              for i in [1, 2, 3, 4] {}
+            `,
+			ok: true,
+		},
+	}
+
+	for _, test := range tests {
+
+		t.Run(test.name, func(t *testing.T) {
+
+			script := []byte(
+				fmt.Sprintf(
+					`
+                      transaction {
+                          prepare() {
+                              %s
+                          }
+                      }
+                    `,
+					test.code,
+				),
+			)
+
+			runtime := NewInterpreterRuntime()
+
+			runtimeInterface := &testRuntimeInterface{
+				getSigningAccounts: func() []Address {
+					return nil
+				},
+				computationLimit: computationLimit,
+			}
+
+			err := runtime.ExecuteTransaction(script, nil, runtimeInterface, utils.TestLocation)
+			if test.ok {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+
+				require.IsType(t, Error{}, err)
+				err = err.(Error).Unwrap()
+
+				assert.Equal(t,
+					ComputationLimitExceededError{
+						Limit: computationLimit,
+					},
+					err,
+				)
+			}
+		})
+	}
+}
+
+func TestRuntimeComputationWeights(t *testing.T) {
+
+	t.Parallel()
+
+	executeScript := func(
+		code string,
+		weights ComputationWeights,
+		limit uint64,
+	) (
+		used uint64,
+		err error,
+	) {
+		runtime := NewInterpreterRuntime()
+
+		runtimeInterface := &testRuntimeInterface{
+			computationLimit:   limit,
+			computationWeights: weights,
+			setComputationUsed: func(computationUsed uint64) {
+				used = computationUsed
+			},
+		}
+
+		_, err = runtime.ExecuteScript([]byte(code), nil, runtimeInterface, utils.TestLocation)
+		return used, err
+	}
+
+	t.Run("default weights", func(t *testing.T) {
+
+		used, err := executeScript(
+			`
+              pub fun main() {
+                  let a = [1, 2, 3].concat([4, 5])
+                  var i = 0
+                  while i < 2 {
+                      i = i + 1
+                  }
+              }
+            `,
+			nil,
+			0,
+		)
+		require.NoError(t, err)
+
+		// 3 statements, 2 loop iterations, 2 statements in the loop body, 1 invocation
+		assert.Equal(t, uint64(8), used)
+	})
+
+	t.Run("array operations", func(t *testing.T) {
+
+		used, err := executeScript(
+			`
+              pub fun main() {
+                  let a = [1, 2, 3].concat([4, 5])
+              }
+            `,
+			ComputationWeights{
+				common.ComputationKindArrayOperation: 1,
+			},
+			0,
+		)
+		require.NoError(t, err)
+
+		// array literals with 3 and 2 elements, concatenation of 5 elements
+		assert.Equal(t, uint64(10), used)
+	})
+
+	t.Run("string operations", func(t *testing.T) {
+
+		used, err := executeScript(
+			`
+              pub fun main() {
+                  let s = "abc".concat("de")
+              }
+            `,
+			ComputationWeights{
+				common.ComputationKindStringOperation: 2,
+			},
+			0,
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, uint64(10), used)
+	})
+
+	t.Run("dictionary operations", func(t *testing.T) {
+
+		used, err := executeScript(
+			`
+              pub fun main() {
+                  let d = {"a": 1, "b": 2}
+                  let keys = d.keys
+              }
+            `,
+			ComputationWeights{
+				common.ComputationKindDictionaryOperation: 1,
+			},
+			0,
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, uint64(4), used)
+	})
+
+	t.Run("array operation with invalid index", func(t *testing.T) {
+
+		for _, code := range []string{
+			`
+              pub fun main() {
+                  let a: [Int] = []
+                  a.insert(at: 5, 1)
+              }
+            `,
+			`
+              pub fun main() {
+                  let a: [Int] = [1]
+                  a.remove(at: 5)
+              }
+            `,
+		} {
+			for _, limit := range []uint64{0, 100} {
+
+				used, err := executeScript(
+					code,
+					ComputationWeights{
+						common.ComputationKindArrayOperation: 1,
+					},
+					limit,
+				)
+				require.Error(t, err)
+
+				require.IsType(t, Error{}, err)
+				err = err.(Error).Unwrap()
+
+				assert.IsType(t, interpreter.ArrayIndexOutOfBoundsError{}, err)
+				assert.Less(t, used, uint64(100))
+			}
+		}
+	})
+
+	t.Run("limit exceeded", func(t *testing.T) {
+
+		const limit = 100
+
+		used, err := executeScript(
+			`
+              pub fun main() {
+                  var a: [Int] = []
+                  var i = 0
+                  while i < 5 {
+                      a = a.concat([1, 2, 3, 4, 5])
+                      i = i + 1
+                  }
+              }
+            `,
+			ComputationWeights{
+				common.ComputationKindArrayOperation: 5,
+			},
+			limit,
+		)
+		require.Error(t, err)
+
+		require.IsType(t, Error{}, err)
+		err = err.(Error).Unwrap()
+
+		assert.Equal(t,
+			ComputationLimitExceededError{
+				Limit: limit,
+			},
+			err,
+		)
+
+		assert.Greater(t, used, uint64(limit))
+	})
+
+	t.Run("storage", func(t *testing.T) {
+
+		runtime := NewInterpreterRuntime()
+
+		var writtenBytes int
+		var used uint64
+
+		storage := newTestStorage()
+		setValue := storage.setValue
+		storage.setValue = func(owner, controller, key, value []byte) error {
+			if string(key) != storageUsedKey {
+				writtenBytes += len(value)
+			}
+			return setValue(owner, controller, key, value)
+		}
+
+		runtimeInterface := &testRuntimeInterface{
+			storage: storage,
+			getSigningAccounts: func() []Address {
+				return []Address{{42}}
+			},
+			computationWeights: ComputationWeights{
+				common.ComputationKindStorageRead:  1,
+				common.ComputationKindStorageWrite: 1,
+			},
+			setComputationUsed: func(computationUsed uint64) {
+				used = computationUsed
+			},
+		}
+
+		err := runtime.ExecuteTransaction(
+			[]byte(`
+              transaction {
+                  prepare(signer: AuthAccount) {
+                      signer.save("hello world", to: /storage/greeting)
+                  }
+              }
+            `),
+			nil,
+			runtimeInterface,
+			utils.TestLocation,
+		)
+		require.NoError(t, err)
+
+		require.NotZero(t, writtenBytes)
+		assert.Equal(t, uint64(writtenBytes), used)
+
+		err = runtime.ExecuteTransaction(
+			[]byte(`
+              transaction {
+                  prepare(signer: AuthAccount) {
+                      signer.copy<String>(from: /storage/greeting)
+                  }
+              }
+            `),
+			nil,
+			runtimeInterface,
+			utils.TestLocation,
+		)
+		require.NoError(t, err)
+
+		// the value is only read, not written back
+
+		assert.Equal(t, uint64(writtenBytes), used)
+	})
+
+	t.Run("storage used", func(t *testing.T) {
+
+		runtime := NewInterpreterRuntime()
+
+		var used uint64
+
+		storage := newTestStorage()
+
+		runtimeInterface := &testRuntimeInterface{
+			storage: storage,
+			getSigningAccounts: func() []Address {
+				return []Address{{42}}
+			},
+			computationWeights: ComputationWeights{
+				common.ComputationKindValueEncoding: 1,
+			},
+			setComputationUsed: func(computationUsed uint64) {
+				used = computationUsed
+			},
+			log: func(message string) {},
+		}
+
+		err := runtime.ExecuteTransaction(
+			[]byte(`
+              transaction {
+                  prepare(signer: AuthAccount) {
+                      signer.save("hello world", to: /storage/greeting)
+
+                      // only the values of the requested account are encoded
+                      log(getAccount(0x1).storageUsed)
+
+                      log(signer.storageUsed)
+                      log(signer.storageUsed)
+                  }
+              }
+            `),
+			nil,
+			runtimeInterface,
+			utils.TestLocation,
+		)
+		require.NoError(t, err)
+
+		address := Address{42}
+
+		storageUsedData, err := storage.getValue(
+			address[:],
+			[]byte{},
+			[]byte(storageUsedKey),
+		)
+		require.NoError(t, err)
+		require.Len(t, storageUsedData, 8)
+
+		storageUsed := binary.BigEndian.Uint64(storageUsedData)
+		require.NotZero(t, storageUsed)
+
+		// the value is encoded each time the storage used is requested
+
+		assert.Equal(t, 2*storageUsed, used)
+	})
+}
+
+func TestRuntimeProfiler(t *testing.T) {
+
+	t.Parallel()
+
+	importedScript := []byte(`
+      pub fun double(_ x: Int): Int {
+          return x * 2
+      }
+    `)
+
+	script := []byte(`
+      import "imported"
+
+      pub fun main(): Int {
+          let a = [1, 2, 3].concat([4])
+          return double(a.length)
+      }
+    `)
+
+	prof := profiler.NewProfiler()
+	runtime := NewInterpreterRuntime(WithProfiler(prof))
+
+	var used uint64
+
+	runtimeInterface := &testRuntimeInterface{
+		resolveImport: func(location Location) ([]byte, error) {
+			switch location {
+			case StringLocation("imported"):
+				return importedScript, nil
+			default:
+				return nil, fmt.Errorf("unknown import location: %s", location)
+			}
+		},
+		computationWeights: ComputationWeights{
+			common.ComputationKindStatement:          1,
+			common.ComputationKindFunctionInvocation: 1,
+			common.ComputationKindArrayOperation:     1,
+		},
+		setComputationUsed: func(computationUsed uint64) {
+			used = computationUsed
+		},
+	}
+
+	value, err := runtime.ExecuteScript(script, nil, runtimeInterface, utils.TestLocation)
+	require.NoError(t, err)
+
+	assert.Equal(t, cadence.NewInt(8), value)
+
+	p := prof.Profile()
+	require.NoError(t, p.CheckValid())
+
+	computation := map[string]int64{}
+	var totalComputation int64
+
+	for _, sample := range p.Sample {
+		line := sample.Location[0].Line[0]
+		key := fmt.Sprintf("%s:%s:%d", line.Function.Filename, line.Function.Name, line.Line)
+		computation[key] = sample.Value[0]
+		totalComputation += sample.Value[0]
+	}
+
+	assert.Equal(t,
+		map[string]int64{
+			// statement, array literals with 3 and 1 elements, concatenation of 4 elements, invocation
+			"test:main:5": 1 + 3 + 1 + 4 + 1,
+			// statement, invocation
+			"test:main:6": 2,
+			// statement
+			"imported:double:3": 1,
+		},
+		computation,
+	)
 
-      transaction {
+	assert.Equal(t, int64(used), totalComputation)
+}
 
-          prepare(signer: AuthAccount) {
-              let ref1 = signer.borrow<&{String: Test.R}>(from: /storage/rs)!
-              log(ref1["a"]?.owner?.address)
-              log(ref1["b"]?.owner?.address)
-              ref1["a"]?.logOwnerAddress()
-              ref1["b"]?.logOwnerAddress()
+func TestRuntimeDebugger(t *testing.T) {
 
-              let publicAccount = getAccount(0x01)
-              let ref2 = publicAccount.getCapability(/public/rs)!.borrow<&{String: Test.R}>()!
-              log(ref2["a"]?.owner?.address)
-              log(ref2["b"]?.owner?.address)
-              ref2["a"]?.logOwnerAddress()
-              ref2["b"]?.logOwnerAddress()
-          }
+	t.Parallel()
+
+	importedScript := []byte(`
+      pub fun double(_ x: Int): Int {
+          return x * 2
       }
     `)
 
-	accountCodes := map[string][]byte{}
-	var events []cadence.Event
+	script := []byte(`
+      import "imported"
 
-	var loggedMessages []string
+      pub fun main(): Int {
+          let a = [1, 2, 3].concat([4])
+          return double(a.length)
+      }
+    `)
+
+	debugger := interpreter.NewDebugger()
+	runtime := NewInterpreterRuntime(WithDebugger(debugger))
 
 	runtimeInterface := &testRuntimeInterface{
-		resolveImport: func(location Location) (bytes []byte, err error) {
-			key := string(location.(AddressLocation).ID())
-			return accountCodes[key], nil
-		},
-		storage: newTestStorage(),
-		getSigningAccounts: func() []Address {
-			return []Address{address}
-		},
-		updateAccountCode: func(address Address, code []byte, checkPermission bool) (err error) {
-			key := string(AddressLocation(address[:]).ID())
-			accountCodes[key] = code
-			return nil
-		},
-		emitEvent: func(event cadence.Event) {
-			events = append(events, event)
-		},
-		log: func(message string) {
-			loggedMessages = append(loggedMessages, message)
+		resolveImport: func(location Location) ([]byte, error) {
+			switch location {
+			case StringLocation("imported"):
+				return importedScript, nil
+			default:
+				return nil, fmt.Errorf("unknown import location: %s", location)
+			}
 		},
 	}
 
-	err := runtime.ExecuteTransaction(deploy, nil, runtimeInterface, utils.TestLocation)
-	require.NoError(t, err)
+	debugger.AddBreakpoint(StringLocation("imported"), 3)
 
-	err = runtime.ExecuteTransaction(tx, nil, runtimeInterface, utils.TestLocation)
-	require.NoError(t, err)
+	type result struct {
+		value cadence.Value
+		err   error
+	}
 
+	results := make(chan result, 1)
+
+	go func() {
+		value, err := runtime.ExecuteScript(script, nil, runtimeInterface, utils.TestLocation)
+		results <- result{value: value, err: err}
+	}()
+
+	stop := <-debugger.Stops()
+	assert.Equal(t, interpreter.StopReasonBreakpoint, stop.Reason)
+	assert.Equal(t, 3, stop.Line)
+
+	callStack := debugger.CallStack()
+	require.Len(t, callStack, 2)
+
+	assert.Equal(t, "double", callStack[0].Function)
+	assert.Equal(t, StringLocation("imported"), callStack[0].Location())
 	assert.Equal(t,
-		[]string{
-			"nil", "nil",
-			"nil", "nil",
-			"0x1", "0x1",
-			"0x1", "0x1",
-			"0x1", "0x1",
-			"0x1", "0x1",
+		map[string]interpreter.Value{
+			"x": interpreter.NewIntValueFromInt64(4),
 		},
-		loggedMessages,
+		callStack[0].Locals(),
 	)
 
-	loggedMessages = nil
-	err = runtime.ExecuteTransaction(tx2, nil, runtimeInterface, utils.TestLocation)
+	assert.Equal(t, "main", callStack[1].Function)
+	assert.Equal(t, utils.TestLocation, callStack[1].Location())
+	assert.Equal(t, 6, callStack[1].Line)
+
+	value, err := debugger.Evaluate(callStack[1], "a[3]")
 	require.NoError(t, err)
+	assert.Equal(t, interpreter.NewIntValueFromInt64(4), value)
 
-	assert.Equal(t,
-		[]string{
-			"0x1", "0x1",
-			"0x1", "0x1",
-			"0x1", "0x1",
-			"0x1", "0x1",
-		},
-		loggedMessages,
-	)
+	debugger.Continue()
+
+	res := <-results
+	require.NoError(t, res.err)
+	assert.Equal(t, cadence.NewInt(8), res.value)
 }
 
-func TestRuntimeComputationLimit(t *testing.T) {
+func TestRuntimeMemoryMetering(t *testing.T) {
 
-	const computationLimit = 5
+	t.Parallel()
 
-	type test struct {
-		name string
-		code string
-		ok   bool
+	executeScript := func(code string, limit uint64) (used uint64, err error) {
+		runtime := NewInterpreterRuntime()
+
+		runtimeInterface := &testRuntimeInterface{
+			memoryLimit: limit,
+			setMemoryUsed: func(memoryUsed uint64) {
+				used = memoryUsed
+			},
+		}
+
+		_, err = runtime.ExecuteScript([]byte(code), nil, runtimeInterface, utils.TestLocation)
+		return used, err
 	}
 
-	tests := []test{
+	type testCase struct {
+		name     string
+		code     string
+		expected uint64
+	}
+
+	for _, testCase := range []testCase{
 		{
-			name: "Infinite while loop",
+			name: "string",
 			code: `
-              while true {}
+              pub fun main() {
+                  let s = "hello"
+              }
             `,
-			ok: false,
+			expected: 5,
 		},
 		{
-			name: "Limited while loop",
+			name: "string concatenation",
 			code: `
-              var i = 0
-              while i < 5 {
-                  i = i + 1
+              pub fun main() {
+                  let s = "abc".concat("de")
               }
             `,
-			ok: false,
+			// literals with 3 and 2 bytes, result with 5 bytes
+			expected: 10,
 		},
 		{
-			name: "Too many for-in loop iterations",
+			name: "array",
 			code: `
-              for i in [1, 2, 3, 4, 5, 6, 7, 8, 9, 10] {}
+              pub fun main() {
+                  let a = [1, 2, 3]
+                  a.append(4)
+              }
             `,
-			ok: false,
+			// 3 elements in the literal, 3 elements in the declared copy, 1 appended element
+			expected: 7 * 16,
 		},
 		{
-			name: "Some for-in loop iterations",
+			name: "string template",
 			code: `
-              for i in [1, 2, 3, 4] {}
+              pub fun main() {
+                  let n = 42
+                  let s = "a\(n)bc"
+              }
             `,
-			ok: true,
+			// parts with 1, 2, and 2 bytes
+			expected: 5,
+		},
+		{
+			name: "dictionary",
+			code: `
+              pub fun main() {
+                  let d = {"a": 1}
+                  d["b"] = 2
+              }
+            `,
+			// 1 entry in the literal, 1 entry in the declared copy, 1 inserted entry,
+			// two 1-byte string literals
+			expected: 3*48 + 2,
+		},
+		{
+			name: "composite",
+			code: `
+              pub struct S {}
+
+              pub fun main() {
+                  let s = S()
+              }
+            `,
+			// the constructed composite and the declared copy
+			expected: 2 * 128,
+		},
+		{
+			name: "big integer",
+			code: `
+              pub fun main() {
+                  let x = 1000 * 1000
+              }
+            `,
+			// 1000000 is 0x0f4240
+			expected: 3,
 		},
+	} {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			t.Parallel()
+
+			used, err := executeScript(testCase.code, 0)
+			require.NoError(t, err)
+
+			assert.Equal(t, testCase.expected, used)
+		})
 	}
 
-	for _, test := range tests {
+	t.Run("limit exceeded", func(t *testing.T) {
 
-		t.Run(test.name, func(t *testing.T) {
+		t.Parallel()
 
-			script := []byte(
-				fmt.Sprintf(
-					`
-                      transaction {
-                          prepare() {
-                              %s
-                          }
+		const limit = 1000
+
+		used, err := executeScript(
+			`
+              pub fun main() {
+                  let a: [Int] = []
+                  var i = 0
+                  while i < 100 {
+                      a.append(i)
+                      i = i + 1
+                  }
+              }
+            `,
+			limit,
+		)
+		require.Error(t, err)
+
+		require.IsType(t, Error{}, err)
+		err = err.(Error).Unwrap()
+
+		assert.Equal(t,
+			MemoryLimitExceededError{
+				Limit: limit,
+			},
+			err,
+		)
+
+		assert.Greater(t, used, uint64(limit))
+	})
+
+	t.Run("limit exceeded when copying", func(t *testing.T) {
+
+		t.Parallel()
+
+		const limit = 100000
+
+		used, err := executeScript(
+			`
+              pub fun main() {
+                  let a: [Int] = []
+                  var i = 0
+                  while i < 1000 {
+                      a.append(i)
+                      i = i + 1
+                  }
+
+                  let b: [[Int]] = []
+                  var j = 0
+                  while j < 1000 {
+                      b.append(a)
+                      j = j + 1
+                  }
+              }
+            `,
+			limit,
+		)
+		require.Error(t, err)
+
+		require.IsType(t, Error{}, err)
+		err = err.(Error).Unwrap()
+
+		assert.Equal(t,
+			MemoryLimitExceededError{
+				Limit: limit,
+			},
+			err,
+		)
+
+		assert.Greater(t, used, uint64(limit))
+	})
+
+	t.Run("limit exceeded when reading from storage", func(t *testing.T) {
+
+		t.Parallel()
+
+		runtime := NewInterpreterRuntime()
+
+		var used uint64
+
+		runtimeInterface := &testRuntimeInterface{
+			storage: newTestStorage(),
+			getSigningAccounts: func() []Address {
+				return []Address{{42}}
+			},
+			setMemoryUsed: func(memoryUsed uint64) {
+				used = memoryUsed
+			},
+		}
+
+		err := runtime.ExecuteTransaction(
+			[]byte(`
+              transaction {
+                  prepare(signer: AuthAccount) {
+                      let a: [Int] = []
+                      var i = 0
+                      while i < 100 {
+                          a.append(i)
+                          i = i + 1
                       }
-                    `,
-					test.code,
-				),
-			)
+                      signer.save(a, to: /storage/a)
+                  }
+              }
+            `),
+			nil,
+			runtimeInterface,
+			utils.TestLocation,
+		)
+		require.NoError(t, err)
 
-			runtime := NewInterpreterRuntime()
+		const limit = 1000
 
-			runtimeInterface := &testRuntimeInterface{
-				getSigningAccounts: func() []Address {
-					return nil
-				},
-				computationLimit: computationLimit,
-			}
+		runtimeInterface.memoryLimit = limit
 
-			err := runtime.ExecuteTransaction(script, nil, runtimeInterface, utils.TestLocation)
-			if test.ok {
-				require.NoError(t, err)
-			} else {
-				require.Error(t, err)
+		// Borrowing does not copy the stored array,
+		// only decoding it is metered
 
-				require.IsType(t, Error{}, err)
-				err = err.(Error).Unwrap()
+		err = runtime.ExecuteTransaction(
+			[]byte(`
+              transaction {
+                  prepare(signer: AuthAccount) {
+                      signer.borrow<&[Int]>(from: /storage/a)
+                  }
+              }
+            `),
+			nil,
+			runtimeInterface,
+			utils.TestLocation,
+		)
+		require.Error(t, err)
 
-				assert.Equal(t,
-					ComputationLimitExceededError{
-						Limit: computationLimit,
-					},
-					err,
-				)
-			}
-		})
-	}
+		require.IsType(t, Error{}, err)
+		err = err.(Error).Unwrap()
+
+		assert.Equal(t,
+			MemoryLimitExceededError{
+				Limit: limit,
+			},
+			err,
+		)
+
+		assert.Greater(t, used, uint64(limit))
+	})
+
+	t.Run("limit exceeded in string template", func(t *testing.T) {
+
+		t.Parallel()
+
+		const limit = 10
+
+		used, err := executeScript(
+			`
+              pub fun main() {
+                  let n = 12345678901234567890
+                  let s = "\(n)\(n)"
+              }
+            `,
+			limit,
+		)
+		require.Error(t, err)
+
+		require.IsType(t, Error{}, err)
+		err = err.(Error).Unwrap()
+
+		assert.Equal(t,
+			MemoryLimitExceededError{
+				Limit: limit,
+			},
+			err,
+		)
+
+		// Only the first interpolated value is metered,
+		// the whole string is never built
+
+		assert.Equal(t, uint64(20), used)
+	})
 }
@@ -35,6 +35,7 @@ const (
 	BinaryOperationKindEquality
 	BinaryOperationKindNilCoalescing
 	BinaryOperationKindConcatenation
+	BinaryOperationKindBitwise
 )
 
 func binaryOperationKind(operation ast.Operation) BinaryOperationKind {
@@ -69,6 +70,13 @@ func binaryOperationKind(operation ast.Operation) BinaryOperationKind {
 
 	case ast.OperationConcat:
 		return BinaryOperationKindConcatenation
+
+	case ast.OperationBitwiseOr,
+		ast.OperationBitwiseXor,
+		ast.OperationBitwiseLeftShift,
+		ast.OperationBitwiseRightShift:
+
+		return BinaryOperationKindBitwise
 	}
 
 	panic(errors.NewUnreachableError())
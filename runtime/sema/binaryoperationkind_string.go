@@ -33,11 +33,12 @@ func _() {
 	_ = x[BinaryOperationKindEquality-4]
 	_ = x[BinaryOperationKindNilCoalescing-5]
 	_ = x[BinaryOperationKindConcatenation-6]
+	_ = x[BinaryOperationKindBitwise-7]
 }
 
-const _BinaryOperationKind_name = "BinaryOperationKindUnknownBinaryOperationKindArithmeticBinaryOperationKindNonEqualityComparisonBinaryOperationKindBooleanLogicBinaryOperationKindEqualityBinaryOperationKindNilCoalescingBinaryOperationKindConcatenation"
+const _BinaryOperationKind_name = "BinaryOperationKindUnknownBinaryOperationKindArithmeticBinaryOperationKindNonEqualityComparisonBinaryOperationKindBooleanLogicBinaryOperationKindEqualityBinaryOperationKindNilCoalescingBinaryOperationKindConcatenationBinaryOperationKindBitwise"
 
-var _BinaryOperationKind_index = [...]uint8{0, 26, 55, 95, 126, 153, 185, 217}
+var _BinaryOperationKind_index = [...]uint8{0, 26, 55, 95, 126, 153, 185, 217, 243}
 
 func (i BinaryOperationKind) String() string {
 	if i < 0 || i >= BinaryOperationKind(len(_BinaryOperationKind_index)-1) {
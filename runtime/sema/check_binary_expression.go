@@ -36,6 +36,16 @@ func (checker *Checker) VisitBinaryExpression(expression *ast.BinaryExpression)
 	operation := expression.Operation
 	operationKind := binaryOperationKind(operation)
 
+	// The ampersand is both the concatenation operator
+	// and the bitwise AND operator: it is the latter
+	// if the left-hand side is an integer
+
+	if operationKind == BinaryOperationKindConcatenation &&
+		IsSubType(leftType, &IntegerType{}) {
+
+		operationKind = BinaryOperationKindBitwise
+	}
+
 	unsupportedOperation := func() Type {
 		panic(&unsupportedOperation{
 			kind:      common.OperationKindBinary,
@@ -48,7 +58,8 @@ func (checker *Checker) VisitBinaryExpression(expression *ast.BinaryExpression)
 	case BinaryOperationKindArithmetic,
 		BinaryOperationKindNonEqualityComparison,
 		BinaryOperationKindEquality,
-		BinaryOperationKindConcatenation:
+		BinaryOperationKindConcatenation,
+		BinaryOperationKindBitwise:
 
 		// Right hand side will always be evaluated
 
@@ -81,6 +92,13 @@ func (checker *Checker) VisitBinaryExpression(expression *ast.BinaryExpression)
 				leftIsInvalid, rightIsInvalid, anyInvalid,
 			)
 
+		case BinaryOperationKindBitwise:
+			return checker.checkBinaryExpressionBitwise(
+				expression, operation, operationKind,
+				leftType, rightType,
+				leftIsInvalid, rightIsInvalid, anyInvalid,
+			)
+
 		default:
 			return unsupportedOperation()
 		}
@@ -421,3 +439,67 @@ func (checker *Checker) checkBinaryExpressionConcatenation(
 
 	return leftType
 }
+
+func (checker *Checker) checkBinaryExpressionBitwise(
+	expression *ast.BinaryExpression,
+	operation ast.Operation,
+	operationKind BinaryOperationKind,
+	leftType, rightType Type,
+	leftIsInvalid, rightIsInvalid, anyInvalid bool,
+) Type {
+	// check both types are integer subtypes
+
+	leftIsInteger := IsSubType(leftType, &IntegerType{})
+	rightIsInteger := IsSubType(rightType, &IntegerType{})
+
+	if !leftIsInteger && !rightIsInteger {
+		if !anyInvalid {
+			checker.report(
+				&InvalidBinaryOperandsError{
+					Operation: operation,
+					LeftType:  leftType,
+					RightType: rightType,
+					Range:     ast.NewRangeFromPositioned(expression),
+				},
+			)
+		}
+	} else if !leftIsInteger {
+		if !leftIsInvalid {
+			checker.report(
+				&InvalidBinaryOperandError{
+					Operation:    operation,
+					Side:         common.OperandSideLeft,
+					ExpectedType: &IntegerType{},
+					ActualType:   leftType,
+					Range:        ast.NewRangeFromPositioned(expression.Left),
+				},
+			)
+		}
+	} else if !rightIsInteger {
+		if !rightIsInvalid {
+			checker.report(
+				&InvalidBinaryOperandError{
+					Operation:    operation,
+					Side:         common.OperandSideRight,
+					ExpectedType: &IntegerType{},
+					ActualType:   rightType,
+					Range:        ast.NewRangeFromPositioned(expression.Right),
+				},
+			)
+		}
+	}
+
+	// check both types are equal
+	if !anyInvalid && !leftType.Equal(rightType) {
+		checker.report(
+			&InvalidBinaryOperandsError{
+				Operation: operation,
+				LeftType:  leftType,
+				RightType: rightType,
+				Range:     ast.NewRangeFromPositioned(expression),
+			},
+		)
+	}
+
+	return leftType
+}
@@ -1,6 +1,8 @@
 package sema
 
 import (
+	"math/big"
+
 	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/errors"
@@ -47,10 +49,18 @@ func (checker *Checker) visitCompositeDeclaration(declaration *ast.CompositeDecl
 	checker.checkNestedIdentifiers(
 		declaration.Members.Fields,
 		declaration.Members.Functions,
+		declaration.Members.EnumCases,
 		declaration.InterfaceDeclarations,
 		declaration.CompositeDeclarations,
 	)
 
+	checker.checkEnumCases(
+		declaration.Members,
+		declaration.CompositeKind,
+		declaration.DeclarationKind(),
+		compositeType.EnumRawType,
+	)
+
 	// Activate new scopes for nested types
 
 	checker.typeActivations.Enter()
@@ -306,7 +316,8 @@ func (checker *Checker) declareNestedDeclarations(
 			switch nestedCompositeKind {
 			case common.CompositeKindResource,
 				common.CompositeKindStructure,
-				common.CompositeKindEvent:
+				common.CompositeKindEvent,
+				common.CompositeKindEnum:
 				break
 
 			default:
@@ -461,10 +472,22 @@ func (checker *Checker) declareCompositeMembersAndValue(
 
 		checker.declareCompositeNestedTypes(declaration, kind, false)
 
-		// Resolve conformances
+		// Resolve conformances.
+		// Enums do not have conformances, but declare their raw type instead
 
-		conformances := checker.conformances(declaration, compositeType)
-		compositeType.Conformances = conformances
+		if declaration.CompositeKind == common.CompositeKindEnum {
+			compositeType.EnumRawType = checker.enumRawType(declaration)
+
+			for _, enumCase := range declaration.Members.EnumCases {
+				compositeType.EnumCases = append(
+					compositeType.EnumCases,
+					enumCase.Identifier.Identifier,
+				)
+			}
+		} else {
+			conformances := checker.conformances(declaration, compositeType)
+			compositeType.Conformances = conformances
+		}
 
 		// NOTE: determine initializer parameter types while nested types are in scope,
 		// and after declaring nested types as the initializer may use nested type in parameters
@@ -527,7 +550,13 @@ func (checker *Checker) declareCompositeMembersAndValue(
 	// Always determine composite constructor type
 
 	constructorType, constructorArgumentLabels := checker.compositeConstructorType(declaration, compositeType)
-	constructorType.Members = declarationMembers
+
+	// NOTE: the constructor of an enum already has the enum cases as members,
+	// and enums cannot have nested declarations
+
+	if constructorType.Members == nil {
+		constructorType.Members = declarationMembers
+	}
 
 	// If the composite is a contract, declare a value – the contract is a singleton.
 	// For all other kinds, declare constructor.
@@ -605,6 +634,52 @@ func (checker *Checker) initializerParameters(initializers []*ast.SpecialFunctio
 	return parameters
 }
 
+// enumRawType returns the raw type of the given enum declaration,
+// which is declared like a conformance, e.g. `enum E: UInt8 {}`.
+// The raw type must be an integer type.
+//
+func (checker *Checker) enumRawType(declaration *ast.CompositeDeclaration) Type {
+
+	conformanceCount := len(declaration.Conformances)
+
+	if conformanceCount == 0 {
+		checker.report(
+			&MissingEnumRawTypeError{
+				Pos: declaration.Identifier.EndPosition().Shifted(1),
+			},
+		)
+
+		return &InvalidType{}
+	}
+
+	if conformanceCount > 1 {
+		checker.report(
+			&InvalidEnumConformancesError{
+				Range: ast.Range{
+					StartPos: declaration.Conformances[1].StartPosition(),
+					EndPos:   declaration.Conformances[conformanceCount-1].EndPosition(),
+				},
+			},
+		)
+	}
+
+	rawTypeAnnotation := declaration.Conformances[0]
+	rawType := checker.ConvertType(rawTypeAnnotation)
+
+	if !rawType.IsInvalidType() &&
+		!IsSubType(rawType, &IntegerType{}) {
+
+		checker.report(
+			&InvalidEnumRawTypeError{
+				Type:  rawType,
+				Range: ast.NewRangeFromPositioned(rawTypeAnnotation),
+			},
+		)
+	}
+
+	return rawType
+}
+
 func (checker *Checker) conformances(declaration *ast.CompositeDeclaration, compositeType *CompositeType) []*InterfaceType {
 
 	var interfaceTypes []*InterfaceType
@@ -946,6 +1021,10 @@ func (checker *Checker) compositeConstructorType(
 	argumentLabels []string,
 ) {
 
+	if compositeDeclaration.CompositeKind == common.CompositeKindEnum {
+		return checker.enumConstructorType(compositeDeclaration, compositeType)
+	}
+
 	constructorFunctionType = &SpecialFunctionType{
 		FunctionType: &FunctionType{
 			ReturnTypeAnnotation: NewTypeAnnotation(compositeType),
@@ -977,6 +1056,59 @@ func (checker *Checker) compositeConstructorType(
 	return constructorFunctionType, argumentLabels
 }
 
+// enumConstructorType returns the type of the constructor function of an enum.
+//
+// The constructor function has a single parameter, the raw value,
+// and returns the enum case with the given raw value, or nil if there is none.
+// The enum cases are declared as members of the constructor function.
+//
+func (checker *Checker) enumConstructorType(
+	compositeDeclaration *ast.CompositeDeclaration,
+	compositeType *CompositeType,
+) (
+	constructorFunctionType *SpecialFunctionType,
+	argumentLabels []string,
+) {
+	rawType := compositeType.EnumRawType
+	if rawType == nil {
+		rawType = &InvalidType{}
+	}
+
+	enumCases := compositeDeclaration.Members.EnumCases
+	members := make(map[string]*Member, len(enumCases))
+
+	for _, enumCase := range enumCases {
+		members[enumCase.Identifier.Identifier] = &Member{
+			ContainerType:   compositeType,
+			Access:          ast.AccessPublic,
+			Identifier:      enumCase.Identifier,
+			DeclarationKind: common.DeclarationKindEnumCase,
+			VariableKind:    ast.VariableKindConstant,
+			TypeAnnotation:  NewTypeAnnotation(compositeType),
+		}
+	}
+
+	constructorFunctionType = &SpecialFunctionType{
+		FunctionType: &FunctionType{
+			Parameters: []*Parameter{
+				{
+					Label:          EnumRawValueFieldName,
+					Identifier:     EnumRawValueFieldName,
+					TypeAnnotation: NewTypeAnnotation(rawType),
+				},
+			},
+			ReturnTypeAnnotation: NewTypeAnnotation(
+				&OptionalType{
+					Type: compositeType,
+				},
+			),
+		},
+		Members: members,
+	}
+
+	return constructorFunctionType, []string{EnumRawValueFieldName}
+}
+
 func (checker *Checker) nonEventMembersAndOrigins(
 	containerType Type,
 	fields []*ast.FieldDeclaration,
@@ -991,7 +1123,7 @@ func (checker *Checker) nonEventMembersAndOrigins(
 	origins = make(map[string]*Origin, memberCount)
 
 	predeclaredMembers := checker.predeclaredMembers(containerType)
-	invalidIdentifiers := make(map[string]bool, len(predeclaredMembers))
+	invalidIdentifiers := make(map[string]bool, len(predeclaredMembers)+len(BuiltinMemberNames))
 
 	for _, predeclaredMember := range predeclaredMembers {
 		name := predeclaredMember.Identifier.Identifier
@@ -999,6 +1131,12 @@ func (checker *Checker) nonEventMembersAndOrigins(
 		invalidIdentifiers[name] = true
 	}
 
+	// The built-in members of all types, e.g. `getType`, may not be redeclared
+
+	for _, name := range BuiltinMemberNames {
+		invalidIdentifiers[name] = true
+	}
+
 	checkInvalidIdentifier := func(declaration ast.Declaration) bool {
 		identifier := declaration.DeclarationIdentifier()
 		if invalidIdentifiers == nil || !invalidIdentifiers[identifier.Identifier] {
@@ -1211,7 +1349,7 @@ func (checker *Checker) checkSpecialFunction(
 	checkResourceLoss := containerKind != ContainerKindInterface
 
 	checker.enterValueScope()
-	defer checker.leaveValueScope(checkResourceLoss)
+	defer checker.leaveValueScope(specialFunction.EndPosition, checkResourceLoss)
 
 	checker.declareSelfValue(containerType)
 
@@ -1268,7 +1406,7 @@ func (checker *Checker) checkCompositeFunctions(
 
 		func() {
 			checker.enterValueScope()
-			defer checker.leaveValueScope(true)
+			defer checker.leaveValueScope(function.EndPosition, true)
 
 			checker.declareSelfValue(selfType)
 
@@ -1318,6 +1456,7 @@ func (checker *Checker) declareSelfValue(selfType Type) {
 func (checker *Checker) checkNestedIdentifiers(
 	fields []*ast.FieldDeclaration,
 	functions []*ast.FunctionDeclaration,
+	enumCases []*ast.EnumCaseDeclaration,
 	nestedInterfaceDeclarations []*ast.InterfaceDeclaration,
 	nestedCompositeDeclarations []*ast.CompositeDeclaration,
 ) {
@@ -1339,6 +1478,14 @@ func (checker *Checker) checkNestedIdentifiers(
 		)
 	}
 
+	for _, enumCase := range enumCases {
+		checker.checkNestedIdentifier(
+			enumCase.Identifier,
+			common.DeclarationKindEnumCase,
+			positions,
+		)
+	}
+
 	for _, interfaceDeclaration := range nestedInterfaceDeclarations {
 		checker.checkNestedIdentifier(
 			interfaceDeclaration.Identifier,
@@ -1401,6 +1548,108 @@ func (checker *Checker) VisitFieldDeclaration(_ *ast.FieldDeclaration) ast.Repr
 	panic(errors.NewUnreachableError())
 }
 
+func (checker *Checker) VisitEnumCaseDeclaration(_ *ast.EnumCaseDeclaration) ast.Repr {
+	// NOTE: enum cases are checked in `checkEnumCases`
+
+	panic(errors.NewUnreachableError())
+}
+
+// checkEnumCases checks that only enums declare enum cases,
+// that enums only declare enum cases, that enum cases are public,
+// and that the raw values of all enum cases fit into the raw type.
+//
+func (checker *Checker) checkEnumCases(
+	members *ast.Members,
+	compositeKind common.CompositeKind,
+	declarationKind common.DeclarationKind,
+	rawType Type,
+) {
+	if compositeKind != common.CompositeKindEnum {
+		for _, enumCase := range members.EnumCases {
+			checker.report(
+				&InvalidEnumCaseError{
+					ContainerDeclarationKind: declarationKind,
+					Range:                    ast.NewRangeFromPositioned(enumCase),
+				},
+			)
+		}
+
+		return
+	}
+
+	reportInvalidNonEnumCase := func(nonEnumCase ast.HasPosition) {
+		checker.report(
+			&InvalidNonEnumCaseError{
+				ContainerDeclarationKind: declarationKind,
+				Range:                    ast.NewRangeFromPositioned(nonEnumCase),
+			},
+		)
+	}
+
+	for _, field := range members.Fields {
+		reportInvalidNonEnumCase(field)
+	}
+
+	for _, function := range members.Functions {
+		reportInvalidNonEnumCase(function)
+	}
+
+	for _, specialFunction := range members.SpecialFunctions {
+		reportInvalidNonEnumCase(specialFunction)
+	}
+
+	for _, enumCase := range members.EnumCases {
+		switch enumCase.Access {
+		case ast.AccessNotSpecified, ast.AccessPublic:
+			break
+
+		default:
+			checker.report(
+				&InvalidAccessModifierError{
+					DeclarationKind: common.DeclarationKindEnumCase,
+					Explanation:     "enum cases must be public",
+					Access:          enumCase.Access,
+					Pos:             enumCase.StartPos,
+				},
+			)
+		}
+	}
+
+	// The raw value of each case is its index,
+	// so the last index must fit into the raw type
+
+	rangedRawType, ok := rawType.(IntegerRangedType)
+	if !ok {
+		return
+	}
+
+	maxInt := rangedRawType.MaxInt()
+	caseCount := len(members.EnumCases)
+	if maxInt == nil ||
+		caseCount == 0 ||
+		big.NewInt(int64(caseCount-1)).Cmp(maxInt) <= 0 {
+
+		return
+	}
+
+	// The maximum is less than the case count, so it fits into an int
+
+	maxCaseCount := maxInt.Int64() + 1
+	firstInvalidCase := members.EnumCases[maxCaseCount]
+	lastCase := members.EnumCases[len(members.EnumCases)-1]
+
+	checker.report(
+		&EnumCaseCountExceededError{
+			RawType:      rawType,
+			MaxCaseCount: maxCaseCount,
+			Range: ast.Range{
+				StartPos: firstInvalidCase.StartPosition(),
+				EndPos:   lastCase.EndPosition(),
+			},
+		},
+	)
+}
+
 // checkUnknownSpecialFunctions checks that the special function declarations
 // are supported, i.e., they are either initializers or destructors
 //
@@ -26,7 +26,7 @@ import (
 func (checker *Checker) VisitForStatement(statement *ast.ForStatement) ast.Repr {
 
 	checker.enterValueScope()
-	defer checker.leaveValueScope(true)
+	defer checker.leaveValueScope(statement.EndPosition, true)
 
 	valueExpression := statement.Value
 	valueType := valueExpression.Accept(checker).(Type)
@@ -121,7 +121,21 @@ func (checker *Checker) checkFunction(
 			//   associated to it, and declare parameters in this new scope
 
 			checker.enterValueScope()
-			defer checker.leaveValueScope(checkResourceLoss)
+			defer checker.leaveValueScope(
+				func() ast.Position {
+					// Functions without a body, e.g. in interfaces,
+					// only declare their parameters in the parameter list
+					switch {
+					case functionBlock != nil:
+						return functionBlock.EndPosition()
+					case parameterList != nil:
+						return parameterList.EndPosition()
+					default:
+						return ast.Position{}
+					}
+				},
+				checkResourceLoss,
+			)
 
 			checker.declareParameters(parameterList, functionType.Parameters)
 
@@ -308,7 +322,7 @@ func (checker *Checker) visitFunctionBlock(
 	checkResourceLoss bool,
 ) {
 	checker.enterValueScope()
-	defer checker.leaveValueScope(checkResourceLoss)
+	defer checker.leaveValueScope(functionBlock.EndPosition, checkResourceLoss)
 
 	if functionBlock.PreConditions != nil {
 		checker.visitConditions(*functionBlock.PreConditions)
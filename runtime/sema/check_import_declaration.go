@@ -184,6 +184,7 @@ func (checker *Checker) EnsureLoaded(location ast.Location, loadProgram func() *
 			WithPredeclaredTypes(checker.PredeclaredTypes),
 			WithAccessCheckMode(checker.accessCheckMode),
 			WithValidTopLevelDeclarationsHandler(checker.validTopLevelDeclarationsHandler),
+			WithRangesEnabled(checker.rangesEnabled),
 			WithAllCheckers(checker.allCheckers),
 		)
 		if err == nil {
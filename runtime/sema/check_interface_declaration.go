@@ -55,13 +55,30 @@ func (checker *Checker) VisitInterfaceDeclaration(declaration *ast.InterfaceDecl
 	// NOTE: functions are checked separately
 	checker.checkFieldsAccessModifier(declaration.Members.Fields)
 
+	if !declaration.CompositeKind.SupportsInterfaces() {
+		checker.report(
+			&InvalidInterfaceDeclarationError{
+				CompositeKind: declaration.CompositeKind,
+				Range:         ast.NewRangeFromPositioned(declaration.Identifier),
+			},
+		)
+	}
+
 	checker.checkNestedIdentifiers(
 		declaration.Members.Fields,
 		declaration.Members.Functions,
+		declaration.Members.EnumCases,
 		declaration.InterfaceDeclarations,
 		declaration.CompositeDeclarations,
 	)
 
+	checker.checkEnumCases(
+		declaration.Members,
+		declaration.CompositeKind,
+		declaration.DeclarationKind(),
+		nil,
+	)
+
 	// Activate new scope for nested types
 
 	checker.typeActivations.Enter()
@@ -173,7 +190,7 @@ func (checker *Checker) checkInterfaceFunctions(
 
 		func() {
 			checker.enterValueScope()
-			defer checker.leaveValueScope(false)
+			defer checker.leaveValueScope(function.EndPosition, false)
 
 			checker.declareSelfValue(selfType)
 
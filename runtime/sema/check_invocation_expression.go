@@ -373,7 +373,7 @@ func (checker *Checker) checkTypeParameterInference(
 	invocationExpression *ast.InvocationExpression,
 ) {
 	for _, typeParameter := range functionType.TypeParameters {
-		if typeParameters[typeParameter] != nil {
+		if typeParameters[typeParameter] != nil || typeParameter.Optional {
 			continue
 		}
 
@@ -130,6 +130,12 @@ func (checker *Checker) visitMember(expression *ast.MemberExpression) (member *M
 			targetRange := ast.NewRangeFromPositioned(expression.Expression)
 			member = ty.GetMember(identifier, targetRange, checker.report)
 		}
+
+		// Values of all types have built-in members, e.g. `getType`
+
+		if member == nil {
+			member = GetBuiltinMember(expressionType, identifier)
+		}
 	}
 
 	// Get the member from the accessed value based
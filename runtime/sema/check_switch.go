@@ -0,0 +1,245 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+)
+
+func (checker *Checker) VisitSwitchStatement(statement *ast.SwitchStatement) ast.Repr {
+
+	testType := statement.Expression.Accept(checker).(Type)
+
+	testTypeIsValid := !testType.IsInvalidType()
+
+	// The test expression must be equatable
+
+	if testTypeIsValid && !IsEquatableType(testType) {
+
+		checker.report(
+			&NotEquatableTypeError{
+				Type:  testType,
+				Range: ast.NewRangeFromPositioned(statement.Expression),
+			},
+		)
+
+		testTypeIsValid = false
+	}
+
+	// Check all case expressions.
+	// The default case must be the last case
+
+	coveredEnumCases := map[string]struct{}{}
+
+	lastIndex := len(statement.Cases) - 1
+
+	for i, switchCase := range statement.Cases {
+
+		if switchCase.IsDefault() {
+			if i != lastIndex {
+				checker.report(
+					&SwitchDefaultPositionError{
+						Range: ast.NewRangeFromPositioned(switchCase),
+					},
+				)
+			}
+
+			continue
+		}
+
+		for _, caseExpression := range switchCase.Expressions {
+			checker.checkSwitchCaseExpression(
+				caseExpression,
+				testType,
+				testTypeIsValid,
+				coveredEnumCases,
+			)
+		}
+	}
+
+	exhaustive := checker.isExhaustiveSwitch(statement, testType, coveredEnumCases)
+
+	// Check the statements of all cases.
+	// Exactly one case is taken if the switch statement is exhaustive,
+	// otherwise at most one case is taken
+
+	checker.checkSwitchCasesStatements(statement.Cases, exhaustive)
+
+	return nil
+}
+
+// checkSwitchCaseExpression checks that the case expression is equatable with the test expression,
+// and records the enum case the expression refers to, if any.
+//
+func (checker *Checker) checkSwitchCaseExpression(
+	caseExpression ast.Expression,
+	testType Type,
+	testTypeIsValid bool,
+	coveredEnumCases map[string]struct{},
+) {
+	caseType := caseExpression.Accept(checker).(Type)
+
+	if !testTypeIsValid || caseType.IsInvalidType() {
+		return
+	}
+
+	if !AreCompatibleEquatableTypes(testType, caseType) {
+		checker.report(
+			&InvalidBinaryOperandsError{
+				Operation: ast.OperationEqual,
+				LeftType:  testType,
+				RightType: caseType,
+				Range:     ast.NewRangeFromPositioned(caseExpression),
+			},
+		)
+
+		return
+	}
+
+	memberExpression, ok := caseExpression.(*ast.MemberExpression)
+	if !ok {
+		return
+	}
+
+	memberInfo, ok := checker.Elaboration.MemberExpressionMemberInfos[memberExpression]
+	if !ok ||
+		memberInfo.Member == nil ||
+		memberInfo.Member.DeclarationKind != common.DeclarationKindEnumCase ||
+		!memberInfo.Member.ContainerType.Equal(testType) {
+
+		return
+	}
+
+	coveredEnumCases[memberInfo.Member.Identifier.Identifier] = struct{}{}
+}
+
+// isExhaustiveSwitch returns true if one of the cases of the switch statement is definitely taken,
+// i.e. the last case is the default case, or the test expression is an enum
+// and the cases cover all enum cases.
+//
+func (checker *Checker) isExhaustiveSwitch(
+	statement *ast.SwitchStatement,
+	testType Type,
+	coveredEnumCases map[string]struct{},
+) bool {
+	caseCount := len(statement.Cases)
+	if caseCount == 0 {
+		return false
+	}
+
+	if statement.Cases[caseCount-1].IsDefault() {
+		return true
+	}
+
+	compositeType, ok := testType.(*CompositeType)
+	if !ok || compositeType.Kind != common.CompositeKindEnum {
+		return false
+	}
+
+	for _, enumCase := range compositeType.EnumCases {
+		if _, ok := coveredEnumCases[enumCase]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// checkSwitchCasesStatements checks the statements of the given cases.
+// The cases are checked like nested conditionals: each case is a branch,
+// and the remaining cases are the alternative branch.
+// If the switch statement is not exhaustive, the last case is only potentially taken.
+//
+func (checker *Checker) checkSwitchCasesStatements(cases []*ast.SwitchCase, exhaustive bool) {
+	caseCount := len(cases)
+	if caseCount == 0 {
+		return
+	}
+
+	switchCase := cases[0]
+
+	if caseCount == 1 && exhaustive {
+		checker.checkSwitchCaseStatements(switchCase)
+		return
+	}
+
+	_, _ = checker.checkConditionalBranches(
+		func() Type {
+			checker.checkSwitchCaseStatements(switchCase)
+			return nil
+		},
+		func() Type {
+			checker.checkSwitchCasesStatements(cases[1:], exhaustive)
+			return nil
+		},
+	)
+}
+
+// checkSwitchCaseStatements checks the statements of a case.
+// As a break statement exits the case early, returns in the case are only potential,
+// and resource invalidations and field initializations are only definite
+// if they also occurred before each break statement.
+//
+func (checker *Checker) checkSwitchCaseStatements(switchCase *ast.SwitchCase) {
+
+	// There is no implicit fallthrough, so a case must have statements
+
+	if len(switchCase.Statements) == 0 {
+		checker.report(
+			&MissingSwitchCaseStatementsError{
+				Pos: switchCase.EndPos,
+			},
+		)
+		return
+	}
+
+	functionActivation := checker.functionActivations.Current()
+
+	initialResources := checker.resources.Clone()
+
+	breaks := checker.functionActivations.WithSwitchCase(func() {
+		checker.enterValueScope()
+		defer checker.leaveValueScope(switchCase.EndPosition, true)
+
+		checker.visitStatements(switchCase.Statements)
+	})
+
+	if len(breaks) == 0 {
+		return
+	}
+
+	functionActivation.ReturnInfo.DefinitelyReturned = false
+	functionActivation.ReturnInfo.DefinitelyHalted = false
+
+	resources := checker.resources
+	for _, switchBreak := range breaks {
+		mergedResources := initialResources.Clone()
+		mergedResources.MergeBranches(resources, switchBreak.Resources)
+		resources = mergedResources
+	}
+	*checker.resources = *resources
+
+	if functionActivation.InitializationInfo != nil {
+		initializedMembers := functionActivation.InitializationInfo.InitializedFieldMembers
+		for _, switchBreak := range breaks {
+			*initializedMembers = *initializedMembers.Intersection(switchBreak.InitializedMembers)
+		}
+	}
+}
@@ -48,7 +48,7 @@ func (checker *Checker) VisitTransactionDeclaration(declaration *ast.Transaction
 
 	// enter a new scope for this transaction
 	checker.enterValueScope()
-	defer checker.leaveValueScope(true)
+	defer checker.leaveValueScope(declaration.EndPosition, true)
 
 	checker.declareSelfValue(transactionType)
 
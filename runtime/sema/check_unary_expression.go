@@ -54,6 +54,14 @@ func (checker *Checker) VisitUnaryExpression(expression *ast.UnaryExpression) as
 
 		return valueType
 
+	case ast.OperationBitwiseNot:
+		expectedType := &IntegerType{}
+		if !IsSubType(valueType, expectedType) {
+			reportInvalidUnaryOperator(expectedType)
+		}
+
+		return valueType
+
 	case ast.OperationMove:
 		if !valueType.IsInvalidType() &&
 			!valueType.IsResourceType() {
@@ -116,6 +116,27 @@ func (checker *Checker) reportResourceUsesInLoop(startPos, endPos ast.Position)
 
 func (checker *Checker) VisitBreakStatement(statement *ast.BreakStatement) ast.Repr {
 
+	// If the statement is inside a switch statement,
+	// record the state at the break, as the statement exits the switch
+
+	functionActivation := checker.functionActivations.Current()
+	if functionActivation.InSwitch() {
+
+		var initializedMembers *MemberSet
+		if functionActivation.InitializationInfo != nil {
+			initializedMembers = functionActivation.InitializationInfo.InitializedFieldMembers.Clone()
+		}
+
+		checker.functionActivations.AddSwitchBreak(
+			SwitchBreak{
+				Resources:          checker.resources.Clone(),
+				InitializedMembers: initializedMembers,
+			},
+		)
+
+		return nil
+	}
+
 	// check statement is inside loop
 
 	if !checker.inLoop() {
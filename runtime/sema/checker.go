@@ -82,6 +82,7 @@ type Checker struct {
 	TransactionTypes                   []*TransactionType
 	inCondition                        bool
 	Occurrences                        *Occurrences
+	Ranges                             *Ranges
 	variableOrigins                    map[*Variable]*Origin
 	memberOrigins                      map[Type]map[string]*Origin
 	seenImports                        map[ast.LocationID]bool
@@ -94,6 +95,7 @@ type Checker struct {
 	currentMemberExpression            *ast.MemberExpression
 	validTopLevelDeclarationsHandler   func(ast.Location) []common.DeclarationKind
 	beforeExtractor                    *BeforeExtractor
+	rangesEnabled                      bool
 }
 
 type Option func(*Checker) error
@@ -145,6 +147,19 @@ func WithValidTopLevelDeclarationsHandler(handler func(location ast.Location) []
 	}
 }
 
+// WithRangesEnabled returns a checker option which enables or disables
+// the recording of the ranges in which variables are visible (see Checker.Ranges).
+//
+// Recording ranges is only needed by tools like the language server,
+// so it is disabled by default.
+//
+func WithRangesEnabled(enabled bool) Option {
+	return func(checker *Checker) error {
+		checker.rangesEnabled = enabled
+		return nil
+	}
+}
+
 // WithAllCheckers returns a checker option which sets
 // the given map of checkers as the map of all checkers.
 //
@@ -191,6 +206,7 @@ func NewChecker(program *ast.Program, location ast.Location, options ...Option)
 		GlobalValues:        map[string]*Variable{},
 		GlobalTypes:         map[string]*Variable{},
 		Occurrences:         NewOccurrences(),
+		Ranges:              NewRanges(),
 		containerTypes:      map[Type]bool{},
 		variableOrigins:     map[*Variable]*Origin{},
 		memberOrigins:       map[Type]map[string]*Origin{},
@@ -875,6 +891,9 @@ func (checker *Checker) ConvertType(t ast.Type) Type {
 
 	case *ast.RestrictedType:
 		return checker.convertRestrictedType(t)
+
+	case *ast.InstantiationType:
+		return checker.convertInstantiationType(t)
 	}
 
 	panic(&astTypeConversionError{invalidASTType: t})
@@ -1090,6 +1109,65 @@ func (checker *Checker) convertReferenceType(t *ast.ReferenceType) Type {
 	}
 }
 
+// convertInstantiationType converts an instantiation of a generic type.
+//
+// Currently only the capability type can be instantiated,
+// with exactly one type argument, the borrow type, which must be a reference type.
+//
+func (checker *Checker) convertInstantiationType(t *ast.InstantiationType) Type {
+	ty := checker.ConvertType(t.Type)
+
+	typeArguments := make([]Type, len(t.TypeArguments))
+	for i, typeArgument := range t.TypeArguments {
+		typeArguments[i] = checker.ConvertType(typeArgument.Type)
+	}
+
+	if ty.IsInvalidType() {
+		return ty
+	}
+
+	capabilityType, ok := ty.(*CapabilityType)
+	if !ok || capabilityType.BorrowType != nil {
+		checker.report(
+			&NonGenericTypeInstantiationError{
+				Type:  ty,
+				Range: ast.NewRangeFromPositioned(t),
+			},
+		)
+
+		return &InvalidType{}
+	}
+
+	const typeParameterCount = 1
+
+	typeArgumentCount := len(typeArguments)
+	if typeArgumentCount != typeParameterCount {
+		checker.reportInvalidTypeArgumentCount(
+			typeArgumentCount,
+			typeParameterCount,
+			t.TypeArguments,
+		)
+
+		return &InvalidType{}
+	}
+
+	borrowType := typeArguments[0]
+
+	if _, ok := borrowType.(*ReferenceType); !ok && !borrowType.IsInvalidType() {
+		checker.report(
+			&TypeMismatchWithDescriptionError{
+				ExpectedTypeDescription: "reference type",
+				ActualType:              borrowType,
+				Range:                   ast.NewRangeFromPositioned(t.TypeArguments[0]),
+			},
+		)
+	}
+
+	return &CapabilityType{
+		BorrowType: borrowType,
+	}
+}
+
 func (checker *Checker) convertDictionaryType(t *ast.DictionaryType) Type {
 	keyType := checker.ConvertType(t.KeyType)
 	valueType := checker.ConvertType(t.ValueType)
@@ -1375,13 +1453,43 @@ func (checker *Checker) enterValueScope() {
 	checker.valueActivations.Enter()
 }
 
-func (checker *Checker) leaveValueScope(checkResourceLoss bool) {
+// leaveValueScope leaves the current value scope, which ends at the position returned by the given function.
+//
+func (checker *Checker) leaveValueScope(getEndPosition func() ast.Position, checkResourceLoss bool) {
+	if checker.rangesEnabled {
+		checker.recordVariableRanges(getEndPosition())
+	}
+
 	if checkResourceLoss {
 		checker.checkResourceLoss(checker.valueActivations.Depth())
 	}
 	checker.valueActivations.Leave()
 }
 
+// recordVariableRanges records the ranges of the variables declared in the current value scope,
+// i.e. from their declaration until the given end of the scope.
+//
+func (checker *Checker) recordVariableRanges(endPosition ast.Position) {
+	depth := checker.valueActivations.Depth()
+
+	for name, variable := range checker.valueActivations.VariablesDeclaredInAndBelow(depth) {
+		if variable.Pos == nil {
+			continue
+		}
+
+		checker.Ranges.Put(
+			*variable.Pos,
+			endPosition,
+			Range{
+				Identifier:      name,
+				Type:            variable.Type,
+				DeclarationKind: variable.DeclarationKind,
+				ArgumentLabels:  variable.ArgumentLabels,
+			},
+		)
+	}
+}
+
 // TODO: prune resource variables declared in function's scope
 //    from `checker.resources`, so they don't get checked anymore
 //    when detecting resource use after invalidation in loops
@@ -1908,6 +2016,24 @@ func (checker *Checker) predeclaredMembers(containerType Type) []*Member {
 				ast.AccessPublic,
 				false,
 			)
+
+		case common.CompositeKindEnum:
+			// `pub let rawValue: T`, where `T` is the enum's raw type,
+			// included in serialization
+
+			var rawType Type = &InvalidType{}
+			if compositeType, ok := containerType.(*CompositeType); ok &&
+				compositeType.EnumRawType != nil {
+
+				rawType = compositeType.EnumRawType
+			}
+
+			addPredeclaredMember(
+				EnumRawValueFieldName,
+				rawType,
+				ast.AccessPublic,
+				false,
+			)
 		}
 	}
 
@@ -2040,3 +2166,19 @@ func (checker *Checker) ValueActivationDepth() int {
 func (checker *Checker) TypeActivationDepth() int {
 	return checker.typeActivations.Depth()
 }
+
+// ValueActivationVariables returns the variables of the current value activation.
+// Once the program is checked, these are the global values,
+// including the base values, predeclared values, and imported values.
+//
+func (checker *Checker) ValueActivationVariables() map[string]*Variable {
+	return checker.valueActivations.VariablesDeclaredInAndBelow(0)
+}
+
+// TypeActivationVariables returns the variables of the current type activation.
+// Once the program is checked, these are the global types,
+// including the base types, predeclared types, and imported types.
+//
+func (checker *Checker) TypeActivationVariables() map[string]*Variable {
+	return checker.typeActivations.VariablesDeclaredInAndBelow(0)
+}
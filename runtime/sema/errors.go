@@ -2644,3 +2644,199 @@ func (e *TypeParameterTypeMismatchError) SecondaryError() string {
 		e.ActualType.QualifiedString(),
 	)
 }
+
+// InvalidEnumCaseError
+
+type InvalidEnumCaseError struct {
+	ContainerDeclarationKind common.DeclarationKind
+	ast.Range
+}
+
+func (e *InvalidEnumCaseError) Error() string {
+	return fmt.Sprintf(
+		"%s declarations cannot contain enum cases",
+		e.ContainerDeclarationKind.Name(),
+	)
+}
+
+func (*InvalidEnumCaseError) isSemanticError() {}
+
+// InvalidNonEnumCaseError
+
+type InvalidNonEnumCaseError struct {
+	ContainerDeclarationKind common.DeclarationKind
+	ast.Range
+}
+
+func (e *InvalidNonEnumCaseError) Error() string {
+	return fmt.Sprintf(
+		"%s declarations may only contain enum cases",
+		e.ContainerDeclarationKind.Name(),
+	)
+}
+
+func (*InvalidNonEnumCaseError) isSemanticError() {}
+
+// MissingEnumRawTypeError
+
+type MissingEnumRawTypeError struct {
+	Pos ast.Position
+}
+
+func (e *MissingEnumRawTypeError) Error() string {
+	return "missing enum raw type"
+}
+
+func (*MissingEnumRawTypeError) isSemanticError() {}
+
+func (e *MissingEnumRawTypeError) StartPosition() ast.Position {
+	return e.Pos
+}
+
+func (e *MissingEnumRawTypeError) EndPosition() ast.Position {
+	return e.Pos
+}
+
+// InvalidEnumRawTypeError
+
+type InvalidEnumRawTypeError struct {
+	Type Type
+	ast.Range
+}
+
+func (e *InvalidEnumRawTypeError) Error() string {
+	return fmt.Sprintf(
+		"invalid enum raw type: `%s`",
+		e.Type.QualifiedString(),
+	)
+}
+
+func (e *InvalidEnumRawTypeError) SecondaryError() string {
+	return "only integer types are supported"
+}
+
+func (*InvalidEnumRawTypeError) isSemanticError() {}
+
+// EnumCaseCountExceededError
+
+type EnumCaseCountExceededError struct {
+	RawType      Type
+	MaxCaseCount int64
+	ast.Range
+}
+
+func (e *EnumCaseCountExceededError) Error() string {
+	return fmt.Sprintf(
+		"too many enum cases for raw type `%s`",
+		e.RawType.QualifiedString(),
+	)
+}
+
+func (e *EnumCaseCountExceededError) SecondaryError() string {
+	return fmt.Sprintf(
+		"at most %d cases are supported",
+		e.MaxCaseCount,
+	)
+}
+
+func (*EnumCaseCountExceededError) isSemanticError() {}
+
+// InvalidEnumConformancesError
+
+type InvalidEnumConformancesError struct {
+	ast.Range
+}
+
+func (e *InvalidEnumConformancesError) Error() string {
+	return "enums cannot conform to interfaces"
+}
+
+func (*InvalidEnumConformancesError) isSemanticError() {}
+
+// InvalidInterfaceDeclarationError
+
+type InvalidInterfaceDeclarationError struct {
+	CompositeKind common.CompositeKind
+	ast.Range
+}
+
+func (e *InvalidInterfaceDeclarationError) Error() string {
+	return fmt.Sprintf(
+		"%s interfaces are not supported",
+		e.CompositeKind.Name(),
+	)
+}
+
+func (*InvalidInterfaceDeclarationError) isSemanticError() {}
+
+// SwitchDefaultPositionError
+
+type SwitchDefaultPositionError struct {
+	ast.Range
+}
+
+func (e *SwitchDefaultPositionError) Error() string {
+	return "the `default` case must be the last case"
+}
+
+func (*SwitchDefaultPositionError) isSemanticError() {}
+
+// MissingSwitchCaseStatementsError
+
+type MissingSwitchCaseStatementsError struct {
+	Pos ast.Position
+}
+
+func (e *MissingSwitchCaseStatementsError) Error() string {
+	return "switch cases must have at least one statement"
+}
+
+func (e *MissingSwitchCaseStatementsError) SecondaryError() string {
+	return "there is no implicit fallthrough to the next case"
+}
+
+func (*MissingSwitchCaseStatementsError) isSemanticError() {}
+
+func (e *MissingSwitchCaseStatementsError) StartPosition() ast.Position {
+	return e.Pos
+}
+
+func (e *MissingSwitchCaseStatementsError) EndPosition() ast.Position {
+	return e.Pos
+}
+
+// InvalidStringInterpolationTypeError
+
+type InvalidStringInterpolationTypeError struct {
+	Type Type
+	ast.Range
+}
+
+func (e *InvalidStringInterpolationTypeError) Error() string {
+	return fmt.Sprintf(
+		"cannot interpolate value of type `%s` in string template",
+		e.Type.QualifiedString(),
+	)
+}
+
+func (e *InvalidStringInterpolationTypeError) SecondaryError() string {
+	return "only values of non-resource types which are not functions or references can be interpolated"
+}
+
+func (*InvalidStringInterpolationTypeError) isSemanticError() {}
+
+// NonGenericTypeInstantiationError
+
+type NonGenericTypeInstantiationError struct {
+	Type Type
+	ast.Range
+}
+
+func (e *NonGenericTypeInstantiationError) Error() string {
+	return fmt.Sprintf(
+		"cannot instantiate non-generic type `%s`",
+		e.Type.QualifiedString(),
+	)
+}
+
+func (*NonGenericTypeInstantiationError) isSemanticError() {}
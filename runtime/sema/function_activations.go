@@ -25,12 +25,29 @@ type FunctionActivation struct {
 	ReturnInfo           *ReturnInfo
 	ReportedDeadCode     bool
 	InitializationInfo   *InitializationInfo
+	// SwitchCaseBreaks are the breaks of the switch cases which are currently checked.
+	// The innermost switch case is last
+	SwitchCaseBreaks [][]SwitchBreak
+}
+
+// SwitchBreak is the state at a `break` statement which exits a switch statement.
+//
+type SwitchBreak struct {
+	Resources          *Resources
+	InitializedMembers *MemberSet
 }
 
 func (a FunctionActivation) InLoop() bool {
 	return a.Loops > 0
 }
 
+// InSwitch returns true if the innermost loop or switch statement
+// is a switch statement.
+//
+func (a FunctionActivation) InSwitch() bool {
+	return len(a.SwitchCaseBreaks) > 0
+}
+
 type FunctionActivations struct {
 	activations []*FunctionActivation
 }
@@ -86,5 +103,42 @@ func (a *FunctionActivations) LeaveLoop() {
 func (a *FunctionActivations) WithLoop(f func()) {
 	a.EnterLoop()
 	defer a.LeaveLoop()
+
+	// A break statement in a loop exits the loop,
+	// even if the loop is nested in a switch statement
+
+	current := a.Current()
+	switchCaseBreaks := current.SwitchCaseBreaks
+	current.SwitchCaseBreaks = nil
+	defer func() {
+		current.SwitchCaseBreaks = switchCaseBreaks
+	}()
+
 	f()
 }
+
+// WithSwitchCase runs the given function for a switch case,
+// and returns the breaks which exited the switch statement in the case.
+//
+func (a *FunctionActivations) WithSwitchCase(f func()) []SwitchBreak {
+	current := a.Current()
+	current.SwitchCaseBreaks = append(current.SwitchCaseBreaks, nil)
+
+	f()
+
+	lastIndex := len(current.SwitchCaseBreaks) - 1
+	breaks := current.SwitchCaseBreaks[lastIndex]
+	current.SwitchCaseBreaks = current.SwitchCaseBreaks[:lastIndex]
+	return breaks
+}
+
+// AddSwitchBreak records a break which exits the innermost switch statement.
+//
+func (a *FunctionActivations) AddSwitchBreak(switchBreak SwitchBreak) {
+	current := a.Current()
+	lastIndex := len(current.SwitchCaseBreaks) - 1
+	current.SwitchCaseBreaks[lastIndex] = append(
+		current.SwitchCaseBreaks[lastIndex],
+		switchBreak,
+	)
+}
@@ -0,0 +1,82 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/common/intervalst"
+)
+
+// Range is a variable which is visible in a range of the program,
+// i.e. from its declaration until the end of the enclosing scope.
+//
+type Range struct {
+	Identifier      string
+	Type            Type
+	DeclarationKind common.DeclarationKind
+	ArgumentLabels  []string
+}
+
+// Ranges records the ranges of the program in which the non-global variables are visible.
+//
+type Ranges struct {
+	T *intervalst.IntervalST
+}
+
+func NewRanges() *Ranges {
+	return &Ranges{
+		T: &intervalst.IntervalST{},
+	}
+}
+
+func (r *Ranges) Put(startPos, endPos ast.Position, variableRange Range) {
+	startPosition := ToPosition(startPos)
+	endPosition := ToPosition(endPos)
+
+	// Ignore variables which are declared after the end of the scope,
+	// e.g. in invalid programs
+
+	if startPosition.Compare(endPosition) > 0 {
+		return
+	}
+
+	interval := intervalst.NewInterval(startPosition, endPosition)
+	r.T.Put(interval, variableRange)
+}
+
+func (r *Ranges) All() []Range {
+	values := r.T.Values()
+	ranges := make([]Range, len(values))
+	for i, value := range values {
+		ranges[i] = value.(Range)
+	}
+	return ranges
+}
+
+// FindAll returns the ranges of all variables which are visible at the given position.
+//
+func (r *Ranges) FindAll(pos Position) []Range {
+	values := r.T.SearchAll(pos)
+	ranges := make([]Range, len(values))
+	for i, value := range values {
+		ranges[i] = value.(Range)
+	}
+	return ranges
+}
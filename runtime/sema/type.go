@@ -27,6 +27,7 @@ import (
 
 	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/crypto"
 	"github.com/onflow/cadence/runtime/errors"
 )
 
@@ -3102,6 +3103,9 @@ func (p *Parameter) EffectiveArgumentLabel() string {
 type TypeParameter struct {
 	Name      string
 	TypeBound Type
+	// Optional type parameters do not have to be bound
+	// by a type argument or through inference
+	Optional bool
 }
 
 func (p TypeParameter) string(typeFormatter func(Type) string) string {
@@ -3479,8 +3483,11 @@ func init() {
 		&AddressType{},
 		&AuthAccountType{},
 		&PublicAccountType{},
+		&DeployedContractType{},
+		&AccountKeyType{},
 		&PathType{},
 		&CapabilityType{},
+		&MetaType{},
 	}
 
 	types := append(
@@ -3500,6 +3507,13 @@ func init() {
 	}
 }
 
+// BaseType returns the nominal type with the given name, if any.
+//
+func BaseType(name string) (Type, bool) {
+	ty, ok := baseTypes[name]
+	return ty, ok
+}
+
 // baseValues are the values available in programs
 
 var BaseValues = map[string]ValueDeclaration{}
@@ -3676,8 +3690,17 @@ type CompositeType struct {
 	ConstructorParameters []*Parameter
 	nestedTypes           map[string]Type
 	ContainerType         Type
+	// The raw type of the cases, only set for enums
+	EnumRawType Type
+	// The names of the cases, only set for enums
+	EnumCases []string
 }
 
+// EnumRawValueFieldName is the name of the field of enum cases
+// which contains the raw value of the case
+//
+const EnumRawValueFieldName = "rawValue"
+
 func (t *CompositeType) ConformanceSet() InterfaceSet {
 	if t.conformanceSet == nil {
 		t.conformanceSet = make(InterfaceSet, len(t.Conformances))
@@ -3719,7 +3742,7 @@ func (t *CompositeType) QualifiedIdentifier() string {
 }
 
 func (t *CompositeType) ID() TypeID {
-	return TypeID(fmt.Sprintf("%s.%s", t.Location.ID(), t.QualifiedIdentifier()))
+	return TypeID(fmt.Sprintf("%s.%s", ast.TypeIDLocationID(t.Location), t.QualifiedIdentifier()))
 }
 
 func (t *CompositeType) Equal(other Type) bool {
@@ -4048,7 +4071,11 @@ var authAccountLinkFunctionType = func() *FunctionType {
 		},
 		ReturnTypeAnnotation: NewTypeAnnotation(
 			&OptionalType{
-				Type: &CapabilityType{},
+				Type: &CapabilityType{
+					BorrowType: &GenericType{
+						TypeParameter: typeParameter,
+					},
+				},
 			},
 		),
 	}
@@ -4065,7 +4092,68 @@ var authAccountUnlinkFunctionType = &FunctionType{
 	ReturnTypeAnnotation: NewTypeAnnotation(&VoidType{}),
 }
 
-var accountGetCapabilityFunctionType = &FunctionType{
+var authAccountForEachStoredFunctionType = &FunctionType{
+	Parameters: []*Parameter{
+		{
+			Label:      ArgumentLabelNotRequired,
+			Identifier: "function",
+			TypeAnnotation: NewTypeAnnotation(
+				&FunctionType{
+					Parameters: []*Parameter{
+						{
+							Identifier:     "path",
+							TypeAnnotation: NewTypeAnnotation(&PathType{}),
+						},
+						{
+							Identifier:     "type",
+							TypeAnnotation: NewTypeAnnotation(&MetaType{}),
+						},
+					},
+					ReturnTypeAnnotation: NewTypeAnnotation(&BoolType{}),
+				},
+			),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(&VoidType{}),
+}
+
+var accountGetCapabilityFunctionType = func() *FunctionType {
+
+	// The type parameter is optional:
+	// If no type argument is given, the capability is untyped
+
+	typeParameter := &TypeParameter{
+		TypeBound: &ReferenceType{
+			Type: &AnyType{},
+		},
+		Name:     "T",
+		Optional: true,
+	}
+
+	return &FunctionType{
+		TypeParameters: []*TypeParameter{
+			typeParameter,
+		},
+		Parameters: []*Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "capabilityPath",
+				TypeAnnotation: NewTypeAnnotation(&PathType{}),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			&OptionalType{
+				Type: &CapabilityType{
+					BorrowType: &GenericType{
+						TypeParameter: typeParameter,
+					},
+				},
+			},
+		),
+	}
+}()
+
+var accountGetLinkTargetFunctionType = &FunctionType{
 	Parameters: []*Parameter{
 		{
 			Label:          ArgumentLabelNotRequired,
@@ -4075,163 +4163,768 @@ var accountGetCapabilityFunctionType = &FunctionType{
 	},
 	ReturnTypeAnnotation: NewTypeAnnotation(
 		&OptionalType{
-			Type: &CapabilityType{},
+			Type: &PathType{},
 		},
 	),
 }
 
-var accountGetLinkTargetFunctionType = &FunctionType{
+func (t *AuthAccountType) GetMember(identifier string, _ ast.Range, _ func(error)) *Member {
+
+	newField := func(fieldType Type) *Member {
+		return NewPublicConstantFieldMember(t, identifier, fieldType)
+	}
+
+	newFunction := func(functionType InvokableType) *Member {
+		return NewPublicFunctionMember(t, identifier, functionType)
+	}
+
+	switch identifier {
+	case "address":
+		return newField(&AddressType{})
+
+	case "contracts":
+		return newField(&AuthAccountContractsType{})
+
+	case "keys":
+		return newField(&AuthAccountKeysType{})
+
+	case "storageUsed", "storageCapacity":
+		return newField(&UInt64Type{})
+
+	case "setCode":
+		return newFunction(authAccountSetCodeFunctionType)
+
+	case "addPublicKey":
+		return newFunction(authAccountAddPublicKeyFunctionType)
+
+	case "removePublicKey":
+		return newFunction(authAccountRemovePublicKeyFunctionType)
+
+	case "save":
+		return newFunction(authAccountSaveFunctionType)
+
+	case "load":
+		return newFunction(authAccountLoadFunctionType)
+
+	case "copy":
+		return newFunction(authAccountCopyFunctionType)
+
+	case "borrow":
+		return newFunction(authAccountBorrowFunctionType)
+
+	case "link":
+		return newFunction(authAccountLinkFunctionType)
+
+	case "unlink":
+		return newFunction(authAccountUnlinkFunctionType)
+
+	case "getCapability":
+		return newFunction(accountGetCapabilityFunctionType)
+
+	case "getLinkTarget":
+		return newFunction(accountGetLinkTargetFunctionType)
+
+	case "storagePaths", "publicPaths", "privatePaths":
+		return newField(&VariableSizedType{Type: &PathType{}})
+
+	case "forEachStored":
+		return newFunction(authAccountForEachStoredFunctionType)
+
+	default:
+		return nil
+	}
+}
+
+func (*AuthAccountType) Unify(_ Type, _ map[*TypeParameter]Type, _ func(err error), _ ast.Range) bool {
+	return false
+}
+
+func (t *AuthAccountType) Resolve(_ map[*TypeParameter]Type) Type {
+	return t
+}
+
+// AuthAccountContractsType represents the contracts of an authorized account,
+// i.e. the type of the `contracts` field of `AuthAccount`.
+//
+type AuthAccountContractsType struct{}
+
+func init() {
+	gob.Register(&AuthAccountContractsType{})
+}
+
+func (*AuthAccountContractsType) IsType() {}
+
+func (*AuthAccountContractsType) String() string {
+	return "AuthAccount.Contracts"
+}
+
+func (*AuthAccountContractsType) QualifiedString() string {
+	return "AuthAccount.Contracts"
+}
+
+func (*AuthAccountContractsType) ID() TypeID {
+	return "AuthAccount.Contracts"
+}
+
+func (*AuthAccountContractsType) Equal(other Type) bool {
+	_, ok := other.(*AuthAccountContractsType)
+	return ok
+}
+
+func (*AuthAccountContractsType) IsResourceType() bool {
+	return false
+}
+
+func (*AuthAccountContractsType) IsInvalidType() bool {
+	return false
+}
+
+func (*AuthAccountContractsType) TypeAnnotationState() TypeAnnotationState {
+	return TypeAnnotationStateValid
+}
+
+func (*AuthAccountContractsType) ContainsFirstLevelInterfaceType() bool {
+	return false
+}
+
+func (*AuthAccountContractsType) CanHaveMembers() bool {
+	return true
+}
+
+var authAccountContractsAddFunctionType = &FunctionType{
 	Parameters: []*Parameter{
 		{
-			Label:          ArgumentLabelNotRequired,
-			Identifier:     "capabilityPath",
-			TypeAnnotation: NewTypeAnnotation(&PathType{}),
+			Identifier:     "name",
+			TypeAnnotation: NewTypeAnnotation(&StringType{}),
+		},
+		{
+			Identifier: "code",
+			TypeAnnotation: NewTypeAnnotation(
+				&VariableSizedType{
+					// TODO: UInt8. Requires array literals of integer literals
+					//   to be type compatible with with [UInt8]
+					Type: &IntType{},
+				},
+			),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		&DeployedContractType{},
+	),
+	// additional arguments are passed to the contract initializer
+	RequiredArgumentCount: (func() *int {
+		var count = 2
+		return &count
+	})(),
+}
+
+var authAccountContractsUpdateFunctionType = &FunctionType{
+	Parameters: []*Parameter{
+		{
+			Identifier:     "name",
+			TypeAnnotation: NewTypeAnnotation(&StringType{}),
+		},
+		{
+			Identifier: "code",
+			TypeAnnotation: NewTypeAnnotation(
+				&VariableSizedType{
+					// TODO: UInt8. Requires array literals of integer literals
+					//   to be type compatible with with [UInt8]
+					Type: &IntType{},
+				},
+			),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		&DeployedContractType{},
+	),
+}
+
+var authAccountContractsGetFunctionType = &FunctionType{
+	Parameters: []*Parameter{
+		{
+			Identifier:     "name",
+			TypeAnnotation: NewTypeAnnotation(&StringType{}),
 		},
 	},
 	ReturnTypeAnnotation: NewTypeAnnotation(
 		&OptionalType{
-			Type: &PathType{},
+			Type: &DeployedContractType{},
+		},
+	),
+}
+
+var authAccountContractsRemoveFunctionType = &FunctionType{
+	Parameters: []*Parameter{
+		{
+			Identifier:     "name",
+			TypeAnnotation: NewTypeAnnotation(&StringType{}),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		&OptionalType{
+			Type: &DeployedContractType{},
+		},
+	),
+}
+
+func (t *AuthAccountContractsType) GetMember(identifier string, _ ast.Range, _ func(error)) *Member {
+
+	newFunction := func(functionType InvokableType) *Member {
+		return NewPublicFunctionMember(t, identifier, functionType)
+	}
+
+	switch identifier {
+	case "add":
+		return newFunction(authAccountContractsAddFunctionType)
+
+	case "update":
+		return newFunction(authAccountContractsUpdateFunctionType)
+
+	case "get":
+		return newFunction(authAccountContractsGetFunctionType)
+
+	case "remove":
+		return newFunction(authAccountContractsRemoveFunctionType)
+
+	default:
+		return nil
+	}
+}
+
+func (*AuthAccountContractsType) Unify(_ Type, _ map[*TypeParameter]Type, _ func(err error), _ ast.Range) bool {
+	return false
+}
+
+func (t *AuthAccountContractsType) Resolve(_ map[*TypeParameter]Type) Type {
+	return t
+}
+
+// DeployedContractType represents a contract deployed to an account under a name
+//
+type DeployedContractType struct{}
+
+func init() {
+	gob.Register(&DeployedContractType{})
+}
+
+func (*DeployedContractType) IsType() {}
+
+func (*DeployedContractType) String() string {
+	return "DeployedContract"
+}
+
+func (*DeployedContractType) QualifiedString() string {
+	return "DeployedContract"
+}
+
+func (*DeployedContractType) ID() TypeID {
+	return "DeployedContract"
+}
+
+func (*DeployedContractType) Equal(other Type) bool {
+	_, ok := other.(*DeployedContractType)
+	return ok
+}
+
+func (*DeployedContractType) IsResourceType() bool {
+	return false
+}
+
+func (*DeployedContractType) IsInvalidType() bool {
+	return false
+}
+
+func (*DeployedContractType) TypeAnnotationState() TypeAnnotationState {
+	return TypeAnnotationStateValid
+}
+
+func (*DeployedContractType) ContainsFirstLevelInterfaceType() bool {
+	return false
+}
+
+func (*DeployedContractType) CanHaveMembers() bool {
+	return true
+}
+
+func (t *DeployedContractType) GetMember(identifier string, _ ast.Range, _ func(error)) *Member {
+
+	newField := func(fieldType Type) *Member {
+		return NewPublicConstantFieldMember(t, identifier, fieldType)
+	}
+
+	switch identifier {
+	case "address":
+		return newField(&AddressType{})
+
+	case "name":
+		return newField(&StringType{})
+
+	case "code":
+		return newField(
+			&VariableSizedType{
+				Type: &UInt8Type{},
+			},
+		)
+
+	default:
+		return nil
+	}
+}
+
+func (*DeployedContractType) Unify(_ Type, _ map[*TypeParameter]Type, _ func(err error), _ ast.Range) bool {
+	return false
+}
+
+func (t *DeployedContractType) Resolve(_ map[*TypeParameter]Type) Type {
+	return t
+}
+
+// PublicAccountType
+
+type PublicAccountType struct{}
+
+func init() {
+	gob.Register(&PublicAccountType{})
+}
+
+func (*PublicAccountType) IsType() {}
+
+func (*PublicAccountType) String() string {
+	return "PublicAccount"
+}
+
+func (*PublicAccountType) QualifiedString() string {
+	return "PublicAccount"
+}
+
+func (*PublicAccountType) ID() TypeID {
+	return "PublicAccount"
+}
+
+func (*PublicAccountType) Equal(other Type) bool {
+	_, ok := other.(*PublicAccountType)
+	return ok
+}
+
+func (*PublicAccountType) IsResourceType() bool {
+	return false
+}
+
+func (*PublicAccountType) IsInvalidType() bool {
+	return false
+}
+
+func (*PublicAccountType) TypeAnnotationState() TypeAnnotationState {
+	return TypeAnnotationStateValid
+}
+
+func (*PublicAccountType) ContainsFirstLevelInterfaceType() bool {
+	return false
+}
+
+func (*PublicAccountType) CanHaveMembers() bool {
+	return true
+}
+
+func (t *PublicAccountType) GetMember(identifier string, _ ast.Range, _ func(error)) *Member {
+
+	newField := func(fieldType Type) *Member {
+		return NewPublicConstantFieldMember(t, identifier, fieldType)
+	}
+
+	newFunction := func(functionType InvokableType) *Member {
+		return NewPublicFunctionMember(t, identifier, functionType)
+	}
+
+	switch identifier {
+	case "address":
+		return newField(&AddressType{})
+
+	case "keys":
+		return newField(&PublicAccountKeysType{})
+
+	case "storageUsed", "storageCapacity":
+		return newField(&UInt64Type{})
+
+	case "getCapability":
+		return newFunction(accountGetCapabilityFunctionType)
+
+	case "getLinkTarget":
+		return newFunction(accountGetLinkTargetFunctionType)
+
+	default:
+		return nil
+	}
+}
+
+func (*PublicAccountType) Unify(_ Type, _ map[*TypeParameter]Type, _ func(err error), _ ast.Range) bool {
+	return false
+}
+
+func (t *PublicAccountType) Resolve(_ map[*TypeParameter]Type) Type {
+	return t
+}
+
+// FlowLocation is the location of the composite types built in to the Flow runtime,
+// e.g. the algorithm enums used for account keys
+//
+var FlowLocation = ast.StringLocation("flow")
+
+// nativeCompositeTypes are the composite types which are built in,
+// i.e. which are not declared in a program, indexed by type ID
+//
+var nativeCompositeTypes = map[TypeID]*CompositeType{}
+
+// NativeCompositeType returns the built-in composite type with the given type ID,
+// or nil if there is no such type
+//
+func NativeCompositeType(typeID TypeID) *CompositeType {
+	return nativeCompositeTypes[typeID]
+}
+
+// newNativeEnumType returns a new built-in enum type with the given cases.
+// The raw type of the enum is `UInt8`, and the raw value of each case is its index.
+//
+func newNativeEnumType(identifier string, cases []string) *CompositeType {
+
+	enumType := &CompositeType{
+		Kind:        common.CompositeKindEnum,
+		Location:    FlowLocation,
+		Identifier:  identifier,
+		EnumRawType: &UInt8Type{},
+		EnumCases:   cases,
+	}
+
+	enumType.Members = map[string]*Member{
+		EnumRawValueFieldName: NewPublicConstantFieldMember(
+			enumType,
+			EnumRawValueFieldName,
+			enumType.EnumRawType,
+		),
+	}
+
+	nativeCompositeTypes[enumType.ID()] = enumType
+
+	return enumType
+}
+
+// HashAlgorithmType represents the built-in enum `HashAlgorithm`
+//
+var HashAlgorithmType = newNativeEnumType(
+	"HashAlgorithm",
+	func() []string {
+		cases := make([]string, len(crypto.HashAlgorithms))
+		for i, hashAlgorithm := range crypto.HashAlgorithms {
+			cases[i] = hashAlgorithm.Name()
+		}
+		return cases
+	}(),
+)
+
+// SignatureAlgorithmType represents the built-in enum `SignatureAlgorithm`
+//
+var SignatureAlgorithmType = newNativeEnumType(
+	"SignatureAlgorithm",
+	func() []string {
+		cases := make([]string, len(crypto.SignatureAlgorithms))
+		for i, signatureAlgorithm := range crypto.SignatureAlgorithms {
+			cases[i] = signatureAlgorithm.Name()
+		}
+		return cases
+	}(),
+)
+
+// AccountKeyType represents a key of an account
+//
+type AccountKeyType struct{}
+
+func init() {
+	gob.Register(&AccountKeyType{})
+}
+
+func (*AccountKeyType) IsType() {}
+
+func (*AccountKeyType) String() string {
+	return "AccountKey"
+}
+
+func (*AccountKeyType) QualifiedString() string {
+	return "AccountKey"
+}
+
+func (*AccountKeyType) ID() TypeID {
+	return "AccountKey"
+}
+
+func (*AccountKeyType) Equal(other Type) bool {
+	_, ok := other.(*AccountKeyType)
+	return ok
+}
+
+func (*AccountKeyType) IsResourceType() bool {
+	return false
+}
+
+func (*AccountKeyType) IsInvalidType() bool {
+	return false
+}
+
+func (*AccountKeyType) TypeAnnotationState() TypeAnnotationState {
+	return TypeAnnotationStateValid
+}
+
+func (*AccountKeyType) ContainsFirstLevelInterfaceType() bool {
+	return false
+}
+
+func (*AccountKeyType) CanHaveMembers() bool {
+	return true
+}
+
+func (t *AccountKeyType) GetMember(identifier string, _ ast.Range, _ func(error)) *Member {
+
+	newField := func(fieldType Type) *Member {
+		return NewPublicConstantFieldMember(t, identifier, fieldType)
+	}
+
+	switch identifier {
+	case "keyIndex":
+		return newField(&IntType{})
+
+	case "publicKey":
+		return newField(
+			&VariableSizedType{
+				Type: &UInt8Type{},
+			},
+		)
+
+	case "signatureAlgorithm":
+		return newField(SignatureAlgorithmType)
+
+	case "hashAlgorithm":
+		return newField(HashAlgorithmType)
+
+	case "weight":
+		return newField(&UFix64Type{})
+
+	case "isRevoked":
+		return newField(&BoolType{})
+
+	default:
+		return nil
+	}
+}
+
+func (*AccountKeyType) Unify(_ Type, _ map[*TypeParameter]Type, _ func(err error), _ ast.Range) bool {
+	return false
+}
+
+func (t *AccountKeyType) Resolve(_ map[*TypeParameter]Type) Type {
+	return t
+}
+
+// AuthAccountKeysType represents the keys of an authorized account,
+// i.e. the type of the `keys` field of `AuthAccount`.
+//
+type AuthAccountKeysType struct{}
+
+func init() {
+	gob.Register(&AuthAccountKeysType{})
+}
+
+func (*AuthAccountKeysType) IsType() {}
+
+func (*AuthAccountKeysType) String() string {
+	return "AuthAccount.Keys"
+}
+
+func (*AuthAccountKeysType) QualifiedString() string {
+	return "AuthAccount.Keys"
+}
+
+func (*AuthAccountKeysType) ID() TypeID {
+	return "AuthAccount.Keys"
+}
+
+func (*AuthAccountKeysType) Equal(other Type) bool {
+	_, ok := other.(*AuthAccountKeysType)
+	return ok
+}
+
+func (*AuthAccountKeysType) IsResourceType() bool {
+	return false
+}
+
+func (*AuthAccountKeysType) IsInvalidType() bool {
+	return false
+}
+
+func (*AuthAccountKeysType) TypeAnnotationState() TypeAnnotationState {
+	return TypeAnnotationStateValid
+}
+
+func (*AuthAccountKeysType) ContainsFirstLevelInterfaceType() bool {
+	return false
+}
+
+func (*AuthAccountKeysType) CanHaveMembers() bool {
+	return true
+}
+
+var authAccountKeysAddFunctionType = &FunctionType{
+	Parameters: []*Parameter{
+		{
+			Identifier: "publicKey",
+			TypeAnnotation: NewTypeAnnotation(
+				&VariableSizedType{
+					Type: &UInt8Type{},
+				},
+			),
+		},
+		{
+			Identifier:     "signatureAlgorithm",
+			TypeAnnotation: NewTypeAnnotation(SignatureAlgorithmType),
+		},
+		{
+			Identifier:     "hashAlgorithm",
+			TypeAnnotation: NewTypeAnnotation(HashAlgorithmType),
+		},
+		{
+			Identifier:     "weight",
+			TypeAnnotation: NewTypeAnnotation(&UFix64Type{}),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		&AccountKeyType{},
+	),
+}
+
+var accountKeysGetFunctionType = &FunctionType{
+	Parameters: []*Parameter{
+		{
+			Identifier:     "keyIndex",
+			TypeAnnotation: NewTypeAnnotation(&IntType{}),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		&OptionalType{
+			Type: &AccountKeyType{},
+		},
+	),
+}
+
+var authAccountKeysRevokeFunctionType = &FunctionType{
+	Parameters: []*Parameter{
+		{
+			Identifier:     "keyIndex",
+			TypeAnnotation: NewTypeAnnotation(&IntType{}),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		&OptionalType{
+			Type: &AccountKeyType{},
 		},
 	),
 }
 
-func (t *AuthAccountType) GetMember(identifier string, _ ast.Range, _ func(error)) *Member {
-
-	newField := func(fieldType Type) *Member {
-		return NewPublicConstantFieldMember(t, identifier, fieldType)
-	}
+func (t *AuthAccountKeysType) GetMember(identifier string, _ ast.Range, _ func(error)) *Member {
 
 	newFunction := func(functionType InvokableType) *Member {
 		return NewPublicFunctionMember(t, identifier, functionType)
 	}
 
 	switch identifier {
-	case "address":
-		return newField(&AddressType{})
-
-	case "setCode":
-		return newFunction(authAccountSetCodeFunctionType)
-
-	case "addPublicKey":
-		return newFunction(authAccountAddPublicKeyFunctionType)
-
-	case "removePublicKey":
-		return newFunction(authAccountRemovePublicKeyFunctionType)
-
-	case "save":
-		return newFunction(authAccountSaveFunctionType)
-
-	case "load":
-		return newFunction(authAccountLoadFunctionType)
-
-	case "copy":
-		return newFunction(authAccountCopyFunctionType)
-
-	case "borrow":
-		return newFunction(authAccountBorrowFunctionType)
-
-	case "link":
-		return newFunction(authAccountLinkFunctionType)
-
-	case "unlink":
-		return newFunction(authAccountUnlinkFunctionType)
+	case "add":
+		return newFunction(authAccountKeysAddFunctionType)
 
-	case "getCapability":
-		return newFunction(accountGetCapabilityFunctionType)
+	case "get":
+		return newFunction(accountKeysGetFunctionType)
 
-	case "getLinkTarget":
-		return newFunction(accountGetLinkTargetFunctionType)
+	case "revoke":
+		return newFunction(authAccountKeysRevokeFunctionType)
 
 	default:
 		return nil
 	}
 }
 
-func (*AuthAccountType) Unify(_ Type, _ map[*TypeParameter]Type, _ func(err error), _ ast.Range) bool {
+func (*AuthAccountKeysType) Unify(_ Type, _ map[*TypeParameter]Type, _ func(err error), _ ast.Range) bool {
 	return false
 }
 
-func (t *AuthAccountType) Resolve(_ map[*TypeParameter]Type) Type {
+func (t *AuthAccountKeysType) Resolve(_ map[*TypeParameter]Type) Type {
 	return t
 }
 
-// PublicAccountType
-
-type PublicAccountType struct{}
+// PublicAccountKeysType represents the keys of a public account,
+// i.e. the type of the `keys` field of `PublicAccount`.
+//
+type PublicAccountKeysType struct{}
 
 func init() {
-	gob.Register(&PublicAccountType{})
+	gob.Register(&PublicAccountKeysType{})
 }
 
-func (*PublicAccountType) IsType() {}
+func (*PublicAccountKeysType) IsType() {}
 
-func (*PublicAccountType) String() string {
-	return "PublicAccount"
+func (*PublicAccountKeysType) String() string {
+	return "PublicAccount.Keys"
 }
 
-func (*PublicAccountType) QualifiedString() string {
-	return "PublicAccount"
+func (*PublicAccountKeysType) QualifiedString() string {
+	return "PublicAccount.Keys"
 }
 
-func (*PublicAccountType) ID() TypeID {
-	return "PublicAccount"
+func (*PublicAccountKeysType) ID() TypeID {
+	return "PublicAccount.Keys"
 }
 
-func (*PublicAccountType) Equal(other Type) bool {
-	_, ok := other.(*PublicAccountType)
+func (*PublicAccountKeysType) Equal(other Type) bool {
+	_, ok := other.(*PublicAccountKeysType)
 	return ok
 }
 
-func (*PublicAccountType) IsResourceType() bool {
+func (*PublicAccountKeysType) IsResourceType() bool {
 	return false
 }
 
-func (*PublicAccountType) IsInvalidType() bool {
+func (*PublicAccountKeysType) IsInvalidType() bool {
 	return false
 }
 
-func (*PublicAccountType) TypeAnnotationState() TypeAnnotationState {
+func (*PublicAccountKeysType) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
 
-func (*PublicAccountType) ContainsFirstLevelInterfaceType() bool {
+func (*PublicAccountKeysType) ContainsFirstLevelInterfaceType() bool {
 	return false
 }
 
-func (*PublicAccountType) CanHaveMembers() bool {
+func (*PublicAccountKeysType) CanHaveMembers() bool {
 	return true
 }
 
-func (t *PublicAccountType) GetMember(identifier string, _ ast.Range, _ func(error)) *Member {
-
-	newField := func(fieldType Type) *Member {
-		return NewPublicConstantFieldMember(t, identifier, fieldType)
-	}
+func (t *PublicAccountKeysType) GetMember(identifier string, _ ast.Range, _ func(error)) *Member {
 
 	newFunction := func(functionType InvokableType) *Member {
 		return NewPublicFunctionMember(t, identifier, functionType)
 	}
 
 	switch identifier {
-	case "address":
-		return newField(&AddressType{})
-
-	case "getCapability":
-		return newFunction(accountGetCapabilityFunctionType)
-
-	case "getLinkTarget":
-		return newFunction(accountGetLinkTargetFunctionType)
+	case "get":
+		return newFunction(accountKeysGetFunctionType)
 
 	default:
 		return nil
 	}
 }
 
-func (*PublicAccountType) Unify(_ Type, _ map[*TypeParameter]Type, _ func(err error), _ ast.Range) bool {
+func (*PublicAccountKeysType) Unify(_ Type, _ map[*TypeParameter]Type, _ func(err error), _ ast.Range) bool {
 	return false
 }
 
-func (t *PublicAccountType) Resolve(_ map[*TypeParameter]Type) Type {
+func (t *PublicAccountKeysType) Resolve(_ map[*TypeParameter]Type) Type {
 	return t
 }
 
@@ -4319,7 +5012,7 @@ func (t *InterfaceType) QualifiedIdentifier() string {
 }
 
 func (t *InterfaceType) ID() TypeID {
-	return TypeID(fmt.Sprintf("%s.%s", t.Location.ID(), t.QualifiedIdentifier()))
+	return TypeID(fmt.Sprintf("%s.%s", ast.TypeIDLocationID(t.Location), t.QualifiedIdentifier()))
 }
 
 func (t *InterfaceType) Equal(other Type) bool {
@@ -5303,6 +5996,29 @@ func IsSubType(subType Type, superType Type) bool {
 			// TODO: Once interfaces can conform to interfaces, check conformances here
 			return false
 		}
+
+	case *CapabilityType:
+
+		typedSubType, ok := subType.(*CapabilityType)
+		if !ok {
+			return false
+		}
+
+		// Any capability type is a subtype of the untyped capability type `Capability`
+
+		if typedSuperType.BorrowType == nil {
+			return true
+		}
+
+		// An untyped capability is not a subtype of a typed capability.
+		// A typed capability type `Capability<T>` is a subtype of a typed capability type `Capability<U>`:
+		// if `T` is a subtype of `U`
+
+		if typedSubType.BorrowType == nil {
+			return false
+		}
+
+		return IsSubType(typedSubType.BorrowType, typedSuperType.BorrowType)
 	}
 
 	return false
@@ -5318,11 +6034,18 @@ func IsEquatableType(ty Type) bool {
 	// TODO: add support for arrays and dictionaries
 	// TODO: add support for composites that are equatable
 
+	if compositeType, ok := ty.(*CompositeType); ok &&
+		compositeType.Kind == common.CompositeKindEnum {
+
+		return true
+	}
+
 	if IsSubType(ty, &StringType{}) ||
 		IsSubType(ty, &BoolType{}) ||
 		IsSubType(ty, &NumberType{}) ||
 		IsSubType(ty, &ReferenceType{}) ||
-		IsSubType(ty, &AddressType{}) {
+		IsSubType(ty, &AddressType{}) ||
+		IsSubType(ty, &MetaType{}) {
 
 		return true
 	}
@@ -5715,9 +6438,14 @@ func (t *PathType) Resolve(_ map[*TypeParameter]Type) Type {
 	return t
 }
 
-// CapabilityType
-
-type CapabilityType struct{}
+// CapabilityType represents the type of a capability.
+//
+// If the borrow type is nil, the capability is untyped,
+// and the reference type must be given when borrowing.
+//
+type CapabilityType struct {
+	BorrowType Type
+}
 
 func init() {
 	gob.Register(&CapabilityType{})
@@ -5725,29 +6453,55 @@ func init() {
 
 func (*CapabilityType) IsType() {}
 
-func (*CapabilityType) String() string {
-	return "Capability"
+func (t *CapabilityType) string(typeArgumentFormatter func(Type) string) string {
+	var builder strings.Builder
+	builder.WriteString("Capability")
+	if t.BorrowType != nil {
+		builder.WriteRune('<')
+		builder.WriteString(typeArgumentFormatter(t.BorrowType))
+		builder.WriteRune('>')
+	}
+	return builder.String()
+}
+
+func (t *CapabilityType) String() string {
+	return t.string(func(t Type) string {
+		return t.String()
+	})
 }
 
-func (*CapabilityType) QualifiedString() string {
-	return "Capability"
+func (t *CapabilityType) QualifiedString() string {
+	return t.string(func(t Type) string {
+		return t.QualifiedString()
+	})
 }
 
-func (*CapabilityType) ID() TypeID {
-	return "Capability"
+func (t *CapabilityType) ID() TypeID {
+	return TypeID(t.string(func(t Type) string {
+		return string(t.ID())
+	}))
 }
 
-func (*CapabilityType) Equal(other Type) bool {
-	_, ok := other.(*CapabilityType)
-	return ok
+func (t *CapabilityType) Equal(other Type) bool {
+	otherCapability, ok := other.(*CapabilityType)
+	if !ok {
+		return false
+	}
+	if otherCapability.BorrowType == nil {
+		return t.BorrowType == nil
+	}
+	return otherCapability.BorrowType.Equal(t.BorrowType)
 }
 
 func (*CapabilityType) IsResourceType() bool {
 	return false
 }
 
-func (*CapabilityType) IsInvalidType() bool {
-	return false
+func (t *CapabilityType) IsInvalidType() bool {
+	if t.BorrowType == nil {
+		return false
+	}
+	return t.BorrowType.IsInvalidType()
 }
 
 func (*CapabilityType) TypeAnnotationState() TypeAnnotationState {
@@ -5758,53 +6512,92 @@ func (*CapabilityType) ContainsFirstLevelInterfaceType() bool {
 	return false
 }
 
-func (*CapabilityType) Unify(_ Type, _ map[*TypeParameter]Type, _ func(err error), _ ast.Range) bool {
-	return false
+func (t *CapabilityType) Unify(other Type, typeParameters map[*TypeParameter]Type, report func(err error), outerRange ast.Range) bool {
+	otherCapability, ok := other.(*CapabilityType)
+	if !ok {
+		return false
+	}
+
+	if t.BorrowType == nil || otherCapability.BorrowType == nil {
+		return false
+	}
+
+	return t.BorrowType.Unify(otherCapability.BorrowType, typeParameters, report, outerRange)
 }
 
-func (t *CapabilityType) Resolve(_ map[*TypeParameter]Type) Type {
-	return t
+func (t *CapabilityType) Resolve(typeParameters map[*TypeParameter]Type) Type {
+	if t.BorrowType == nil {
+		return t
+	}
+
+	// NOTE: the borrow type might be an optional type parameter
+	// which was not bound, e.g. in `getCapability<T>`.
+	// The capability is untyped in that case
+
+	resolvedBorrowType := t.BorrowType.Resolve(typeParameters)
+
+	return &CapabilityType{
+		BorrowType: resolvedBorrowType,
+	}
 }
 
-var capabilityBorrowFunctionType = func() *FunctionType {
+var capabilityBorrowTypeTypeParameter = &TypeParameter{
+	TypeBound: &ReferenceType{
+		Type: &AnyType{},
+	},
+	Name: "T",
+}
 
-	typeParameter := &TypeParameter{
-		TypeBound: &ReferenceType{
-			Type: &AnyType{},
-		},
-		Name: "T",
+// capabilityBorrowFunctionType returns the type of the `borrow` function
+// of a capability with the given borrow type.
+//
+// Untyped capabilities require the reference type as a type argument,
+// typed capabilities borrow using their borrow type.
+//
+func capabilityBorrowFunctionType(borrowType Type) *FunctionType {
+
+	var typeParameters []*TypeParameter
+
+	if borrowType == nil {
+		typeParameter := capabilityBorrowTypeTypeParameter
+
+		typeParameters = []*TypeParameter{
+			typeParameter,
+		}
+
+		borrowType = &GenericType{
+			TypeParameter: typeParameter,
+		}
 	}
 
 	return &FunctionType{
-		TypeParameters: []*TypeParameter{
-			typeParameter,
-		},
+		TypeParameters: typeParameters,
 		ReturnTypeAnnotation: NewTypeAnnotation(
 			&OptionalType{
-				Type: &GenericType{
-					TypeParameter: typeParameter,
-				},
+				Type: borrowType,
 			},
 		),
 	}
-}()
+}
 
-var capabilityCheckFunctionType = func() *FunctionType {
+// capabilityCheckFunctionType returns the type of the `check` function
+// of a capability with the given borrow type.
+//
+func capabilityCheckFunctionType(borrowType Type) *FunctionType {
 
-	typeParameter := &TypeParameter{
-		TypeBound: &ReferenceType{
-			Type: &AnyType{},
-		},
-		Name: "T",
+	var typeParameters []*TypeParameter
+
+	if borrowType == nil {
+		typeParameters = []*TypeParameter{
+			capabilityBorrowTypeTypeParameter,
+		}
 	}
 
 	return &FunctionType{
-		TypeParameters: []*TypeParameter{
-			typeParameter,
-		},
+		TypeParameters:       typeParameters,
 		ReturnTypeAnnotation: NewTypeAnnotation(&BoolType{}),
 	}
-}()
+}
 
 func (t *CapabilityType) CanHaveMembers() bool {
 	return true
@@ -5818,10 +6611,162 @@ func (t *CapabilityType) GetMember(identifier string, _ ast.Range, _ func(error)
 
 	switch identifier {
 	case "borrow":
-		return newFunction(capabilityBorrowFunctionType)
+		return newFunction(capabilityBorrowFunctionType(t.BorrowType))
 
 	case "check":
-		return newFunction(capabilityCheckFunctionType)
+		return newFunction(capabilityCheckFunctionType(t.BorrowType))
+
+	default:
+		return nil
+	}
+}
+
+// MetaType represents the type of a type value, i.e. `Type`
+
+type MetaType struct{}
+
+func init() {
+	gob.Register(&MetaType{})
+}
+
+func (*MetaType) IsType() {}
+
+func (*MetaType) String() string {
+	return "Type"
+}
+
+func (*MetaType) QualifiedString() string {
+	return "Type"
+}
+
+func (*MetaType) ID() TypeID {
+	return "Type"
+}
+
+func (*MetaType) Equal(other Type) bool {
+	_, ok := other.(*MetaType)
+	return ok
+}
+
+func (*MetaType) IsResourceType() bool {
+	return false
+}
+
+func (*MetaType) IsInvalidType() bool {
+	return false
+}
+
+func (*MetaType) TypeAnnotationState() TypeAnnotationState {
+	return TypeAnnotationStateValid
+}
+
+func (*MetaType) ContainsFirstLevelInterfaceType() bool {
+	return false
+}
+
+func (*MetaType) Unify(_ Type, _ map[*TypeParameter]Type, _ func(err error), _ ast.Range) bool {
+	return false
+}
+
+func (t *MetaType) Resolve(_ map[*TypeParameter]Type) Type {
+	return t
+}
+
+const MetaTypeIdentifierFieldName = "identifier"
+const MetaTypeIsSubtypeFunctionName = "isSubtype"
+
+var metaTypeIsSubtypeFunctionType = &FunctionType{
+	Parameters: []*Parameter{
+		{
+			Label:          "of",
+			Identifier:     "otherType",
+			TypeAnnotation: NewTypeAnnotation(&MetaType{}),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(&BoolType{}),
+}
+
+func (t *MetaType) CanHaveMembers() bool {
+	return true
+}
+
+func (t *MetaType) GetMember(identifier string, _ ast.Range, _ func(error)) *Member {
+	switch identifier {
+	case MetaTypeIdentifierFieldName:
+		return NewPublicConstantFieldMember(t, identifier, &StringType{})
+
+	case MetaTypeIsSubtypeFunctionName:
+		return NewPublicFunctionMember(t, identifier, metaTypeIsSubtypeFunctionType)
+
+	default:
+		return nil
+	}
+}
+
+// metaTypeFunctionType is the type of the function `Type<T>()`,
+// which returns the type value for the given type argument
+//
+var metaTypeFunctionType = &FunctionType{
+	TypeParameters: []*TypeParameter{
+		{Name: "T"},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(&MetaType{}),
+}
+
+func init() {
+	metaType := &MetaType{}
+	typeName := metaType.String()
+
+	// check type is not accidentally redeclared
+	if _, ok := BaseValues[typeName]; ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	BaseValues[typeName] = baseFunction{
+		name:          typeName,
+		invokableType: metaTypeFunctionType,
+	}
+}
+
+// Built-in members, which are available for values of all types
+
+const GetTypeFunctionName = "getType"
+const IsInstanceFunctionName = "isInstance"
+
+var getTypeFunctionType = &FunctionType{
+	ReturnTypeAnnotation: NewTypeAnnotation(&MetaType{}),
+}
+
+var isInstanceFunctionType = &FunctionType{
+	Parameters: []*Parameter{
+		{
+			Label:          ArgumentLabelNotRequired,
+			Identifier:     "type",
+			TypeAnnotation: NewTypeAnnotation(&MetaType{}),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(&BoolType{}),
+}
+
+// BuiltinMemberNames are the names of the members
+// which are available for values of all types.
+// Composites and interfaces may not declare members with these names
+//
+var BuiltinMemberNames = []string{
+	GetTypeFunctionName,
+	IsInstanceFunctionName,
+}
+
+// GetBuiltinMember returns the built-in member with the given identifier
+// for values of the given type, if any
+//
+func GetBuiltinMember(ty Type, identifier string) *Member {
+	switch identifier {
+	case GetTypeFunctionName:
+		return NewPublicFunctionMember(ty, identifier, getTypeFunctionType)
+
+	case IsInstanceFunctionName:
+		return NewPublicFunctionMember(ty, identifier, isInstanceFunctionType)
 
 	default:
 		return nil
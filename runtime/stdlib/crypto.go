@@ -0,0 +1,332 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package stdlib
+
+import (
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/crypto"
+	"github.com/onflow/cadence/runtime/errors"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/trampoline"
+)
+
+// This file defines the cryptography values built in to the Flow runtime.
+
+// built-in enum constructors
+
+// newFlowEnumConstructorFunction returns the constructor function for the given built-in enum.
+//
+// Like for enums declared in programs, the cases are available as members of the constructor,
+// and the constructor returns the case with the given raw value, if any, or nil.
+//
+func newFlowEnumConstructorFunction(enumType *sema.CompositeType) StandardLibraryFunction {
+
+	typeMembers := make(map[string]*sema.Member, len(enumType.EnumCases))
+	valueMembers := make(map[string]interpreter.Value, len(enumType.EnumCases))
+	caseValues := make([]*interpreter.CompositeValue, len(enumType.EnumCases))
+
+	for i, enumCase := range enumType.EnumCases {
+
+		typeMembers[enumCase] = &sema.Member{
+			ContainerType:   enumType,
+			Access:          ast.AccessPublic,
+			Identifier:      ast.Identifier{Identifier: enumCase},
+			DeclarationKind: common.DeclarationKindEnumCase,
+			VariableKind:    ast.VariableKindConstant,
+			TypeAnnotation:  sema.NewTypeAnnotation(enumType),
+		}
+
+		caseValue := NewFlowEnumCaseValue(enumType, uint8(i))
+
+		caseValues[i] = caseValue
+		valueMembers[enumCase] = caseValue
+	}
+
+	constructorType := &sema.SpecialFunctionType{
+		FunctionType: &sema.FunctionType{
+			Parameters: []*sema.Parameter{
+				{
+					Label:          sema.EnumRawValueFieldName,
+					Identifier:     sema.EnumRawValueFieldName,
+					TypeAnnotation: sema.NewTypeAnnotation(enumType.EnumRawType),
+				},
+			},
+			ReturnTypeAnnotation: sema.NewTypeAnnotation(
+				&sema.OptionalType{
+					Type: enumType,
+				},
+			),
+		},
+		Members: typeMembers,
+	}
+
+	function := NewStandardLibraryFunction(
+		enumType.Identifier,
+		constructorType,
+		func(invocation interpreter.Invocation) trampoline.Trampoline {
+			rawValue := int(invocation.Arguments[0].(interpreter.UInt8Value))
+
+			if rawValue >= len(caseValues) {
+				return trampoline.Done{Result: interpreter.NilValue{}}
+			}
+
+			caseValue := caseValues[rawValue].Copy()
+			return trampoline.Done{Result: interpreter.NewSomeValueOwningNonCopying(caseValue)}
+		},
+		[]string{sema.EnumRawValueFieldName},
+	)
+
+	function.Function.Members = valueMembers
+
+	return function
+}
+
+// NewFlowEnumCaseValue returns the case of the given built-in enum with the given raw value.
+//
+func NewFlowEnumCaseValue(enumType *sema.CompositeType, rawValue uint8) *interpreter.CompositeValue {
+	return &interpreter.CompositeValue{
+		Location: flowLocation,
+		TypeID:   enumType.ID(),
+		Kind:     common.CompositeKindEnum,
+		Fields: map[string]interpreter.Value{
+			sema.EnumRawValueFieldName: interpreter.UInt8Value(rawValue),
+		},
+		// NOTE: new value has no owner
+		Owner: nil,
+	}
+}
+
+// NewHashAlgorithmCaseValue returns the case of the `HashAlgorithm` enum for the given hash algorithm.
+//
+func NewHashAlgorithmCaseValue(hashAlgorithm crypto.HashAlgorithm) *interpreter.CompositeValue {
+	for i, supportedHashAlgorithm := range crypto.HashAlgorithms {
+		if supportedHashAlgorithm == hashAlgorithm {
+			return NewFlowEnumCaseValue(sema.HashAlgorithmType, uint8(i))
+		}
+	}
+
+	panic(crypto.UnsupportedHashAlgorithmError{
+		HashAlgorithm: hashAlgorithm,
+	})
+}
+
+// NewSignatureAlgorithmCaseValue returns the case of the `SignatureAlgorithm` enum for the given signature algorithm.
+//
+func NewSignatureAlgorithmCaseValue(signatureAlgorithm crypto.SignatureAlgorithm) *interpreter.CompositeValue {
+	for i, supportedSignatureAlgorithm := range crypto.SignatureAlgorithms {
+		if supportedSignatureAlgorithm == signatureAlgorithm {
+			return NewFlowEnumCaseValue(sema.SignatureAlgorithmType, uint8(i))
+		}
+	}
+
+	panic(crypto.UnsupportedSignatureAlgorithmError{
+		SignatureAlgorithm: signatureAlgorithm,
+	})
+}
+
+// HashAlgorithmFromValue returns the hash algorithm for the given case of the `HashAlgorithm` enum.
+//
+func HashAlgorithmFromValue(value interpreter.Value) crypto.HashAlgorithm {
+	index := enumCaseRawValue(value)
+	if index >= len(crypto.HashAlgorithms) {
+		return crypto.HashAlgorithmUnknown
+	}
+	return crypto.HashAlgorithms[index]
+}
+
+// SignatureAlgorithmFromValue returns the signature algorithm for the given case of the `SignatureAlgorithm` enum.
+//
+func SignatureAlgorithmFromValue(value interpreter.Value) crypto.SignatureAlgorithm {
+	index := enumCaseRawValue(value)
+	if index >= len(crypto.SignatureAlgorithms) {
+		return crypto.SignatureAlgorithmUnknown
+	}
+	return crypto.SignatureAlgorithms[index]
+}
+
+func enumCaseRawValue(value interpreter.Value) int {
+	compositeValue := value.(*interpreter.CompositeValue)
+	return int(compositeValue.Fields[sema.EnumRawValueFieldName].(interpreter.UInt8Value))
+}
+
+// built-in function types
+
+var byteArrayType = &sema.VariableSizedType{
+	Type: &sema.UInt8Type{},
+}
+
+var cryptoHashFunctionType = &sema.FunctionType{
+	Parameters: []*sema.Parameter{
+		{
+			Label:          sema.ArgumentLabelNotRequired,
+			Identifier:     "data",
+			TypeAnnotation: sema.NewTypeAnnotation(byteArrayType),
+		},
+		{
+			Identifier:     "algorithm",
+			TypeAnnotation: sema.NewTypeAnnotation(sema.HashAlgorithmType),
+		},
+	},
+	ReturnTypeAnnotation: sema.NewTypeAnnotation(byteArrayType),
+}
+
+var cryptoVerifySignatureFunctionType = &sema.FunctionType{
+	Parameters: []*sema.Parameter{
+		{
+			Identifier:     "signature",
+			TypeAnnotation: sema.NewTypeAnnotation(byteArrayType),
+		},
+		{
+			Identifier:     "tag",
+			TypeAnnotation: sema.NewTypeAnnotation(&sema.StringType{}),
+		},
+		{
+			Identifier:     "signedData",
+			TypeAnnotation: sema.NewTypeAnnotation(byteArrayType),
+		},
+		{
+			Identifier:     "publicKey",
+			TypeAnnotation: sema.NewTypeAnnotation(byteArrayType),
+		},
+		{
+			Identifier:     "signatureAlgorithm",
+			TypeAnnotation: sema.NewTypeAnnotation(sema.SignatureAlgorithmType),
+		},
+		{
+			Identifier:     "hashAlgorithm",
+			TypeAnnotation: sema.NewTypeAnnotation(sema.HashAlgorithmType),
+		},
+	},
+	ReturnTypeAnnotation: sema.NewTypeAnnotation(&sema.BoolType{}),
+}
+
+// CryptoType
+
+type CryptoType struct{}
+
+func (*CryptoType) IsType() {}
+
+func (*CryptoType) String() string {
+	return "Crypto"
+}
+
+func (*CryptoType) QualifiedString() string {
+	return "Crypto"
+}
+
+func (*CryptoType) ID() sema.TypeID {
+	return "Crypto"
+}
+
+func (*CryptoType) Equal(other sema.Type) bool {
+	_, ok := other.(*CryptoType)
+	return ok
+}
+
+func (*CryptoType) IsResourceType() bool {
+	return false
+}
+
+func (*CryptoType) TypeAnnotationState() sema.TypeAnnotationState {
+	return sema.TypeAnnotationStateValid
+}
+
+func (*CryptoType) IsInvalidType() bool {
+	return false
+}
+
+func (*CryptoType) ContainsFirstLevelInterfaceType() bool {
+	return false
+}
+
+func (*CryptoType) CanHaveMembers() bool {
+	return true
+}
+
+func (t *CryptoType) GetMember(identifier string, _ ast.Range, _ func(error)) *sema.Member {
+	switch identifier {
+	case "hash":
+		return sema.NewPublicFunctionMember(t, identifier, cryptoHashFunctionType)
+
+	case "verifySignature":
+		return sema.NewPublicFunctionMember(t, identifier, cryptoVerifySignatureFunctionType)
+
+	default:
+		return nil
+	}
+}
+
+func (t *CryptoType) Unify(_ sema.Type, _ map[*sema.TypeParameter]sema.Type, _ func(err error), _ ast.Range) bool {
+	return false
+}
+
+func (t *CryptoType) Resolve(_ map[*sema.TypeParameter]sema.Type) sema.Type {
+	return t
+}
+
+// CryptoValue
+
+type CryptoValue struct {
+	Hash            interpreter.HostFunctionValue
+	VerifySignature interpreter.HostFunctionValue
+}
+
+func (CryptoValue) IsValue() {}
+
+func (CryptoValue) DynamicType(*interpreter.Interpreter) interpreter.DynamicType {
+	return interpreter.CompositeDynamicType{
+		StaticType: &CryptoType{},
+	}
+}
+
+func (v CryptoValue) Copy() interpreter.Value {
+	return v
+}
+
+func (CryptoValue) GetOwner() *common.Address {
+	// value is never owned
+	return nil
+}
+
+func (CryptoValue) SetOwner(_ *common.Address) {
+	// NO-OP: value cannot be owned
+}
+
+func (v CryptoValue) GetMember(_ *interpreter.Interpreter, _ interpreter.LocationRange, name string) interpreter.Value {
+	switch name {
+	case "hash":
+		return v.Hash
+
+	case "verifySignature":
+		return v.VerifySignature
+
+	default:
+		panic(errors.NewUnreachableError())
+	}
+}
+
+func (v CryptoValue) SetMember(_ *interpreter.Interpreter, _ interpreter.LocationRange, _ string, _ interpreter.Value) {
+	panic(errors.NewUnreachableError())
+}
+
+func (CryptoValue) String() string {
+	return "Crypto"
+}
@@ -29,7 +29,7 @@ import (
 
 // This file defines functions built in to the Flow runtime.
 
-var flowLocation = ast.StringLocation("flow")
+var flowLocation = sema.FlowLocation
 
 // built-in function types
 
@@ -161,6 +161,32 @@ func FlowBuiltInFunctions(impls FlowBuiltinImpls) StandardLibraryFunctions {
 			impls.GetBlock,
 			nil,
 		),
+		newFlowEnumConstructorFunction(sema.HashAlgorithmType),
+		newFlowEnumConstructorFunction(sema.SignatureAlgorithmType),
+	}
+}
+
+// CryptoImpls defines the set of functions needed to implement the Flow
+// built-in `Crypto` value.
+type CryptoImpls struct {
+	Hash            interpreter.HostFunction
+	VerifySignature interpreter.HostFunction
+}
+
+// FlowBuiltInValues returns a list of standard library values, bound to
+// the provided implementation.
+func FlowBuiltInValues(cryptoImpls CryptoImpls) StandardLibraryValues {
+	return StandardLibraryValues{
+		{
+			Name:       "Crypto",
+			Type:       &CryptoType{},
+			Kind:       common.DeclarationKindConstant,
+			IsConstant: true,
+			Value: CryptoValue{
+				Hash:            interpreter.NewHostFunctionValue(cryptoImpls.Hash),
+				VerifySignature: interpreter.NewHostFunctionValue(cryptoImpls.VerifySignature),
+			},
+		},
 	}
 }
 
@@ -228,6 +254,11 @@ var AccountEventContractsParameter = &sema.Parameter{
 	TypeAnnotation: sema.NewTypeAnnotation(TypeIDsType),
 }
 
+var AccountEventContractParameter = &sema.Parameter{
+	Identifier:     "contract",
+	TypeAnnotation: sema.NewTypeAnnotation(&sema.StringType{}),
+}
+
 var AccountCreatedEventType = newFlowEventType(
 	"AccountCreated",
 	AccountEventAddressParameter,
@@ -235,12 +266,57 @@ var AccountCreatedEventType = newFlowEventType(
 	AccountEventContractsParameter,
 )
 
+var AccountEventKeyIndexParameter = &sema.Parameter{
+	Identifier:     "keyIndex",
+	TypeAnnotation: sema.NewTypeAnnotation(&sema.IntType{}),
+}
+
+var AccountEventSignatureAlgorithmParameter = &sema.Parameter{
+	Identifier:     "signatureAlgorithm",
+	TypeAnnotation: sema.NewTypeAnnotation(sema.SignatureAlgorithmType),
+}
+
+var AccountEventHashAlgorithmParameter = &sema.Parameter{
+	Identifier:     "hashAlgorithm",
+	TypeAnnotation: sema.NewTypeAnnotation(sema.HashAlgorithmType),
+}
+
+var AccountEventWeightParameter = &sema.Parameter{
+	Identifier:     "weight",
+	TypeAnnotation: sema.NewTypeAnnotation(&sema.UFix64Type{}),
+}
+
+// AccountKeyAddedEventType is the type of the event emitted by `AuthAccount.addPublicKey`.
+//
+// Deprecated: The event has an untyped payload, the encoded public key.
+// It is only emitted by the deprecated `AuthAccount.addPublicKey`,
+// `AuthAccount.keys.add` emits AccountStructuredKeyAddedEventType instead.
+//
 var AccountKeyAddedEventType = newFlowEventType(
 	"AccountKeyAdded",
 	AccountEventAddressParameter,
 	AccountEventPublicKeyParameter,
 )
 
+// AccountStructuredKeyAddedEventType is the type of the event emitted by `AuthAccount.keys.add`.
+//
+var AccountStructuredKeyAddedEventType = newFlowEventType(
+	"AccountStructuredKeyAdded",
+	AccountEventAddressParameter,
+	AccountEventKeyIndexParameter,
+	AccountEventPublicKeyParameter,
+	AccountEventSignatureAlgorithmParameter,
+	AccountEventHashAlgorithmParameter,
+	AccountEventWeightParameter,
+)
+
+var AccountKeyRevokedEventType = newFlowEventType(
+	"AccountKeyRevoked",
+	AccountEventAddressParameter,
+	AccountEventKeyIndexParameter,
+	AccountEventPublicKeyParameter,
+)
+
 var AccountKeyRemovedEventType = newFlowEventType(
 	"AccountKeyRemoved",
 	AccountEventAddressParameter,
@@ -255,6 +331,27 @@ var AccountCodeUpdatedEventType = newFlowEventType(
 	AccountEventContractsParameter,
 )
 
+var AccountContractAddedEventType = newFlowEventType(
+	"AccountContractAdded",
+	AccountEventAddressParameter,
+	AccountEventCodeHashParameter,
+	AccountEventContractParameter,
+)
+
+var AccountContractUpdatedEventType = newFlowEventType(
+	"AccountContractUpdated",
+	AccountEventAddressParameter,
+	AccountEventCodeHashParameter,
+	AccountEventContractParameter,
+)
+
+var AccountContractRemovedEventType = newFlowEventType(
+	"AccountContractRemoved",
+	AccountEventAddressParameter,
+	AccountEventCodeHashParameter,
+	AccountEventContractParameter,
+)
+
 // BlockType
 
 type BlockType struct{}
@@ -343,4 +440,14 @@ var FlowBuiltInTypes = StandardLibraryTypes{
 		Type: &BlockType{},
 		Kind: common.DeclarationKindType,
 	},
+	StandardLibraryType{
+		Name: sema.HashAlgorithmType.Identifier,
+		Type: sema.HashAlgorithmType,
+		Kind: common.DeclarationKindType,
+	},
+	StandardLibraryType{
+		Name: sema.SignatureAlgorithmType.Identifier,
+		Type: sema.SignatureAlgorithmType,
+		Kind: common.DeclarationKindType,
+	},
 }
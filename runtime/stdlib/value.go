@@ -21,6 +21,7 @@ package stdlib
 import (
 	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
 	"github.com/onflow/cadence/runtime/sema"
 )
 
@@ -29,6 +30,7 @@ type StandardLibraryValue struct {
 	Type       sema.Type
 	Kind       common.DeclarationKind
 	IsConstant bool
+	Value      interpreter.Value
 }
 
 func (v StandardLibraryValue) ValueDeclarationType() sema.Type {
@@ -65,3 +67,11 @@ func (functions StandardLibraryValues) ToValueDeclarations() map[string]sema.Val
 	}
 	return valueDeclarations
 }
+
+func (values StandardLibraryValues) ToValues() map[string]interpreter.Value {
+	result := make(map[string]interpreter.Value, len(values))
+	for _, value := range values {
+		result[value.Name] = value.Value
+	}
+	return result
+}
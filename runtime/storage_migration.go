@@ -0,0 +1,301 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+//go:generate stringer -type=StorageMigrationStatus
+
+// StorageMigrationStatus is the outcome of the migration of a single stored value.
+//
+type StorageMigrationStatus int
+
+const (
+	StorageMigrationStatusUnknown StorageMigrationStatus = iota
+	// StorageMigrationStatusMigrated indicates the value was re-encoded
+	// (or would have been, in a dry run)
+	StorageMigrationStatusMigrated
+	// StorageMigrationStatusUpToDate indicates the value is already
+	// encoded with the current encoding version
+	StorageMigrationStatusUpToDate
+	// StorageMigrationStatusMissing indicates there is no value stored for the key
+	StorageMigrationStatusMissing
+	// StorageMigrationStatusFailed indicates the value could not be migrated
+	StorageMigrationStatusFailed
+)
+
+// StorageMigrationResult is the result of the migration of the value stored under a key.
+//
+type StorageMigrationResult struct {
+	Key    []byte
+	Status StorageMigrationStatus
+	// FromVersion is the encoding version the value was stored with
+	FromVersion uint16
+	// Err is the reason the migration failed, if the status is StorageMigrationStatusFailed
+	Err error
+	// size is the number of bytes of the value stored under the key after the migration
+	size int
+}
+
+// StorageMigrationReport summarizes the migration of the storage of an account.
+//
+type StorageMigrationReport struct {
+	Owner   Address
+	DryRun  bool
+	Results []StorageMigrationResult
+	// StorageUsed is the number of bytes used by the values stored in the account
+	// after the migration, or which would be used, in dry-run mode
+	StorageUsed uint64
+}
+
+// Count returns the number of results with the given status.
+//
+func (r StorageMigrationReport) Count(status StorageMigrationStatus) int {
+	count := 0
+	for _, result := range r.Results {
+		if result.Status == status {
+			count++
+		}
+	}
+	return count
+}
+
+// Failures returns the results of all keys which could not be migrated.
+//
+func (r StorageMigrationReport) Failures() []StorageMigrationResult {
+	var failures []StorageMigrationResult
+	for _, result := range r.Results {
+		if result.Status == StorageMigrationStatusFailed {
+			failures = append(failures, result)
+		}
+	}
+	return failures
+}
+
+func (r StorageMigrationReport) String() string {
+	var builder strings.Builder
+
+	mode := ""
+	if r.DryRun {
+		mode = " (dry run)"
+	}
+
+	builder.WriteString(fmt.Sprintf(
+		"storage migration of account %s%s: %d keys, %d migrated, %d up-to-date, %d missing, %d failed, %d bytes used",
+		r.Owner,
+		mode,
+		len(r.Results),
+		r.Count(StorageMigrationStatusMigrated),
+		r.Count(StorageMigrationStatusUpToDate),
+		r.Count(StorageMigrationStatusMissing),
+		r.Count(StorageMigrationStatusFailed),
+		r.StorageUsed,
+	))
+
+	for _, failure := range r.Failures() {
+		builder.WriteString(fmt.Sprintf(
+			"\n  %q (version %d): %s",
+			failure.Key,
+			failure.FromVersion,
+			failure.Err,
+		))
+	}
+
+	return builder.String()
+}
+
+// StorageMigration upgrades the values stored in accounts
+// to the current encoding version.
+//
+// The keys of the stored values are enumerated through Interface.GetValueKeys.
+// Each value is read through Interface.GetValue, decoded with the decoder
+// for the version it was encoded with, re-encoded with the current version,
+// and written back through Interface.SetValue.
+//
+// As re-encoding changes the size of values, the number of bytes used
+// by the values stored in the account is recomputed after the migration.
+//
+type StorageMigration struct {
+	runtimeInterface Interface
+	dryRun           bool
+}
+
+type StorageMigrationOption func(*StorageMigration)
+
+// WithStorageMigrationDryRun returns a storage migration option which enables
+// or disables the dry-run mode. In dry-run mode, values are decoded and re-encoded,
+// but not written back.
+//
+func WithStorageMigrationDryRun(dryRun bool) StorageMigrationOption {
+	return func(migration *StorageMigration) {
+		migration.dryRun = dryRun
+	}
+}
+
+// NewStorageMigration returns a new storage migration which accesses storage
+// through the given runtime interface.
+//
+func NewStorageMigration(runtimeInterface Interface, options ...StorageMigrationOption) *StorageMigration {
+	migration := &StorageMigration{
+		runtimeInterface: runtimeInterface,
+	}
+
+	for _, option := range options {
+		option(migration)
+	}
+
+	return migration
+}
+
+// Migrate migrates all values stored in the storage of the given account.
+//
+// The migration continues when the value for a key fails to migrate,
+// the failure is reported in the result for the key.
+//
+// An error is returned if the keys cannot be enumerated,
+// or if the recomputed storage used cannot be written.
+//
+func (m *StorageMigration) Migrate(owner Address) (StorageMigrationReport, error) {
+
+	// TODO: fix controller
+	keys, err := m.runtimeInterface.GetValueKeys(owner[:], []byte{})
+	if err != nil {
+		return StorageMigrationReport{}, err
+	}
+
+	// Migrate the values in a deterministic order
+
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i], keys[j]) < 0
+	})
+
+	report := StorageMigrationReport{
+		Owner:   owner,
+		DryRun:  m.dryRun,
+		Results: make([]StorageMigrationResult, 0, len(keys)),
+	}
+
+	for _, key := range keys {
+
+		// The storage used is not a value, it is recomputed below
+
+		if string(key) == storageUsedKey {
+			continue
+		}
+
+		result := m.migrateKey(owner, key)
+		report.Results = append(report.Results, result)
+		report.StorageUsed += uint64(result.size)
+	}
+
+	if !m.dryRun {
+		var data [8]byte
+		binary.BigEndian.PutUint64(data[:], report.StorageUsed)
+
+		// TODO: fix controller
+		err = m.runtimeInterface.SetValue(owner[:], []byte{}, []byte(storageUsedKey), data[:])
+		if err != nil {
+			return report, err
+		}
+	}
+
+	return report, nil
+}
+
+func (m *StorageMigration) migrateKey(owner Address, key []byte) (result StorageMigrationResult) {
+	result.Key = key
+
+	fail := func(err error) StorageMigrationResult {
+		result.Status = StorageMigrationStatusFailed
+		result.Err = err
+		return result
+	}
+
+	// TODO: fix controller
+	data, err := m.runtimeInterface.GetValue(owner[:], []byte{}, key)
+	if err != nil {
+		return fail(err)
+	}
+
+	// Values which fail to migrate are kept as they are
+
+	result.size = len(data)
+
+	if len(data) == 0 {
+		result.Status = StorageMigrationStatusMissing
+		return result
+	}
+
+	result.FromVersion, _ = interpreter.EncodingVersion(data)
+
+	if result.FromVersion == interpreter.CurrentEncodingVersion {
+		result.Status = StorageMigrationStatusUpToDate
+		return result
+	}
+
+	newData, err := m.reencode(owner, data)
+	if err != nil {
+		return fail(err)
+	}
+
+	if !m.dryRun {
+		// TODO: fix controller
+		err = m.runtimeInterface.SetValue(owner[:], []byte{}, key, newData)
+		if err != nil {
+			return fail(err)
+		}
+	}
+
+	result.size = len(newData)
+	result.Status = StorageMigrationStatusMigrated
+	return result
+}
+
+// reencode decodes the given data and encodes it again with the current encoding version.
+//
+// Decoding and encoding may panic, e.g. for invalid legacy data,
+// so panics are recovered and returned as errors.
+//
+func (m *StorageMigration) reencode(owner Address, data []byte) (newData []byte, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			switch r := r.(type) {
+			case error:
+				err = r
+			default:
+				err = fmt.Errorf("%s", r)
+			}
+		}
+	}()
+
+	value, err := interpreter.DecodeValue(data, &owner)
+	if err != nil {
+		return nil, err
+	}
+
+	return interpreter.EncodeValue(value)
+}
@@ -0,0 +1,181 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+func encodeLegacyTestValue(t *testing.T, owner Address, value interpreter.Value) []byte {
+	value.SetOwner(&owner)
+
+	var w bytes.Buffer
+	err := gob.NewEncoder(&w).Encode(&value)
+	require.NoError(t, err)
+
+	return w.Bytes()
+}
+
+func TestRuntimeStorageMigration(t *testing.T) {
+
+	owner := Address{0x1}
+
+	newStorage := func() *testRuntimeInterface {
+
+		runtimeInterface := &testRuntimeInterface{
+			storage: newTestStorage(),
+		}
+
+		legacyData := encodeLegacyTestValue(t,
+			owner,
+			interpreter.NewArrayValueUnownedNonCopying(
+				interpreter.NewStringValue("legacy"),
+			),
+		)
+
+		currentData, err := interpreter.EncodeValue(interpreter.NewStringValue("current"))
+		require.NoError(t, err)
+
+		var storageUsedData [8]byte
+		binary.BigEndian.PutUint64(storageUsedData[:], 1)
+
+		storedValues := map[string][]byte{
+			"legacy":       legacyData,
+			"current":      currentData,
+			"invalid":      {0x1, 0x2, 0x3},
+			storageUsedKey: storageUsedData[:],
+		}
+
+		for key, data := range storedValues {
+			err := runtimeInterface.SetValue(owner[:], []byte{}, []byte(key), data)
+			require.NoError(t, err)
+		}
+
+		return runtimeInterface
+	}
+
+	getValue := func(t *testing.T, runtimeInterface *testRuntimeInterface, key string) []byte {
+		data, err := runtimeInterface.GetValue(owner[:], []byte{}, []byte(key))
+		require.NoError(t, err)
+		return data
+	}
+
+	// assertResults asserts the results of the migration,
+	// which are ordered by key, and do not include the storage used
+
+	assertResults := func(t *testing.T, report StorageMigrationReport) {
+		require.Len(t, report.Results, 3)
+
+		assert.Equal(t, []byte("current"), report.Results[0].Key)
+		assert.Equal(t, StorageMigrationStatusUpToDate, report.Results[0].Status)
+		assert.Equal(t, interpreter.CurrentEncodingVersion, report.Results[0].FromVersion)
+
+		assert.Equal(t, []byte("invalid"), report.Results[1].Key)
+		assert.Equal(t, StorageMigrationStatusFailed, report.Results[1].Status)
+		assert.Error(t, report.Results[1].Err)
+
+		assert.Equal(t, []byte("legacy"), report.Results[2].Key)
+		assert.Equal(t, StorageMigrationStatusMigrated, report.Results[2].Status)
+		assert.Equal(t, interpreter.EncodingVersionLegacy, report.Results[2].FromVersion)
+
+		failures := report.Failures()
+		require.Len(t, failures, 1)
+		assert.Equal(t, []byte("invalid"), failures[0].Key)
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+
+		runtimeInterface := newStorage()
+
+		legacyData := getValue(t, runtimeInterface, "legacy")
+		storageUsedData := getValue(t, runtimeInterface, storageUsedKey)
+
+		migration := NewStorageMigration(
+			runtimeInterface,
+			WithStorageMigrationDryRun(true),
+		)
+
+		report, err := migration.Migrate(owner)
+		require.NoError(t, err)
+
+		assert.True(t, report.DryRun)
+		assertResults(t, report)
+		assert.NotZero(t, report.StorageUsed)
+
+		assert.Equal(t, legacyData, getValue(t, runtimeInterface, "legacy"))
+		assert.Equal(t, storageUsedData, getValue(t, runtimeInterface, storageUsedKey))
+	})
+
+	t.Run("migration", func(t *testing.T) {
+
+		runtimeInterface := newStorage()
+
+		migration := NewStorageMigration(runtimeInterface)
+
+		report, err := migration.Migrate(owner)
+		require.NoError(t, err)
+
+		assert.False(t, report.DryRun)
+		assertResults(t, report)
+
+		data := getValue(t, runtimeInterface, "legacy")
+
+		version, _ := interpreter.EncodingVersion(data)
+		require.Equal(t, interpreter.CurrentEncodingVersion, version)
+
+		value, err := interpreter.DecodeValue(data, &owner)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			[]interpreter.Value{interpreter.NewStringValue("legacy")},
+			value.(*interpreter.ArrayValue).Values,
+		)
+
+		// the storage used is recomputed from the sizes of the values after the migration
+
+		storageUsed := uint64(
+			len(getValue(t, runtimeInterface, "current")) +
+				len(getValue(t, runtimeInterface, "invalid")) +
+				len(data),
+		)
+
+		assert.Equal(t, storageUsed, report.StorageUsed)
+		assert.Equal(t,
+			storageUsed,
+			binary.BigEndian.Uint64(getValue(t, runtimeInterface, storageUsedKey)),
+		)
+
+		// migrating again has no effect
+
+		report, err = migration.Migrate(owner)
+		require.NoError(t, err)
+
+		assert.Equal(t, StorageMigrationStatusUpToDate, report.Results[2].Status)
+		assert.Equal(t, 2, report.Count(StorageMigrationStatusUpToDate))
+		assert.Equal(t, storageUsed, report.StorageUsed)
+	})
+}
@@ -533,8 +533,11 @@ func TestCheckAccessModifierGlobalCompositeDeclaration(t *testing.T) {
 					}
 
 					body := "{}"
-					if compositeKind == common.CompositeKindEvent {
+					switch compositeKind {
+					case common.CompositeKindEvent:
 						body = "()"
+					case common.CompositeKindEnum:
+						body = ": UInt8 { case a }"
 					}
 
 					testName := fmt.Sprintf("%s %s/%s/%s",
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/cadence/runtime/common"
@@ -859,3 +860,246 @@ func TestCheckAccount(t *testing.T) {
 		}
 	}
 }
+
+func TestCheckAccountContracts(t *testing.T) {
+
+	t.Run("AuthAccount.contracts", func(t *testing.T) {
+
+		_, err := ParseAndCheckAccount(t,
+			`
+              fun test(): [DeployedContract?] {
+                  let added: DeployedContract = authAccount.contracts.add(name: "A", code: [0x1], 1, "two")
+                  let updated: DeployedContract = authAccount.contracts.update(name: "A", code: [0x2])
+                  let name: String = added.name
+                  let code: [UInt8] = updated.code
+                  let address: Address = updated.address
+                  return [
+                      authAccount.contracts.get(name: "A"),
+                      authAccount.contracts.remove(name: "A")
+                  ]
+              }
+            `,
+		)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("PublicAccount.contracts", func(t *testing.T) {
+
+		_, err := ParseAndCheckAccount(t,
+			`
+              fun test() {
+                  publicAccount.contracts.get(name: "A")
+              }
+            `,
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+	})
+}
+
+func TestCheckAccountStorageEnumeration(t *testing.T) {
+
+	t.Run("AuthAccount", func(t *testing.T) {
+
+		_, err := ParseAndCheckAccount(t,
+			`
+              fun test(): [Path] {
+                  let storagePaths: [Path] = authAccount.storagePaths
+                  let publicPaths: [Path] = authAccount.publicPaths
+                  let privatePaths: [Path] = authAccount.privatePaths
+
+                  authAccount.forEachStored(fun (path: Path, type: Type): Bool {
+                      return type.isSubtype(of: Type<AnyStruct>())
+                  })
+
+                  return storagePaths.concat(publicPaths).concat(privatePaths)
+              }
+            `,
+		)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("AuthAccount.storagePaths, constant", func(t *testing.T) {
+
+		_, err := ParseAndCheckAccount(t,
+			`
+              fun test() {
+                  authAccount.storagePaths = []
+              }
+            `,
+		)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.InvalidAssignmentAccessError{}, errs[0])
+		assert.IsType(t, &sema.AssignmentToConstantMemberError{}, errs[1])
+	})
+
+	t.Run("AuthAccount.forEachStored, invalid function", func(t *testing.T) {
+
+		_, err := ParseAndCheckAccount(t,
+			`
+              fun test() {
+                  authAccount.forEachStored(fun (path: Path, type: Type) {})
+              }
+            `,
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	for _, name := range []string{"storagePaths", "publicPaths", "privatePaths", "forEachStored"} {
+
+		t.Run(fmt.Sprintf("PublicAccount.%s", name), func(t *testing.T) {
+
+			_, err := ParseAndCheckAccount(t,
+				fmt.Sprintf(
+					`
+                      fun test() {
+                          publicAccount.%s
+                      }
+                    `,
+					name,
+				),
+			)
+
+			errs := ExpectCheckerErrors(t, err, 1)
+
+			assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+		})
+	}
+}
+
+func TestCheckAccountStorageUsage(t *testing.T) {
+
+	_, err := ParseAndCheckAccount(t,
+		`
+          fun test(): [UInt64] {
+              return [
+                  authAccount.storageUsed,
+                  authAccount.storageCapacity,
+                  publicAccount.storageUsed,
+                  publicAccount.storageCapacity
+              ]
+          }
+        `,
+	)
+
+	require.NoError(t, err)
+}
+
+func TestCheckAccountKeys(t *testing.T) {
+
+	parseAndCheck := func(t *testing.T, code string) (*sema.Checker, error) {
+
+		valueDeclarations := stdlib.FlowBuiltInFunctions(stdlib.FlowBuiltinImpls{}).ToValueDeclarations()
+
+		valueDeclarations["authAccount"] = stdlib.StandardLibraryValue{
+			Name:       "authAccount",
+			Type:       &sema.AuthAccountType{},
+			Kind:       common.DeclarationKindConstant,
+			IsConstant: true,
+		}
+
+		valueDeclarations["publicAccount"] = stdlib.StandardLibraryValue{
+			Name:       "publicAccount",
+			Type:       &sema.PublicAccountType{},
+			Kind:       common.DeclarationKindConstant,
+			IsConstant: true,
+		}
+
+		return ParseAndCheckWithOptions(t,
+			code,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithPredeclaredValues(valueDeclarations),
+					sema.WithPredeclaredTypes(stdlib.FlowBuiltInTypes.ToTypeDeclarations()),
+				},
+			},
+		)
+	}
+
+	t.Run("AuthAccount.keys", func(t *testing.T) {
+
+		_, err := parseAndCheck(t,
+			`
+              fun test(): [AccountKey?] {
+                  let publicKey: [UInt8] = []
+                  let added: AccountKey = authAccount.keys.add(
+                      publicKey: publicKey,
+                      signatureAlgorithm: SignatureAlgorithm.ECDSA_P256,
+                      hashAlgorithm: HashAlgorithm.SHA3_256,
+                      weight: 1000.0
+                  )
+                  let keyIndex: Int = added.keyIndex
+                  let key: [UInt8] = added.publicKey
+                  let signatureAlgorithm: SignatureAlgorithm = added.signatureAlgorithm
+                  let hashAlgorithm: HashAlgorithm = added.hashAlgorithm
+                  let weight: UFix64 = added.weight
+                  let isRevoked: Bool = added.isRevoked
+                  return [
+                      authAccount.keys.get(keyIndex: 0),
+                      authAccount.keys.revoke(keyIndex: 0)
+                  ]
+              }
+            `,
+		)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("PublicAccount.keys", func(t *testing.T) {
+
+		_, err := parseAndCheck(t,
+			`
+              fun test(): AccountKey? {
+                  return publicAccount.keys.get(keyIndex: 0)
+              }
+            `,
+		)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("PublicAccount.keys.revoke", func(t *testing.T) {
+
+		_, err := parseAndCheck(t,
+			`
+              fun test() {
+                  publicAccount.keys.revoke(keyIndex: 0)
+              }
+            `,
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+	})
+
+	t.Run("invalid algorithm", func(t *testing.T) {
+
+		_, err := parseAndCheck(t,
+			`
+              fun test() {
+                  let publicKey: [UInt8] = []
+                  authAccount.keys.add(
+                      publicKey: publicKey,
+                      signatureAlgorithm: HashAlgorithm.SHA3_256,
+                      hashAlgorithm: HashAlgorithm.SHA3_256,
+                      weight: 1000.0
+                  )
+              }
+            `,
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+}
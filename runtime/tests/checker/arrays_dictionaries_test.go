@@ -624,8 +624,11 @@ func TestCheckArraySubtyping(t *testing.T) {
 		t.Run(kind.Keyword(), func(t *testing.T) {
 
 			body := "{}"
-			if kind == common.CompositeKindEvent {
+			switch kind {
+			case common.CompositeKindEvent:
 				body = "()"
+			case common.CompositeKindEnum:
+				body = ": UInt8 { case a }"
 			}
 
 			interfaceType := AsInterfaceType("I", kind)
@@ -677,8 +680,11 @@ func TestCheckDictionarySubtyping(t *testing.T) {
 		t.Run(kind.Keyword(), func(t *testing.T) {
 
 			body := "{}"
-			if kind == common.CompositeKindEvent {
+			switch kind {
+			case common.CompositeKindEvent:
 				body = "()"
+			case common.CompositeKindEnum:
+				body = ": UInt8 { case a }"
 			}
 
 			interfaceType := AsInterfaceType("I", kind)
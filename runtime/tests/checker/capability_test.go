@@ -286,3 +286,308 @@ func TestCheckCapability(t *testing.T) {
 		})
 	})
 }
+
+func TestCheckTypedCapability(t *testing.T) {
+
+	t.Run("type annotation", func(t *testing.T) {
+
+		checker, err := ParseAndCheckWithPanic(t, `
+          resource R {}
+
+          let x: Capability<&R> = panic("")
+        `)
+
+		require.NoError(t, err)
+
+		rType := checker.GlobalTypes["R"].Type
+
+		assert.Equal(t,
+			&sema.CapabilityType{
+				BorrowType: &sema.ReferenceType{
+					Type: rType,
+				},
+			},
+			checker.GlobalValues["x"].Type,
+		)
+	})
+
+	t.Run("type annotation, non-reference type argument", func(t *testing.T) {
+
+		_, err := ParseAndCheckWithPanic(t, `
+          resource R {}
+
+          let x: Capability<R> = panic("")
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.TypeMismatchWithDescriptionError{}, errs[0])
+	})
+
+	t.Run("type annotation, too many type arguments", func(t *testing.T) {
+
+		_, err := ParseAndCheckWithPanic(t, `
+          resource R {}
+
+          let x: Capability<&R, &R> = panic("")
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.InvalidTypeArgumentCountError{}, errs[0])
+	})
+
+	t.Run("type annotation, non-generic type", func(t *testing.T) {
+
+		_, err := ParseAndCheckWithPanic(t, `
+          resource R {}
+
+          let x: Int<&R> = panic("")
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.NonGenericTypeInstantiationError{}, errs[0])
+	})
+
+	t.Run("borrowing: implicit borrow type", func(t *testing.T) {
+
+		checker, err := ParseAndCheckWithPanic(t, `
+          resource R {}
+
+          let capability: Capability<auth &R> = panic("")
+
+          let r = capability.borrow()
+        `)
+
+		require.NoError(t, err)
+
+		rType := checker.GlobalTypes["R"].Type
+
+		assert.Equal(t,
+			&sema.OptionalType{
+				Type: &sema.ReferenceType{
+					Authorized: true,
+					Type:       rType,
+				},
+			},
+			checker.GlobalValues["r"].Type,
+		)
+	})
+
+	t.Run("borrowing: explicit type argument", func(t *testing.T) {
+
+		_, err := ParseAndCheckWithPanic(t, `
+          resource R {}
+
+          let capability: Capability<&R> = panic("")
+
+          let r = capability.borrow<&R>()
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.InvalidTypeArgumentCountError{}, errs[0])
+	})
+
+	t.Run("checking: implicit borrow type", func(t *testing.T) {
+
+		checker, err := ParseAndCheckWithPanic(t, `
+          resource R {}
+
+          let capability: Capability<&R> = panic("")
+
+          let ok = capability.check()
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.BoolType{},
+			checker.GlobalValues["ok"].Type,
+		)
+	})
+
+	t.Run("subtyping: typed is subtype of untyped", func(t *testing.T) {
+
+		_, err := ParseAndCheckWithPanic(t, `
+          resource R {}
+
+          let x: Capability<&R> = panic("")
+          let y: Capability = x
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("subtyping: untyped is not subtype of typed", func(t *testing.T) {
+
+		_, err := ParseAndCheckWithPanic(t, `
+          resource R {}
+
+          let x: Capability = panic("")
+          let y: Capability<&R> = x
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("subtyping: borrow types", func(t *testing.T) {
+
+		_, err := ParseAndCheckWithPanic(t, `
+          resource interface I {}
+
+          resource R: I {}
+
+          let x: Capability<auth &R> = panic("")
+          let y: Capability<&R> = x
+          let z: Capability<&R{I}> = y
+          let w: Capability<&AnyResource{I}> = x
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("subtyping: unrelated borrow types", func(t *testing.T) {
+
+		_, err := ParseAndCheckWithPanic(t, `
+          resource R {}
+
+          resource S {}
+
+          let x: Capability<&R> = panic("")
+          let y: Capability<&S> = x
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("subtyping: unauthorized is not subtype of authorized", func(t *testing.T) {
+
+		_, err := ParseAndCheckWithPanic(t, `
+          resource R {}
+
+          let x: Capability<&R> = panic("")
+          let y: Capability<auth &R> = x
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("AuthAccount.link", func(t *testing.T) {
+
+		checker, err := ParseAndCheckAccount(t, `
+          resource R {}
+
+          let capability = authAccount.link<&R>(/public/r, target: /storage/r)
+        `)
+
+		require.NoError(t, err)
+
+		rType := checker.GlobalTypes["R"].Type
+
+		assert.Equal(t,
+			&sema.OptionalType{
+				Type: &sema.CapabilityType{
+					BorrowType: &sema.ReferenceType{
+						Type: rType,
+					},
+				},
+			},
+			checker.GlobalValues["capability"].Type,
+		)
+	})
+
+	for accountType, accountVariable := range map[string]string{
+		"AuthAccount":   "authAccount",
+		"PublicAccount": "publicAccount",
+	} {
+
+		t.Run(fmt.Sprintf("%s.getCapability: type argument", accountType), func(t *testing.T) {
+
+			checker, err := ParseAndCheckAccount(t,
+				fmt.Sprintf(
+					`
+                      resource R {}
+
+                      let capability = %s.getCapability<&R>(/public/r)
+                    `,
+					accountVariable,
+				),
+			)
+
+			require.NoError(t, err)
+
+			rType := checker.GlobalTypes["R"].Type
+
+			assert.Equal(t,
+				&sema.OptionalType{
+					Type: &sema.CapabilityType{
+						BorrowType: &sema.ReferenceType{
+							Type: rType,
+						},
+					},
+				},
+				checker.GlobalValues["capability"].Type,
+			)
+		})
+
+		t.Run(fmt.Sprintf("%s.getCapability: no type argument", accountType), func(t *testing.T) {
+
+			checker, err := ParseAndCheckAccount(t,
+				fmt.Sprintf(
+					`
+                      let capability = %s.getCapability(/public/r)
+                    `,
+					accountVariable,
+				),
+			)
+
+			require.NoError(t, err)
+
+			assert.Equal(t,
+				&sema.OptionalType{
+					Type: &sema.CapabilityType{},
+				},
+				checker.GlobalValues["capability"].Type,
+			)
+		})
+	}
+
+	t.Run("contract interface parameter", func(t *testing.T) {
+
+		_, err := ParseAndCheckWithPanic(t, `
+          pub contract interface FungibleToken {
+
+              pub resource interface Receiver {
+                  pub fun deposit(amount: Int)
+              }
+
+              pub fun transfer(receiver: Capability<&{FungibleToken.Receiver}>) {
+                  pre {
+                      receiver.check(): "invalid receiver"
+                  }
+              }
+          }
+
+          pub contract Token: FungibleToken {
+
+              pub resource Vault: FungibleToken.Receiver {
+                  pub fun deposit(amount: Int) {}
+              }
+
+              pub fun transfer(receiver: Capability<&{FungibleToken.Receiver}>) {
+                  receiver.borrow()!.deposit(amount: 1)
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+}
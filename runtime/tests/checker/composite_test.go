@@ -37,8 +37,11 @@ func TestCheckInvalidCompositeRedeclaringType(t *testing.T) {
 	for _, kind := range common.AllCompositeKinds {
 
 		body := "{}"
-		if kind == common.CompositeKindEvent {
+		switch kind {
+		case common.CompositeKindEvent:
 			body = "()"
+		case common.CompositeKindEnum:
+			body = ": UInt8 { case a }"
 		}
 
 		t.Run(kind.Keyword(), func(t *testing.T) {
@@ -279,14 +282,22 @@ func TestCheckInvalidCompositeRedeclaringFields(t *testing.T) {
 		t.Run(kind.Keyword(), func(t *testing.T) {
 
 			var body string
-			if kind == common.CompositeKindEvent {
+			switch kind {
+			case common.CompositeKindEvent:
 				body = `
                   (
                       x: Int,
                       x: Int
                   )
                 `
-			} else {
+			case common.CompositeKindEnum:
+				body = `
+                  : UInt8 {
+                      case x
+                      case x
+                  }
+                `
+			default:
 				body = `
                   {
                       let x: Int
@@ -305,11 +316,12 @@ func TestCheckInvalidCompositeRedeclaringFields(t *testing.T) {
 				),
 			)
 
-			if kind == common.CompositeKindEvent {
+			switch kind {
+			case common.CompositeKindEvent, common.CompositeKindEnum:
 				errs := ExpectCheckerErrors(t, err, 1)
 
 				assert.IsType(t, &sema.RedeclarationError{}, errs[0])
-			} else {
+			default:
 				errs := ExpectCheckerErrors(t, err, 2)
 
 				assert.IsType(t, &sema.RedeclarationError{}, errs[0])
@@ -398,6 +410,13 @@ func TestCheckCompositeFieldsAndFunctions(t *testing.T) {
 func TestCheckInvalidCompositeFieldType(t *testing.T) {
 
 	for _, kind := range common.AllCompositeKinds {
+
+		// enums cannot declare fields, see TestCheckInvalidEnumMembers
+
+		if kind == common.CompositeKindEnum {
+			continue
+		}
+
 		t.Run(kind.Keyword(), func(t *testing.T) {
 
 			if kind == common.CompositeKindEvent {
@@ -1156,8 +1175,11 @@ func TestCheckInvalidSameCompositeRedeclaration(t *testing.T) {
 		t.Run(kind.Keyword(), func(t *testing.T) {
 
 			body := "{}"
-			if kind == common.CompositeKindEvent {
+			switch kind {
+			case common.CompositeKindEvent:
 				body = "()"
+			case common.CompositeKindEnum:
+				body = ": UInt8 { case a }"
 			}
 
 			_, err := ParseAndCheck(t,
@@ -1194,13 +1216,19 @@ func TestCheckInvalidDifferentCompositeRedeclaration(t *testing.T) {
 			}
 
 			firstBody := "{}"
-			if firstKind == common.CompositeKindEvent {
+			switch firstKind {
+			case common.CompositeKindEvent:
 				firstBody = "()"
+			case common.CompositeKindEnum:
+				firstBody = ": UInt8 { case a }"
 			}
 
 			secondBody := "{}"
-			if secondKind == common.CompositeKindEvent {
+			switch secondKind {
+			case common.CompositeKindEvent:
 				secondBody = "()"
+			case common.CompositeKindEnum:
+				secondBody = ": UInt8 { case a }"
 			}
 
 			testName := fmt.Sprintf(
@@ -512,8 +512,11 @@ func TestCheckInvalidLocalDeclarations(t *testing.T) {
 			)
 
 			body := "{}"
-			if kind == common.CompositeKindEvent {
+			switch kind {
+			case common.CompositeKindEvent:
 				body = "()"
+			case common.CompositeKindEnum:
+				body = ": UInt8 { case a }"
 			}
 
 			tests[name] = fmt.Sprintf(
@@ -0,0 +1,253 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+	. "github.com/onflow/cadence/runtime/tests/utils"
+)
+
+func TestCheckEnum(t *testing.T) {
+
+	checker, err := ParseAndCheck(t, `
+      enum E: UInt8 {
+          case a
+          pub case b
+      }
+    `)
+
+	require.NoError(t, err)
+
+	enumType := checker.GlobalTypes["E"].Type
+	require.IsType(t, &sema.CompositeType{}, enumType)
+
+	compositeType := enumType.(*sema.CompositeType)
+	assert.Equal(t, common.CompositeKindEnum, compositeType.Kind)
+	assert.IsType(t, &sema.UInt8Type{}, compositeType.EnumRawType)
+
+	rawValueMember := compositeType.Members[sema.EnumRawValueFieldName]
+	require.NotNil(t, rawValueMember)
+	assert.IsType(t, &sema.UInt8Type{}, rawValueMember.TypeAnnotation.Type)
+}
+
+func TestCheckEnumCasesAndConstructor(t *testing.T) {
+
+	checker, err := ParseAndCheck(t, `
+      enum E: Int {
+          case a
+          case b
+      }
+
+      let a: E = E.a
+      let rawValue: Int = E.b.rawValue
+      let b: E? = E(rawValue: 1)
+      let equal = E.a == E.b
+    `)
+
+	require.NoError(t, err)
+
+	assert.IsType(t,
+		&sema.OptionalType{},
+		checker.GlobalValues["b"].Type,
+	)
+}
+
+func TestCheckEnumInContract(t *testing.T) {
+
+	_, err := ParseAndCheck(t, `
+      contract C {
+          enum E: UInt64 {
+              case a
+          }
+
+          let e: E
+
+          init() {
+              self.e = E.a
+          }
+      }
+
+      let e: C.E = C.E.a
+    `)
+
+	require.NoError(t, err)
+}
+
+func TestCheckInvalidEnumRawType(t *testing.T) {
+
+	t.Run("missing", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t, `
+          enum E {
+              case a
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.MissingEnumRawTypeError{}, errs[0])
+	})
+
+	t.Run("non-integer", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t, `
+          enum E: String {
+              case a
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidEnumRawTypeError{}, errs[0])
+	})
+
+	t.Run("conformances", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t, `
+          struct interface I {}
+
+          enum E: UInt8, I {
+              case a
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidEnumConformancesError{}, errs[0])
+	})
+}
+
+func TestCheckInvalidEnumDuplicateCase(t *testing.T) {
+
+	_, err := ParseAndCheck(t, `
+      enum E: UInt8 {
+          case a
+          case a
+      }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.RedeclarationError{}, errs[0])
+}
+
+func TestCheckInvalidEnumCaseCount(t *testing.T) {
+
+	enumCode := func(count int) string {
+		var builder strings.Builder
+		builder.WriteString("enum E: UInt8 {\n")
+		for i := 0; i < count; i++ {
+			builder.WriteString(fmt.Sprintf("case c%d\n", i))
+		}
+		builder.WriteString("}\n")
+		return builder.String()
+	}
+
+	t.Run("all cases fit", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t, enumCode(256))
+
+		require.NoError(t, err)
+	})
+
+	t.Run("too many cases", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t, enumCode(300))
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.EnumCaseCountExceededError{}, errs[0])
+	})
+}
+
+func TestCheckInvalidEnumMembers(t *testing.T) {
+
+	_, err := ParseAndCheck(t, `
+      enum E: UInt8 {
+          case a
+          fun test() {}
+          init() {}
+      }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 2)
+
+	assert.IsType(t, &sema.InvalidNonEnumCaseError{}, errs[0])
+	assert.IsType(t, &sema.InvalidNonEnumCaseError{}, errs[1])
+}
+
+func TestCheckInvalidEnumCaseAccess(t *testing.T) {
+
+	_, err := ParseAndCheck(t, `
+      enum E: UInt8 {
+          priv case a
+      }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.InvalidAccessModifierError{}, errs[0])
+}
+
+func TestCheckInvalidEnumCaseInStruct(t *testing.T) {
+
+	_, err := ParseAndCheck(t, `
+      struct S {
+          case a
+      }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.InvalidEnumCaseError{}, errs[0])
+}
+
+func TestCheckInvalidEnumInterface(t *testing.T) {
+
+	_, err := ParseAndCheck(t, `
+      enum interface E {}
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.InvalidInterfaceDeclarationError{}, errs[0])
+}
+
+func TestCheckInvalidEnumConstructorArgument(t *testing.T) {
+
+	_, err := ParseAndCheck(t, `
+      enum E: UInt8 {
+          case a
+      }
+
+      let e = E(rawValue: "a")
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+}
@@ -181,8 +181,11 @@ func TestCheckImportTypes(t *testing.T) {
 		t.Run(compositeKind.Keyword(), func(t *testing.T) {
 
 			body := "{}"
-			if compositeKind == common.CompositeKindEvent {
+			switch compositeKind {
+			case common.CompositeKindEvent:
 				body = "()"
+			case common.CompositeKindEnum:
+				body = ": UInt8 { case a }"
 			}
 
 			checker, err := ParseAndCheck(t,
@@ -32,8 +32,12 @@ import (
 )
 
 func constructorArguments(compositeKind common.CompositeKind) string {
-	if compositeKind == common.CompositeKindContract {
+	switch compositeKind {
+	case common.CompositeKindContract:
 		return ""
+	case common.CompositeKindEnum:
+		// enum values are not constructed, but are the enum's cases
+		return ".a"
 	}
 	return "()"
 }
@@ -49,8 +53,11 @@ func TestCheckInvalidLocalInterface(t *testing.T) {
 		t.Run(kind.Keyword(), func(t *testing.T) {
 
 			body := "{}"
-			if kind == common.CompositeKindEvent {
+			switch kind {
+			case common.CompositeKindEvent:
 				body = "()"
+			case common.CompositeKindEnum:
+				body = ": UInt8 { case a }"
 			}
 
 			_, err := ParseAndCheck(t,
@@ -250,8 +257,11 @@ func TestCheckInterfaceUse(t *testing.T) {
 		}
 
 		body := "{}"
-		if kind == common.CompositeKindEvent {
+		switch kind {
+		case common.CompositeKindEvent:
 			body = "()"
+		case common.CompositeKindEnum:
+			body = ": UInt8 { case a }"
 		}
 
 		annotationType := AsInterfaceType("Test", kind)
@@ -287,8 +297,11 @@ func TestCheckInterfaceConformanceNoRequirements(t *testing.T) {
 		}
 
 		body := "{}"
-		if compositeKind == common.CompositeKindEvent {
+		switch compositeKind {
+		case common.CompositeKindEvent:
 			body = "()"
+		case common.CompositeKindEnum:
+			body = ": UInt8 { case a }"
 		}
 
 		annotationType := AsInterfaceType("Test", compositeKind)
@@ -346,13 +359,19 @@ func TestCheckInvalidInterfaceConformanceIncompatibleCompositeKinds(t *testing.T
 			}
 
 			firstBody := "{}"
-			if firstKind == common.CompositeKindEvent {
+			switch firstKind {
+			case common.CompositeKindEvent:
 				firstBody = "()"
+			case common.CompositeKindEnum:
+				firstBody = ": UInt8 { case a }"
 			}
 
 			secondBody := "{}"
-			if secondKind == common.CompositeKindEvent {
+			switch secondKind {
+			case common.CompositeKindEvent:
 				secondBody = "()"
+			case common.CompositeKindEnum:
+				secondBody = ": UInt8 { case a }"
 			}
 
 			firstKindInterfaceType := AsInterfaceType("Test", firstKind)
@@ -450,8 +469,11 @@ func TestCheckInvalidInterfaceConformanceUndeclared(t *testing.T) {
 		}
 
 		body := "{}"
-		if compositeKind == common.CompositeKindEvent {
+		switch compositeKind {
+		case common.CompositeKindEvent:
 			body = "()"
+		case common.CompositeKindEnum:
+			body = ": UInt8 { case a }"
 		}
 
 		t.Run(compositeKind.Keyword(), func(t *testing.T) {
@@ -497,8 +519,11 @@ func TestCheckInvalidCompositeInterfaceConformanceNonInterface(t *testing.T) {
 		}
 
 		body := "{}"
-		if kind == common.CompositeKindEvent {
+		switch kind {
+		case common.CompositeKindEvent:
 			body = "()"
+		case common.CompositeKindEnum:
+			body = ": UInt8 { case a }"
 		}
 
 		t.Run(kind.Keyword(), func(t *testing.T) {
@@ -1217,8 +1242,11 @@ func TestCheckInvalidInterfaceConformanceRepetition(t *testing.T) {
 		}
 
 		body := "{}"
-		if kind == common.CompositeKindEvent {
+		switch kind {
+		case common.CompositeKindEvent:
 			body = "()"
+		case common.CompositeKindEnum:
+			body = ": UInt8 { case a }"
 		}
 
 		t.Run(kind.Keyword(), func(t *testing.T) {
@@ -1253,8 +1281,11 @@ func TestCheckInvalidInterfaceTypeAsValue(t *testing.T) {
 		}
 
 		body := "{}"
-		if kind == common.CompositeKindEvent {
+		switch kind {
+		case common.CompositeKindEvent:
 			body = "()"
+		case common.CompositeKindEnum:
+			body = ": UInt8 { case a }"
 		}
 
 		t.Run(kind.Keyword(), func(t *testing.T) {
@@ -0,0 +1,149 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/sema"
+	. "github.com/onflow/cadence/runtime/tests/utils"
+)
+
+func TestCheckMetaType(t *testing.T) {
+
+	checker, err := ParseAndCheck(t, `
+      resource R {}
+
+      let type: Type = Type<@R>()
+      let identifier: String = type.identifier
+      let isSubtype: Bool = type.isSubtype(of: Type<@AnyResource>())
+    `)
+
+	require.NoError(t, err)
+
+	assert.IsType(t,
+		&sema.MetaType{},
+		checker.GlobalValues["type"].Type,
+	)
+}
+
+func TestCheckInvalidMetaTypeMissingTypeArgument(t *testing.T) {
+
+	_, err := ParseAndCheck(t, `
+      let type = Type()
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.TypeParameterTypeInferenceError{}, errs[0])
+}
+
+func TestCheckMetaTypeEquality(t *testing.T) {
+
+	_, err := ParseAndCheck(t, `
+      let equal = Type<Int>() == Type<String>()
+    `)
+
+	require.NoError(t, err)
+}
+
+func TestCheckGetType(t *testing.T) {
+
+	for name, code := range map[string]string{
+		"Int":       `let x = 1`,
+		"String":    `let x = "test"`,
+		"array":     `let x = [1, 2]`,
+		"optional":  `let x: Int? = 1`,
+		"composite": `struct S {}; let x = S()`,
+		"type":      `let x = Type<Int>()`,
+	} {
+
+		t.Run(name, func(t *testing.T) {
+
+			checker, err := ParseAndCheck(t,
+				code+`
+                  let type = x.getType()
+                `,
+			)
+
+			require.NoError(t, err)
+
+			assert.IsType(t,
+				&sema.MetaType{},
+				checker.GlobalValues["type"].Type,
+			)
+		})
+	}
+}
+
+func TestCheckIsInstance(t *testing.T) {
+
+	checker, err := ParseAndCheck(t, `
+      resource R {}
+
+      fun test(): Bool {
+          let r <- create R()
+          let isInstance = r.isInstance(Type<@R>())
+          destroy r
+          return isInstance
+      }
+
+      let x = 1.isInstance(Type<Int>())
+    `)
+
+	require.NoError(t, err)
+
+	assert.IsType(t,
+		&sema.BoolType{},
+		checker.GlobalValues["x"].Type,
+	)
+}
+
+func TestCheckInvalidIsInstanceArgument(t *testing.T) {
+
+	_, err := ParseAndCheck(t, `
+      let x = 1.isInstance(Int)
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+}
+
+func TestCheckInvalidBuiltinMemberDeclaration(t *testing.T) {
+
+	for _, name := range sema.BuiltinMemberNames {
+
+		t.Run(name, func(t *testing.T) {
+
+			_, err := ParseAndCheck(t, `
+              struct S {
+                  fun `+name+`() {}
+              }
+            `)
+
+			errs := ExpectCheckerErrors(t, err, 1)
+
+			assert.IsType(t, &sema.InvalidDeclarationError{}, errs[0])
+		})
+	}
+}
@@ -37,7 +37,7 @@ func TestCheckCompositeDeclarationNesting(t *testing.T) {
 		for _, outerIsInterface := range interfacePossibilities {
 			for _, innerComposite := range common.AllCompositeKinds {
 				for _, innerIsInterface := range interfacePossibilities {
-					if innerIsInterface && innerComposite == common.CompositeKindEvent {
+					if innerIsInterface && !innerComposite.SupportsInterfaces() {
 						continue
 					}
 
@@ -49,8 +49,11 @@ func TestCheckCompositeDeclarationNesting(t *testing.T) {
 					t.Run(testName, func(t *testing.T) {
 
 						innerBody := "{}"
-						if innerComposite == common.CompositeKindEvent {
+						switch innerComposite {
+						case common.CompositeKindEvent:
 							innerBody = "()"
+						case common.CompositeKindEnum:
+							innerBody = ": UInt8 { case a }"
 						}
 
 						_, err := ParseAndCheck(t,
@@ -77,7 +80,8 @@ func TestCheckCompositeDeclarationNesting(t *testing.T) {
 
 							case common.CompositeKindResource,
 								common.CompositeKindStructure,
-								common.CompositeKindEvent:
+								common.CompositeKindEvent,
+								common.CompositeKindEnum:
 
 								require.NoError(t, err)
 
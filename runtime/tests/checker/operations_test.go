@@ -241,8 +241,8 @@ func TestCheckConcatenatingExpression(t *testing.T) {
 			&sema.InvalidBinaryOperandError{},
 			&sema.InvalidBinaryOperandsError{},
 		}},
+		// NOTE: the ampersand is the bitwise AND operator for integers
 		{&sema.StringType{}, "1", "2", []error{
-			&sema.InvalidBinaryOperandsError{},
 			&sema.TypeMismatchError{},
 		}},
 
@@ -287,11 +287,124 @@ func TestCheckConcatenatingExpression(t *testing.T) {
 	}
 }
 
+func TestCheckBitwiseOperations(t *testing.T) {
+
+	operations := []ast.Operation{
+		ast.OperationBitwiseOr,
+		ast.OperationBitwiseXor,
+		ast.OperationConcat,
+		ast.OperationBitwiseLeftShift,
+		ast.OperationBitwiseRightShift,
+	}
+
+	tests := []operationTest{
+		{&sema.IntType{}, "1", "2", nil},
+		{&sema.UInt8Type{}, "UInt8(1)", "UInt8(2)", nil},
+		{&sema.Int128Type{}, "Int128(1)", "Int128(2)", nil},
+		{&sema.Word64Type{}, "Word64(1)", "Word64(2)", nil},
+		{&sema.IntType{}, "1", "UInt8(2)", []error{
+			&sema.InvalidBinaryOperandsError{},
+		}},
+		{&sema.Fix64Type{}, "1.2", "3.4", []error{
+			&sema.InvalidBinaryOperandsError{},
+		}},
+		{&sema.IntType{}, "1", "2.3", []error{
+			&sema.InvalidBinaryOperandError{},
+			&sema.InvalidBinaryOperandsError{},
+		}},
+		{&sema.IntType{}, "1", "true", []error{
+			&sema.InvalidBinaryOperandError{},
+			&sema.InvalidBinaryOperandsError{},
+		}},
+	}
+
+	for _, operation := range operations {
+		for _, test := range tests {
+
+			testName := fmt.Sprintf(
+				"%s / %s %s %s",
+				test.ty, test.left, operation.Symbol(), test.right,
+			)
+
+			t.Run(testName, func(t *testing.T) {
+
+				_, err := ParseAndCheck(t,
+					fmt.Sprintf(
+						`fun test(): %s { return %s %s %s }`,
+						test.ty, test.left, operation.Symbol(), test.right,
+					),
+				)
+
+				errs := ExpectCheckerErrors(t, err, len(test.expectedErrors))
+
+				for i, expectedErr := range test.expectedErrors {
+					assert.IsType(t, expectedErr, errs[i])
+				}
+			})
+		}
+	}
+
+	// NOTE: the ampersand is the concatenation operator if the left-hand side is not an integer,
+	// so it is tested separately
+
+	for _, operation := range operations[:2] {
+
+		testName := fmt.Sprintf("Bool / true %s false", operation.Symbol())
+
+		t.Run(testName, func(t *testing.T) {
+
+			_, err := ParseAndCheck(t,
+				fmt.Sprintf(
+					`fun test(): Bool { return true %s false }`,
+					operation.Symbol(),
+				),
+			)
+
+			errs := ExpectCheckerErrors(t, err, 1)
+
+			assert.IsType(t, &sema.InvalidBinaryOperandsError{}, errs[0])
+		})
+	}
+}
+
+func TestCheckBitwiseNot(t *testing.T) {
+
+	t.Run("integers", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t, `
+          let a: Int = ~1
+          let b: UInt8 = ~UInt8(1)
+          let c: Int256 = ~Int256(1)
+          let d: Word32 = ~Word32(1)
+        `)
+
+		require.NoError(t, err)
+	})
+
+	for _, value := range []string{"true", "1.2", `"1"`} {
+
+		t.Run(value, func(t *testing.T) {
+
+			_, err := ParseAndCheck(t,
+				fmt.Sprintf(`let x = ~%s`, value),
+			)
+
+			errs := ExpectCheckerErrors(t, err, 1)
+
+			assert.IsType(t, &sema.InvalidUnaryOperandError{}, errs[0])
+		})
+	}
+}
+
 func TestCheckInvalidCompositeEquality(t *testing.T) {
 
 	for _, compositeKind := range common.AllCompositeKinds {
 
-		if compositeKind == common.CompositeKindEvent {
+		// NOTE: enums are equatable, see TestCheckEnumCasesAndConstructor
+
+		if compositeKind == common.CompositeKindEvent ||
+			compositeKind == common.CompositeKindEnum {
+
 			continue
 		}
 
@@ -191,11 +191,20 @@ func TestCheckCompositeNilEquality(t *testing.T) {
 			continue
 		}
 
+		body := "{}"
+
 		var setupCode, identifier string
 
-		if compositeKind == common.CompositeKindContract {
+		switch compositeKind {
+		case common.CompositeKindContract:
 			identifier = "X"
-		} else {
+
+		case common.CompositeKindEnum:
+			body = ": UInt8 { case a }"
+			setupCode = `let x: X? = X.a`
+			identifier = "x"
+
+		default:
 			setupCode = fmt.Sprintf(
 				`let x: %[1]sX? %[2]s %[3]s X%[4]s`,
 				compositeKind.Annotation(),
@@ -211,14 +220,15 @@ func TestCheckCompositeNilEquality(t *testing.T) {
 			_, err := ParseAndCheck(t,
 				fmt.Sprintf(
 					`
-                      %[1]s X {}
+                      %[1]s X %[2]s
 
-                      %[2]s
+                      %[3]s
 
-                      let a = %[3]s == nil
-                      let b = nil == %[3]s
+                      let a = %[4]s == nil
+                      let b = nil == %[4]s
                     `,
 					compositeKind.Keyword(),
+					body,
 					setupCode,
 					identifier,
 				),
@@ -233,7 +243,11 @@ func TestCheckInvalidCompositeNilEquality(t *testing.T) {
 
 	for _, compositeKind := range common.AllCompositeKinds {
 
-		if compositeKind == common.CompositeKindEvent {
+		// NOTE: enums are equatable, see TestCheckEnumCasesAndConstructor
+
+		if compositeKind == common.CompositeKindEvent ||
+			compositeKind == common.CompositeKindEnum {
+
 			continue
 		}
 
@@ -256,8 +270,11 @@ func TestCheckInvalidCompositeNilEquality(t *testing.T) {
 		}
 
 		body := "{}"
-		if compositeKind == common.CompositeKindEvent {
+		switch compositeKind {
+		case common.CompositeKindEvent:
 			body = "()"
+		case common.CompositeKindEnum:
+			body = ": UInt8 { case a }"
 		}
 
 		t.Run(compositeKind.Name(), func(t *testing.T) {
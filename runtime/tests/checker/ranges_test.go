@@ -0,0 +1,104 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+	. "github.com/onflow/cadence/runtime/tests/utils"
+)
+
+func TestCheckRanges(t *testing.T) {
+
+	t.Parallel()
+
+	const code = `
+      pub fun test(a: Int) {
+          let b = 1
+          if true {
+              let c = 2
+          }
+          for d in [1] {
+              b
+          }
+      }
+    `
+
+	checker, err := ParseAndCheckWithOptions(t,
+		code,
+		ParseAndCheckOptions{
+			Options: []sema.Option{
+				sema.WithRangesEnabled(true),
+			},
+		},
+	)
+
+	require.NoError(t, err)
+
+	identifiers := func(line, column int) []string {
+		ranges := checker.Ranges.FindAll(sema.Position{Line: line, Column: column})
+
+		result := make([]string, 0, len(ranges))
+		for _, r := range ranges {
+			result = append(result, r.Identifier)
+		}
+		sort.Strings(result)
+		return result
+	}
+
+	assert.Equal(t, []string{"a"}, identifiers(2, 30))
+	assert.Equal(t, []string{"a", "b"}, identifiers(3, 20))
+	assert.Equal(t, []string{"a", "b", "c"}, identifiers(5, 24))
+	assert.Equal(t, []string{"a", "b"}, identifiers(7, 10))
+	assert.Equal(t, []string{"a", "b", "d"}, identifiers(8, 14))
+	assert.Equal(t, []string{}, identifiers(11, 0))
+
+	for _, r := range checker.Ranges.FindAll(sema.Position{Line: 8, Column: 14}) {
+		switch r.Identifier {
+		case "a":
+			assert.Equal(t, common.DeclarationKindParameter, r.DeclarationKind)
+			assert.IsType(t, &sema.IntType{}, r.Type)
+		case "b":
+			assert.Equal(t, common.DeclarationKindConstant, r.DeclarationKind)
+		case "d":
+			assert.Equal(t, common.DeclarationKindConstant, r.DeclarationKind)
+		}
+	}
+}
+
+func TestCheckRangesDisabled(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      pub fun test(a: Int) {
+          let b = 1
+      }
+    `)
+
+	require.NoError(t, err)
+
+	assert.Empty(t, checker.Ranges.All())
+}
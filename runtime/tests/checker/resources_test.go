@@ -37,8 +37,11 @@ func TestCheckFailableCastingWithResourceAnnotation(t *testing.T) {
 	for _, compositeKind := range common.AllCompositeKinds {
 
 		body := "{}"
-		if compositeKind == common.CompositeKindEvent {
+		switch compositeKind {
+		case common.CompositeKindEvent:
 			body = "()"
+		case common.CompositeKindEnum:
+			body = ": UInt8 { case a }"
 		}
 
 		t.Run(compositeKind.Keyword(), func(t *testing.T) {
@@ -64,7 +67,8 @@ func TestCheckFailableCastingWithResourceAnnotation(t *testing.T) {
 
 				assert.IsType(t, &sema.InvalidFailableResourceDowncastOutsideOptionalBindingError{}, errs[0])
 
-			case common.CompositeKindStructure, common.CompositeKindContract:
+			case common.CompositeKindStructure,
+				common.CompositeKindEnum, common.CompositeKindContract:
 				errs := ExpectCheckerErrors(t, err, 1)
 
 				assert.IsType(t, &sema.InvalidResourceAnnotationError{}, errs[0])
@@ -87,8 +91,11 @@ func TestCheckFunctionDeclarationParameterWithResourceAnnotation(t *testing.T) {
 	for _, kind := range common.AllCompositeKinds {
 
 		body := "{}"
-		if kind == common.CompositeKindEvent {
+		switch kind {
+		case common.CompositeKindEvent:
 			body = "()"
+		case common.CompositeKindEnum:
+			body = ": UInt8 { case a }"
 		}
 
 		t.Run(kind.Keyword(), func(t *testing.T) {
@@ -113,6 +120,7 @@ func TestCheckFunctionDeclarationParameterWithResourceAnnotation(t *testing.T) {
 				require.NoError(t, err)
 
 			case common.CompositeKindStructure,
+				common.CompositeKindEnum,
 				common.CompositeKindContract,
 				common.CompositeKindEvent:
 
@@ -132,8 +140,11 @@ func TestCheckFunctionDeclarationParameterWithoutResourceAnnotation(t *testing.T
 	for _, kind := range common.AllCompositeKinds {
 
 		body := "{}"
-		if kind == common.CompositeKindEvent {
+		switch kind {
+		case common.CompositeKindEvent:
 			body = "()"
+		case common.CompositeKindEnum:
+			body = ": UInt8 { case a }"
 		}
 
 		t.Run(kind.Keyword(), func(t *testing.T) {
@@ -160,6 +171,7 @@ func TestCheckFunctionDeclarationParameterWithoutResourceAnnotation(t *testing.T
 				assert.IsType(t, &sema.MissingResourceAnnotationError{}, errs[0])
 
 			case common.CompositeKindStructure,
+				common.CompositeKindEnum,
 				common.CompositeKindContract,
 				common.CompositeKindEvent:
 
@@ -177,8 +189,11 @@ func TestCheckFunctionDeclarationReturnTypeWithResourceAnnotation(t *testing.T)
 	for _, compositeKind := range common.AllCompositeKinds {
 
 		body := "{}"
-		if compositeKind == common.CompositeKindEvent {
+		switch compositeKind {
+		case common.CompositeKindEvent:
 			body = "()"
+		case common.CompositeKindEnum:
+			body = ": UInt8 { case a }"
 		}
 
 		t.Run(compositeKind.Keyword(), func(t *testing.T) {
@@ -204,7 +219,8 @@ func TestCheckFunctionDeclarationReturnTypeWithResourceAnnotation(t *testing.T)
 			case common.CompositeKindResource:
 				require.NoError(t, err)
 
-			case common.CompositeKindStructure:
+			case common.CompositeKindStructure,
+				common.CompositeKindEnum:
 				errs := ExpectCheckerErrors(t, err, 1)
 
 				assert.IsType(t, &sema.InvalidResourceAnnotationError{}, errs[0])
@@ -238,8 +254,11 @@ func TestCheckFunctionDeclarationReturnTypeWithoutResourceAnnotation(t *testing.
 		}
 
 		body := "{}"
-		if compositeKind == common.CompositeKindEvent {
+		switch compositeKind {
+		case common.CompositeKindEvent:
 			body = "()"
+		case common.CompositeKindEnum:
+			body = ": UInt8 { case a }"
 		}
 
 		t.Run(compositeKind.Keyword(), func(t *testing.T) {
@@ -268,6 +287,7 @@ func TestCheckFunctionDeclarationReturnTypeWithoutResourceAnnotation(t *testing.
 				assert.IsType(t, &sema.MissingResourceAnnotationError{}, errs[0])
 
 			case common.CompositeKindStructure,
+				common.CompositeKindEnum,
 				common.CompositeKindContract:
 
 			case common.CompositeKindEvent:
@@ -287,8 +307,11 @@ func TestCheckVariableDeclarationWithResourceAnnotation(t *testing.T) {
 	for _, compositeKind := range common.AllCompositeKinds {
 
 		body := "{}"
-		if compositeKind == common.CompositeKindEvent {
+		switch compositeKind {
+		case common.CompositeKindEvent:
 			body = "()"
+		case common.CompositeKindEnum:
+			body = ": UInt8 { case a }"
 		}
 
 		t.Run(compositeKind.Keyword(), func(t *testing.T) {
@@ -312,7 +335,8 @@ func TestCheckVariableDeclarationWithResourceAnnotation(t *testing.T) {
 			case common.CompositeKindResource:
 				require.NoError(t, err)
 
-			case common.CompositeKindStructure:
+			case common.CompositeKindStructure,
+				common.CompositeKindEnum:
 				errs := ExpectCheckerErrors(t, err, 1)
 
 				assert.IsType(t, &sema.InvalidResourceAnnotationError{}, errs[0])
@@ -345,8 +369,11 @@ func TestCheckVariableDeclarationWithoutResourceAnnotation(t *testing.T) {
 		}
 
 		body := "{}"
-		if compositeKind == common.CompositeKindEvent {
+		switch compositeKind {
+		case common.CompositeKindEvent:
 			body = "()"
+		case common.CompositeKindEnum:
+			body = ": UInt8 { case a }"
 		}
 
 		t.Run(compositeKind.Keyword(), func(t *testing.T) {
@@ -373,6 +400,7 @@ func TestCheckVariableDeclarationWithoutResourceAnnotation(t *testing.T) {
 				assert.IsType(t, &sema.MissingResourceAnnotationError{}, errs[0])
 
 			case common.CompositeKindStructure,
+				common.CompositeKindEnum,
 				common.CompositeKindContract:
 
 				require.NoError(t, err)
@@ -428,7 +456,8 @@ func TestCheckFieldDeclarationWithResourceAnnotation(t *testing.T) {
 			case common.CompositeKindResource:
 				require.NoError(t, err)
 
-			case common.CompositeKindStructure:
+			case common.CompositeKindStructure,
+				common.CompositeKindEnum:
 				errs := ExpectCheckerErrors(t, err, 2)
 
 				// NOTE: one invalid resource annotation error for field, one for parameter
@@ -499,7 +528,8 @@ func TestCheckFieldDeclarationWithoutResourceAnnotation(t *testing.T) {
 
 				assert.IsType(t, &sema.InvalidMoveError{}, errs[0])
 
-			case common.CompositeKindStructure:
+			case common.CompositeKindStructure,
+				common.CompositeKindEnum:
 				require.NoError(t, err)
 
 			default:
@@ -514,8 +544,11 @@ func TestCheckFunctionExpressionParameterWithResourceAnnotation(t *testing.T) {
 	for _, kind := range common.AllCompositeKinds {
 
 		body := "{}"
-		if kind == common.CompositeKindEvent {
+		switch kind {
+		case common.CompositeKindEvent:
 			body = "()"
+		case common.CompositeKindEnum:
+			body = ": UInt8 { case a }"
 		}
 
 		t.Run(kind.Keyword(), func(t *testing.T) {
@@ -540,6 +573,7 @@ func TestCheckFunctionExpressionParameterWithResourceAnnotation(t *testing.T) {
 				require.NoError(t, err)
 
 			case common.CompositeKindStructure,
+				common.CompositeKindEnum,
 				common.CompositeKindContract,
 				common.CompositeKindEvent:
 
@@ -559,8 +593,11 @@ func TestCheckFunctionExpressionParameterWithoutResourceAnnotation(t *testing.T)
 	for _, kind := range common.AllCompositeKinds {
 
 		body := "{}"
-		if kind == common.CompositeKindEvent {
+		switch kind {
+		case common.CompositeKindEvent:
 			body = "()"
+		case common.CompositeKindEnum:
+			body = ": UInt8 { case a }"
 		}
 
 		t.Run(kind.Keyword(), func(t *testing.T) {
@@ -588,6 +625,7 @@ func TestCheckFunctionExpressionParameterWithoutResourceAnnotation(t *testing.T)
 				assert.IsType(t, &sema.MissingResourceAnnotationError{}, errs[0])
 
 			case common.CompositeKindStructure,
+				common.CompositeKindEnum,
 				common.CompositeKindContract,
 				common.CompositeKindEvent:
 
@@ -605,8 +643,11 @@ func TestCheckFunctionExpressionReturnTypeWithResourceAnnotation(t *testing.T) {
 	for _, compositeKind := range common.AllCompositeKinds {
 
 		body := "{}"
-		if compositeKind == common.CompositeKindEvent {
+		switch compositeKind {
+		case common.CompositeKindEvent:
 			body = "()"
+		case common.CompositeKindEnum:
+			body = ": UInt8 { case a }"
 		}
 
 		t.Run(compositeKind.Keyword(), func(t *testing.T) {
@@ -632,7 +673,8 @@ func TestCheckFunctionExpressionReturnTypeWithResourceAnnotation(t *testing.T) {
 			case common.CompositeKindResource:
 				require.NoError(t, err)
 
-			case common.CompositeKindStructure:
+			case common.CompositeKindStructure,
+				common.CompositeKindEnum:
 				errs := ExpectCheckerErrors(t, err, 1)
 
 				assert.IsType(t, &sema.InvalidResourceAnnotationError{}, errs[0])
@@ -665,8 +707,11 @@ func TestCheckFunctionExpressionReturnTypeWithoutResourceAnnotation(t *testing.T
 		}
 
 		body := "{}"
-		if compositeKind == common.CompositeKindEvent {
+		switch compositeKind {
+		case common.CompositeKindEvent:
 			body = "()"
+		case common.CompositeKindEnum:
+			body = ": UInt8 { case a }"
 		}
 
 		t.Run(compositeKind.Keyword(), func(t *testing.T) {
@@ -695,6 +740,7 @@ func TestCheckFunctionExpressionReturnTypeWithoutResourceAnnotation(t *testing.T
 				assert.IsType(t, &sema.MissingResourceAnnotationError{}, errs[0])
 
 			case common.CompositeKindStructure,
+				common.CompositeKindEnum,
 				common.CompositeKindContract:
 
 				require.NoError(t, err)
@@ -716,8 +762,11 @@ func TestCheckFunctionTypeParameterWithResourceAnnotation(t *testing.T) {
 	for _, kind := range common.AllCompositeKinds {
 
 		body := "{}"
-		if kind == common.CompositeKindEvent {
+		switch kind {
+		case common.CompositeKindEvent:
 			body = "()"
+		case common.CompositeKindEnum:
+			body = ": UInt8 { case a }"
 		}
 
 		t.Run(kind.Keyword(), func(t *testing.T) {
@@ -742,6 +791,7 @@ func TestCheckFunctionTypeParameterWithResourceAnnotation(t *testing.T) {
 				require.NoError(t, err)
 
 			case common.CompositeKindStructure,
+				common.CompositeKindEnum,
 				common.CompositeKindContract,
 				common.CompositeKindEvent:
 
@@ -763,8 +813,11 @@ func TestCheckFunctionTypeParameterWithoutResourceAnnotation(t *testing.T) {
 	for _, kind := range common.AllCompositeKinds {
 
 		body := "{}"
-		if kind == common.CompositeKindEvent {
+		switch kind {
+		case common.CompositeKindEvent:
 			body = "()"
+		case common.CompositeKindEnum:
+			body = ": UInt8 { case a }"
 		}
 
 		t.Run(kind.Keyword(), func(t *testing.T) {
@@ -792,6 +845,7 @@ func TestCheckFunctionTypeParameterWithoutResourceAnnotation(t *testing.T) {
 				assert.IsType(t, &sema.MissingResourceAnnotationError{}, errs[0])
 
 			case common.CompositeKindStructure,
+				common.CompositeKindEnum,
 				common.CompositeKindContract,
 				common.CompositeKindEvent:
 
@@ -813,8 +867,11 @@ func TestCheckFunctionTypeReturnTypeWithResourceAnnotation(t *testing.T) {
 		}
 
 		body := "{}"
-		if compositeKind == common.CompositeKindEvent {
+		switch compositeKind {
+		case common.CompositeKindEvent:
 			body = "()"
+		case common.CompositeKindEnum:
+			body = ": UInt8 { case a }"
 		}
 
 		t.Run(compositeKind.Keyword(), func(t *testing.T) {
@@ -841,6 +898,7 @@ func TestCheckFunctionTypeReturnTypeWithResourceAnnotation(t *testing.T) {
 				require.NoError(t, err)
 
 			case common.CompositeKindStructure,
+				common.CompositeKindEnum,
 				common.CompositeKindContract:
 
 				errs := ExpectCheckerErrors(t, err, 2)
@@ -871,8 +929,11 @@ func TestCheckFunctionTypeReturnTypeWithoutResourceAnnotation(t *testing.T) {
 		}
 
 		body := "{}"
-		if compositeKind == common.CompositeKindEvent {
+		switch compositeKind {
+		case common.CompositeKindEvent:
 			body = "()"
+		case common.CompositeKindEnum:
+			body = ": UInt8 { case a }"
 		}
 
 		t.Run(compositeKind.Keyword(), func(t *testing.T) {
@@ -902,6 +963,7 @@ func TestCheckFunctionTypeReturnTypeWithoutResourceAnnotation(t *testing.T) {
 				assert.IsType(t, &sema.MissingResourceAnnotationError{}, errs[1])
 
 			case common.CompositeKindStructure,
+				common.CompositeKindEnum,
 				common.CompositeKindContract:
 
 				require.NoError(t, err)
@@ -923,8 +985,11 @@ func TestCheckFailableCastingWithoutResourceAnnotation(t *testing.T) {
 	for _, compositeKind := range common.AllCompositeKinds {
 
 		body := "{}"
-		if compositeKind == common.CompositeKindEvent {
+		switch compositeKind {
+		case common.CompositeKindEvent:
 			body = "()"
+		case common.CompositeKindEnum:
+			body = ": UInt8 { case a }"
 		}
 
 		t.Run(compositeKind.Keyword(), func(t *testing.T) {
@@ -952,6 +1017,7 @@ func TestCheckFailableCastingWithoutResourceAnnotation(t *testing.T) {
 				assert.IsType(t, &sema.InvalidFailableResourceDowncastOutsideOptionalBindingError{}, errs[1])
 
 			case common.CompositeKindStructure,
+				common.CompositeKindEnum,
 				common.CompositeKindContract:
 
 				require.NoError(t, err)
@@ -2432,8 +2498,11 @@ func testResourceNesting(
 		}
 
 		innerBody := "{}"
-		if innerCompositeKind == common.CompositeKindEvent {
+		switch innerCompositeKind {
+		case common.CompositeKindEvent:
 			innerBody = "()"
+		case common.CompositeKindEnum:
+			innerBody = ": UInt8 { case a }"
 		}
 
 		// Prepare the full program defining an empty composite,
@@ -2463,10 +2532,12 @@ func testResourceNesting(
 		_, err := ParseAndCheck(t, program)
 
 		switch outerCompositeKind {
-		case common.CompositeKindStructure:
+		case common.CompositeKindStructure,
+			common.CompositeKindEnum:
 
 			switch innerCompositeKind {
 			case common.CompositeKindStructure,
+				common.CompositeKindEnum,
 				common.CompositeKindEvent:
 
 				require.NoError(t, err)
@@ -244,3 +244,94 @@ func TestCheckStringIndexingAssignmentWithCharacterLiteral(t *testing.T) {
 
 	require.NoError(t, err)
 }
+
+func TestCheckStringTemplate(t *testing.T) {
+
+	checker, err := ParseAndCheck(t, `
+      struct S {}
+
+      fun test(): Int { return 1 }
+
+      let a = 1
+      let b: String? = nil
+      let s = S()
+      let x = "a: \(a), b: \(b), s: \(s), test: \(test()), sum: \(a + 2), path: \(/storage/foo)"
+	`)
+
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		&sema.StringType{},
+		checker.GlobalValues["x"].Type,
+	)
+}
+
+func TestCheckInvalidStringTemplate(t *testing.T) {
+
+	t.Run("resource", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test() {
+              let r <- create R()
+              let x = "r: \(r)"
+              destroy r
+          }
+	    `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidStringInterpolationTypeError{}, errs[0])
+	})
+
+	t.Run("function", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t, `
+          fun test() {}
+
+          let x = "test: \(test)"
+	    `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidStringInterpolationTypeError{}, errs[0])
+	})
+
+	t.Run("reference", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t, `
+          struct S {}
+
+          let s = S()
+          let x = "ref: \(&s as &S)"
+	    `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidStringInterpolationTypeError{}, errs[0])
+	})
+
+	t.Run("undeclared", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t, `
+          let x = "y: \(y)"
+	    `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
+
+	t.Run("character", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t, `
+          let a = 1
+          let x: Character = "\(a)"
+	    `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+}
@@ -0,0 +1,399 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/sema"
+	. "github.com/onflow/cadence/runtime/tests/utils"
+)
+
+func TestCheckSwitchStatement(t *testing.T) {
+
+	_, err := ParseAndCheck(t, `
+      fun test(x: Int): String {
+          switch x {
+          case 1:
+              return "one"
+          case 2, 3:
+              return "two or three"
+          default:
+              return "other"
+          }
+      }
+    `)
+
+	require.NoError(t, err)
+}
+
+func TestCheckInvalidSwitchStatementTest(t *testing.T) {
+
+	_, err := ParseAndCheck(t, `
+      struct S {}
+
+      fun test(s: S) {
+          switch s {
+          default:
+              return
+          }
+      }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.NotEquatableTypeError{}, errs[0])
+}
+
+func TestCheckInvalidSwitchStatementCaseExpression(t *testing.T) {
+
+	_, err := ParseAndCheck(t, `
+      fun test(x: Int) {
+          switch x {
+          case "1":
+              return
+          }
+      }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.InvalidBinaryOperandsError{}, errs[0])
+}
+
+func TestCheckInvalidSwitchStatementDefaultPosition(t *testing.T) {
+
+	t.Run("not last", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t, `
+          fun test(x: Int) {
+              switch x {
+              default:
+                  return
+              case 1:
+                  return
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.SwitchDefaultPositionError{}, errs[0])
+	})
+
+	t.Run("duplicate", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t, `
+          fun test(x: Int) {
+              switch x {
+              default:
+                  return
+              default:
+                  return
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.SwitchDefaultPositionError{}, errs[0])
+	})
+}
+
+func TestCheckInvalidSwitchStatementMissingCaseStatements(t *testing.T) {
+
+	_, err := ParseAndCheck(t, `
+      fun test(x: Int) {
+          switch x {
+          case 1:
+          case 2:
+              return
+          }
+      }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.MissingSwitchCaseStatementsError{}, errs[0])
+}
+
+func TestCheckSwitchStatementDefinitiveReturn(t *testing.T) {
+
+	t.Run("default", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t, `
+          fun test(x: Int): Int {
+              switch x {
+              case 1:
+                  return 1
+              default:
+                  return 2
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("no default", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t, `
+          fun test(x: Int): Int {
+              switch x {
+              case 1:
+                  return 1
+              case 2:
+                  return 2
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.MissingReturnStatementError{}, errs[0])
+	})
+
+	t.Run("case without return", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t, `
+          fun test(x: Int): Int {
+              switch x {
+              case 1:
+                  x + 1
+              default:
+                  return 2
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.MissingReturnStatementError{}, errs[0])
+	})
+
+	t.Run("break", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t, `
+          fun test(x: Int, y: Bool): Int {
+              switch x {
+              case 1:
+                  if y {
+                      break
+                  }
+                  return 1
+              default:
+                  return 2
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.MissingReturnStatementError{}, errs[0])
+	})
+}
+
+func TestCheckSwitchStatementEnumExhaustive(t *testing.T) {
+
+	t.Run("all cases", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t, `
+          enum E: UInt8 {
+              case a
+              case b
+          }
+
+          fun test(e: E): Int {
+              switch e {
+              case E.a:
+                  return 1
+              case E.b:
+                  return 2
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("missing case", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t, `
+          enum E: UInt8 {
+              case a
+              case b
+              case c
+          }
+
+          fun test(e: E): Int {
+              switch e {
+              case E.a, E.b:
+                  return 1
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.MissingReturnStatementError{}, errs[0])
+	})
+}
+
+func TestCheckSwitchStatementBreak(t *testing.T) {
+
+	t.Run("break", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t, `
+          fun test(x: Int) {
+              switch x {
+              case 1:
+                  break
+              default:
+                  return
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("continue", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t, `
+          fun test(x: Int) {
+              switch x {
+              case 1:
+                  continue
+              default:
+                  return
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ControlStatementError{}, errs[0])
+	})
+
+	t.Run("continue in loop", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t, `
+          fun test(x: Int) {
+              while true {
+                  switch x {
+                  case 1:
+                      continue
+                  default:
+                      break
+                  }
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestCheckSwitchStatementResourceInvalidation(t *testing.T) {
+
+	t.Run("all cases", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test(x: Int) {
+              let r <- create R()
+              switch x {
+              case 1:
+                  destroy r
+              default:
+                  destroy r
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("no default", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test(x: Int) {
+              let r <- create R()
+              switch x {
+              case 1:
+                  destroy r
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ResourceLossError{}, errs[0])
+	})
+
+	t.Run("break", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test(x: Int, y: Bool) {
+              let r <- create R()
+              switch x {
+              default:
+                  if y {
+                      break
+                  }
+                  destroy r
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ResourceLossError{}, errs[0])
+	})
+
+	t.Run("use after invalidation", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test(x: Int) {
+              let r <- create R()
+              switch x {
+              case 1:
+                  destroy r
+              default:
+                  destroy r
+              }
+              destroy r
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ResourceUseAfterInvalidationError{}, errs[0])
+	})
+}
@@ -20,6 +20,7 @@ package interpreter_test
 
 import (
 	"fmt"
+	"sort"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -58,6 +59,8 @@ func testAccount(t *testing.T, auth bool, code string) (*interpreter.Interpreter
 		panicFunction,
 		panicFunction,
 		panicFunction,
+		nil,
+		nil,
 	)
 
 	// `pubAccount`
@@ -69,7 +72,7 @@ func testAccount(t *testing.T, auth bool, code string) (*interpreter.Interpreter
 		IsConstant: true,
 	}
 
-	values["pubAccount"] = interpreter.NewPublicAccountValue(address)
+	values["pubAccount"] = interpreter.NewPublicAccountValue(address, nil)
 
 	// `account`
 
@@ -107,6 +110,15 @@ func testAccount(t *testing.T, auth bool, code string) (*interpreter.Interpreter
 		return value
 	}
 
+	storageEnumerator := func(_ *interpreter.Interpreter, _ common.Address) []string {
+		keys := make([]string, 0, len(storedValues))
+		for key := range storedValues {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		return keys
+	}
+
 	inter := parseCheckAndInterpretWithOptions(t,
 		code,
 		ParseCheckAndInterpretOptions{
@@ -118,6 +130,7 @@ func testAccount(t *testing.T, auth bool, code string) (*interpreter.Interpreter
 				interpreter.WithStorageExistenceHandler(storageChecker),
 				interpreter.WithStorageReadHandler(storageGetter),
 				interpreter.WithStorageWriteHandler(storageSetter),
+				interpreter.WithStorageEnumerationHandler(storageEnumerator),
 			},
 		},
 	)
@@ -1333,6 +1346,163 @@ func TestInterpretAuthAccountUnlink(t *testing.T) {
 	})
 }
 
+func TestInterpretAuthAccountPaths(t *testing.T) {
+
+	inter, _ := testAccount(
+		t,
+		true,
+		`
+          resource R {}
+
+          struct S {}
+
+          fun test(): [[Path]] {
+              account.save(<-create R(), to: /storage/r)
+              account.save(S(), to: /storage/s)
+              account.link<&R>(/public/r, target: /storage/r)
+              account.link<&S>(/private/s, target: /storage/s)
+              account.link<&S>(/public/s, target: /storage/s)
+
+              return [
+                  account.storagePaths,
+                  account.publicPaths,
+                  account.privatePaths
+              ]
+          }
+        `,
+	)
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		interpreter.NewArrayValueUnownedNonCopying(
+			interpreter.NewArrayValueUnownedNonCopying(
+				interpreter.PathValue{Domain: common.PathDomainStorage, Identifier: "r"},
+				interpreter.PathValue{Domain: common.PathDomainStorage, Identifier: "s"},
+			),
+			interpreter.NewArrayValueUnownedNonCopying(
+				interpreter.PathValue{Domain: common.PathDomainPublic, Identifier: "r"},
+				interpreter.PathValue{Domain: common.PathDomainPublic, Identifier: "s"},
+			),
+			interpreter.NewArrayValueUnownedNonCopying(
+				interpreter.PathValue{Domain: common.PathDomainPrivate, Identifier: "s"},
+			),
+		),
+		value,
+	)
+}
+
+func TestInterpretAuthAccountForEachStored(t *testing.T) {
+
+	t.Run("all", func(t *testing.T) {
+
+		inter, _ := testAccount(
+			t,
+			true,
+			`
+              resource R {}
+
+              struct S {}
+
+              fun test(): [String] {
+                  account.save(<-create R(), to: /storage/r)
+                  account.save(S(), to: /storage/s)
+                  account.save(1, to: /storage/one)
+                  account.link<&R>(/public/r, target: /storage/r)
+
+                  let results: [String] = []
+                  account.forEachStored(fun (path: Path, type: Type): Bool {
+                      if type == Type<@R>() {
+                          results.append("R")
+                      } else if type == Type<S>() {
+                          results.append("S")
+                      } else if type == Type<Int>() {
+                          results.append("Int")
+                      }
+                      return true
+                  })
+                  return results
+              }
+            `,
+		)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewArrayValueUnownedNonCopying(
+				interpreter.NewStringValue("Int"),
+				interpreter.NewStringValue("R"),
+				interpreter.NewStringValue("S"),
+			),
+			value,
+		)
+	})
+
+	t.Run("stop", func(t *testing.T) {
+
+		inter, _ := testAccount(
+			t,
+			true,
+			`
+              fun test(): Int {
+                  account.save(1, to: /storage/a)
+                  account.save(2, to: /storage/b)
+                  account.save(3, to: /storage/c)
+
+                  var count = 0
+                  account.forEachStored(fun (path: Path, type: Type): Bool {
+                      count = count + 1
+                      return count < 2
+                  })
+                  return count
+              }
+            `,
+		)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t, interpreter.NewIntValueFromInt64(2), value)
+	})
+
+	t.Run("load during iteration", func(t *testing.T) {
+
+		inter, storedValues := testAccount(
+			t,
+			true,
+			`
+              fun test(): [Path] {
+                  account.save(1, to: /storage/a)
+                  account.save(2, to: /storage/b)
+
+                  let paths: [Path] = []
+                  account.forEachStored(fun (path: Path, type: Type): Bool {
+                      paths.append(path)
+                      account.load<Int>(from: /storage/a)
+                      account.load<Int>(from: /storage/b)
+                      return true
+                  })
+                  return paths
+              }
+            `,
+		)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewArrayValueUnownedNonCopying(
+				interpreter.PathValue{Domain: common.PathDomainStorage, Identifier: "a"},
+			),
+			value,
+		)
+
+		assert.Empty(t, storedValues)
+	})
+}
+
 func TestInterpretAccountGetLinkTarget(t *testing.T) {
 
 	for _, auth := range []bool{true, false} {
@@ -23,7 +23,9 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/interpreter"
+	. "github.com/onflow/cadence/runtime/tests/utils"
 )
 
 func TestInterpretCapabilityBorrowResource(t *testing.T) {
@@ -588,3 +590,227 @@ func TestInterpretCapabilityCheck(t *testing.T) {
 	})
 
 }
+
+func TestInterpretTypedCapability(t *testing.T) {
+
+	inter, _ := testAccount(
+		t,
+		true,
+		`
+          resource R {
+              let foo: Int
+
+              init() {
+                  self.foo = 42
+              }
+          }
+
+          resource R2 {}
+
+          fun save() {
+              let r <- create R()
+              account.save(<-r, to: /storage/r)
+
+              account.link<&R>(/public/r, target: /storage/r)
+          }
+
+          fun linked(): Int {
+              let capability = account.link<&R>(/public/linked, target: /storage/r)!
+              return capability.borrow()!.foo
+          }
+
+          fun linkedPath(): Capability<&R> {
+              return account.link<&R>(/public/linkedPath, target: /storage/r)!
+          }
+
+          fun unlinked(): &R? {
+              let capability = account.link<&R>(/public/unlinked, target: /storage/r)!
+              account.unlink(/public/unlinked)
+              return capability.borrow()
+          }
+
+          fun borrowR(): Int {
+              let capability = account.getCapability<&R>(/public/r)!
+              return capability.borrow()!.foo
+          }
+
+          fun borrowR2(): &R2? {
+              let capability = account.getCapability<&R2>(/public/r)!
+              return capability.borrow()
+          }
+
+          fun checkR(): Bool {
+              return account.getCapability<&R>(/public/r)!.check()
+          }
+
+          fun checkAuthR(): Bool {
+              return account.getCapability<auth &R>(/public/r)!.check()
+          }
+
+          fun castR(): Capability<&R>? {
+              let capability: Capability = account.getCapability<&R>(/public/r)!
+              return capability as? Capability<&R>
+          }
+
+          fun castR2(): Capability<&R2>? {
+              let capability: Capability = account.getCapability<&R>(/public/r)!
+              return capability as? Capability<&R2>
+          }
+
+          fun castUntyped(): Capability<&R>? {
+              let capability: Capability = account.getCapability(/public/r)!
+              return capability as? Capability<&R>
+          }
+
+          fun borrowAnyAsUntyped(): &AnyResource? {
+              let capability: Capability = account.getCapability<&R>(/public/r)!
+              return capability.borrow<&AnyResource>()
+          }
+
+          fun checkAnyAsUntyped(): Bool {
+              let capability: Capability = account.getCapability<&R>(/public/r)!
+              return capability.check<&AnyResource>()
+          }
+
+          fun borrowAsUntyped(): Int {
+              let capability: Capability = account.getCapability<&R>(/public/r)!
+              return capability.borrow<&R>()!.foo
+          }
+
+          fun borrowAnyUntyped(): Bool {
+              let capability = account.getCapability(/public/r)!
+              return capability.borrow<&AnyResource>() != nil
+          }
+        `,
+	)
+
+	_, err := inter.Invoke("save")
+	require.NoError(t, err)
+
+	t.Run("link", func(t *testing.T) {
+
+		value, err := inter.Invoke("linked")
+		require.NoError(t, err)
+
+		require.Equal(t, interpreter.NewIntValueFromInt64(42), value)
+	})
+
+	t.Run("link, path", func(t *testing.T) {
+
+		value, err := inter.Invoke("linkedPath")
+		require.NoError(t, err)
+
+		require.IsType(t, interpreter.CapabilityValue{}, value)
+
+		require.Equal(t,
+			interpreter.PathValue{
+				Domain:     common.PathDomainPublic,
+				Identifier: "linkedPath",
+			},
+			value.(interpreter.CapabilityValue).Path,
+		)
+	})
+
+	t.Run("link, unlinked", func(t *testing.T) {
+
+		value, err := inter.Invoke("unlinked")
+		require.NoError(t, err)
+
+		require.Equal(t, interpreter.NilValue{}, value)
+	})
+
+	t.Run("borrow", func(t *testing.T) {
+
+		value, err := inter.Invoke("borrowR")
+		require.NoError(t, err)
+
+		require.Equal(t, interpreter.NewIntValueFromInt64(42), value)
+	})
+
+	t.Run("borrow, mismatching type", func(t *testing.T) {
+
+		value, err := inter.Invoke("borrowR2")
+		require.NoError(t, err)
+
+		require.Equal(t, interpreter.NilValue{}, value)
+	})
+
+	t.Run("check", func(t *testing.T) {
+
+		value, err := inter.Invoke("checkR")
+		require.NoError(t, err)
+
+		require.Equal(t, interpreter.BoolValue(true), value)
+	})
+
+	t.Run("check, authorized", func(t *testing.T) {
+
+		value, err := inter.Invoke("checkAuthR")
+		require.NoError(t, err)
+
+		require.Equal(t, interpreter.BoolValue(false), value)
+	})
+
+	t.Run("cast", func(t *testing.T) {
+
+		value, err := inter.Invoke("castR")
+		require.NoError(t, err)
+
+		require.IsType(t, &interpreter.SomeValue{}, value)
+
+		capability := value.(*interpreter.SomeValue).Value.(interpreter.CapabilityValue)
+
+		require.Equal(t,
+			interpreter.ReferenceStaticType{
+				Type: interpreter.CompositeStaticType{
+					Location: TestLocation,
+					TypeID:   "test.R",
+				},
+			},
+			capability.BorrowType,
+		)
+	})
+
+	t.Run("cast, mismatching type", func(t *testing.T) {
+
+		value, err := inter.Invoke("castR2")
+		require.NoError(t, err)
+
+		require.Equal(t, interpreter.NilValue{}, value)
+	})
+
+	t.Run("cast, untyped", func(t *testing.T) {
+
+		value, err := inter.Invoke("castUntyped")
+		require.NoError(t, err)
+
+		require.Equal(t, interpreter.NilValue{}, value)
+	})
+
+	t.Run("borrow as untyped capability", func(t *testing.T) {
+
+		// The link allows the type, but it is not a subtype of the capability's borrow type
+
+		value, err := inter.Invoke("borrowAnyAsUntyped")
+		require.NoError(t, err)
+
+		require.Equal(t, interpreter.NilValue{}, value)
+
+		value, err = inter.Invoke("checkAnyAsUntyped")
+		require.NoError(t, err)
+
+		require.Equal(t, interpreter.BoolValue(false), value)
+
+		value, err = inter.Invoke("borrowAsUntyped")
+		require.NoError(t, err)
+
+		require.Equal(t, interpreter.NewIntValueFromInt64(42), value)
+
+		// Capabilities without a borrow type can be borrowed as any type the link allows
+
+		value, err = inter.Invoke("borrowAnyUntyped")
+		require.NoError(t, err)
+
+		require.Equal(t, interpreter.BoolValue(true), value)
+	})
+}
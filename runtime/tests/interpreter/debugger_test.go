@@ -0,0 +1,387 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/interpreter"
+	. "github.com/onflow/cadence/runtime/tests/utils"
+)
+
+type debuggerResult struct {
+	value interpreter.Value
+	err   error
+}
+
+// invokeDebugged invokes the function with the given name in a separate goroutine,
+// as the debugger blocks the execution when it is paused.
+//
+func invokeDebugged(inter *interpreter.Interpreter, functionName string) <-chan debuggerResult {
+	results := make(chan debuggerResult, 1)
+
+	go func() {
+		value, err := inter.Invoke(functionName)
+		results <- debuggerResult{
+			value: value,
+			err:   err,
+		}
+	}()
+
+	return results
+}
+
+const debuggerTestCode = `
+pub fun add(_ a: Int, _ b: Int): Int {
+    let sum = a + b
+    return sum
+}
+
+pub fun main(): Int {
+    let x = 1
+    let y = add(x, 2)
+    let z = y * 2
+    return z
+}
+`
+
+func TestInterpretDebuggerBreakpoint(t *testing.T) {
+
+	t.Parallel()
+
+	debugger := interpreter.NewDebugger()
+
+	inter := parseCheckAndInterpretWithOptions(t,
+		debuggerTestCode,
+		ParseCheckAndInterpretOptions{
+			Options: []interpreter.Option{
+				interpreter.WithDebugger(debugger),
+			},
+		},
+	)
+
+	debugger.AddBreakpoint(TestLocation, 3)
+
+	assert.Equal(t, []int{3}, debugger.Breakpoints(TestLocation))
+
+	results := invokeDebugged(inter, "main")
+
+	stop := <-debugger.Stops()
+	assert.Equal(t, interpreter.StopReasonBreakpoint, stop.Reason)
+	assert.Equal(t, 3, stop.Line)
+	assert.Same(t, inter, stop.Interpreter)
+
+	require.NotNil(t, debugger.Paused())
+
+	callStack := debugger.CallStack()
+	require.Len(t, callStack, 2)
+
+	addFrame := callStack[0]
+	assert.Equal(t, "add", addFrame.Function)
+	assert.Equal(t, 3, addFrame.Line)
+	assert.Equal(t, TestLocation, addFrame.Location())
+	assert.Equal(t,
+		map[string]interpreter.Value{
+			"a": interpreter.NewIntValueFromInt64(1),
+			"b": interpreter.NewIntValueFromInt64(2),
+		},
+		addFrame.Locals(),
+	)
+
+	mainFrame := callStack[1]
+	assert.Equal(t, "main", mainFrame.Function)
+	assert.Equal(t, 9, mainFrame.Line)
+	assert.Equal(t,
+		map[string]interpreter.Value{
+			"x": interpreter.NewIntValueFromInt64(1),
+		},
+		mainFrame.Locals(),
+	)
+
+	value, err := debugger.Evaluate(addFrame, "b")
+	require.NoError(t, err)
+	assert.Equal(t, interpreter.NewIntValueFromInt64(2), value)
+
+	_, err = debugger.Evaluate(mainFrame, "a")
+	require.Error(t, err)
+	require.IsType(t, &interpreter.NotDeclaredError{}, err)
+
+	debugger.RemoveBreakpoint(TestLocation, 3)
+	debugger.Continue()
+
+	result := <-results
+	require.NoError(t, result.err)
+	assert.Equal(t, interpreter.NewIntValueFromInt64(6), result.value)
+
+	assert.Nil(t, debugger.Paused())
+	assert.Nil(t, debugger.CallStack())
+}
+
+func TestInterpretDebuggerStepping(t *testing.T) {
+
+	t.Parallel()
+
+	debugger := interpreter.NewDebugger()
+
+	inter := parseCheckAndInterpretWithOptions(t,
+		debuggerTestCode,
+		ParseCheckAndInterpretOptions{
+			Options: []interpreter.Option{
+				interpreter.WithDebugger(debugger),
+			},
+		},
+	)
+
+	debugger.AddBreakpoint(TestLocation, 8)
+
+	results := invokeDebugged(inter, "main")
+
+	type step struct {
+		action func()
+		reason interpreter.StopReason
+		line   int
+		depth  int
+	}
+
+	steps := []step{
+		{debugger.StepOver, interpreter.StopReasonStep, 9, 1},
+		{debugger.StepInto, interpreter.StopReasonStep, 3, 2},
+		{debugger.StepOver, interpreter.StopReasonStep, 4, 2},
+		{debugger.StepOut, interpreter.StopReasonStep, 10, 1},
+		{debugger.StepOver, interpreter.StopReasonStep, 11, 1},
+	}
+
+	stop := <-debugger.Stops()
+	assert.Equal(t, interpreter.StopReasonBreakpoint, stop.Reason)
+	assert.Equal(t, 8, stop.Line)
+
+	for _, step := range steps {
+		step.action()
+
+		stop := <-debugger.Stops()
+		assert.Equal(t, step.reason, stop.Reason)
+		assert.Equal(t, step.line, stop.Line)
+		assert.Len(t, debugger.CallStack(), step.depth)
+	}
+
+	debugger.Continue()
+
+	result := <-results
+	require.NoError(t, result.err)
+	assert.Equal(t, interpreter.NewIntValueFromInt64(6), result.value)
+}
+
+func TestInterpretDebuggerStepOverCall(t *testing.T) {
+
+	t.Parallel()
+
+	debugger := interpreter.NewDebugger()
+
+	inter := parseCheckAndInterpretWithOptions(t,
+		debuggerTestCode,
+		ParseCheckAndInterpretOptions{
+			Options: []interpreter.Option{
+				interpreter.WithDebugger(debugger),
+			},
+		},
+	)
+
+	debugger.RequestPause()
+
+	results := invokeDebugged(inter, "main")
+
+	stop := <-debugger.Stops()
+	assert.Equal(t, interpreter.StopReasonPause, stop.Reason)
+	assert.Equal(t, 8, stop.Line)
+
+	debugger.StepOver()
+
+	stop = <-debugger.Stops()
+	assert.Equal(t, 9, stop.Line)
+
+	// Stepping over the call of `add` does not stop in it
+
+	debugger.StepOver()
+
+	stop = <-debugger.Stops()
+	assert.Equal(t, 10, stop.Line)
+
+	value, err := debugger.Evaluate(debugger.CallStack()[0], "y")
+	require.NoError(t, err)
+	assert.Equal(t, interpreter.NewIntValueFromInt64(3), value)
+
+	debugger.Continue()
+
+	result := <-results
+	require.NoError(t, result.err)
+	assert.Equal(t, interpreter.NewIntValueFromInt64(6), result.value)
+}
+
+func TestInterpretDebuggerEvaluate(t *testing.T) {
+
+	t.Parallel()
+
+	debugger := interpreter.NewDebugger()
+
+	inter := parseCheckAndInterpretWithOptions(t,
+		`
+pub struct S {
+    pub let values: {String: Int}
+
+    init() {
+        self.values = {"a": 1}
+    }
+}
+
+pub let global = 42
+
+pub fun main(): Int {
+    let s = S()
+    let structs = [s]
+    return structs.length
+}
+        `,
+		ParseCheckAndInterpretOptions{
+			Options: []interpreter.Option{
+				interpreter.WithDebugger(debugger),
+			},
+		},
+	)
+
+	debugger.AddBreakpoint(TestLocation, 15)
+
+	results := invokeDebugged(inter, "main")
+
+	stop := <-debugger.Stops()
+	assert.Equal(t, 15, stop.Line)
+
+	frame := debugger.CallStack()[0]
+
+	assert.Equal(t,
+		map[string]interpreter.Value{
+			"global": interpreter.NewIntValueFromInt64(42),
+		},
+		frame.Globals(),
+	)
+
+	value, err := debugger.Evaluate(frame, `structs[0].values["a"]`)
+	require.NoError(t, err)
+	assert.Equal(t,
+		interpreter.NewSomeValueOwningNonCopying(
+			interpreter.NewIntValueFromInt64(1),
+		),
+		value,
+	)
+
+	value, err = debugger.Evaluate(frame, `global`)
+	require.NoError(t, err)
+	assert.Equal(t, interpreter.NewIntValueFromInt64(42), value)
+
+	_, err = debugger.Evaluate(frame, `s.values["a"] + 1`)
+	require.Error(t, err)
+	require.IsType(t, &interpreter.UnsupportedEvaluationError{}, err)
+
+	_, err = debugger.Evaluate(frame, `s.`)
+	require.Error(t, err)
+
+	debugger.Continue()
+
+	result := <-results
+	require.NoError(t, result.err)
+	assert.Equal(t, interpreter.NewIntValueFromInt64(1), result.value)
+}
+
+func TestInterpretDebuggerAbortedExecution(t *testing.T) {
+
+	t.Parallel()
+
+	debugger := interpreter.NewDebugger()
+
+	inter := parseCheckAndInterpretWithOptions(t,
+		debuggerTestCode+`
+          pub fun inner(): Int {
+              let x: Int? = nil
+              return x!
+          }
+
+          pub fun outer(): Int {
+              return inner()
+          }
+        `,
+		ParseCheckAndInterpretOptions{
+			Options: []interpreter.Option{
+				interpreter.WithDebugger(debugger),
+			},
+		},
+	)
+
+	// Step into the failing function, and leave the step mode set
+	// when the execution is aborted
+
+	debugger.AddBreakpoint(TestLocation, 20)
+
+	results := invokeDebugged(inter, "outer")
+
+	stop := <-debugger.Stops()
+	assert.Equal(t, interpreter.StopReasonBreakpoint, stop.Reason)
+	assert.Equal(t, 20, stop.Line)
+
+	debugger.RemoveBreakpoint(TestLocation, 20)
+	debugger.StepInto()
+
+	stop = <-debugger.Stops()
+	assert.Equal(t, 15, stop.Line)
+	assert.Len(t, debugger.CallStack(), 2)
+
+	debugger.StepInto()
+
+	stop = <-debugger.Stops()
+	assert.Equal(t, 16, stop.Line)
+
+	debugger.StepInto()
+
+	result := <-results
+	require.Error(t, result.err)
+	require.IsType(t, &interpreter.ForceNilError{}, result.err)
+
+	// The frames of the aborted execution are popped,
+	// and the step mode does not affect the next execution
+
+	debugger.AddBreakpoint(TestLocation, 3)
+
+	results = invokeDebugged(inter, "main")
+
+	stop = <-debugger.Stops()
+	assert.Equal(t, interpreter.StopReasonBreakpoint, stop.Reason)
+	assert.Equal(t, 3, stop.Line)
+
+	callStack := debugger.CallStack()
+	require.Len(t, callStack, 2)
+	assert.Equal(t, "add", callStack[0].Function)
+	assert.Equal(t, "main", callStack[1].Function)
+
+	debugger.Continue()
+
+	result = <-results
+	require.NoError(t, result.err)
+	assert.Equal(t, interpreter.NewIntValueFromInt64(6), result.value)
+}
@@ -0,0 +1,167 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+func TestInterpretEnum(t *testing.T) {
+
+	inter := parseCheckAndInterpret(t, `
+      enum E: UInt8 {
+          case a
+          case b
+      }
+
+      let a = E.a
+      let b = E.b
+    `)
+
+	require.IsType(t, &interpreter.CompositeValue{}, inter.Globals["a"].Value)
+	a := inter.Globals["a"].Value.(*interpreter.CompositeValue)
+
+	assert.Equal(t, common.CompositeKindEnum, a.Kind)
+	assert.Equal(t,
+		interpreter.UInt8Value(0),
+		a.Fields["rawValue"],
+	)
+
+	require.IsType(t, &interpreter.CompositeValue{}, inter.Globals["b"].Value)
+
+	assert.Equal(t,
+		interpreter.UInt8Value(1),
+		inter.Globals["b"].Value.(*interpreter.CompositeValue).Fields["rawValue"],
+	)
+}
+
+func TestInterpretEnumRawValue(t *testing.T) {
+
+	inter := parseCheckAndInterpret(t, `
+      enum E: Int64 {
+          case a
+          case b
+          case c
+      }
+
+      let rawValue = E.c.rawValue
+    `)
+
+	assert.Equal(t,
+		interpreter.Int64Value(2),
+		inter.Globals["rawValue"].Value,
+	)
+}
+
+func TestInterpretEnumConstructor(t *testing.T) {
+
+	inter := parseCheckAndInterpret(t, `
+      enum E: UInt8 {
+          case a
+          case b
+      }
+
+      fun test(_ rawValue: UInt8): Bool {
+          return E(rawValue: rawValue) == E.b
+      }
+
+      let isNil = E(rawValue: 2) == nil
+    `)
+
+	value, err := inter.Invoke("test", interpreter.UInt8Value(1))
+	require.NoError(t, err)
+
+	assert.Equal(t, interpreter.BoolValue(true), value)
+
+	value, err = inter.Invoke("test", interpreter.UInt8Value(0))
+	require.NoError(t, err)
+
+	assert.Equal(t, interpreter.BoolValue(false), value)
+
+	assert.Equal(t,
+		interpreter.BoolValue(true),
+		inter.Globals["isNil"].Value,
+	)
+}
+
+func TestInterpretEnumEquality(t *testing.T) {
+
+	inter := parseCheckAndInterpret(t, `
+      enum E: UInt8 {
+          case a
+          case b
+      }
+
+      let a = E.a
+      let equal = a == E.a
+      let notEqual = E.a != E.b
+    `)
+
+	assert.Equal(t,
+		interpreter.BoolValue(true),
+		inter.Globals["equal"].Value,
+	)
+
+	assert.Equal(t,
+		interpreter.BoolValue(true),
+		inter.Globals["notEqual"].Value,
+	)
+}
+
+func TestInterpretEnumInContract(t *testing.T) {
+
+	inter := parseCheckAndInterpretWithOptions(t,
+		`
+          contract C {
+              enum E: UInt8 {
+                  case a
+                  case b
+              }
+
+              fun test(): Bool {
+                  return E(rawValue: 1) == E.b
+              }
+          }
+
+          let rawValue = C.E.b.rawValue
+          let test = C.test()
+        `,
+		ParseCheckAndInterpretOptions{
+			Options: []interpreter.Option{
+				makeContractValueHandler(nil, nil, nil),
+			},
+		},
+	)
+
+	assert.Equal(t,
+		interpreter.UInt8Value(1),
+		inter.Globals["rawValue"].Value,
+	)
+
+	assert.Equal(t,
+		interpreter.BoolValue(true),
+		inter.Globals["test"].Value,
+	)
+}
@@ -19,8 +19,6 @@
 package interpreter_test
 
 import (
-	"bytes"
-	"encoding/gob"
 	"fmt"
 	"math/big"
 	"strings"
@@ -98,8 +96,12 @@ func parseCheckAndInterpretWithOptions(
 }
 
 func constructorArguments(compositeKind common.CompositeKind, arguments string) string {
-	if compositeKind == common.CompositeKindContract {
+	switch compositeKind {
+	case common.CompositeKindContract:
 		return ""
+	case common.CompositeKindEnum:
+		// enum values are not constructed, but are the enum's cases
+		return ".a"
 	}
 	return fmt.Sprintf("(%s)", arguments)
 }
@@ -798,6 +800,85 @@ func TestInterpretConcatOperator(t *testing.T) {
 	// 	}))
 }
 
+func TestInterpretBitwiseOperators(t *testing.T) {
+
+	inter := parseCheckAndInterpret(t, `
+        let a = 0xf0 | 0x0f
+        let b = 0xff ^ 0x0f
+        let c = 0xff & 0x0f
+        let d = 1 << 4
+        let e = 0x80 >> 3
+        let f = ~0
+        let g = 1 | 2 ^ 3 & 4 << 1
+        let h = UInt8(0xff) << UInt8(4)
+        let i = Int8(-128) >> Int8(7)
+        let j = ~Word16(0x00ff)
+    `)
+
+	for name, expected := range map[string]interpreter.Value{
+		"a": interpreter.NewIntValueFromInt64(0xff),
+		"b": interpreter.NewIntValueFromInt64(0xf0),
+		"c": interpreter.NewIntValueFromInt64(0x0f),
+		"d": interpreter.NewIntValueFromInt64(16),
+		"e": interpreter.NewIntValueFromInt64(16),
+		"f": interpreter.NewIntValueFromInt64(-1),
+		// 1 | (2 ^ (3 & (4 << 1)))
+		"g": interpreter.NewIntValueFromInt64(3),
+		"h": interpreter.UInt8Value(0xf0),
+		"i": interpreter.Int8Value(-1),
+		"j": interpreter.Word16Value(0xff00),
+	} {
+		assert.Equal(t,
+			expected,
+			inter.Globals[name].Value,
+			name,
+		)
+	}
+}
+
+func TestInterpretBitwiseShiftNegative(t *testing.T) {
+
+	inter := parseCheckAndInterpret(t, `
+        fun test(): Int {
+            return 1 << -1
+        }
+    `)
+
+	_, err := inter.Invoke("test")
+	require.Error(t, err)
+
+	require.IsType(t, interpreter.NegativeShiftError{}, err)
+}
+
+func TestInterpretBitwiseShiftHuge(t *testing.T) {
+
+	inter := parseCheckAndInterpret(t, `
+        fun testInt(): Int {
+            return 1 << 10000000000
+        }
+
+        fun testUInt(): UInt {
+            return UInt(1) << UInt(10000000000)
+        }
+
+        fun testZero(): Int {
+            return 0 << 10000000000
+        }
+    `)
+
+	for _, name := range []string{"testInt", "testUInt"} {
+		_, err := inter.Invoke(name)
+		require.Error(t, err, name)
+
+		require.IsType(t, interpreter.OverflowError{}, err, name)
+	}
+
+	value, err := inter.Invoke("testZero")
+	require.NoError(t, err)
+
+	assert.Equal(t, interpreter.NewIntValueFromInt64(0), value)
+}
+
 func TestInterpretEqualOperator(t *testing.T) {
 
 	inter := parseCheckAndInterpret(t, `
@@ -1653,12 +1734,17 @@ func TestInterpretCompositeDeclaration(t *testing.T) {
 			continue
 		}
 
+		body := "{}"
+		if compositeKind == common.CompositeKindEnum {
+			body = ": UInt8 { pub case a }"
+		}
+
 		t.Run(compositeKind.Name(), func(t *testing.T) {
 
 			inter := parseCheckAndInterpretWithOptions(t,
 				fmt.Sprintf(
 					`
-                       pub %[1]s Test {}
+                       pub %[1]s Test %[6]s
 
                        pub fun test(): %[2]sTest {
                            return %[3]s %[4]s Test%[5]s
@@ -1669,6 +1755,7 @@ func TestInterpretCompositeDeclaration(t *testing.T) {
 					compositeKind.MoveOperator(),
 					compositeKind.ConstructionKeyword(),
 					constructorArguments(compositeKind, ""),
+					body,
 				),
 				ParseCheckAndInterpretOptions{
 					Options: []interpreter.Option{
@@ -3189,6 +3276,11 @@ func TestInterpretCompositeNilEquality(t *testing.T) {
 			continue
 		}
 
+		body := "{}"
+		if compositeKind == common.CompositeKindEnum {
+			body = ": UInt8 { pub case a }"
+		}
+
 		var setupCode, identifier string
 		if compositeKind == common.CompositeKindContract {
 			identifier = "X"
@@ -3208,14 +3300,15 @@ func TestInterpretCompositeNilEquality(t *testing.T) {
 			inter := parseCheckAndInterpretWithOptions(t,
 				fmt.Sprintf(
 					`
-                      pub %[1]s X {}
+                      pub %[1]s X %[2]s
 
-                      %[2]s
+                      %[3]s
 
-                      pub let y = %[3]s == nil
-                      pub let z = nil == %[3]s
+                      pub let y = %[4]s == nil
+                      pub let z = nil == %[4]s
                     `,
 					compositeKind.Keyword(),
+					body,
 					setupCode,
 					identifier,
 				),
@@ -6535,6 +6628,8 @@ func TestInterpretContractAccountFieldUse(t *testing.T) {
 								panicFunction,
 								panicFunction,
 								panicFunction,
+								nil,
+								nil,
 							),
 						}
 					},
@@ -7225,6 +7320,8 @@ func TestInterpretResourceOwnerFieldUse(t *testing.T) {
 			panicFunction,
 			panicFunction,
 			panicFunction,
+			nil,
+			nil,
 		),
 	}
 
@@ -7437,12 +7534,10 @@ func TestInterpretCompositeValueFieldEncodingOrder(t *testing.T) {
 			0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1,
 		})
 
-		w := new(bytes.Buffer)
-		encoder := gob.NewEncoder(w)
-		err := encoder.Encode(test)
+		encoded, err := interpreter.EncodeValue(test)
 		require.NoError(t, err)
 
-		encodings[i] = w.Bytes()
+		encodings[i] = encoded
 	}
 
 	expected := encodings[0]
@@ -7485,20 +7580,16 @@ func TestInterpretDictionaryValueEncodingOrder(t *testing.T) {
 
 		test := inter.Globals["test"].Value
 
-		test.SetOwner(&common.Address{
+		owner := common.Address{
 			0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1,
-		})
+		}
 
-		w := new(bytes.Buffer)
-		encoder := gob.NewEncoder(w)
-		err := encoder.Encode(&test)
-		require.NoError(t, err)
+		test.SetOwner(&owner)
 
-		encoded := w.Bytes()
+		encoded, err := interpreter.EncodeValue(test)
+		require.NoError(t, err)
 
-		var decoded interpreter.Value
-		decoder := gob.NewDecoder(bytes.NewReader(encoded))
-		err = decoder.Decode(&decoded)
+		decoded, err := interpreter.DecodeValue(encoded, &owner)
 		require.NoError(t, err)
 
 		require.Equal(t, test, decoded)
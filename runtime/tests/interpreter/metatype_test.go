@@ -0,0 +1,256 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/sema"
+	. "github.com/onflow/cadence/runtime/tests/utils"
+)
+
+func TestInterpretMetaType(t *testing.T) {
+
+	inter := parseCheckAndInterpret(t, `
+      resource R {}
+
+      let type = Type<@R>()
+      let identifier = type.identifier
+      let arrayIdentifier = Type<[Int?]>().identifier
+    `)
+
+	assert.Equal(t,
+		interpreter.TypeValue{
+			Type: interpreter.CompositeStaticType{
+				Location: TestLocation,
+				TypeID:   "test.R",
+			},
+		},
+		inter.Globals["type"].Value,
+	)
+
+	assert.Equal(t,
+		interpreter.NewStringValue("test.R"),
+		inter.Globals["identifier"].Value,
+	)
+
+	assert.Equal(t,
+		interpreter.NewStringValue("[Int?]"),
+		inter.Globals["arrayIdentifier"].Value,
+	)
+}
+
+func TestInterpretMetaTypeEquality(t *testing.T) {
+
+	inter := parseCheckAndInterpret(t, `
+      struct S {}
+
+      let a = Type<Int>() == Type<Int>()
+      let b = Type<Int>() == Type<String>()
+      let c = Type<S>() == Type<S>()
+      let d = Type<[S]>() == Type<[Int]>()
+    `)
+
+	for name, expected := range map[string]bool{
+		"a": true,
+		"b": false,
+		"c": true,
+		"d": false,
+	} {
+		assert.Equal(t,
+			interpreter.BoolValue(expected),
+			inter.Globals[name].Value,
+			name,
+		)
+	}
+}
+
+func TestInterpretMetaTypeIsSubtype(t *testing.T) {
+
+	inter := parseCheckAndInterpret(t, `
+      resource interface I {}
+
+      resource R: I {}
+
+      let a = Type<@R>().isSubtype(of: Type<@AnyResource>())
+      let b = Type<@R>().isSubtype(of: Type<@R{I}>())
+      let c = Type<@R>().isSubtype(of: Type<AnyStruct>())
+      let d = Type<Int>().isSubtype(of: Type<Int?>())
+    `)
+
+	for name, expected := range map[string]bool{
+		"a": true,
+		"b": true,
+		"c": false,
+		"d": true,
+	} {
+		assert.Equal(t,
+			interpreter.BoolValue(expected),
+			inter.Globals[name].Value,
+			name,
+		)
+	}
+}
+
+func TestInterpretGetType(t *testing.T) {
+
+	inter := parseCheckAndInterpret(t, `
+      struct S {}
+
+      let int = 1.getType()
+      let string = "test".getType()
+      let composite = S().getType()
+      let array = [1, 2].getType()
+      let mixedArray = [1 as AnyStruct, "2" as AnyStruct].getType()
+      let dictionary = {"a": 1}.getType()
+      let optional = (1 as Int?).getType()
+      let nilType = (nil as Int?).getType()
+      let type = Type<Int>().getType()
+      let anyStruct = (1 as AnyStruct).getType()
+    `)
+
+	intType := interpreter.TypeStaticType{Type: &sema.IntType{}}
+
+	for name, expected := range map[string]interpreter.StaticType{
+		"int":    intType,
+		"string": interpreter.TypeStaticType{Type: &sema.StringType{}},
+		"composite": interpreter.CompositeStaticType{
+			Location: TestLocation,
+			TypeID:   "test.S",
+		},
+		"array": interpreter.VariableSizedStaticType{
+			Type: intType,
+		},
+		"mixedArray": interpreter.VariableSizedStaticType{
+			Type: interpreter.TypeStaticType{Type: &sema.AnyStructType{}},
+		},
+		"dictionary": interpreter.DictionaryStaticType{
+			KeyType:   interpreter.TypeStaticType{Type: &sema.StringType{}},
+			ValueType: intType,
+		},
+		"optional": interpreter.OptionalStaticType{
+			Type: intType,
+		},
+		"nilType": interpreter.OptionalStaticType{
+			Type: interpreter.TypeStaticType{Type: &sema.NeverType{}},
+		},
+		"type":      interpreter.TypeStaticType{Type: &sema.MetaType{}},
+		"anyStruct": intType,
+	} {
+		assert.Equal(t,
+			interpreter.TypeValue{Type: expected},
+			inter.Globals[name].Value,
+			name,
+		)
+	}
+}
+
+func TestInterpretIsInstance(t *testing.T) {
+
+	inter := parseCheckAndInterpret(t, `
+      resource interface I {}
+
+      resource R: I {}
+
+      struct S {}
+
+      fun test(_ type: Type): Bool {
+          let r <- create R()
+          let isInstance = r.isInstance(type)
+          destroy r
+          return isInstance
+      }
+
+      let a = test(Type<@R>())
+      let b = test(Type<@R{I}>())
+      let c = test(Type<@AnyResource>())
+      let d = test(Type<S>())
+      let e = (1 as AnyStruct).isInstance(Type<Int>())
+      let f = (1 as AnyStruct).isInstance(Type<String>())
+      let g = [1, 2].isInstance(Type<[Int]>())
+      let h = /storage/test.isInstance(Type<Path>())
+    `)
+
+	for name, expected := range map[string]bool{
+		"a": true,
+		"b": true,
+		"c": true,
+		"d": false,
+		"e": true,
+		"f": false,
+		"g": true,
+		"h": true,
+	} {
+		assert.Equal(t,
+			interpreter.BoolValue(expected),
+			inter.Globals[name].Value,
+			name,
+		)
+	}
+}
+
+func TestInterpretGetTypeInfersElementTypes(t *testing.T) {
+
+	// Arrays and dictionaries do not carry their static type,
+	// so the element types are inferred from the elements
+
+	inter := parseCheckAndInterpret(t, `
+      let numbers: [AnyStruct] = [1, 2]
+      let numbersType = numbers.getType()
+      let numbersIsIntArray = numbers.isInstance(Type<[Int]>())
+
+      let emptyArray: [String] = []
+      let emptyArrayType = emptyArray.getType()
+
+      let emptyDictionary: {String: Int} = {}
+      let emptyDictionaryType = emptyDictionary.getType()
+    `)
+
+	intType := interpreter.TypeStaticType{Type: &sema.IntType{}}
+	neverType := interpreter.TypeStaticType{Type: &sema.NeverType{}}
+
+	for name, expected := range map[string]interpreter.Value{
+		"numbersType": interpreter.TypeValue{
+			Type: interpreter.VariableSizedStaticType{
+				Type: intType,
+			},
+		},
+		"numbersIsIntArray": interpreter.BoolValue(true),
+		"emptyArrayType": interpreter.TypeValue{
+			Type: interpreter.VariableSizedStaticType{
+				Type: neverType,
+			},
+		},
+		"emptyDictionaryType": interpreter.TypeValue{
+			Type: interpreter.DictionaryStaticType{
+				KeyType:   neverType,
+				ValueType: neverType,
+			},
+		},
+	} {
+		assert.Equal(t,
+			expected,
+			inter.Globals[name].Value,
+			name,
+		)
+	}
+}
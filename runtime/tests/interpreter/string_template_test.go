@@ -0,0 +1,71 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+func TestInterpretStringTemplate(t *testing.T) {
+
+	inter := parseCheckAndInterpret(t, `
+      fun test(_ x: Int): String {
+          let name = "Alice"
+          let balance: UFix64 = 1.5
+          let tags: [String] = ["a", "b"]
+          let nickname: String? = nil
+          let address: Address = 0x1
+          return "\(name) (\(address), \(/storage/vault)): \(balance) x \(x) = \(balance * UFix64(x)), \(x > 1), \(tags), \(nickname) \u{1F3CE}"
+      }
+    `)
+
+	value, err := inter.Invoke("test", interpreter.NewIntValueFromInt64(2))
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		interpreter.NewStringValue(
+			`Alice (0x1, /storage/vault): 1.50000000 x 2 = 3.00000000, true, ["a", "b"], nil 🏎`,
+		),
+		value,
+	)
+}
+
+func TestInterpretStringTemplateEvaluationOrder(t *testing.T) {
+
+	inter := parseCheckAndInterpret(t, `
+      var counter = 0
+
+      fun next(): Int {
+          counter = counter + 1
+          return counter
+      }
+
+      let x = "\(next()), \(next()), \(next())"
+    `)
+
+	assert.Equal(t,
+		interpreter.NewStringValue("1, 2, 3"),
+		inter.Globals["x"].Value,
+	)
+}
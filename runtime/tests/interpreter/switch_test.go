@@ -0,0 +1,194 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+func TestInterpretSwitchStatement(t *testing.T) {
+
+	inter := parseCheckAndInterpret(t, `
+      fun test(_ x: Int): String {
+          switch x {
+          case 1:
+              return "one"
+          case 2, 3:
+              return "two or three"
+          default:
+              return "other"
+          }
+      }
+    `)
+
+	for argument, expected := range map[int64]string{
+		1: "one",
+		2: "two or three",
+		3: "two or three",
+		4: "other",
+	} {
+
+		t.Run(fmt.Sprint(argument), func(t *testing.T) {
+
+			value, err := inter.Invoke("test", interpreter.NewIntValueFromInt64(argument))
+			require.NoError(t, err)
+
+			assert.Equal(t,
+				interpreter.NewStringValue(expected),
+				value,
+			)
+		})
+	}
+}
+
+func TestInterpretSwitchStatementNoMatch(t *testing.T) {
+
+	inter := parseCheckAndInterpret(t, `
+      fun test(_ x: Int): Int {
+          var y = 0
+          switch x {
+          case 1:
+              y = 1
+          }
+          return y
+      }
+    `)
+
+	value, err := inter.Invoke("test", interpreter.NewIntValueFromInt64(2))
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		interpreter.NewIntValueFromInt64(0),
+		value,
+	)
+}
+
+func TestInterpretSwitchStatementCaseEvaluation(t *testing.T) {
+
+	inter := parseCheckAndInterpret(t, `
+      var evaluated: [Int] = []
+
+      fun evaluate(_ x: Int): Int {
+          evaluated.append(x)
+          return x
+      }
+
+      fun test() {
+          switch 2 {
+          case evaluate(1), evaluate(2), evaluate(3):
+              return
+          case evaluate(4):
+              return
+          }
+      }
+    `)
+
+	_, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		interpreter.NewArrayValueUnownedNonCopying(
+			interpreter.NewIntValueFromInt64(1),
+			interpreter.NewIntValueFromInt64(2),
+		),
+		inter.Globals["evaluated"].Value,
+	)
+}
+
+func TestInterpretSwitchStatementBreak(t *testing.T) {
+
+	inter := parseCheckAndInterpret(t, `
+      fun test(_ x: Int): Int {
+          var y = 0
+          while y < 10 {
+              y = y + 1
+              switch x {
+              case 1:
+                  if y < 3 {
+                      break
+                  }
+                  y = y + 10
+              case 2:
+                  y = y + 10
+              default:
+                  continue
+              }
+              y = y + 100
+              break
+          }
+          return y
+      }
+    `)
+
+	for argument, expected := range map[int64]int64{
+		1: 101,
+		2: 111,
+		3: 10,
+	} {
+
+		t.Run(fmt.Sprint(argument), func(t *testing.T) {
+
+			value, err := inter.Invoke("test", interpreter.NewIntValueFromInt64(argument))
+			require.NoError(t, err)
+
+			assert.Equal(t,
+				interpreter.NewIntValueFromInt64(expected),
+				value,
+			)
+		})
+	}
+}
+
+func TestInterpretSwitchStatementEnum(t *testing.T) {
+
+	inter := parseCheckAndInterpret(t, `
+      enum E: UInt8 {
+          case a
+          case b
+      }
+
+      fun test(_ e: E): Int {
+          switch e {
+          case E.a:
+              return 1
+          case E.b:
+              return 2
+          }
+      }
+
+      let a = test(E.a)
+      let b = test(E.b)
+    `)
+
+	assert.Equal(t,
+		interpreter.NewIntValueFromInt64(1),
+		inter.Globals["a"].Value,
+	)
+
+	assert.Equal(t,
+		interpreter.NewIntValueFromInt64(2),
+		inter.Globals["b"].Value,
+	)
+}
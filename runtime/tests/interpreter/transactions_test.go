@@ -221,12 +221,16 @@ func TestInterpretTransactions(t *testing.T) {
 			panicFunction,
 			panicFunction,
 			panicFunction,
+			nil,
+			nil,
 		)
 		signer2 := interpreter.NewAuthAccountValue(
 			interpreter.AddressValue{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2},
 			panicFunction,
 			panicFunction,
 			panicFunction,
+			nil,
+			nil,
 		)
 
 		// first transaction
@@ -264,6 +268,8 @@ func TestInterpretTransactions(t *testing.T) {
 				panicFunction,
 				panicFunction,
 				panicFunction,
+				nil,
+				nil,
 			),
 		}
 
@@ -889,6 +889,162 @@ func TestParseRelationalExpression(t *testing.T) {
 	utils.AssertEqualWithDiff(t, expected, actual)
 }
 
+func TestParseBitwiseExpressions(t *testing.T) {
+
+	actual, _, err := parser.ParseProgram(`
+        let a = 1 | 2 ^ 3 & 4 << 5 + 6
+	`)
+
+	require.NoError(t, err)
+
+	a := &VariableDeclaration{
+		IsConstant: true,
+		Identifier: Identifier{
+			Identifier: "a",
+			Pos:        Position{Offset: 13, Line: 2, Column: 12},
+		},
+		Transfer: &Transfer{
+			Operation: TransferOperationCopy,
+			Pos:       Position{Offset: 15, Line: 2, Column: 14},
+		},
+		Value: &BinaryExpression{
+			Operation: OperationBitwiseOr,
+			Left: &IntegerExpression{
+				Value: big.NewInt(1),
+				Base:  10,
+				Range: Range{
+					StartPos: Position{Offset: 17, Line: 2, Column: 16},
+					EndPos:   Position{Offset: 17, Line: 2, Column: 16},
+				},
+			},
+			Right: &BinaryExpression{
+				Operation: OperationBitwiseXor,
+				Left: &IntegerExpression{
+					Value: big.NewInt(2),
+					Base:  10,
+					Range: Range{
+						StartPos: Position{Offset: 21, Line: 2, Column: 20},
+						EndPos:   Position{Offset: 21, Line: 2, Column: 20},
+					},
+				},
+				Right: &BinaryExpression{
+					Operation: OperationConcat,
+					Left: &IntegerExpression{
+						Value: big.NewInt(3),
+						Base:  10,
+						Range: Range{
+							StartPos: Position{Offset: 25, Line: 2, Column: 24},
+							EndPos:   Position{Offset: 25, Line: 2, Column: 24},
+						},
+					},
+					Right: &BinaryExpression{
+						Operation: OperationBitwiseLeftShift,
+						Left: &IntegerExpression{
+							Value: big.NewInt(4),
+							Base:  10,
+							Range: Range{
+								StartPos: Position{Offset: 29, Line: 2, Column: 28},
+								EndPos:   Position{Offset: 29, Line: 2, Column: 28},
+							},
+						},
+						Right: &BinaryExpression{
+							Operation: OperationPlus,
+							Left: &IntegerExpression{
+								Value: big.NewInt(5),
+								Base:  10,
+								Range: Range{
+									StartPos: Position{Offset: 34, Line: 2, Column: 33},
+									EndPos:   Position{Offset: 34, Line: 2, Column: 33},
+								},
+							},
+							Right: &IntegerExpression{
+								Value: big.NewInt(6),
+								Base:  10,
+								Range: Range{
+									StartPos: Position{Offset: 38, Line: 2, Column: 37},
+									EndPos:   Position{Offset: 38, Line: 2, Column: 37},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		StartPos: Position{Offset: 9, Line: 2, Column: 8},
+	}
+
+	expected := &Program{
+		Declarations: []Declaration{a},
+	}
+
+	utils.AssertEqualWithDiff(t, expected, actual)
+}
+
+func TestParseBitwiseRightShiftAndNotExpression(t *testing.T) {
+
+	actual, _, err := parser.ParseProgram(`
+        let b = ~x >> 2
+	`)
+
+	require.NoError(t, err)
+
+	b := &VariableDeclaration{
+		IsConstant: true,
+		Identifier: Identifier{
+			Identifier: "b",
+			Pos:        Position{Offset: 13, Line: 2, Column: 12},
+		},
+		Transfer: &Transfer{
+			Operation: TransferOperationCopy,
+			Pos:       Position{Offset: 15, Line: 2, Column: 14},
+		},
+		Value: &BinaryExpression{
+			Operation: OperationBitwiseRightShift,
+			Left: &UnaryExpression{
+				Operation: OperationBitwiseNot,
+				Expression: &IdentifierExpression{
+					Identifier: Identifier{
+						Identifier: "x",
+						Pos:        Position{Offset: 18, Line: 2, Column: 17},
+					},
+				},
+				Range: Range{
+					StartPos: Position{Offset: 17, Line: 2, Column: 16},
+					EndPos:   Position{Offset: 18, Line: 2, Column: 17},
+				},
+			},
+			Right: &IntegerExpression{
+				Value: big.NewInt(2),
+				Base:  10,
+				Range: Range{
+					StartPos: Position{Offset: 23, Line: 2, Column: 22},
+					EndPos:   Position{Offset: 23, Line: 2, Column: 22},
+				},
+			},
+		},
+		StartPos: Position{Offset: 9, Line: 2, Column: 8},
+	}
+
+	expected := &Program{
+		Declarations: []Declaration{b},
+	}
+
+	utils.AssertEqualWithDiff(t, expected, actual)
+}
+
+func TestParseInvalidBitwiseShiftWithWhitespace(t *testing.T) {
+
+	for _, code := range []string{
+		`let a = x < < 2`,
+		`let b = x > > 2`,
+	} {
+		_, _, err := parser.ParseProgram(code)
+
+		require.Error(t, err)
+		require.IsType(t, parser.Error{}, err)
+	}
+}
+
 func TestParseAdditiveExpression(t *testing.T) {
 
 	actual, _, err := parser.ParseProgram(`
@@ -1832,6 +1988,122 @@ func TestParseForStatement(t *testing.T) {
 	utils.AssertEqualWithDiff(t, expected, actual)
 }
 
+func TestParseSwitchStatement(t *testing.T) {
+
+	actual, _, err := parser.ParseProgram(`
+	    fun test() {
+            switch x {
+            case 1, 2:
+                return
+            default:
+                return
+            }
+        }
+	`)
+
+	require.NoError(t, err)
+
+	test := &FunctionDeclaration{
+		Access: AccessNotSpecified,
+		Identifier: Identifier{
+			Identifier: "test",
+			Pos:        Position{Offset: 10, Line: 2, Column: 9},
+		},
+		ParameterList: &ParameterList{
+			Range: Range{
+				StartPos: Position{Offset: 14, Line: 2, Column: 13},
+				EndPos:   Position{Offset: 15, Line: 2, Column: 14},
+			},
+		},
+		ReturnTypeAnnotation: &TypeAnnotation{
+			IsResource: false,
+			Type: &NominalType{
+				Identifier: Identifier{
+					Pos: Position{Offset: 15, Line: 2, Column: 14},
+				},
+			},
+			StartPos: Position{Offset: 15, Line: 2, Column: 14},
+		},
+		FunctionBlock: &FunctionBlock{
+			Block: &Block{
+				Statements: []Statement{
+					&SwitchStatement{
+						Expression: &IdentifierExpression{
+							Identifier: Identifier{
+								Identifier: "x",
+								Pos:        Position{Offset: 38, Line: 3, Column: 19},
+							},
+						},
+						Cases: []*SwitchCase{
+							{
+								Expressions: []Expression{
+									&IntegerExpression{
+										Value: big.NewInt(1),
+										Base:  10,
+										Range: Range{
+											StartPos: Position{Offset: 59, Line: 4, Column: 17},
+											EndPos:   Position{Offset: 59, Line: 4, Column: 17},
+										},
+									},
+									&IntegerExpression{
+										Value: big.NewInt(2),
+										Base:  10,
+										Range: Range{
+											StartPos: Position{Offset: 62, Line: 4, Column: 20},
+											EndPos:   Position{Offset: 62, Line: 4, Column: 20},
+										},
+									},
+								},
+								Statements: []Statement{
+									&ReturnStatement{
+										Range: Range{
+											StartPos: Position{Offset: 81, Line: 5, Column: 16},
+											EndPos:   Position{Offset: 86, Line: 5, Column: 21},
+										},
+									},
+								},
+								Range: Range{
+									StartPos: Position{Offset: 54, Line: 4, Column: 12},
+									EndPos:   Position{Offset: 86, Line: 5, Column: 21},
+								},
+							},
+							{
+								Statements: []Statement{
+									&ReturnStatement{
+										Range: Range{
+											StartPos: Position{Offset: 125, Line: 7, Column: 16},
+											EndPos:   Position{Offset: 130, Line: 7, Column: 21},
+										},
+									},
+								},
+								Range: Range{
+									StartPos: Position{Offset: 100, Line: 6, Column: 12},
+									EndPos:   Position{Offset: 130, Line: 7, Column: 21},
+								},
+							},
+						},
+						Range: Range{
+							StartPos: Position{Offset: 31, Line: 3, Column: 12},
+							EndPos:   Position{Offset: 144, Line: 8, Column: 12},
+						},
+					},
+				},
+				Range: Range{
+					StartPos: Position{Offset: 17, Line: 2, Column: 16},
+					EndPos:   Position{Offset: 154, Line: 9, Column: 8},
+				},
+			},
+		},
+		StartPos: Position{Offset: 6, Line: 2, Column: 5},
+	}
+
+	expected := &Program{
+		Declarations: []Declaration{test},
+	}
+
+	utils.AssertEqualWithDiff(t, expected, actual)
+}
+
 func TestParseAssignment(t *testing.T) {
 
 	actual, _, err := parser.ParseProgram(`
@@ -4436,6 +4708,151 @@ func TestParseStringWithUnicode(t *testing.T) {
 	utils.AssertEqualWithDiff(t, expected, actual)
 }
 
+func TestParseStringTemplate(t *testing.T) {
+
+	actual, _, err := parser.ParseExpression(`
+      "x: \(x), sum: \(a + 1)!"
+	`)
+
+	require.NoError(t, err)
+
+	expected := &StringTemplateExpression{
+		Values: []string{"x: ", ", sum: ", "!"},
+		Expressions: []Expression{
+			&IdentifierExpression{
+				Identifier: Identifier{
+					Identifier: "x",
+					Pos:        Position{Offset: 13, Line: 2, Column: 12},
+				},
+			},
+			&BinaryExpression{
+				Operation: OperationPlus,
+				Left: &IdentifierExpression{
+					Identifier: Identifier{
+						Identifier: "a",
+						Pos:        Position{Offset: 24, Line: 2, Column: 23},
+					},
+				},
+				Right: &IntegerExpression{
+					Value: big.NewInt(1),
+					Base:  10,
+					Range: Range{
+						StartPos: Position{Offset: 28, Line: 2, Column: 27},
+						EndPos:   Position{Offset: 28, Line: 2, Column: 27},
+					},
+				},
+			},
+		},
+		Range: Range{
+			StartPos: Position{Offset: 7, Line: 2, Column: 6},
+			EndPos:   Position{Offset: 31, Line: 2, Column: 30},
+		},
+	}
+
+	utils.AssertEqualWithDiff(t, expected, actual)
+}
+
+func TestParseStringTemplateWithUnicodeAndNestedParentheses(t *testing.T) {
+
+	actual, _, err := parser.ParseExpression(`
+      "\u{1F3CE}🏎 \((x))\n"
+	`)
+
+	require.NoError(t, err)
+
+	expected := &StringTemplateExpression{
+		Values: []string{"\U0001F3CE🏎 ", "\n"},
+		Expressions: []Expression{
+			&IdentifierExpression{
+				Identifier: Identifier{
+					Identifier: "x",
+					Pos:        Position{Offset: 22, Line: 2, Column: 21},
+				},
+			},
+		},
+		Range: Range{
+			StartPos: Position{Offset: 7, Line: 2, Column: 6},
+			EndPos:   Position{Offset: 27, Line: 2, Column: 26},
+		},
+	}
+
+	utils.AssertEqualWithDiff(t, expected, actual)
+}
+
+func TestParseStringWithEscapedInterpolation(t *testing.T) {
+
+	actual, _, err := parser.ParseExpression(`
+      "\\(x)"
+	`)
+
+	require.NoError(t, err)
+
+	expected := &StringExpression{
+		Value: "\\(x)",
+		Range: Range{
+			StartPos: Position{Offset: 7, Line: 2, Column: 6},
+			EndPos:   Position{Offset: 13, Line: 2, Column: 12},
+		},
+	}
+
+	utils.AssertEqualWithDiff(t, expected, actual)
+}
+
+func TestParseInvalidStringTemplate(t *testing.T) {
+
+	t.Run("unterminated", func(t *testing.T) {
+
+		_, _, err := parser.ParseExpression(`
+          "a \(x"
+	    `)
+
+		require.IsType(t, parser.Error{}, err)
+
+		errors := err.(parser.Error).Errors
+		require.Len(t, errors, 1)
+
+		require.IsType(t, &parser.UnterminatedStringInterpolationError{}, errors[0])
+
+		assert.Equal(t,
+			Position{Offset: 14, Line: 2, Column: 13},
+			errors[0].(*parser.UnterminatedStringInterpolationError).Pos,
+		)
+	})
+
+	t.Run("extraneous token", func(t *testing.T) {
+
+		_, _, err := parser.ParseExpression(`
+          "a \(x y)"
+	    `)
+
+		require.IsType(t, parser.Error{}, err)
+
+		errors := err.(parser.Error).Errors
+		require.Len(t, errors, 1)
+
+		require.IsType(t, &parser.SyntaxError{}, errors[0])
+
+		assert.Equal(t,
+			Position{Offset: 18, Line: 2, Column: 17},
+			errors[0].(*parser.SyntaxError).Pos,
+		)
+	})
+
+	t.Run("import", func(t *testing.T) {
+
+		_, _, err := parser.ParseProgram(`
+          import "\(x)"
+	    `)
+
+		require.IsType(t, parser.Error{}, err)
+
+		errors := err.(parser.Error).Errors
+		require.Len(t, errors, 1)
+
+		require.IsType(t, &parser.InvalidImportLocationError{}, errors[0])
+	})
+}
+
 func TestParseConditionMessage(t *testing.T) {
 
 	actual, _, err := parser.ParseProgram(`
@@ -5137,6 +5554,74 @@ func TestParseResource(t *testing.T) {
 	utils.AssertEqualWithDiff(t, expected, actual)
 }
 
+func TestParseEnum(t *testing.T) {
+
+	actual, _, err := parser.ParseProgram(`
+        pub enum E: UInt8 {
+            pub case a
+            case b
+        }
+	`)
+
+	require.NoError(t, err)
+
+	test := &CompositeDeclaration{
+		Access:        AccessPublic,
+		CompositeKind: common.CompositeKindEnum,
+		Identifier: Identifier{
+			Identifier: "E",
+			Pos:        Position{Offset: 18, Line: 2, Column: 17},
+		},
+		Conformances: []*NominalType{
+			{
+				Identifier: Identifier{
+					Identifier: "UInt8",
+					Pos:        Position{Offset: 21, Line: 2, Column: 20},
+				},
+			},
+		},
+		Members: &Members{
+			EnumCases: []*EnumCaseDeclaration{
+				{
+					Access: AccessPublic,
+					Identifier: Identifier{
+						Identifier: "a",
+						Pos:        Position{Offset: 50, Line: 3, Column: 21},
+					},
+					StartPos: Position{Offset: 41, Line: 3, Column: 12},
+				},
+				{
+					Access: AccessNotSpecified,
+					Identifier: Identifier{
+						Identifier: "b",
+						Pos:        Position{Offset: 69, Line: 4, Column: 17},
+					},
+					StartPos: Position{Offset: 64, Line: 4, Column: 12},
+				},
+			},
+		},
+		Range: Range{
+			StartPos: Position{Offset: 9, Line: 2, Column: 8},
+			EndPos:   Position{Offset: 79, Line: 5, Column: 8},
+		},
+	}
+
+	expected := &Program{
+		Declarations: []Declaration{test},
+	}
+
+	utils.AssertEqualWithDiff(t, expected, actual)
+}
+
+func TestParseInvalidEnumCaseIdentifier(t *testing.T) {
+
+	_, _, err := parser.ParseProgram(`
+        let case = 1
+	`)
+
+	assert.Error(t, err)
+}
+
 func TestParseEvent(t *testing.T) {
 
 	actual, _, err := parser.ParseProgram(`
@@ -8003,3 +8488,97 @@ func TestParsePreconditionWithUnaryNegation(t *testing.T) {
 
 	utils.AssertEqualWithDiff(t, expected, actual)
 }
+
+func TestParseInstantiationType(t *testing.T) {
+
+	actual, _, err := parser.ParseProgram(`
+       let x: Capability<&R> = 1
+	`)
+
+	require.NoError(t, err)
+
+	expected := &Program{
+		Declarations: []Declaration{
+			&VariableDeclaration{
+				IsConstant: true,
+				Identifier: Identifier{
+					Identifier: "x",
+					Pos:        Position{Offset: 12, Line: 2, Column: 11},
+				},
+				TypeAnnotation: &TypeAnnotation{
+					IsResource: false,
+					Type: &InstantiationType{
+						Type: &NominalType{
+							Identifier: Identifier{
+								Identifier: "Capability",
+								Pos:        Position{Offset: 15, Line: 2, Column: 14},
+							},
+						},
+						TypeArguments: []*TypeAnnotation{
+							{
+								IsResource: false,
+								Type: &ReferenceType{
+									Type: &NominalType{
+										Identifier: Identifier{
+											Identifier: "R",
+											Pos:        Position{Offset: 27, Line: 2, Column: 26},
+										},
+									},
+									StartPos: Position{Offset: 26, Line: 2, Column: 25},
+								},
+								StartPos: Position{Offset: 26, Line: 2, Column: 25},
+							},
+						},
+						Range: Range{
+							StartPos: Position{Offset: 15, Line: 2, Column: 14},
+							EndPos:   Position{Offset: 28, Line: 2, Column: 27},
+						},
+					},
+					StartPos: Position{Offset: 15, Line: 2, Column: 14},
+				},
+				Transfer: &Transfer{
+					Operation: TransferOperationCopy,
+					Pos:       Position{Offset: 30, Line: 2, Column: 29},
+				},
+				Value: &IntegerExpression{
+					Value: big.NewInt(1),
+					Base:  10,
+					Range: Range{
+						StartPos: Position{Offset: 32, Line: 2, Column: 31},
+						EndPos:   Position{Offset: 32, Line: 2, Column: 31},
+					},
+				},
+				StartPos: Position{Offset: 8, Line: 2, Column: 7},
+			},
+		},
+	}
+
+	utils.AssertEqualWithDiff(t, expected, actual)
+}
+
+func TestParseInstantiationTypeWithWhitespace(t *testing.T) {
+
+	actual, _, err := parser.ParseProgram(`
+	    let x: Capability <&R> = 1
+	`)
+
+	assert.Nil(t, actual)
+
+	require.Error(t, err)
+}
+
+func TestParseOptionalInstantiationType(t *testing.T) {
+
+	actual, _, err := parser.ParseProgram(`
+	    let x: Capability<&{A.I}>? = nil
+	`)
+
+	require.NoError(t, err)
+
+	variableDeclaration := actual.Declarations[0].(*VariableDeclaration)
+
+	assert.Equal(t,
+		"Capability<&{A.I}>?",
+		variableDeclaration.TypeAnnotation.Type.String(),
+	)
+}
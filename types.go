@@ -132,6 +132,16 @@ func (AddressType) ID() string {
 	return "Address"
 }
 
+// MetaType
+
+type MetaType struct{}
+
+func (MetaType) isType() {}
+
+func (MetaType) ID() string {
+	return "Type"
+}
+
 // IntType
 
 type IntType struct{}
@@ -498,6 +508,36 @@ func (t EventType) CompositeInitializers() [][]Parameter {
 	return [][]Parameter{t.Initializer}
 }
 
+// EnumType
+
+type EnumType struct {
+	TypeID       string
+	Identifier   string
+	RawType      Type
+	Fields       []Field
+	Initializers [][]Parameter
+}
+
+func (EnumType) isType() {}
+
+func (t EnumType) ID() string {
+	return t.TypeID
+}
+
+func (EnumType) isCompositeType() {}
+
+func (t EnumType) CompositeIdentifier() string {
+	return t.Identifier
+}
+
+func (t EnumType) CompositeFields() []Field {
+	return t.Fields
+}
+
+func (t EnumType) CompositeInitializers() [][]Parameter {
+	return t.Initializers
+}
+
 // Function
 
 type Function struct {
@@ -173,6 +173,26 @@ func BytesToAddress(b []byte) Address {
 	return a
 }
 
+// TypeValue
+
+type TypeValue struct {
+	StaticType string
+}
+
+func NewTypeValue(staticType string) TypeValue {
+	return TypeValue{StaticType: staticType}
+}
+
+func (TypeValue) isValue() {}
+
+func (TypeValue) Type() Type {
+	return MetaType{}
+}
+
+func (v TypeValue) ToGoValue() interface{} {
+	return v.StaticType
+}
+
 // Int
 
 type Int struct {
@@ -786,3 +806,35 @@ func (v Event) ToGoValue() interface{} {
 
 	return ret
 }
+
+// Enum
+
+type Enum struct {
+	EnumType EnumType
+	Fields   []Value
+}
+
+func NewEnum(fields []Value) Enum {
+	return Enum{Fields: fields}
+}
+
+func (Enum) isValue() {}
+
+func (v Enum) Type() Type {
+	return v.EnumType
+}
+
+func (v Enum) WithType(typ EnumType) Enum {
+	v.EnumType = typ
+	return v
+}
+
+func (v Enum) ToGoValue() interface{} {
+	ret := make([]interface{}, len(v.Fields))
+
+	for i, field := range v.Fields {
+		ret[i] = field.ToGoValue()
+	}
+
+	return ret
+}